@@ -46,6 +46,12 @@ const (
 	// resource to indicate that it is related to a specific promotion.
 	AnnotationKeyPromotion = "kargo.akuity.io/promotion"
 
+	// AnnotationKeyFreight is an annotation key that can be set on a resource
+	// or artifact to indicate that it was produced from a specific piece of
+	// Freight. The value of this annotation is expected to be the name of the
+	// Freight.
+	AnnotationKeyFreight = "kargo.akuity.io/freight"
+
 	// AnnotationKeyArgoCDContext is an annotation key that is set on a Stage
 	// to reference the last ArgoCD Applications that were part of a Promotion.
 	AnnotationKeyArgoCDContext = "kargo.akuity.io/argocd-context"
@@ -101,4 +101,15 @@ const (
 	// the criteria have been satisfied, and the absence of the condition or
 	// a status of "False" indicates that no new Freight was created.
 	ConditionTypeFreightCreated = "FreightCreated"
+
+	// ConditionTypeImagesVerified denotes that all images considered for
+	// selection by a Warehouse satisfied the verification policies of their
+	// respective ImageSubscriptions.
+	//
+	// This is a "normal-true" or "positive polarity" condition, meaning that
+	// the presence of the condition with a status of "True" indicates that no
+	// images were rejected, and a status of "False" indicates that one or more
+	// images were rejected. The condition is absent when no ImageSubscription
+	// specifies a verification policy.
+	ConditionTypeImagesVerified = "ImagesVerified"
 )
@@ -12,6 +12,8 @@ const (
 	AnnotationKeyEventFreightCommits         = AnnotationKeyEventPrefix + "freight-commits"
 	AnnotationKeyEventFreightImages          = AnnotationKeyEventPrefix + "freight-images"
 	AnnotationKeyEventFreightCharts          = AnnotationKeyEventPrefix + "freight-charts"
+	AnnotationKeyEventFreightOCIArtifacts    = AnnotationKeyEventPrefix + "freight-oci-artifacts"
+	AnnotationKeyEventFreightReleases        = AnnotationKeyEventPrefix + "freight-releases"
 	AnnotationKeyEventStageName              = AnnotationKeyEventPrefix + "stage-name"
 	AnnotationKeyEventAnalysisRunName        = AnnotationKeyEventPrefix + "analysis-run-name"
 	AnnotationKeyEventVerificationPending    = AnnotationKeyEventPrefix + "verification-pending"
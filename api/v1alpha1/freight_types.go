@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"time"
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -40,6 +41,10 @@ type Freight struct {
 	Images []Image `json:"images,omitempty" protobuf:"bytes,4,rep,name=images"`
 	// Charts describes specific versions of specific Helm charts.
 	Charts []Chart `json:"charts,omitempty" protobuf:"bytes,5,rep,name=charts"`
+	// OCIArtifacts describes specific versions of specific OCI artifacts.
+	OCIArtifacts []OCIArtifact `json:"ociArtifacts,omitempty" protobuf:"bytes,10,rep,name=ociArtifacts"`
+	// Releases describes specific releases of repositories hosted by Git forges.
+	Releases []Release `json:"releases,omitempty" protobuf:"bytes,11,rep,name=releases"`
 	// Status describes the current status of this Freight.
 	Status FreightStatus `json:"status,omitempty" protobuf:"bytes,6,opt,name=status"`
 }
@@ -67,6 +72,33 @@ type GitCommit struct {
 	Author string `json:"author,omitempty" protobuf:"bytes,7,opt,name=author"`
 	// Committer is the person who committed the commit.
 	Committer string `json:"committer,omitempty" protobuf:"bytes,8,opt,name=committer"`
+	// PullRequests describes the pull requests (or equivalent; e.g. GitLab merge
+	// requests) that contain the commit. This is only populated when the
+	// GitSubscription from which the commit was discovered specifies a
+	// PullRequestLookup.
+	PullRequests []PullRequest `json:"pullRequests,omitempty" protobuf:"bytes,9,rep,name=pullRequests"`
+}
+
+// PullRequest describes a pull request (or equivalent; e.g. a GitLab merge
+// request) hosted by a Git provider.
+type PullRequest struct {
+	// Number is the number of the pull request, which is unique only within a
+	// single repository.
+	Number int64 `json:"number,omitempty" protobuf:"varint,1,opt,name=number"`
+	// Title is the title of the pull request.
+	Title string `json:"title,omitempty" protobuf:"bytes,2,opt,name=title"`
+	// URL is the URL of the pull request's web page.
+	URL string `json:"url,omitempty" protobuf:"bytes,3,opt,name=url"`
+	// Labels are the labels applied to the pull request.
+	Labels []string `json:"labels,omitempty" protobuf:"bytes,4,rep,name=labels"`
+}
+
+// Equals returns a bool indicating whether two PullRequests are equivalent.
+func (p PullRequest) Equals(other PullRequest) bool {
+	return p.Number == other.Number &&
+		p.Title == other.Title &&
+		p.URL == other.URL &&
+		slices.Equal(p.Labels, other.Labels)
 }
 
 // DeepEquals returns a bool indicating whether the receiver deep-equals the
@@ -84,7 +116,8 @@ func (g *GitCommit) DeepEquals(other *GitCommit) bool {
 		g.Tag == other.Tag &&
 		g.Message == other.Message &&
 		g.Author == other.Author &&
-		g.Committer == other.Committer
+		g.Committer == other.Committer &&
+		slices.EqualFunc(g.PullRequests, other.PullRequests, PullRequest.Equals)
 }
 
 // Equals returns a bool indicating whether two GitCommits are equivalent.
@@ -122,6 +122,34 @@ func TestGitCommitDeepEquals(t *testing.T) {
 			},
 			expectedResult: false,
 		},
+		{
+			name: "pull requests differ",
+			a: &GitCommit{
+				RepoURL:      "fake-url",
+				ID:           "fake-commit-id",
+				PullRequests: []PullRequest{{Number: 1}},
+			},
+			b: &GitCommit{
+				RepoURL:      "fake-url",
+				ID:           "fake-commit-id",
+				PullRequests: []PullRequest{{Number: 2}},
+			},
+			expectedResult: false,
+		},
+		{
+			name: "pull request labels differ",
+			a: &GitCommit{
+				RepoURL:      "fake-url",
+				ID:           "fake-commit-id",
+				PullRequests: []PullRequest{{Number: 1, Labels: []string{"foo"}}},
+			},
+			b: &GitCommit{
+				RepoURL:      "fake-url",
+				ID:           "fake-commit-id",
+				PullRequests: []PullRequest{{Number: 1, Labels: []string{"bar"}}},
+			},
+			expectedResult: false,
+		},
 		{
 			name: "perfect match",
 			a: &GitCommit{
@@ -132,6 +160,12 @@ func TestGitCommitDeepEquals(t *testing.T) {
 				Message:   "fake-message",
 				Author:    "fake-author",
 				Committer: "fake-committer",
+				PullRequests: []PullRequest{{
+					Number: 1,
+					Title:  "fake-title",
+					URL:    "fake-pr-url",
+					Labels: []string{"fake-label"},
+				}},
 			},
 			b: &GitCommit{
 				RepoURL:   "fake-url",
@@ -141,6 +175,12 @@ func TestGitCommitDeepEquals(t *testing.T) {
 				Message:   "fake-message",
 				Author:    "fake-author",
 				Committer: "fake-committer",
+				PullRequests: []PullRequest{{
+					Number: 1,
+					Title:  "fake-title",
+					URL:    "fake-pr-url",
+					Labels: []string{"fake-label"},
+				}},
 			},
 			expectedResult: true,
 		},
@@ -35,6 +35,18 @@ type ProjectConfigSpec struct {
 	// WebhookReceivers describes Project-specific webhook receivers used for
 	// processing events from various external platforms
 	WebhookReceivers []WebhookReceiverConfig `json:"webhookReceivers,omitempty" protobuf:"bytes,2,rep,name=webhookReceivers"`
+	// PromotionWorker describes the worker Pods that execute the Project's
+	// Promotions. It has no effect unless the controller is configured to
+	// execute Promotions in worker Pods.
+	PromotionWorker *PromotionWorkerConfig `json:"promotionWorker,omitempty" protobuf:"bytes,3,opt,name=promotionWorker"`
+}
+
+// PromotionWorkerConfig describes the worker Pods that execute a Project's
+// Promotions.
+type PromotionWorkerConfig struct {
+	// Resources describes the compute resources (e.g. CPU and memory) requested
+	// by, and the limits enforced on, each worker Pod.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,1,opt,name=resources"`
 }
 
 // ProjectConfigStatus describes the current status of a ProjectConfig.
@@ -93,6 +93,23 @@ const (
 	PromotionStepStatusSkipped PromotionStepStatus = "Skipped"
 )
 
+// PromotionStepBlock identifies the block of steps a PromotionStep belongs to.
+type PromotionStepBlock string
+
+const (
+	// PromotionStepBlockMain denotes a PromotionStep that belongs to the main
+	// sequence of steps of a Promotion.
+	PromotionStepBlockMain PromotionStepBlock = ""
+	// PromotionStepBlockOnFailure denotes a PromotionStep that belongs to the
+	// block of steps that is executed only if the main sequence of steps of a
+	// Promotion failed.
+	PromotionStepBlockOnFailure PromotionStepBlock = "onFailure"
+	// PromotionStepBlockFinally denotes a PromotionStep that belongs to the
+	// block of steps that is always executed after the main sequence of steps
+	// of a Promotion (and any onFailure steps) have completed.
+	PromotionStepBlockFinally PromotionStepBlock = "finally"
+)
+
 // severityByStatus defines relative severity levels for each
 // PromotionStepStatus.
 var severityByStatus = map[PromotionStepStatus]int{
@@ -187,8 +204,40 @@ type PromotionSpec struct {
 	//
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:items:XValidation:message="Promotion step must have uses set and must not reference a task",rule="has(self.uses) && !has(self.task)"
+	// +kubebuilder:validation:items:XValidation:message="Promotion step must have exactly one of uses or parallel set and must not reference a task",rule="!has(self.task) && has(self.uses) != has(self.parallel)"
 	Steps []PromotionStep `json:"steps" protobuf:"bytes,3,rep,name=steps"`
+	// OnFailure specifies the directives to be executed, in order, if any of
+	// the directives in the Steps field failed or errored.
+	//
+	// +kubebuilder:validation:items:XValidation:message="Promotion step must have exactly one of uses or parallel set and must not reference a task",rule="!has(self.task) && has(self.uses) != has(self.parallel)"
+	OnFailure []PromotionStep `json:"onFailure,omitempty" protobuf:"bytes,5,rep,name=onFailure"`
+	// Finally specifies the directives to be executed, in order, after all
+	// directives in the Steps and OnFailure fields have been executed,
+	// regardless of their outcome.
+	//
+	// +kubebuilder:validation:items:XValidation:message="Promotion step must have exactly one of uses or parallel set and must not reference a task",rule="!has(self.task) && has(self.uses) != has(self.parallel)"
+	Finally []PromotionStep `json:"finally,omitempty" protobuf:"bytes,6,rep,name=finally"`
+}
+
+// GetSteps returns all directives of the Promotion in the order in which they
+// are executed: the main sequence of Steps, followed by the OnFailure and
+// Finally blocks. Steps defining a parallel group are directly followed by the
+// steps in the group. The index of a step within the returned slice
+// corresponds to the index used by the CurrentStep and StepExecutionMetadata
+// fields of the PromotionStatus.
+func (s *PromotionSpec) GetSteps() []PromotionStep {
+	steps := make([]PromotionStep, 0, len(s.Steps)+len(s.OnFailure)+len(s.Finally))
+	for _, block := range [][]PromotionStep{s.Steps, s.OnFailure, s.Finally} {
+		for _, step := range block {
+			steps = append(steps, step)
+			if step.Parallel != nil {
+				for _, groupStep := range step.Parallel.Steps {
+					steps = append(steps, groupStep.PromotionStep())
+				}
+			}
+		}
+	}
+	return steps
 }
 
 // PromotionTaskReference describes a reference to a PromotionTask.
@@ -247,6 +296,87 @@ type PromotionStepRetry struct {
 	// and will immediately cause the Promotion to be marked as failed without
 	// further condition.
 	ErrorThreshold uint32 `json:"errorThreshold,omitempty" protobuf:"varint,2,opt,name=errorThreshold"`
+	// Backoff is the policy for delaying the next attempt to execute a step
+	// after an attempt errored or failed. If this field is set to nil, the next
+	// attempt will be made when the Promotion is next reconciled.
+	Backoff *PromotionStepRetryBackoff `json:"backoff,omitempty" protobuf:"bytes,3,opt,name=backoff"`
+	// AttemptTimeout is the maximum interval a single attempt to execute the
+	// step may take. An attempt that exceeds this interval is considered to
+	// have errored and counts towards the ErrorThreshold.
+	//
+	// If this field is set to nil or 0, the duration of an attempt will not be
+	// bounded.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	AttemptTimeout *metav1.Duration `json:"attemptTimeout,omitempty" protobuf:"bytes,4,opt,name=attemptTimeout"`
+}
+
+// PromotionStepRetryBackoff describes an exponential backoff policy for
+// attempts to execute a step.
+type PromotionStepRetryBackoff struct {
+	// InitialInterval is the delay before the first retry of a step. If this
+	// field is set to nil, the effective default will be 10s.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	InitialInterval *metav1.Duration `json:"initialInterval,omitempty" protobuf:"bytes,1,opt,name=initialInterval"`
+	// Factor is the factor the delay is multiplied by after each consecutive
+	// failed attempt. If this field is set to 0, the effective default will be
+	// 2.
+	//
+	// +kubebuilder:validation:Minimum=0
+	Factor int32 `json:"factor,omitempty" protobuf:"varint,2,opt,name=factor"`
+	// MaxInterval is the maximum delay between two attempts. If this field is
+	// set to nil, the effective default will be 5m.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	MaxInterval *metav1.Duration `json:"maxInterval,omitempty" protobuf:"bytes,3,opt,name=maxInterval"`
+	// Jitter is the maximum percentage of the delay that is randomly added to
+	// it, to prevent the steps of many Promotions from being retried in
+	// lockstep.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Jitter int32 `json:"jitter,omitempty" protobuf:"varint,4,opt,name=jitter"`
+}
+
+const (
+	// DefaultPromotionStepRetryBackoffInitialInterval is the default initial
+	// interval of a PromotionStepRetryBackoff.
+	DefaultPromotionStepRetryBackoffInitialInterval = 10 * time.Second
+	// DefaultPromotionStepRetryBackoffFactor is the default factor of a
+	// PromotionStepRetryBackoff.
+	DefaultPromotionStepRetryBackoffFactor = 2
+	// DefaultPromotionStepRetryBackoffMaxInterval is the default maximum
+	// interval of a PromotionStepRetryBackoff.
+	DefaultPromotionStepRetryBackoffMaxInterval = 5 * time.Minute
+)
+
+// GetInterval returns the delay before the next attempt to execute a step
+// after the given number of consecutive failed attempts, not accounting for
+// jitter.
+func (b *PromotionStepRetryBackoff) GetInterval(failedAttempts uint32) time.Duration {
+	interval := DefaultPromotionStepRetryBackoffInitialInterval
+	if b.InitialInterval != nil {
+		interval = b.InitialInterval.Duration
+	}
+	factor := time.Duration(DefaultPromotionStepRetryBackoffFactor)
+	if b.Factor > 0 {
+		factor = time.Duration(b.Factor)
+	}
+	maxInterval := DefaultPromotionStepRetryBackoffMaxInterval
+	if b.MaxInterval != nil {
+		maxInterval = b.MaxInterval.Duration
+	}
+	for i := uint32(1); i < failedAttempts && interval < maxInterval; i++ {
+		interval *= factor
+	}
+	return min(interval, maxInterval)
 }
 
 // GetTimeout returns the Timeout field with the given fallback value.
@@ -257,6 +387,24 @@ func (r *PromotionStepRetry) GetTimeout(fallback time.Duration) time.Duration {
 	return r.Timeout.Duration
 }
 
+// GetAttemptTimeout returns the AttemptTimeout field with the given fallback
+// value.
+func (r *PromotionStepRetry) GetAttemptTimeout(fallback time.Duration) time.Duration {
+	if r == nil || r.AttemptTimeout == nil {
+		return fallback
+	}
+	return r.AttemptTimeout.Duration
+}
+
+// GetBackoff returns the Backoff field, or nil if the PromotionStepRetry is
+// nil.
+func (r *PromotionStepRetry) GetBackoff() *PromotionStepRetryBackoff {
+	if r == nil {
+		return nil
+	}
+	return r.Backoff
+}
+
 // GetErrorThreshold returns the ErrorThreshold field with the given fallback
 // value.
 func (r *PromotionStepRetry) GetErrorThreshold(fallback uint32) uint32 {
@@ -290,6 +438,21 @@ type PromotionStep struct {
 	ContinueOnError bool `json:"continueOnError,omitempty" protobuf:"varint,8,opt,name=continueOnError"`
 	// Retry is the retry policy for this step.
 	Retry *PromotionStepRetry `json:"retry,omitempty" protobuf:"bytes,4,opt,name=retry"`
+	// ForEach is an optional expression that, if present, must evaluate to a
+	// list. When a Promotion is built, the step is expanded into one step for
+	// each item in the list, with the item and its index being available to
+	// expressions as vars.item and vars.index. Each expanded step is assigned
+	// an alias of the form "<alias>[<index>]", and the outputs of all expanded
+	// steps are aggregated into a list under the alias of the original step.
+	// Note that the expression is evaluated when the Promotion is built, and
+	// can therefore not reference the outputs of other steps.
+	ForEach string `json:"forEach,omitempty" protobuf:"bytes,9,opt,name=forEach"`
+	// Parallel is a group of steps that are executed concurrently in place of
+	// this step. A step that defines a parallel group must not set Uses or
+	// Task. The step succeeds only if all steps in the group succeed or are
+	// skipped, and otherwise assumes the status of the step in the group that
+	// fared worst.
+	Parallel *PromotionStepGroup `json:"parallel,omitempty" protobuf:"bytes,10,opt,name=parallel"`
 	// Vars is a list of variables that can be referenced by expressions in
 	// the step's Config. The values override the values specified in the
 	// PromotionSpec.
@@ -316,6 +479,85 @@ func (s *PromotionStep) GetAlias(i int) string {
 	}
 }
 
+// PromotionStepGroup describes a group of steps that are executed
+// concurrently. Each step in the group is executed in its own subdirectory of
+// the Promotion's working directory, named after the alias of the step.
+type PromotionStepGroup struct {
+	// MaxConcurrency is the maximum number of steps in the group that are
+	// executed concurrently. If not specified, all steps in the group are
+	// executed concurrently.
+	//
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrency *int32 `json:"maxConcurrency,omitempty" protobuf:"varint,1,opt,name=maxConcurrency"`
+	// Steps are the steps in the group.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Steps []PromotionGroupStep `json:"steps" protobuf:"bytes,2,rep,name=steps"`
+}
+
+// GetMaxConcurrency returns the MaxConcurrency field, or the number of steps
+// in the group if the MaxConcurrency field is not set.
+func (g *PromotionStepGroup) GetMaxConcurrency() int {
+	if g.MaxConcurrency == nil || *g.MaxConcurrency < 1 {
+		return len(g.Steps)
+	}
+	return int(*g.MaxConcurrency)
+}
+
+// PromotionGroupStep describes a directive to be executed as part of a
+// PromotionStepGroup. It supports a subset of the fields of a PromotionStep.
+type PromotionGroupStep struct {
+	// Uses identifies a runner that can execute this step.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Uses string `json:"uses" protobuf:"bytes,1,opt,name=uses"`
+	// As is the alias this step can be referred to as.
+	As string `json:"as,omitempty" protobuf:"bytes,2,opt,name=as"`
+	// If is an optional expression that, if present, must evaluate to a boolean
+	// value. If the expression evaluates to false, the step will be skipped.
+	// If the expression does not evaluate to a boolean value, the step will be
+	// considered to have failed.
+	If string `json:"if,omitempty" protobuf:"bytes,3,opt,name=if"`
+	// ContinueOnError is a boolean value that, if set to true, will prevent a
+	// failure of this step from impacting the status of the group.
+	ContinueOnError bool `json:"continueOnError,omitempty" protobuf:"varint,4,opt,name=continueOnError"`
+	// Retry is the retry policy for this step.
+	Retry *PromotionStepRetry `json:"retry,omitempty" protobuf:"bytes,5,opt,name=retry"`
+	// Vars is a list of variables that can be referenced by expressions in
+	// the step's Config. The values override the values specified in the
+	// PromotionSpec.
+	Vars []ExpressionVariable `json:"vars,omitempty" protobuf:"bytes,6,rep,name=vars"`
+	// Config is opaque configuration for the PromotionGroupStep that is
+	// understood only by each PromotionGroupStep's implementation. It is legal
+	// to utilize expressions in defining values at any level of this block.
+	// See https://docs.kargo.io/user-guide/reference-docs/expressions for details.
+	Config *apiextensionsv1.JSON `json:"config,omitempty" protobuf:"bytes,7,opt,name=config"`
+}
+
+// GetAlias returns the As field, or a default value in the form of
+// "<group>-step-<i>" if the As field is empty. The index i is provided as an
+// argument to this method and should be the index of the PromotionGroupStep in
+// the group it belongs to.
+func (s *PromotionGroupStep) GetAlias(group string, i int) string {
+	if s.As != "" {
+		return s.As
+	}
+	return fmt.Sprintf("%s-step-%d", group, i+1)
+}
+
+// PromotionStep returns the PromotionGroupStep as a PromotionStep.
+func (s *PromotionGroupStep) PromotionStep() PromotionStep {
+	return PromotionStep{
+		Uses:            s.Uses,
+		As:              s.As,
+		If:              s.If,
+		ContinueOnError: s.ContinueOnError,
+		Retry:           s.Retry,
+		Vars:            s.Vars,
+		Config:          s.Config,
+	}
+}
+
 // PromotionStatus describes the current state of the transition represented by
 // a Promotion.
 type PromotionStatus struct {
@@ -429,6 +671,18 @@ func (s StepExecutionMetadataList) HasFailures() bool {
 	return false
 }
 
+// InBlock returns the StepExecutionMetadata in the list that belong to the
+// specified block of steps.
+func (s StepExecutionMetadataList) InBlock(block PromotionStepBlock) StepExecutionMetadataList {
+	var res StepExecutionMetadataList
+	for _, stepExecMeta := range s {
+		if stepExecMeta.Block == block {
+			res = append(res, stepExecMeta)
+		}
+	}
+	return res
+}
+
 // StepExecutionMetadata tracks metadata pertaining to the execution of
 // a promotion step.
 type StepExecutionMetadata struct {
@@ -451,4 +705,13 @@ type StepExecutionMetadata struct {
 	// also will not permit this failure to impact the overall status of the
 	// Promotion.
 	ContinueOnError bool `json:"continueOnError,omitempty" protobuf:"varint,7,opt,name=continueOnError"`
+	// Block is the block of steps the step belongs to. It is empty for steps
+	// belonging to the main sequence of steps and either "onFailure" or
+	// "finally" otherwise.
+	Block PromotionStepBlock `json:"block,omitempty" protobuf:"bytes,8,opt,name=block"`
+	// Attempts is the number of attempts made to execute the step.
+	Attempts uint32 `json:"attempts,omitempty" protobuf:"varint,9,opt,name=attempts"`
+	// NextAttemptAt is the time before which no further attempt to execute the
+	// step will be made, as determined by the backoff policy of the step.
+	NextAttemptAt *metav1.Time `json:"nextAttemptAt,omitempty" protobuf:"bytes,10,opt,name=nextAttemptAt"`
 }
@@ -186,6 +186,90 @@ func TestPromotionRetry_GetErrorThreshold(t *testing.T) {
 	}
 }
 
+func TestPromotionRetry_GetAttemptTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		retry    *PromotionStepRetry
+		fallback time.Duration
+		want     time.Duration
+	}{
+		{
+			name:     "retry is nil",
+			retry:    nil,
+			fallback: time.Minute,
+			want:     time.Minute,
+		},
+		{
+			name:     "attempt timeout is not set",
+			retry:    &PromotionStepRetry{},
+			fallback: time.Minute,
+			want:     time.Minute,
+		},
+		{
+			name: "attempt timeout is set",
+			retry: &PromotionStepRetry{
+				AttemptTimeout: &metav1.Duration{
+					Duration: 30 * time.Second,
+				},
+			},
+			want: 30 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, tt.retry.GetAttemptTimeout(tt.fallback))
+	}
+}
+
+func TestPromotionStepRetryBackoff_GetInterval(t *testing.T) {
+	tests := []struct {
+		name           string
+		backoff        PromotionStepRetryBackoff
+		failedAttempts uint32
+		want           time.Duration
+	}{
+		{
+			name:           "defaults after first failed attempt",
+			failedAttempts: 1,
+			want:           DefaultPromotionStepRetryBackoffInitialInterval,
+		},
+		{
+			name:           "defaults after third failed attempt",
+			failedAttempts: 3,
+			want:           4 * DefaultPromotionStepRetryBackoffInitialInterval,
+		},
+		{
+			name:           "defaults are capped at default max interval",
+			failedAttempts: 100,
+			want:           DefaultPromotionStepRetryBackoffMaxInterval,
+		},
+		{
+			name: "custom settings",
+			backoff: PromotionStepRetryBackoff{
+				InitialInterval: &metav1.Duration{Duration: time.Second},
+				Factor:          3,
+				MaxInterval:     &metav1.Duration{Duration: time.Hour},
+			},
+			failedAttempts: 3,
+			want:           9 * time.Second,
+		},
+		{
+			name: "custom settings are capped at max interval",
+			backoff: PromotionStepRetryBackoff{
+				InitialInterval: &metav1.Duration{Duration: time.Second},
+				Factor:          3,
+				MaxInterval:     &metav1.Duration{Duration: 5 * time.Second},
+			},
+			failedAttempts: 3,
+			want:           5 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.backoff.GetInterval(tt.failedAttempts))
+		})
+	}
+}
+
 func TestStepExecutionMetadataList_HasFailures(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -250,3 +334,58 @@ func TestStepExecutionMetadataList_HasFailures(t *testing.T) {
 		})
 	}
 }
+
+func TestStepExecutionMetadataList_InBlock(t *testing.T) {
+	metadata := StepExecutionMetadataList{
+		{Alias: "step-1"},
+		{Alias: "on-failure-step-1", Block: PromotionStepBlockOnFailure},
+		{Alias: "step-2"},
+		{Alias: "finally-step-1", Block: PromotionStepBlockFinally},
+	}
+	require.Equal(
+		t,
+		StepExecutionMetadataList{{Alias: "step-1"}, {Alias: "step-2"}},
+		metadata.InBlock(PromotionStepBlockMain),
+	)
+	require.Equal(
+		t,
+		StepExecutionMetadataList{{Alias: "finally-step-1", Block: PromotionStepBlockFinally}},
+		metadata.InBlock(PromotionStepBlockFinally),
+	)
+	require.Empty(t, StepExecutionMetadataList{}.InBlock(PromotionStepBlockOnFailure))
+}
+
+func TestPromotionSpec_GetSteps(t *testing.T) {
+	spec := PromotionSpec{
+		Steps: []PromotionStep{
+			{Uses: "fake-step", As: "step-1"},
+			{
+				As: "group",
+				Parallel: &PromotionStepGroup{
+					Steps: []PromotionGroupStep{
+						{Uses: "fake-step", As: "group-step-1"},
+						{Uses: "fake-step", As: "group-step-2"},
+					},
+				},
+			},
+		},
+		Finally: []PromotionStep{{Uses: "fake-step", As: "finally-step-1"}},
+	}
+	steps := spec.GetSteps()
+	require.Len(t, steps, 5)
+	for i, alias := range []string{"step-1", "group", "group-step-1", "group-step-2", "finally-step-1"} {
+		require.Equal(t, alias, steps[i].As)
+	}
+	require.NotNil(t, steps[1].Parallel)
+	require.Equal(t, "fake-step", steps[2].Uses)
+}
+
+func TestPromotionStepGroup_GetMaxConcurrency(t *testing.T) {
+	group := PromotionStepGroup{
+		Steps: []PromotionGroupStep{{Uses: "fake-step"}, {Uses: "fake-step"}, {Uses: "fake-step"}},
+	}
+	require.Equal(t, 3, group.GetMaxConcurrency())
+	maxConcurrency := int32(2)
+	group.MaxConcurrency = &maxConcurrency
+	require.Equal(t, 2, group.GetMaxConcurrency())
+}
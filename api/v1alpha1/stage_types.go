@@ -8,6 +8,7 @@ import (
 	"slices"
 	"strings"
 
+	batchv1 "k8s.io/api/batch/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -373,10 +374,29 @@ type PromotionTemplateSpec struct {
 	// are listed in this field.
 	//
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses or task set",rule="(has(self.uses) ? !has(self.task) : has(self.task))"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses, task, or parallel set",rule="[has(self.uses), has(self.task), has(self.parallel)].filter(x, x).size() == 1"
 	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set continueOnError",rule="!has(self.task) || !has(self.continueOnError)"
 	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set retry",rule="!has(self.task) || !has(self.retry)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step with a parallel group cannot set retry or forEach",rule="!has(self.parallel) || (!has(self.retry) && !has(self.forEach))"
 	Steps []PromotionStep `json:"steps,omitempty" protobuf:"bytes,1,rep,name=steps"`
+	// OnFailure specifies the directives to be executed, in order, if any of
+	// the directives in the Steps field failed or errored. This is useful for
+	// compensating for a partially applied change.
+	//
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses, task, or parallel set",rule="[has(self.uses), has(self.task), has(self.parallel)].filter(x, x).size() == 1"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set continueOnError",rule="!has(self.task) || !has(self.continueOnError)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set retry",rule="!has(self.task) || !has(self.retry)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step with a parallel group cannot set retry or forEach",rule="!has(self.parallel) || (!has(self.retry) && !has(self.forEach))"
+	OnFailure []PromotionStep `json:"onFailure,omitempty" protobuf:"bytes,3,rep,name=onFailure"`
+	// Finally specifies the directives to be executed, in order, after all
+	// directives in the Steps and OnFailure fields have been executed,
+	// regardless of their outcome. This is useful for cleaning up.
+	//
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses, task, or parallel set",rule="[has(self.uses), has(self.task), has(self.parallel)].filter(x, x).size() == 1"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set continueOnError",rule="!has(self.task) || !has(self.continueOnError)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set retry",rule="!has(self.task) || !has(self.retry)"
+	// +kubebuilder:validation:items:XValidation:message="PromotionTemplate step with a parallel group cannot set retry or forEach",rule="!has(self.parallel) || (!has(self.retry) && !has(self.forEach))"
+	Finally []PromotionStep `json:"finally,omitempty" protobuf:"bytes,4,rep,name=finally"`
 }
 
 // StageStatus describes a Stages's current and recent Freight, health, and
@@ -487,6 +507,10 @@ type FreightReference struct {
 	Images []Image `json:"images,omitempty" protobuf:"bytes,3,rep,name=images"`
 	// Charts describes specific versions of specific Helm charts.
 	Charts []Chart `json:"charts,omitempty" protobuf:"bytes,4,rep,name=charts"`
+	// OCIArtifacts describes specific versions of specific OCI artifacts.
+	OCIArtifacts []OCIArtifact `json:"ociArtifacts,omitempty" protobuf:"bytes,9,rep,name=ociArtifacts"`
+	// Releases describes specific releases of repositories hosted by Git forges.
+	Releases []Release `json:"releases,omitempty" protobuf:"bytes,10,rep,name=releases"`
 }
 
 // FreightCollection is a collection of FreightReferences, each of which
@@ -658,6 +682,76 @@ func (c *Chart) DeepEquals(other *Chart) bool {
 		c.Version == other.Version
 }
 
+// OCIArtifact describes a specific version of an arbitrary OCI artifact.
+type OCIArtifact struct {
+	// RepoURL describes the repository in which the artifact can be found.
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,1,opt,name=repoURL"`
+	// Tag identifies a specific version of the artifact in the repository
+	// specified by RepoURL.
+	Tag string `json:"tag,omitempty" protobuf:"bytes,2,opt,name=tag"`
+	// Digest identifies a specific version of the artifact in the repository
+	// specified by RepoURL. This is a more precise identifier than Tag.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,3,opt,name=digest"`
+	// ArtifactType is the type of the artifact.
+	ArtifactType string `json:"artifactType,omitempty" protobuf:"bytes,4,opt,name=artifactType"`
+	// Annotations is a map of arbitrary metadata for the artifact.
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,5,rep,name=annotations" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+// DeepEquals returns a bool indicating whether the receiver deep-equals the
+// provided OCIArtifact. I.e., all fields must be equal.
+func (o *OCIArtifact) DeepEquals(other *OCIArtifact) bool {
+	if o == nil && other == nil {
+		return true
+	}
+	if o == nil || other == nil {
+		return false
+	}
+	return o.RepoURL == other.RepoURL &&
+		o.Tag == other.Tag &&
+		o.Digest == other.Digest &&
+		o.ArtifactType == other.ArtifactType &&
+		maps.Equal(o.Annotations, other.Annotations)
+}
+
+// Release describes a specific release of a repository hosted by a Git forge.
+type Release struct {
+	// RepoURL is the URL of the repository the release belongs to.
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,1,opt,name=repoURL"`
+	// Tag is the name of the tag the release was created from.
+	Tag string `json:"tag,omitempty" protobuf:"bytes,2,opt,name=tag"`
+	// Name is the name (title) of the release.
+	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+	// URL is the URL of the release's web page.
+	URL string `json:"url,omitempty" protobuf:"bytes,4,opt,name=url"`
+	// Assets is a list of the assets attached to the release.
+	Assets []ReleaseAsset `json:"assets,omitempty" protobuf:"bytes,5,rep,name=assets"`
+}
+
+// DeepEquals returns a bool indicating whether the receiver deep-equals the
+// provided Release. I.e., all fields must be equal.
+func (r *Release) DeepEquals(other *Release) bool {
+	if r == nil && other == nil {
+		return true
+	}
+	if r == nil || other == nil {
+		return false
+	}
+	return r.RepoURL == other.RepoURL &&
+		r.Tag == other.Tag &&
+		r.Name == other.Name &&
+		r.URL == other.URL &&
+		slices.Equal(r.Assets, other.Assets)
+}
+
+// ReleaseAsset describes an asset attached to a release.
+type ReleaseAsset struct {
+	// Name is the name of the asset.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// URL is the URL from which the asset can be downloaded.
+	URL string `json:"url,omitempty" protobuf:"bytes,2,opt,name=url"`
+}
+
 // Health describes the health of a Stage.
 type Health struct {
 	// Status describes the health of the Stage.
@@ -728,7 +822,10 @@ func (r *PromotionReference) GetHealthChecks() []HealthCheckStep {
 }
 
 // Verification describes how to verify that a Promotion has been successful
-// using Argo Rollouts AnalysisTemplates.
+// using either Argo Rollouts AnalysisTemplates or checks performed natively by
+// Kargo.
+//
+// +kubebuilder:validation:XValidation:message="analysisTemplates and checks are mutually exclusive",rule="!has(self.analysisTemplates) || !has(self.checks)"
 type Verification struct {
 	// AnalysisTemplates is a list of AnalysisTemplates from which AnalysisRuns
 	// should be created to verify a Stage's current Freight is fit to be promoted
@@ -739,6 +836,16 @@ type Verification struct {
 	AnalysisRunMetadata *AnalysisRunMetadata `json:"analysisRunMetadata,omitempty" protobuf:"bytes,2,opt,name=analysisRunMetadata"`
 	// Args lists arguments that should be added to all AnalysisRuns.
 	Args []AnalysisRunArgument `json:"args,omitempty" protobuf:"bytes,3,rep,name=args"`
+	// Checks is a list of checks Kargo should perform natively (i.e. without
+	// Argo Rollouts) to verify a Stage's current Freight is fit to be promoted
+	// downstream. Verification succeeds when all checks have succeeded and
+	// fails as soon as any one check has failed. This field is mutually
+	// exclusive with AnalysisTemplates.
+	//
+	// +kubebuilder:validation:items:XValidation:message="check must have exactly one of job, http, or prometheus set",rule="[has(self.job), has(self.http), has(self.prometheus)].filter(x, x).size() == 1"
+	// +listType=map
+	// +listMapKey=name
+	Checks []VerificationCheck `json:"checks,omitempty" protobuf:"bytes,4,rep,name=checks"`
 }
 
 // AnalysisTemplateReference is a reference to an AnalysisTemplate.
@@ -777,6 +884,162 @@ type AnalysisRunArgument struct {
 	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
 }
 
+// VerificationCheck describes a check Kargo performs natively as part of a
+// Verification. A check consists of one or more measurements, each of which is
+// made by running a Job, sending an HTTP request, or querying a
+// Prometheus-compatible endpoint.
+//
+// String fields may contain expressions enclosed by ${{ and }}. These are
+// evaluated prior to each measurement, using the same functions and variables
+// that are available to the args of a Verification. The successExpression and
+// failureExpression fields are themselves expressions, evaluated after each
+// measurement, and must therefore not be enclosed by ${{ and }}.
+type VerificationCheck struct {
+	// Name is the name of the check. It must be unique within the Verification.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Count is the number of measurements to make. If this field is set to 0,
+	// the effective default will be 1.
+	//
+	// +kubebuilder:validation:Minimum=0
+	Count int32 `json:"count,omitempty" protobuf:"varint,2,opt,name=count"`
+	// Interval is the interval between the start of consecutive measurements.
+	// If this field is set to nil, the effective default will be 1m.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	Interval *metav1.Duration `json:"interval,omitempty" protobuf:"bytes,3,opt,name=interval"`
+	// FailureLimit is the number of measurements that may fail (or error)
+	// before the check is considered to have failed (or errored). The default
+	// of 0 causes the check to fail after a single failed measurement.
+	//
+	// +kubebuilder:validation:Minimum=0
+	FailureLimit int32 `json:"failureLimit,omitempty" protobuf:"varint,4,opt,name=failureLimit"`
+	// Job describes a Kubernetes Job to run for each measurement. The
+	// measurement succeeds if the Job completes successfully and fails if the
+	// Job fails.
+	Job *VerificationJobCheck `json:"job,omitempty" protobuf:"bytes,5,opt,name=job"`
+	// HTTP describes an HTTP request to send for each measurement.
+	HTTP *VerificationHTTPCheck `json:"http,omitempty" protobuf:"bytes,6,opt,name=http"`
+	// Prometheus describes a query to run against a Prometheus-compatible
+	// endpoint for each measurement.
+	Prometheus *VerificationPrometheusCheck `json:"prometheus,omitempty" protobuf:"bytes,7,opt,name=prometheus"`
+}
+
+// VerificationJobCheck describes a Kubernetes Job that is run to make a
+// measurement for a VerificationCheck.
+type VerificationJobCheck struct {
+	// Labels are additional labels to apply to each Job.
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,1,rep,name=labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Annotations are additional annotations to apply to each Job.
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,2,rep,name=annotations" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Spec is the specification of the Job. The Job is created in the
+	// namespace of the Stage.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Spec batchv1.JobSpec `json:"spec" protobuf:"bytes,3,opt,name=spec"`
+}
+
+// VerificationHTTPCheck describes an HTTP request that is sent to make a
+// measurement for a VerificationCheck.
+type VerificationHTTPCheck struct {
+	// URL is the URL to send the request to.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Method is the HTTP method of the request. If this field is left empty,
+	// the effective default will be GET.
+	//
+	// +kubebuilder:validation:Enum=GET;HEAD;POST;PUT;PATCH
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+	// Headers are headers to include in the request.
+	Headers []VerificationHTTPHeader `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+	// Body is the body of the request.
+	Body string `json:"body,omitempty" protobuf:"bytes,4,opt,name=body"`
+	// InsecureSkipTLSVerify indicates whether to skip verification of the
+	// server's TLS certificate.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,5,opt,name=insecureSkipTLSVerify"`
+	// Timeout is the maximum duration of the request. If this field is set to
+	// nil, the effective default will be 10s.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,6,opt,name=timeout"`
+	// SuccessExpression is an expression that, if it evaluates to true,
+	// indicates the measurement was successful. The expression is evaluated
+	// against the response, using the same conventions as the http promotion
+	// step. If both this field and FailureExpression are left empty, any 2xx
+	// response is considered successful.
+	SuccessExpression string `json:"successExpression,omitempty" protobuf:"bytes,7,opt,name=successExpression"`
+	// FailureExpression is an expression that, if it evaluates to true,
+	// indicates the measurement failed.
+	FailureExpression string `json:"failureExpression,omitempty" protobuf:"bytes,8,opt,name=failureExpression"`
+}
+
+// VerificationHTTPHeader is a header to include in an HTTP request made by a
+// VerificationCheck.
+type VerificationHTTPHeader struct {
+	// Name is the name of the header.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value is the value of the header. An expression such as
+	// ${{ secret('my-secret').token }} may be used to reference a value stored
+	// in a Secret.
+	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
+}
+
+// VerificationPrometheusCheck describes a query that is run against a
+// Prometheus-compatible endpoint to make a measurement for a VerificationCheck.
+//
+// +kubebuilder:validation:XValidation:message="at least one of successExpression or failureExpression must be set",rule="has(self.successExpression) || has(self.failureExpression)"
+type VerificationPrometheusCheck struct {
+	// Address is the base URL of the Prometheus-compatible API. e.g.
+	// http://prometheus.monitoring.svc:9090
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Query is the PromQL query to run. The query must yield a scalar or an
+	// instant vector.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query" protobuf:"bytes,2,opt,name=query"`
+	// Headers are headers to include in requests to the API. e.g. For
+	// authentication.
+	Headers []VerificationHTTPHeader `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+	// InsecureSkipTLSVerify indicates whether to skip verification of the
+	// server's TLS certificate.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,4,opt,name=insecureSkipTLSVerify"`
+	// Timeout is the maximum duration of the query. If this field is set to
+	// nil, the effective default will be 10s.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+	// +akuity:test-kubebuilder-pattern=Duration
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,5,opt,name=timeout"`
+	// SuccessExpression is an expression that, if it evaluates to true,
+	// indicates the measurement was successful. The result of the query is
+	// available to the expression as `result`. For a scalar, this is a number.
+	// For an instant vector, this is a list of numbers.
+	SuccessExpression string `json:"successExpression,omitempty" protobuf:"bytes,6,opt,name=successExpression"`
+	// FailureExpression is an expression that, if it evaluates to true,
+	// indicates the measurement failed.
+	FailureExpression string `json:"failureExpression,omitempty" protobuf:"bytes,7,opt,name=failureExpression"`
+}
+
 // VerificationInfo contains the details of an instance of a Verification
 // process.
 type VerificationInfo struct {
@@ -800,6 +1063,9 @@ type VerificationInfo struct {
 	AnalysisRun *AnalysisRunReference `json:"analysisRun,omitempty" protobuf:"bytes,3,opt,name=analysisRun"`
 	// FinishTime is the time at which the Verification process finished.
 	FinishTime *metav1.Time `json:"finishTime,omitempty" protobuf:"bytes,6,opt,name=finishTime"`
+	// Checks contains the results of the checks performed natively by Kargo
+	// to implement the Verification process.
+	Checks []VerificationCheckResult `json:"checks,omitempty" protobuf:"bytes,8,rep,name=checks"`
 }
 
 // HasAnalysisRun returns a bool indicating whether the VerificationInfo has an
@@ -808,6 +1074,39 @@ func (v *VerificationInfo) HasAnalysisRun() bool {
 	return v != nil && v.AnalysisRun != nil
 }
 
+// HasChecks returns a bool indicating whether the VerificationInfo is
+// implemented by checks performed natively by Kargo.
+func (v *VerificationInfo) HasChecks() bool {
+	return v != nil && len(v.Checks) > 0
+}
+
+// VerificationCheckResult contains the result of a single VerificationCheck.
+type VerificationCheckResult struct {
+	// Name is the name of the VerificationCheck.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Phase is the current phase of the check.
+	Phase VerificationPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase"`
+	// Message may contain additional information about the outcome of the
+	// most recent measurement.
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// Count is the number of measurements made so far.
+	Count int32 `json:"count,omitempty" protobuf:"varint,4,opt,name=count"`
+	// Successful is the number of successful measurements.
+	Successful int32 `json:"successful,omitempty" protobuf:"varint,5,opt,name=successful"`
+	// Failed is the number of failed measurements.
+	Failed int32 `json:"failed,omitempty" protobuf:"varint,6,opt,name=failed"`
+	// Inconclusive is the number of inconclusive measurements.
+	Inconclusive int32 `json:"inconclusive,omitempty" protobuf:"varint,7,opt,name=inconclusive"`
+	// Error is the number of measurements that could not be made or evaluated.
+	Error int32 `json:"error,omitempty" protobuf:"varint,8,opt,name=error"`
+	// LastMeasurementTime is the time at which the most recent measurement was
+	// started.
+	LastMeasurementTime *metav1.Time `json:"lastMeasurementTime,omitempty" protobuf:"bytes,9,opt,name=lastMeasurementTime"`
+	// Job is the name of the Job implementing a measurement that is still in
+	// progress, if any.
+	Job string `json:"job,omitempty" protobuf:"bytes,10,opt,name=job"`
+}
+
 type VerificationInfoStack []VerificationInfo
 
 // Current returns the VerificationInfo at the top of the stack.
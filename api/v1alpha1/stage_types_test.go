@@ -974,6 +974,184 @@ func TestChartDeepEquals(t *testing.T) {
 	}
 }
 
+func TestOCIArtifactDeepEquals(t *testing.T) {
+	testCases := []struct {
+		name           string
+		a              *OCIArtifact
+		b              *OCIArtifact
+		expectedResult bool
+	}{
+		{
+			name:           "a and b both nil",
+			expectedResult: true,
+		},
+		{
+			name:           "only a is nil",
+			b:              &OCIArtifact{},
+			expectedResult: false,
+		},
+		{
+			name:           "only b is nil",
+			a:              &OCIArtifact{},
+			expectedResult: false,
+		},
+		{
+			name: "repo URLs differ",
+			a: &OCIArtifact{
+				RepoURL: "foo",
+			},
+			b: &OCIArtifact{
+				RepoURL: "bar",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "digests differ",
+			a: &OCIArtifact{
+				RepoURL: "fake-url",
+				Digest:  "foo",
+			},
+			b: &OCIArtifact{
+				RepoURL: "fake-url",
+				Digest:  "bar",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "artifact types differ",
+			a: &OCIArtifact{
+				RepoURL:      "fake-url",
+				ArtifactType: "foo",
+			},
+			b: &OCIArtifact{
+				RepoURL:      "fake-url",
+				ArtifactType: "bar",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "annotations differ",
+			a: &OCIArtifact{
+				RepoURL:     "fake-url",
+				Annotations: map[string]string{"foo": "bar"},
+			},
+			b: &OCIArtifact{
+				RepoURL:     "fake-url",
+				Annotations: map[string]string{"foo": "baz"},
+			},
+			expectedResult: false,
+		},
+		{
+			name: "perfect match",
+			a: &OCIArtifact{
+				RepoURL:      "fake-url",
+				Tag:          "fake-tag",
+				Digest:       "fake-digest",
+				ArtifactType: "fake-type",
+				Annotations:  map[string]string{"foo": "bar"},
+			},
+			b: &OCIArtifact{
+				RepoURL:      "fake-url",
+				Tag:          "fake-tag",
+				Digest:       "fake-digest",
+				ArtifactType: "fake-type",
+				Annotations:  map[string]string{"foo": "bar"},
+			},
+			expectedResult: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expectedResult, testCase.a.DeepEquals(testCase.b))
+			require.Equal(t, testCase.expectedResult, testCase.b.DeepEquals(testCase.a))
+		})
+	}
+}
+
+func TestReleaseDeepEquals(t *testing.T) {
+	testCases := []struct {
+		name           string
+		a              *Release
+		b              *Release
+		expectedResult bool
+	}{
+		{
+			name:           "a and b both nil",
+			expectedResult: true,
+		},
+		{
+			name:           "only a is nil",
+			b:              &Release{},
+			expectedResult: false,
+		},
+		{
+			name:           "only b is nil",
+			a:              &Release{},
+			expectedResult: false,
+		},
+		{
+			name: "repo URLs differ",
+			a: &Release{
+				RepoURL: "foo",
+			},
+			b: &Release{
+				RepoURL: "bar",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "tags differ",
+			a: &Release{
+				RepoURL: "fake-url",
+				Tag:     "foo",
+			},
+			b: &Release{
+				RepoURL: "fake-url",
+				Tag:     "bar",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "assets differ",
+			a: &Release{
+				RepoURL: "fake-url",
+				Assets:  []ReleaseAsset{{Name: "foo", URL: "fake-asset-url"}},
+			},
+			b: &Release{
+				RepoURL: "fake-url",
+				Assets:  []ReleaseAsset{{Name: "bar", URL: "fake-asset-url"}},
+			},
+			expectedResult: false,
+		},
+		{
+			name: "perfect match",
+			a: &Release{
+				RepoURL: "fake-url",
+				Tag:     "fake-tag",
+				Name:    "fake-name",
+				URL:     "fake-release-url",
+				Assets:  []ReleaseAsset{{Name: "fake-asset", URL: "fake-asset-url"}},
+			},
+			b: &Release{
+				RepoURL: "fake-url",
+				Tag:     "fake-tag",
+				Name:    "fake-name",
+				URL:     "fake-release-url",
+				Assets:  []ReleaseAsset{{Name: "fake-asset", URL: "fake-asset-url"}},
+			},
+			expectedResult: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expectedResult, testCase.a.DeepEquals(testCase.b))
+			require.Equal(t, testCase.expectedResult, testCase.b.DeepEquals(testCase.a))
+		})
+	}
+}
+
 func TestStageStatus_UpsertMetadata(t *testing.T) {
 	testCases := []struct {
 		name         string
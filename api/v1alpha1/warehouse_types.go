@@ -3,29 +3,40 @@ package v1alpha1
 import (
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// +kubebuilder:validation:Enum={Lexical,NewestFromBranch,NewestTag,SemVer}
+// +kubebuilder:validation:Enum={Expression,Lexical,NewestFromBranch,NewestTag,SemVer}
 type CommitSelectionStrategy string
 
 const (
+	CommitSelectionStrategyExpression       CommitSelectionStrategy = "Expression"
 	CommitSelectionStrategyLexical          CommitSelectionStrategy = "Lexical"
 	CommitSelectionStrategyNewestFromBranch CommitSelectionStrategy = "NewestFromBranch"
 	CommitSelectionStrategyNewestTag        CommitSelectionStrategy = "NewestTag"
 	CommitSelectionStrategySemVer           CommitSelectionStrategy = "SemVer"
 )
 
-// +kubebuilder:validation:Enum={Digest,Lexical,NewestBuild,SemVer}
+// +kubebuilder:validation:Enum={Digest,Expression,Lexical,NewestBuild,SemVer}
 type ImageSelectionStrategy string
 
 const (
 	ImageSelectionStrategyDigest      ImageSelectionStrategy = "Digest"
+	ImageSelectionStrategyExpression  ImageSelectionStrategy = "Expression"
 	ImageSelectionStrategyLexical     ImageSelectionStrategy = "Lexical"
 	ImageSelectionStrategyNewestBuild ImageSelectionStrategy = "NewestBuild"
 	ImageSelectionStrategySemVer      ImageSelectionStrategy = "SemVer"
 )
 
+// +kubebuilder:validation:Enum={Expression,SemVer}
+type ChartSelectionStrategy string
+
+const (
+	ChartSelectionStrategyExpression ChartSelectionStrategy = "Expression"
+	ChartSelectionStrategySemVer     ChartSelectionStrategy = "SemVer"
+)
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name=Shard,type=string,JSONPath=`.spec.shard`
@@ -83,7 +94,8 @@ type WarehouseSpec struct {
 	// Interval is the reconciliation interval for this Warehouse. On each
 	// reconciliation, the Warehouse will discover new artifacts and optionally
 	// produce new Freight. This field is optional. When left unspecified, the
-	// field is implicitly treated as if its value were "5m0s".
+	// field is implicitly treated as if its value were "5m0s". This field has
+	// no effect when the Schedule field is specified.
 	//
 	// +kubebuilder:validation:Type=string
 	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
@@ -115,6 +127,62 @@ type WarehouseSpec struct {
 	//
 	// +kubebuilder:validation:Optional
 	FreightCreationCriteria *FreightCreationCriteria `json:"freightCreationCriteria,omitempty" protobuf:"bytes,5,opt,name=freightCreationCriteria"`
+	// Schedule is an optional cron expression (e.g. "0 2 * * 1-5") specifying
+	// when the Warehouse should periodically discover new artifacts. When
+	// specified, it takes the place of the Interval field for that purpose. A
+	// Warehouse will still discover artifacts promptly when first created and
+	// when its spec is modified. Descriptors such as "@daily" are also
+	// supported.
+	//
+	// +kubebuilder:validation:Optional
+	Schedule string `json:"schedule,omitempty" protobuf:"bytes,6,opt,name=schedule"`
+	// TimeZone is the name of the time zone (e.g. "America/New_York") in which
+	// the Schedule is evaluated. This field is optional. When left unspecified,
+	// the Schedule is evaluated in UTC.
+	//
+	// +kubebuilder:validation:Optional
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,7,opt,name=timeZone"`
+	// BlackoutWindows are periods of time during which the Warehouse will
+	// neither discover artifacts nor produce Freight, regardless of its Interval
+	// or Schedule. Discovery that would otherwise have occurred during a
+	// blackout window is deferred until the window ends or, for a Warehouse
+	// with a Schedule, until the first scheduled time thereafter.
+	//
+	// +kubebuilder:validation:Optional
+	BlackoutWindows []BlackoutWindow `json:"blackoutWindows,omitempty" protobuf:"bytes,8,rep,name=blackoutWindows"`
+	// RefreshesBypassSchedule specifies whether a request to refresh the
+	// Warehouse (e.g. one issued in response to a webhook from an artifact
+	// repository) should result in immediate artifact discovery even when the
+	// Warehouse's Schedule does not call for it or a blackout window is in
+	// effect. By default, such requests are honored only at the next time
+	// discovery is permitted.
+	//
+	// +kubebuilder:validation:Optional
+	RefreshesBypassSchedule bool `json:"refreshesBypassSchedule,omitempty" protobuf:"varint,9,opt,name=refreshesBypassSchedule"`
+}
+
+// BlackoutWindow is a period of time during which a Warehouse will not
+// discover artifacts.
+type BlackoutWindow struct {
+	// Start is the time at which the window begins.
+	//
+	// +kubebuilder:validation:Required
+	Start metav1.Time `json:"start" protobuf:"bytes,1,opt,name=start"`
+	// End is the time at which the window ends. It must be after Start.
+	//
+	// +kubebuilder:validation:Required
+	End metav1.Time `json:"end" protobuf:"bytes,2,opt,name=end"`
+	// Reason is an optional, human-readable explanation of the window's
+	// purpose. e.g. "End of quarter freeze"
+	//
+	// +kubebuilder:validation:Optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,3,opt,name=reason"`
+}
+
+// Contains returns true if the specified time falls within the
+// BlackoutWindow. The window's start is inclusive and its end is exclusive.
+func (b *BlackoutWindow) Contains(t time.Time) bool {
+	return !t.Before(b.Start.Time) && t.Before(b.End.Time)
 }
 
 // FreightCreationPolicy defines how Freight is created by a Warehouse.
@@ -137,7 +205,9 @@ type FreightCreationCriteria struct {
 }
 
 // RepoSubscription describes a subscription to ONE OF a Git repository, a
-// container image repository, or a Helm chart repository.
+// container image repository, a Helm chart repository, a repository of
+// arbitrary OCI artifacts, or the releases of a repository hosted by a Git
+// forge.
 type RepoSubscription struct {
 	// Git describes a subscriptions to a Git repository.
 	Git *GitSubscription `json:"git,omitempty" protobuf:"bytes,1,opt,name=git"`
@@ -145,9 +215,16 @@ type RepoSubscription struct {
 	Image *ImageSubscription `json:"image,omitempty" protobuf:"bytes,2,opt,name=image"`
 	// Chart describes a subscription to a Helm chart repository.
 	Chart *ChartSubscription `json:"chart,omitempty" protobuf:"bytes,3,opt,name=chart"`
+	// OCI describes a subscription to a repository of arbitrary OCI artifacts.
+	OCI *OCISubscription `json:"oci,omitempty" protobuf:"bytes,4,opt,name=oci"`
+	// Release describes a subscription to the releases of a repository hosted
+	// by a Git forge such as GitHub, GitLab, or Gitea.
+	Release *ReleaseSubscription `json:"release,omitempty" protobuf:"bytes,5,opt,name=release"`
 }
 
 // GitSubscription defines a subscription to a Git repository.
+//
+// +kubebuilder:validation:XValidation:message="If commitSelectionStrategy is Expression, sortKeyExpression must be set",rule="!(has(self.commitSelectionStrategy) && self.commitSelectionStrategy == 'Expression') || has(self.sortKeyExpression)"
 type GitSubscription struct {
 	// URL is the repository's URL. This is a required field.
 	//
@@ -179,6 +256,13 @@ type GitSubscription struct {
 	//   tag. The AllowTagsRegexes and IgnoreTagsRegexes fields can optionally be
 	//   used to narrow the set of tags eligible for selection.
 	//
+	// - "Expression": Selects the commit referenced by the tag with the greatest
+	//   key, as extracted from each tag by the expression in the
+	//   SortKeyExpression field. Useful when tags follow a scheme that none of
+	//   the other strategies order correctly. The AllowTagsRegexes and
+	//   IgnoreTagsRegexes fields can optionally be used to narrow the set of tags
+	//   eligible for selection.
+	//
 	// +kubebuilder:default=NewestFromBranch
 	CommitSelectionStrategy CommitSelectionStrategy `json:"commitSelectionStrategy,omitempty" protobuf:"bytes,2,opt,name=commitSelectionStrategy"`
 	// Branch references a particular branch of the repository. The value in this
@@ -216,7 +300,7 @@ type GitSubscription struct {
 	// AllowTags is a regular expression that can optionally be used to limit the
 	// tags that are considered in determining the newest commit of interest. The
 	// value in this field only has any effect when the CommitSelectionStrategy is
-	// Lexical, NewestTag, or SemVer. This field is optional.
+	// Expression, Lexical, NewestTag, or SemVer. This field is optional.
 	//
 	// Deprecated: Use AllowTagsRegexes instead. Beginning in v1.11.0, artifact
 	// discovery will FAIL if this field is non-empty. This field will be removed
@@ -227,16 +311,16 @@ type GitSubscription struct {
 	// AllowTagsRegexes is a list of regular expressions that can optionally be
 	// used to limit the tags that are considered in determining the newest commit
 	// of interest. The values in this field only have any effect when the
-	// CommitSelectionStrategy is Lexical, NewestTag, or SemVer. This field is
-	// optional.
+	// CommitSelectionStrategy is Expression, Lexical, NewestTag, or SemVer. This
+	// field is optional.
 	//
 	// +kubebuilder:validation:Optional
 	AllowTagsRegexes []string `json:"allowTagsRegexes,omitempty" protobuf:"bytes,13,rep,name=allowTagsRegexes"`
 	// IgnoreTags is a list of tags that must be ignored when determining the
 	// newest commit of interest. No regular expressions or glob patterns are
 	// supported yet. The value in this field only has any effect when the
-	// CommitSelectionStrategy is Lexical, NewestTag, or SemVer. This field is
-	// optional.
+	// CommitSelectionStrategy is Expression, Lexical, NewestTag, or SemVer. This
+	// field is optional.
 	//
 	// Deprecated: Use IgnoreTagsRegexes instead. Beginning in v1.11.0, artifact
 	// discovery will FAIL if this field is non-empty. This field will be removed
@@ -247,8 +331,8 @@ type GitSubscription struct {
 	// IgnoreTagsRegexes is a list of regular expressions that can optionally be
 	// used to exclude tags from consideration when determining the newest commit
 	// of interest. The values in this field only have any effect when the
-	// CommitSelectionStrategy is Lexical, NewestTag, or SemVer. This field is
-	// optional.
+	// CommitSelectionStrategy is Expression, Lexical, NewestTag, or SemVer. This
+	// field is optional.
 	//
 	// +kubebuilder:validation:Optional
 	IgnoreTagsRegexes []string `json:"ignoreTagsRegexes,omitempty" protobuf:"bytes,14,rep,name=ignoreTagsRegexes"`
@@ -258,7 +342,8 @@ type GitSubscription struct {
 	//
 	// For commit-based strategies (NewestFromBranch), the filter applies to
 	// commits and has access to commit metadata variables.
-	// For tag-based strategies (Lexical, NewestTag, SemVer), the filter applies
+	// For tag-based strategies (Expression, Lexical, NewestTag, SemVer), the
+	// filter applies
 	// to tags and has access to tag metadata variables. The filter is applied
 	// after AllowTagsRegexes, IgnoreTagsRegexes, and SemverConstraint fields.
 	//
@@ -277,7 +362,7 @@ type GitSubscription struct {
 	//	   "Name <email>".
 	//   - `subject`: The subject (first line) of the commit message.
 	//
-	// For Lexical, NewestTag, SemVer (tag filtering):
+	// For Expression, Lexical, NewestTag, SemVer (tag filtering):
 	//   - `tag`: The name of the tag.
 	//   - `id`: The ID (sha) of the commit associated with the tag.
 	//   - `creatorDate`: The creation date of an annotated tag, or the commit
@@ -298,6 +383,29 @@ type GitSubscription struct {
 	//
 	// +kubebuilder:validation:Optional
 	ExpressionFilter string `json:"expressionFilter,omitempty" protobuf:"bytes,12,opt,name=expressionFilter"`
+	// SortKeyExpression is an expression that extracts a sortable key from each
+	// tag. The value in this field only has any effect when the
+	// CommitSelectionStrategy is Expression, in which case it is required. Tags
+	// are ordered from greatest to least key.
+	//
+	// The expression should be a valid expr-lang expression that evaluates to
+	// a number, a string, a time, a semantic version (e.g. as returned by the
+	// `semverParse()` function), or a list of any of these, in which case keys
+	// are compared element by element. All tags must produce keys of the same
+	// type. Tags for which the expression evaluates to nil are excluded from
+	// consideration. The variables available to the expression are the same as
+	// those available to the ExpressionFilter for tag-based strategies.
+	//
+	// For example, the following orders tags of the form "2026.10.3-b412" by
+	// date and then by build number:
+	//
+	//   [semverParse(split(tag, "-b")[0]), int(split(tag, "-b")[1])]
+	//
+	// Refer to the expr-lang documentation for more details on syntax and
+	// capabilities of the expression language: https://expr-lang.org.
+	//
+	// +kubebuilder:validation:Optional
+	SortKeyExpression string `json:"sortKeyExpression,omitempty" protobuf:"bytes,15,opt,name=sortKeyExpression"`
 	// InsecureSkipTLSVerify specifies whether certificate verification errors
 	// should be ignored when connecting to the repository. This should be enabled
 	// only with great caution.
@@ -342,11 +450,35 @@ type GitSubscription struct {
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:default=20
 	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,10,opt,name=discoveryLimit"`
+	// PullRequestLookup, when specified, causes the pull requests containing
+	// the latest discovered commit to be looked up using the Git hosting
+	// provider's API. Details of those pull requests (number, title, URL, and
+	// labels) are recorded alongside the commit and carried into any Freight
+	// built from it. This field is optional. Lookups require credentials for the
+	// repository that include an access token with permission to read pull
+	// requests. A failed lookup does not prevent discovery of the commit.
+	//
+	// +kubebuilder:validation:Optional
+	PullRequestLookup *PullRequestLookup `json:"pullRequestLookup,omitempty" protobuf:"bytes,16,opt,name=pullRequestLookup"`
+}
+
+// PullRequestLookup describes how to look up the pull requests containing
+// commits discovered from a Git repository.
+type PullRequestLookup struct {
+	// Provider is the name of the Git provider whose API should be used to look
+	// up pull requests. Currently 'github' and 'gitlab' are supported. When left
+	// unspecified, the provider is inferred from the RepoURL of the
+	// GitSubscription.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=github;gitlab
+	Provider string `json:"provider,omitempty" protobuf:"bytes,1,opt,name=provider"`
 }
 
 // ImageSubscription defines a subscription to an image repository.
 //
 // +kubebuilder:validation:XValidation:message="If imageSelectionStrategy is Digest, constraint must be set",rule="!(self.imageSelectionStrategy == 'Digest') || has(self.constraint)"
+// +kubebuilder:validation:XValidation:message="If imageSelectionStrategy is Expression, sortKeyExpression must be set",rule="!(self.imageSelectionStrategy == 'Expression') || has(self.sortKeyExpression)"
 type ImageSubscription struct {
 	// RepoURL specifies the URL of the image repository to subscribe to. The
 	// value in this field MUST NOT include an image tag. This field is required.
@@ -365,6 +497,13 @@ type ImageSubscription struct {
 	// - "Digest": Selects the image currently referenced by the tag specified
 	//   by the Constraint field.
 	//
+	// - "Expression": Selects the image referenced by the tag with the greatest
+	//   key, as extracted from each tag by the expression in the
+	//   SortKeyExpression field. Useful when tags follow a scheme that none of
+	//   the other strategies order correctly. The AllowTagsRegexes and
+	//   IgnoreTagsRegexes fields can optionally be used to narrow the set of tags
+	//   eligible for selection.
+	//
 	// - "Lexical": Selects the image referenced by the lexicographically greatest
 	//   tag. This strategy is useful when tags embed a leading date or timestamp.
 	//   The AllowTagsRegexes and IgnoreTagsRegexes fields can optionally be used
@@ -441,6 +580,54 @@ type ImageSubscription struct {
 	//
 	// +kubebuilder:validation:Optional
 	IgnoreTagsRegexes []string `json:"ignoreTagsRegexes,omitempty" protobuf:"bytes,14,rep,name=ignoreTagsRegexes"`
+	// ExpressionFilter is an expression that can optionally be used to limit
+	// the images that are considered in determining the newest version of an
+	// image based on their metadata. The filter is applied after the
+	// AllowTagsRegexes, IgnoreTagsRegexes, Constraint, and Platform fields.
+	//
+	// The expression should be a valid expr-lang expression that evaluates to
+	// true or false. When the expression evaluates to true, the image is
+	// included in the set that is considered. When the expression evaluates to
+	// false, the image is excluded.
+	//
+	// Available variables:
+	//   - `tag`: The tag of the image.
+	//   - `digest`: The digest of the image.
+	//   - `annotations`: A map of the image's OCI annotations.
+	//   - `createdAt`: The time at which the image was created. This may be nil
+	//     if the image does not record a creation time, so expressions should
+	//     guard against this (e.g. `createdAt != nil && ...`).
+	//
+	// Refer to the expr-lang documentation for more details on syntax and
+	// capabilities of the expression language: https://expr-lang.org.
+	//
+	// +kubebuilder:validation:Optional
+	ExpressionFilter string `json:"expressionFilter,omitempty" protobuf:"bytes,15,opt,name=expressionFilter"`
+	// SortKeyExpression is an expression that extracts a sortable key from each
+	// tag. The value in this field only has any effect when the
+	// ImageSelectionStrategy is Expression, in which case it is required. Tags
+	// are ordered from greatest to least key.
+	//
+	// The expression should be a valid expr-lang expression that evaluates to
+	// a number, a string, a time, a semantic version (e.g. as returned by the
+	// `semverParse()` function), or a list of any of these, in which case keys
+	// are compared element by element. All tags must produce keys of the same
+	// type. Tags for which the expression evaluates to nil are excluded from
+	// consideration.
+	//
+	// Available variables:
+	//   - `tag`: The tag of the image.
+	//
+	// For example, the following orders tags of the form "2026.10.3-b412" by
+	// date and then by build number:
+	//
+	//   [semverParse(split(tag, "-b")[0]), int(split(tag, "-b")[1])]
+	//
+	// Refer to the expr-lang documentation for more details on syntax and
+	// capabilities of the expression language: https://expr-lang.org.
+	//
+	// +kubebuilder:validation:Optional
+	SortKeyExpression string `json:"sortKeyExpression,omitempty" protobuf:"bytes,17,opt,name=sortKeyExpression"`
 
 	// Platform is a string of the form <os>/<arch> that limits the tags that can
 	// be considered when searching for new versions of an image. This field is
@@ -459,17 +646,112 @@ type ImageSubscription struct {
 	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,8,opt,name=insecureSkipTLSVerify"`
 	// DiscoveryLimit is an optional limit on the number of image references
 	// that can be discovered for this subscription. The limit is applied after
-	// filtering images based on the AllowTagsRegexes and IgnoreTagsRegexes
-	// fields. When left unspecified, the field is implicitly treated as if its
-	// value were "20". The upper limit for this field is 100.
+	// filtering images based on the AllowTagsRegexes, IgnoreTagsRegexes, and
+	// ExpressionFilter fields. When left unspecified, the field is implicitly
+	// treated as if its value were "20". The upper limit for this field is 100.
 	//
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:default=20
 	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,9,opt,name=discoveryLimit"`
+	// Verification is an optional policy describing the signatures and
+	// attestations an image must carry to be eligible for selection. Images
+	// that do not satisfy the policy are never selected and are instead
+	// reported as rejected in the Warehouse's status.
+	//
+	// +kubebuilder:validation:Optional
+	Verification *ImageVerificationPolicy `json:"verification,omitempty" protobuf:"bytes,16,opt,name=verification"`
+}
+
+// ImageVerificationPolicy describes the signatures and attestations an image
+// must carry to be eligible for selection. Signatures and attestations are
+// expected to have been produced by cosign and stored alongside the image in
+// the same repository using cosign's tag-based conventions (i.e.
+// sha256-<digest>.sig and sha256-<digest>.att).
+//
+// An image satisfies the policy if it carries at least one signature that can
+// be verified using any of the PublicKeys or that was produced by any of the
+// Keyless identities AND, for each of the RequiredAttestations, carries an
+// attestation of that predicate type that can be verified in the same manner.
+//
+// +kubebuilder:validation:XValidation:message="At least one of publicKeys or keyless must be set",rule="(has(self.publicKeys) && size(self.publicKeys) > 0) || has(self.keyless)"
+type ImageVerificationPolicy struct {
+	// PublicKeys is a list of references to PEM-encoded public keys, any of
+	// which may be used to verify an image's signatures and attestations.
+	//
+	// +kubebuilder:validation:Optional
+	PublicKeys []ImageVerificationPublicKey `json:"publicKeys,omitempty" protobuf:"bytes,1,rep,name=publicKeys"`
+	// Keyless describes the identities that are trusted to have produced
+	// "keyless" signatures and attestations, i.e. those made using short-lived
+	// certificates issued by a certificate authority such as Sigstore's Fulcio.
+	//
+	// +kubebuilder:validation:Optional
+	Keyless *KeylessVerification `json:"keyless,omitempty" protobuf:"bytes,2,opt,name=keyless"`
+	// RequiredAttestations is a list of in-toto predicate types (e.g.
+	// "https://slsa.dev/provenance/v1") for which an image must carry a
+	// verifiable attestation.
+	//
+	// +kubebuilder:validation:Optional
+	RequiredAttestations []string `json:"requiredAttestations,omitempty" protobuf:"bytes,3,rep,name=requiredAttestations"`
+}
+
+// ImageVerificationPublicKey references a PEM-encoded public key stored in a
+// Secret in the Warehouse's namespace.
+type ImageVerificationPublicKey struct {
+	// SecretRef is a reference to a Secret in the Warehouse's namespace that
+	// contains the public key.
+	//
+	// +kubebuilder:validation:Required
+	SecretRef corev1.LocalObjectReference `json:"secretRef" protobuf:"bytes,1,opt,name=secretRef"`
+	// Key is the key within the Secret's data whose value is the PEM-encoded
+	// public key. When left unspecified, the field is implicitly treated as if
+	// its value were "cosign.pub".
+	//
+	// +kubebuilder:validation:Optional
+	Key string `json:"key,omitempty" protobuf:"bytes,2,opt,name=key"`
+}
+
+// KeylessVerification describes the certificate authorities and identities
+// that are trusted to have produced keyless signatures and attestations.
+type KeylessVerification struct {
+	// TrustedRootsSecretRef is a reference to a Secret in the Warehouse's
+	// namespace that contains the PEM-encoded certificates of the trusted
+	// certificate authority (and any intermediates) under the "ca.crt" key.
+	// The Secret must also contain one or more PEM-encoded transparency log
+	// (Rekor) public keys under the "rekor.pub" key. Every keyless signature
+	// must have been recorded in one of these logs. The time at which it was
+	// integrated into the log, as attested to by the log's signed entry
+	// timestamp, is used to validate short-lived signing certificates.
+	//
+	// +kubebuilder:validation:Required
+	TrustedRootsSecretRef corev1.LocalObjectReference `json:"trustedRootsSecretRef" protobuf:"bytes,1,opt,name=trustedRootsSecretRef"`
+	// Identities is a list of identities, any of which is trusted to have
+	// produced an image's signatures and attestations.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Identities []KeylessIdentity `json:"identities" protobuf:"bytes,2,rep,name=identities"`
+}
+
+// KeylessIdentity describes an identity that is trusted to have produced
+// keyless signatures and attestations.
+type KeylessIdentity struct {
+	// IssuerRegex is a regular expression that must match the OIDC issuer
+	// recorded in the signing certificate. e.g.
+	// "^https://token\.actions\.githubusercontent\.com$"
+	//
+	// +kubebuilder:validation:MinLength=1
+	IssuerRegex string `json:"issuerRegex" protobuf:"bytes,1,opt,name=issuerRegex"`
+	// SubjectRegex is a regular expression that must match the subject (an
+	// email address or URI) recorded in the signing certificate. e.g.
+	// "^https://github\.com/example/repo/\.github/workflows/release\.yaml@refs/tags/.*$"
+	//
+	// +kubebuilder:validation:MinLength=1
+	SubjectRegex string `json:"subjectRegex" protobuf:"bytes,2,opt,name=subjectRegex"`
 }
 
 // ChartSubscription defines a subscription to a Helm chart repository.
+//
+// +kubebuilder:validation:XValidation:message="If selectionStrategy is Expression, sortKeyExpression must be set",rule="!(has(self.selectionStrategy) && self.selectionStrategy == 'Expression') || has(self.sortKeyExpression)"
 type ChartSubscription struct {
 	// RepoURL specifies the URL of a Helm chart repository. It may be a classic
 	// chart repository (using HTTP/S) OR a repository within an OCI registry.
@@ -498,9 +780,68 @@ type ChartSubscription struct {
 	//
 	// +kubebuilder:validation:Optional
 	SemverConstraint string `json:"semverConstraint,omitempty" protobuf:"bytes,3,opt,name=semverConstraint"`
+	// SelectionStrategy specifies the rules for how to order the versions of
+	// the chart. This field is optional. When left unspecified, the field is
+	// implicitly treated as if its value were "SemVer".
+	//
+	// Accepted values:
+	//
+	// - "SemVer": Selects the semantically greatest chart version.
+	//
+	// - "Expression": Selects the chart version with the greatest key, as
+	//   extracted from each version by the expression in the SortKeyExpression
+	//   field. Useful when versions follow a scheme that semantic version
+	//   precedence does not order correctly. The SemverConstraint field can
+	//   optionally be used to narrow the set of versions eligible for selection.
+	//
+	// +kubebuilder:default=SemVer
+	SelectionStrategy ChartSelectionStrategy `json:"selectionStrategy,omitempty" protobuf:"bytes,6,opt,name=selectionStrategy"`
+	// SortKeyExpression is an expression that extracts a sortable key from each
+	// chart version. The value in this field only has any effect when the
+	// SelectionStrategy is Expression, in which case it is required. Versions
+	// are ordered from greatest to least key.
+	//
+	// The expression should be a valid expr-lang expression that evaluates to
+	// a number, a string, a time, a semantic version (e.g. as returned by the
+	// `semverParse()` function), or a list of any of these, in which case keys
+	// are compared element by element. All versions must produce keys of the
+	// same type. Versions for which the expression evaluates to nil are
+	// excluded from consideration.
+	//
+	// Available variables:
+	//   - `version`: The version of the chart.
+	//
+	// Refer to the expr-lang documentation for more details on syntax and
+	// capabilities of the expression language: https://expr-lang.org.
+	//
+	// +kubebuilder:validation:Optional
+	SortKeyExpression string `json:"sortKeyExpression,omitempty" protobuf:"bytes,7,opt,name=sortKeyExpression"`
+	// ExpressionFilter is an expression that can optionally be used to limit
+	// the chart versions that are considered based on their metadata. The
+	// filter is applied after the SemverConstraint field.
+	//
+	// The expression should be a valid expr-lang expression that evaluates to
+	// true or false. When the expression evaluates to true, the chart version
+	// is included in the set that is considered. When the expression evaluates
+	// to false, the chart version is excluded.
+	//
+	// Available variables:
+	//   - `version`: The version of the chart.
+	//   - `appVersion`: The appVersion of the chart.
+	//   - `annotations`: A map of the annotations from the chart's metadata.
+	//
+	// Note that for charts stored in OCI registries, evaluating this expression
+	// requires retrieving the metadata of each candidate chart version.
+	//
+	// Refer to the expr-lang documentation for more details on syntax and
+	// capabilities of the expression language: https://expr-lang.org.
+	//
+	// +kubebuilder:validation:Optional
+	ExpressionFilter string `json:"expressionFilter,omitempty" protobuf:"bytes,5,opt,name=expressionFilter"`
 	// DiscoveryLimit is an optional limit on the number of chart versions that
 	// can be discovered for this subscription. The limit is applied after
-	// filtering charts based on the SemverConstraint field.
+	// filtering charts based on the SemverConstraint and ExpressionFilter
+	// fields.
 	// When left unspecified, the field is implicitly treated as if its value
 	// were "20". The upper limit for this field is 100.
 	//
@@ -510,6 +851,137 @@ type ChartSubscription struct {
 	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,4,opt,name=discoveryLimit"`
 }
 
+// OCISubscription defines a subscription to a repository within an OCI
+// registry that contains arbitrary artifacts, such as Kubernetes manifests,
+// Terraform modules, or WASM plugins, rather than container images.
+//
+// +kubebuilder:validation:XValidation:message="If selectionStrategy is Digest, constraint must be set",rule="!(self.selectionStrategy == 'Digest') || has(self.constraint)"
+// +kubebuilder:validation:XValidation:message="selectionStrategy Expression is not supported for OCI subscriptions",rule="!has(self.selectionStrategy) || self.selectionStrategy != 'Expression'"
+type OCISubscription struct {
+	// RepoURL specifies the URL of the repository to subscribe to. The value in
+	// this field MUST NOT include a tag or digest. This field is required.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^(\w+([\.-]\w+)*(:[\d]+)?/)?(\w+([\.-]\w+)*)(/\w+([\.-]\w+)*)*$`
+	// +akuity:test-kubebuilder-pattern=ImageRepoURL
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// ArtifactTypes is an optional list of artifact types that artifacts must
+	// have to be eligible for selection. The artifact type of an artifact is
+	// read from the artifactType field of its manifest or, if that is not set,
+	// from the media type of its config. When left unspecified, artifacts of
+	// any type are eligible.
+	//
+	// +kubebuilder:validation:Optional
+	ArtifactTypes []string `json:"artifactTypes,omitempty" protobuf:"bytes,2,rep,name=artifactTypes"`
+	// MediaTypes is an optional list of layer media types. When specified, only
+	// artifacts with at least one layer of one of these media types are eligible
+	// for selection.
+	//
+	// +kubebuilder:validation:Optional
+	MediaTypes []string `json:"mediaTypes,omitempty" protobuf:"bytes,3,rep,name=mediaTypes"`
+	// SelectionStrategy specifies the rules for how to identify the newest
+	// version of the artifact specified by the RepoURL field. This field is
+	// optional. When left unspecified, the field is implicitly treated as if its
+	// value were "SemVer". The accepted values and their semantics are the same
+	// as those of the ImageSelectionStrategy field of an ImageSubscription, with
+	// the exceptions that the "NewestBuild" strategy determines the age of an
+	// artifact from the org.opencontainers.image.created annotation of its
+	// manifest and that the "Expression" strategy is not supported.
+	//
+	// +kubebuilder:default=SemVer
+	SelectionStrategy ImageSelectionStrategy `json:"selectionStrategy,omitempty" protobuf:"bytes,4,opt,name=selectionStrategy"`
+	// StrictSemvers specifies whether only "strict" semver tags should be
+	// considered. A "strict" semver tag is one containing ALL of major, minor,
+	// and patch version components. This is enabled by default, but only has any
+	// effect when the SelectionStrategy is SemVer.
+	//
+	// +kubebuilder:default=true
+	StrictSemvers bool `json:"strictSemvers" protobuf:"varint,5,opt,name=strictSemvers"`
+	// Constraint specifies SelectionStrategy-specific constraints on what new
+	// artifact revisions are permissible. Refer to the Constraint field of an
+	// ImageSubscription to learn how each strategy uses this field.
+	//
+	// +kubebuilder:validation:Optional
+	Constraint string `json:"constraint,omitempty" protobuf:"bytes,6,opt,name=constraint"`
+	// AllowTagsRegexes is a list of regular expressions that can optionally be
+	// used to limit the tags that are considered in determining the newest
+	// revision of an artifact. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	AllowTagsRegexes []string `json:"allowTagsRegexes,omitempty" protobuf:"bytes,7,rep,name=allowTagsRegexes"`
+	// IgnoreTagsRegexes is a list of regular expressions that can optionally be
+	// used to exclude tags from consideration when determining the newest
+	// revision of an artifact. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	IgnoreTagsRegexes []string `json:"ignoreTagsRegexes,omitempty" protobuf:"bytes,8,rep,name=ignoreTagsRegexes"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the repository. This should be enabled
+	// only with great caution.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,9,opt,name=insecureSkipTLSVerify"`
+	// DiscoveryLimit is an optional limit on the number of artifacts that can be
+	// discovered for this subscription. The limit is applied after filtering
+	// artifacts based on all other criteria. When left unspecified, the field is
+	// implicitly treated as if its value were "20". The upper limit for this
+	// field is 100.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=20
+	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,10,opt,name=discoveryLimit"`
+}
+
+// ReleaseSubscription defines a subscription to the releases of a repository
+// hosted by a Git forge such as GitHub, GitLab, or Gitea. Releases are
+// discovered using the forge's API rather than by cloning the repository.
+type ReleaseSubscription struct {
+	// RepoURL is the URL of the repository whose releases are subscribed to.
+	// This is a required field.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^https?://[\w.-]+(:\d{1,5})?/.+$`
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// Provider is the name of the Git provider to use for discovering releases.
+	// Currently 'gitea', 'github', and 'gitlab' are supported. When left
+	// unspecified, the provider is inferred from the RepoURL.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=gitea;github;gitlab
+	Provider string `json:"provider,omitempty" protobuf:"bytes,2,opt,name=provider"`
+	// StrictSemvers specifies whether only releases with "strict" semver tags
+	// should be considered. A "strict" semver tag is one containing ALL of
+	// major, minor, and patch version components. This is enabled by default.
+	//
+	// +kubebuilder:default=true
+	StrictSemvers bool `json:"strictSemvers" protobuf:"varint,3,opt,name=strictSemvers"`
+	// SemverConstraint specifies constraints on what releases are considered,
+	// based on their tags. Releases whose tags are not valid semantic versions
+	// are never considered. This field is optional. When left unspecified, there
+	// will be no constraints, which means the release with the semantically
+	// greatest tag will always be used.
+	//
+	// +kubebuilder:validation:Optional
+	SemverConstraint string `json:"semverConstraint,omitempty" protobuf:"bytes,4,opt,name=semverConstraint"`
+	// ExcludePrereleases specifies whether releases that are marked as
+	// prereleases by the Git forge, or whose tags have a semver prerelease
+	// component, should be excluded from consideration.
+	ExcludePrereleases bool `json:"excludePrereleases,omitempty" protobuf:"varint,5,opt,name=excludePrereleases"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the Git forge's API. This should be
+	// enabled only with great caution.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,6,opt,name=insecureSkipTLSVerify"`
+	// DiscoveryLimit is an optional limit on the number of releases that can be
+	// discovered for this subscription. The limit is applied after filtering
+	// releases based on all other criteria. When left unspecified, the field is
+	// implicitly treated as if its value were "20". The upper limit for this
+	// field is 100.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=20
+	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,7,opt,name=discoveryLimit"`
+}
+
 // WarehouseStatus describes a Warehouse's most recently observed state.
 type WarehouseStatus struct {
 	// Conditions contains the last observations of the Warehouse's current
@@ -532,6 +1004,12 @@ type WarehouseStatus struct {
 	LastFreightID string `json:"lastFreightID,omitempty" protobuf:"bytes,8,opt,name=lastFreightID"`
 	// DiscoveredArtifacts holds the artifacts discovered by the Warehouse.
 	DiscoveredArtifacts *DiscoveredArtifacts `json:"discoveredArtifacts,omitempty" protobuf:"bytes,7,opt,name=discoveredArtifacts"`
+	// NextDiscoveryTime is the next time at which the Warehouse is scheduled to
+	// discover artifacts, taking blackout windows into account. It is only set
+	// for Warehouses with a Schedule.
+	//
+	// +optional
+	NextDiscoveryTime *metav1.Time `json:"nextDiscoveryTime,omitempty" protobuf:"bytes,10,opt,name=nextDiscoveryTime"`
 }
 
 // GetConditions implements the conditions.Getter interface.
@@ -566,6 +1044,16 @@ type DiscoveredArtifacts struct {
 	//
 	// +optional
 	Charts []ChartDiscoveryResult `json:"charts,omitempty" protobuf:"bytes,3,rep,name=charts"`
+	// OCIArtifacts holds the artifacts discovered by the Warehouse for the OCI
+	// subscriptions.
+	//
+	// +optional
+	OCIArtifacts []OCIArtifactDiscoveryResult `json:"ociArtifacts,omitempty" protobuf:"bytes,5,rep,name=ociArtifacts"`
+	// Releases holds the releases discovered by the Warehouse for the release
+	// subscriptions.
+	//
+	// +optional
+	Releases []ReleaseDiscoveryResult `json:"releases,omitempty" protobuf:"bytes,6,rep,name=releases"`
 }
 
 // GitDiscoveryResult represents the result of a Git discovery operation for a
@@ -609,6 +1097,10 @@ type DiscoveredCommit struct {
 	// CreatorDate is the commit creation date as specified by the commit, or
 	// the tagger date if the commit belongs to an annotated tag.
 	CreatorDate *metav1.Time `json:"creatorDate,omitempty" protobuf:"bytes,7,opt,name=creatorDate"`
+	// PullRequests describes the pull requests that contain the commit. This
+	// field is only populated for the latest discovered commit, and only when
+	// the GitSubscription specifies a PullRequestLookup.
+	PullRequests []PullRequest `json:"pullRequests,omitempty" protobuf:"bytes,8,rep,name=pullRequests"`
 }
 
 // ImageDiscoveryResult represents the result of an image discovery operation
@@ -630,6 +1122,25 @@ type ImageDiscoveryResult struct {
 	//
 	// +optional
 	References []DiscoveredImageReference `json:"references" protobuf:"bytes,3,rep,name=references"`
+	// Rejected is a list of image references that were otherwise eligible for
+	// selection, but were rejected because they did not satisfy the
+	// ImageSubscription's verification policy. This field is only populated if
+	// the ImageSubscription specifies a verification policy.
+	//
+	// +optional
+	Rejected []RejectedImageReference `json:"rejected,omitempty" protobuf:"bytes,4,rep,name=rejected"`
+}
+
+// RejectedImageReference represents an image reference that was rejected by
+// a Warehouse because it did not satisfy an ImageSubscription's verification
+// policy.
+type RejectedImageReference struct {
+	// Tag is the tag of the image.
+	Tag string `json:"tag" protobuf:"bytes,1,opt,name=tag"`
+	// Digest is the digest of the image.
+	Digest string `json:"digest" protobuf:"bytes,2,opt,name=digest"`
+	// Reason is a human-readable explanation of why the image was rejected.
+	Reason string `json:"reason" protobuf:"bytes,3,opt,name=reason"`
 }
 
 // DiscoveredImageReference represents an image reference discovered by a
@@ -679,6 +1190,85 @@ type ChartDiscoveryResult struct {
 	Versions []string `json:"versions" protobuf:"bytes,4,rep,name=versions"`
 }
 
+// OCIArtifactDiscoveryResult represents the result of an artifact discovery
+// operation for an OCISubscription.
+type OCIArtifactDiscoveryResult struct {
+	// RepoURL is the repository URL of the artifacts, as specified in the
+	// OCISubscription.
+	//
+	// +kubebuilder:validation:MinLength=1
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// References is a list of artifacts discovered by the Warehouse for the
+	// OCISubscription. An empty list indicates that the discovery operation was
+	// successful, but no artifacts matching the OCISubscription criteria were
+	// found.
+	//
+	// +optional
+	References []DiscoveredOCIArtifact `json:"references" protobuf:"bytes,2,rep,name=references"`
+}
+
+// DiscoveredOCIArtifact represents an artifact discovered by a Warehouse for
+// an OCISubscription.
+type DiscoveredOCIArtifact struct {
+	// Tag is the tag of the artifact.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[\w.\-\_]+$`
+	// +akuity:test-kubebuilder-pattern=Tag
+	Tag string `json:"tag" protobuf:"bytes,1,opt,name=tag"`
+	// Digest is the digest of the artifact's manifest.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]+:[a-f0-9]+$`
+	// +akuity:test-kubebuilder-pattern=Digest
+	Digest string `json:"digest" protobuf:"bytes,2,opt,name=digest"`
+	// ArtifactType is the type of the artifact.
+	ArtifactType string `json:"artifactType,omitempty" protobuf:"bytes,3,opt,name=artifactType"`
+	// Annotations is a map of key-value pairs read from the artifact's manifest.
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,4,rep,name=annotations" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// CreatedAt is the time the artifact was created. This field is optional,
+	// and only populated if the artifact's manifest is annotated with its
+	// creation time.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty" protobuf:"bytes,5,opt,name=createdAt"`
+}
+
+// ReleaseDiscoveryResult represents the result of a release discovery
+// operation for a ReleaseSubscription.
+type ReleaseDiscoveryResult struct {
+	// RepoURL is the repository URL of the ReleaseSubscription.
+	//
+	// +kubebuilder:validation:MinLength=1
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// Releases is a list of releases discovered by the Warehouse for the
+	// ReleaseSubscription. An empty list indicates that the discovery operation
+	// was successful, but no releases matching the ReleaseSubscription criteria
+	// were found.
+	//
+	// +optional
+	Releases []DiscoveredRelease `json:"releases" protobuf:"bytes,2,rep,name=releases"`
+}
+
+// DiscoveredRelease represents a release discovered by a Warehouse for a
+// ReleaseSubscription.
+type DiscoveredRelease struct {
+	// Tag is the name of the tag the release was created from.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Tag string `json:"tag" protobuf:"bytes,1,opt,name=tag"`
+	// Name is the name (title) of the release.
+	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
+	// URL is the URL of the release's web page.
+	URL string `json:"url,omitempty" protobuf:"bytes,3,opt,name=url"`
+	// Prerelease indicates whether the release is marked as a prerelease by the
+	// Git forge.
+	Prerelease bool `json:"prerelease,omitempty" protobuf:"varint,4,opt,name=prerelease"`
+	// Assets is a list of the assets attached to the release.
+	Assets []ReleaseAsset `json:"assets,omitempty" protobuf:"bytes,5,rep,name=assets"`
+	// PublishedAt is the time the release was published.
+	PublishedAt *metav1.Time `json:"publishedAt,omitempty" protobuf:"bytes,6,opt,name=publishedAt"`
+}
+
 // +kubebuilder:object:root=true
 
 // WarehouseList is a list of Warehouse resources.
@@ -121,3 +121,16 @@ func TestWarehouse_GetInterval(t *testing.T) {
 		})
 	}
 }
+
+func TestBlackoutWindow_Contains(t *testing.T) {
+	start := time.Date(2025, 3, 24, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	window := &BlackoutWindow{
+		Start: metav1.NewTime(start),
+		End:   metav1.NewTime(end),
+	}
+	require.False(t, window.Contains(start.Add(-time.Nanosecond)))
+	require.True(t, window.Contains(start))
+	require.True(t, window.Contains(start.Add(30*time.Minute)))
+	require.False(t, window.Contains(end))
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/akuity/kargo/pkg/cli/cmd/promote"
 	"github.com/akuity/kargo/pkg/cli/cmd/refresh"
 	"github.com/akuity/kargo/pkg/cli/cmd/revoke"
+	"github.com/akuity/kargo/pkg/cli/cmd/run"
 	"github.com/akuity/kargo/pkg/cli/cmd/server"
 	"github.com/akuity/kargo/pkg/cli/cmd/update"
 	"github.com/akuity/kargo/pkg/cli/cmd/verify"
@@ -55,6 +56,7 @@ func NewRootCommand(cfg clicfg.CLIConfig) *cobra.Command {
 	cmd.AddCommand(logout.NewCommand())
 	cmd.AddCommand(refresh.NewCommand(cfg))
 	cmd.AddCommand(revoke.NewCommand(cfg, streams))
+	cmd.AddCommand(run.NewCommand(cfg, streams))
 	cmd.AddCommand(update.NewCommand(cfg, streams))
 	cmd.AddCommand(dashboard.NewCommand(cfg))
 	cmd.AddCommand(promote.NewCommand(cfg, streams))
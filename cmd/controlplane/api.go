@@ -4,9 +4,15 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"runtime"
+	stdruntime "runtime"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	libargocd "github.com/akuity/kargo/pkg/argocd"
+	argocd "github.com/akuity/kargo/pkg/controller/argocd/api/v1alpha1"
 
 	k8sevent "github.com/akuity/kargo/pkg/event/kubernetes"
 	"github.com/akuity/kargo/pkg/kubernetes/event"
@@ -28,6 +34,11 @@ type apiOptions struct {
 	BindAddress string
 	Port        string
 
+	ArgoCDEnabled    bool
+	ArgoCDKubeConfig string
+
+	StepPluginsConfigPath string
+
 	Logger *logging.Logger
 }
 
@@ -50,7 +61,7 @@ func newAPICommand() *cobra.Command {
 				"Starting Kargo API Server",
 				"version", version.Version,
 				"commit", version.GitCommit,
-				"GOMAXPROCS", runtime.GOMAXPROCS(0),
+				"GOMAXPROCS", stdruntime.GOMAXPROCS(0),
 				"GOMEMLIMIT", os.GetEnv("GOMEMLIMIT", ""),
 			)
 			cmdOpts.complete()
@@ -70,6 +81,11 @@ func (o *apiOptions) complete() {
 	o.BindAddress = os.GetEnv("BIND_ADDRESS", "0.0.0.0")
 	o.Port = os.GetEnv("PORT", "8080")
 
+	o.ArgoCDEnabled = types.MustParseBool(os.GetEnv("ARGOCD_INTEGRATION_ENABLED", "false"))
+	o.ArgoCDKubeConfig = os.GetEnv("ARGOCD_KUBECONFIG", "")
+
+	o.StepPluginsConfigPath = os.GetEnv("STEP_PLUGINS_CONFIG_PATH", "")
+
 	logLevel, logFormat := getLogVars()
 
 	o.Logger = logging.NewLoggerOrDie(logLevel, logFormat)
@@ -117,6 +133,19 @@ func (o *apiOptions) run(ctx context.Context) error {
 		o.Logger.Debug("Argo Rollouts integration is disabled")
 	}
 
+	// Promotion dry runs resolve the Argo CD Applications that argocd-update
+	// steps would update, which requires access to Argo CD.
+	argocdClient, err := o.newArgoCDClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Promotion dry runs never call step plugins, but do validate the
+	// configuration of steps implemented by plugins.
+	if err = registerStepPluginSimulators(o.Logger, o.StepPluginsConfigPath); err != nil {
+		return fmt.Errorf("error registering step plugin simulators: %w", err)
+	}
+
 	if serverCfg.AdminConfig != nil {
 		o.Logger.Info("admin account is enabled")
 	}
@@ -132,6 +161,7 @@ func (o *apiOptions) run(ctx context.Context) error {
 	srv := server.NewServer(
 		serverCfg,
 		kubeClient,
+		argocdClient,
 		rbac.NewKubernetesRolesDatabase(kubeClient),
 		rbac.NewKubernetesServiceAccountsDatabase(
 			kubeClient,
@@ -157,3 +187,52 @@ func (o *apiOptions) run(ctx context.Context) error {
 	}
 	return nil
 }
+
+// newArgoCDClient returns a client for the cluster hosting Argo CD, or nil if
+// Argo CD integration is disabled or Argo CD is not installed.
+func (o *apiOptions) newArgoCDClient(ctx context.Context) (client.Client, error) {
+	if !o.ArgoCDEnabled {
+		o.Logger.Debug("Argo CD integration is disabled")
+		return nil, nil
+	}
+	restCfg, err := kubernetes.GetRestConfig(ctx, o.ArgoCDKubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error loading REST config for Argo CD client: %w", err)
+	}
+	kubernetes.ConfigureQPSBurst(ctx, restCfg, o.QPS, o.Burst)
+
+	if exists, err := argoCDExists(ctx, restCfg, libargocd.Namespace()); !exists || err != nil {
+		// If we are unable to determine if Argo CD is installed, we will
+		// return an error and fail to start the server. Note this will only
+		// happen if we get an inconclusive response from the API server (e.g.
+		// due to network issues), and not if Argo CD is not installed.
+		if err != nil {
+			return nil, fmt.Errorf("unable to determine if Argo CD is installed: %w", err)
+		}
+		o.Logger.Info(
+			"Argo CD integration was enabled, but no Argo CD CRDs were found. " +
+				"Proceeding without Argo CD integration.",
+		)
+		return nil, nil
+	}
+	o.Logger.Debug("Argo CD integration is enabled")
+
+	scheme := runtime.NewScheme()
+	if err = corev1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf(
+			"error adding Kubernetes core API to Argo CD client scheme: %w",
+			err,
+		)
+	}
+	if err = argocd.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf(
+			"error adding Argo CD API to Argo CD client scheme: %w",
+			err,
+		)
+	}
+	argocdClient, err := client.New(restCfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("error creating Argo CD client: %w", err)
+	}
+	return argocdClient, nil
+}
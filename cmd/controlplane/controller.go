@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -33,6 +34,7 @@ import (
 	"github.com/akuity/kargo/pkg/logging"
 	"github.com/akuity/kargo/pkg/os"
 	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/promotion/runner/plugin"
 	"github.com/akuity/kargo/pkg/server/kubernetes"
 	"github.com/akuity/kargo/pkg/types"
 	versionpkg "github.com/akuity/kargo/pkg/x/version"
@@ -61,6 +63,8 @@ type controllerOptions struct {
 	MetricsBindAddress string
 	PprofBindAddress   string
 
+	StepPluginsConfigPath string
+
 	Logger *logging.Logger
 }
 
@@ -114,12 +118,22 @@ func (o *controllerOptions) complete() {
 	o.MetricsBindAddress = os.GetEnv("METRICS_BIND_ADDRESS", "0")
 	o.PprofBindAddress = os.GetEnv("PPROF_BIND_ADDRESS", "")
 
+	o.StepPluginsConfigPath = os.GetEnv("STEP_PLUGINS_CONFIG_PATH", "")
+
 	logLevel, logFormat := getLogVars()
 
 	o.Logger = logging.NewLoggerOrDie(logLevel, logFormat)
 }
 
 func (o *controllerOptions) run(ctx context.Context) error {
+	if err := registerStepPlugins(
+		o.Logger,
+		o.StepPluginsConfigPath,
+		promotion.PodOrchestratorConfigFromEnv().Enabled,
+	); err != nil {
+		return fmt.Errorf("error registering step plugins: %w", err)
+	}
+
 	kargoMgr, localClusterClient, stagesReconcilerCfg, err := o.setupKargoManager(
 		ctx,
 		stages.ReconcilerConfigFromEnv(),
@@ -153,6 +167,52 @@ func (o *controllerOptions) run(ctx context.Context) error {
 	return o.startManagers(ctx, kargoMgr, argocdMgr)
 }
 
+// registerStepPlugins registers the step plugins described by the file at the
+// specified path, if any, with the default step runner registry. When steps
+// are to be executed by promotion worker Pods, plugins those Pods cannot reach
+// are rejected rather than left to fail every Promotion that uses them.
+func registerStepPlugins(logger *logging.Logger, path string, workers bool) error {
+	if path == "" {
+		return nil
+	}
+	cfg, err := plugin.LoadConfig(path)
+	if err != nil {
+		return err
+	}
+	if workers {
+		if err = cfg.ValidateForWorkers(); err != nil {
+			return err
+		}
+	}
+	if err = plugin.Register(promotion.DefaultStepRunnerRegistry, cfg); err != nil {
+		return err
+	}
+	for _, p := range cfg.Plugins {
+		logger.Info("registered step plugin", "name", p.Name)
+	}
+	return nil
+}
+
+// registerStepPluginSimulators loads the step plugins configuration from the
+// specified path, if any, and registers a simulator for each plugin, to be
+// used when the steps of a Promotion are executed as a dry run.
+func registerStepPluginSimulators(logger *logging.Logger, path string) error {
+	if path == "" {
+		return nil
+	}
+	cfg, err := plugin.LoadConfig(path)
+	if err != nil {
+		return err
+	}
+	if err = plugin.RegisterSimulators(promotion.DryRunStepRunnerRegistry, cfg); err != nil {
+		return err
+	}
+	for _, p := range cfg.Plugins {
+		logger.Info("registered step plugin simulator", "name", p.Name)
+	}
+	return nil
+}
+
 func (o *controllerOptions) setupKargoManager(
 	ctx context.Context,
 	stagesReconcilerCfg stages.ReconcilerConfig,
@@ -178,6 +238,12 @@ func (o *controllerOptions) setupKargoManager(
 			err,
 		)
 	}
+	if err = batchv1.AddToScheme(scheme); err != nil {
+		return nil, nil, stagesReconcilerCfg, fmt.Errorf(
+			"error adding Kubernetes batch API to Kargo controller manager scheme: %w",
+			err,
+		)
+	}
 	if err = kargoapi.AddToScheme(scheme); err != nil {
 		return nil, nil, stagesReconcilerCfg, fmt.Errorf(
 			"error adding Kargo API to Kargo controller manager scheme: %w",
@@ -264,6 +330,10 @@ func (o *controllerOptions) setupKargoManager(
 						// ConfigMaps, but ConfigMaps have the potential to be quite large,
 						// so we prefer to not cache them.
 						&corev1.ConfigMap{},
+						// The controller only ever gets Jobs it created itself to
+						// implement verification checks, and only periodically, so
+						// there is no need to watch all Jobs in the cluster.
+						&batchv1.Job{},
 					},
 				},
 			},
@@ -418,21 +488,24 @@ func (o *controllerOptions) setupReconcilers(
 		argoCDClient = argocdMgr.GetClient()
 	}
 
-	healthCheckers.Initialize(argoCDClient)
+	healthCheckClient, err := o.newHealthCheckClient(ctx)
+	if err != nil {
+		return fmt.Errorf("error creating client for health checks: %w", err)
+	}
+	healthCheckers.Initialize(kargoMgr.GetClient(), argoCDClient, healthCheckClient)
 
 	sharedIndexer := indexer.NewSharedFieldIndexer(kargoMgr.GetFieldIndexer())
 
 	if promotionsReconcilerCfg := promotions.ReconcilerConfigFromEnv(); promotionsReconcilerCfg.Enable {
-		if err := promotions.SetupReconcilerWithManager(
+		promoEngine, err := o.newPromotionEngine(ctx, kargoMgr.GetClient(), argoCDClient, credentialsDB)
+		if err != nil {
+			return fmt.Errorf("error setting up promotion engine: %w", err)
+		}
+		if err = promotions.SetupReconcilerWithManager(
 			ctx,
 			kargoMgr,
 			argocdMgr,
-			promotion.NewLocalEngine(
-				kargoMgr.GetClient(),
-				argoCDClient,
-				credentialsDB,
-				promotion.DefaultExprDataCacheFn,
-			),
+			promoEngine,
 			promotionsReconcilerCfg,
 		); err != nil {
 			return fmt.Errorf("error setting up Promotions reconciler: %w", err)
@@ -471,6 +544,73 @@ func (o *controllerOptions) setupReconcilers(
 	return nil
 }
 
+// newHealthCheckClient returns a client for the cluster the controller is
+// running in that is used to assess the health of arbitrary Kubernetes
+// resources. The client is not backed by a cache, as the kinds of resources it
+// is used for are not known in advance.
+func (o *controllerOptions) newHealthCheckClient(ctx context.Context) (client.Client, error) {
+	restCfg, err := kubernetes.GetRestConfig(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("error loading REST config for health check client: %w", err)
+	}
+	kubernetes.ConfigureQPSBurst(ctx, restCfg, o.QPS, o.Burst)
+	restCfg.ContentType = runtime.ContentTypeJSON
+	return client.New(restCfg, client.Options{Scheme: runtime.NewScheme()})
+}
+
+// newPromotionEngine returns the promotion.Engine used by the Promotions
+// reconciler. Steps are executed in-process unless promotion worker Pods are
+// enabled, in which case each Promotion's steps are executed in a worker Pod
+// in the local cluster.
+func (o *controllerOptions) newPromotionEngine(
+	ctx context.Context,
+	kargoClient client.Client,
+	argoCDClient client.Client,
+	credentialsDB credentials.Database,
+) (promotion.Engine, error) {
+	workerCfg := promotion.PodOrchestratorConfigFromEnv()
+	if !workerCfg.Enabled {
+		return promotion.NewLocalEngine(
+			kargoClient,
+			argoCDClient,
+			credentialsDB,
+			promotion.DefaultExprDataCacheFn,
+		), nil
+	}
+
+	// Worker Pods always run in the cluster the controller is running in.
+	restCfg, err := kubernetes.GetRestConfig(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("error loading REST config for promotion worker client: %w", err)
+	}
+	kubernetes.ConfigureQPSBurst(ctx, restCfg, o.QPS, o.Burst)
+
+	scheme := runtime.NewScheme()
+	if err = corev1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf(
+			"error adding Kubernetes core API to promotion worker client scheme: %w",
+			err,
+		)
+	}
+	if err = batchv1.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf(
+			"error adding Kubernetes batch API to promotion worker client scheme: %w",
+			err,
+		)
+	}
+	workerClient, err := client.New(restCfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("error creating promotion worker client: %w", err)
+	}
+
+	orchestrator, err := promotion.NewPodOrchestrator(workerClient, kargoClient, workerCfg)
+	if err != nil {
+		return nil, fmt.Errorf("error creating promotion worker orchestrator: %w", err)
+	}
+	o.Logger.Info("Promotion worker Pods are enabled", "namespace", workerCfg.Namespace)
+	return promotion.NewWorkerPodEngine(orchestrator), nil
+}
+
 func (o *controllerOptions) startManagers(ctx context.Context, kargoMgr, argocdMgr manager.Manager) error {
 	var (
 		errChan = make(chan error)
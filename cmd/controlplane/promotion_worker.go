@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	argocd "github.com/akuity/kargo/pkg/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	credsdb "github.com/akuity/kargo/pkg/credentials/kubernetes"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/os"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/server/kubernetes"
+	"github.com/akuity/kargo/pkg/types"
+	versionpkg "github.com/akuity/kargo/pkg/x/version"
+)
+
+type promotionWorkerOptions struct {
+	Namespace string
+	Name      string
+
+	ControlPlaneKubeConfig string
+	QPS                    float32
+	Burst                  int
+
+	ArgoCDEnabled    bool
+	ArgoCDKubeConfig string
+
+	StepPluginsConfigPath string
+
+	Logger *logging.Logger
+}
+
+func newPromotionWorkerCommand() *cobra.Command {
+	_, format := getLogVars()
+	cmdOpts := &promotionWorkerOptions{
+		// During startup, we enforce use of an info-level logger to ensure that
+		// no important startup messages are missed.
+		Logger: logging.NewLoggerOrDie(logging.InfoLevel, format),
+	}
+
+	cmd := &cobra.Command{
+		Use:               "promotion-worker",
+		Hidden:            true,
+		DisableAutoGenTag: true,
+		SilenceErrors:     true,
+		SilenceUsage:      true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			version := versionpkg.GetVersion()
+
+			cmdOpts.Logger.Info(
+				"Starting Kargo Promotion Worker",
+				"version", version.Version,
+				"commit", version.GitCommit,
+				"namespace", cmdOpts.Namespace,
+				"name", cmdOpts.Name,
+			)
+
+			cmdOpts.complete()
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVar(
+		&cmdOpts.Namespace,
+		"namespace",
+		"",
+		"The namespace of the Secret holding the worker request",
+	)
+	cmd.Flags().StringVar(
+		&cmdOpts.Name,
+		"name",
+		"",
+		"The name of the Secret holding the worker request",
+	)
+
+	return cmd
+}
+
+func (o *promotionWorkerOptions) complete() {
+	o.ControlPlaneKubeConfig = os.GetEnv("KUBECONFIG", "")
+	o.QPS = types.MustParseFloat32(os.GetEnv("KUBE_API_QPS", "50.0"))
+	o.Burst = types.MustParseInt(os.GetEnv("KUBE_API_BURST", "300"))
+
+	o.ArgoCDEnabled = types.MustParseBool(os.GetEnv("ARGOCD_INTEGRATION_ENABLED", "true"))
+	o.ArgoCDKubeConfig = os.GetEnv("ARGOCD_KUBECONFIG", "")
+
+	o.StepPluginsConfigPath = os.GetEnv("STEP_PLUGINS_CONFIG_PATH", "")
+
+	logLevel, logFormat := getLogVars()
+
+	o.Logger = logging.NewLoggerOrDie(logLevel, logFormat)
+}
+
+func (o *promotionWorkerOptions) validate() error {
+	var errs []error
+	if o.Namespace == "" {
+		errs = append(errs, errors.New("--namespace is required"))
+	}
+	if o.Name == "" {
+		errs = append(errs, errors.New("--name is required"))
+	}
+	return errors.Join(errs...)
+}
+
+func (o *promotionWorkerOptions) run(ctx context.Context) error {
+	if err := registerStepPlugins(o.Logger, o.StepPluginsConfigPath, true); err != nil {
+		return fmt.Errorf("error registering step plugins: %w", err)
+	}
+
+	// The worker's request and result are always stored in the cluster the
+	// worker is running in.
+	localClient, err := o.newClient(ctx, "", corev1.AddToScheme)
+	if err != nil {
+		return fmt.Errorf("error creating local cluster client: %w", err)
+	}
+
+	kargoClient, err := o.newClient(
+		ctx,
+		o.ControlPlaneKubeConfig,
+		corev1.AddToScheme,
+		kargoapi.AddToScheme,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating Kargo client: %w", err)
+	}
+
+	var argoCDClient client.Client
+	if o.ArgoCDEnabled {
+		if argoCDClient, err = o.newClient(
+			ctx,
+			o.ArgoCDKubeConfig,
+			corev1.AddToScheme,
+			argocd.AddToScheme,
+		); err != nil {
+			return fmt.Errorf("error creating Argo CD client: %w", err)
+		}
+	}
+
+	// Mirror the controller's behavior of only falling back to the local
+	// cluster for credentials when explicitly enabled.
+	var localClusterClient client.Client
+	if o.ControlPlaneKubeConfig != "" &&
+		os.GetEnv("LOCAL_CLUSTER_CREDS_FALLBACK", "false") == "true" {
+		localClusterClient = localClient
+	}
+	credentialsDB := credsdb.NewDatabase(
+		kargoClient,
+		localClusterClient,
+		credentials.DefaultProviderRegistry,
+		credsdb.DatabaseConfigFromEnv(),
+	)
+
+	return promotion.NewWorker(
+		localClient,
+		promotion.NewLocalOrchestrator(
+			promotion.DefaultStepRunnerRegistry,
+			kargoClient,
+			argoCDClient,
+			credentialsDB,
+			promotion.DefaultExprDataCacheFn,
+		),
+		client.ObjectKey{Namespace: o.Namespace, Name: o.Name},
+	).Run(logging.ContextWithLogger(ctx, o.Logger))
+}
+
+// newClient returns a non-caching client for the cluster described by the
+// specified kubeconfig. If the kubeconfig is empty, the client is for the
+// cluster the worker is running in.
+func (o *promotionWorkerOptions) newClient(
+	ctx context.Context,
+	kubeConfig string,
+	addToScheme ...func(*runtime.Scheme) error,
+) (client.Client, error) {
+	restCfg, err := kubernetes.GetRestConfig(ctx, kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error loading REST config: %w", err)
+	}
+	kubernetes.ConfigureQPSBurst(ctx, restCfg, o.QPS, o.Burst)
+	restCfg.ContentType = runtime.ContentTypeJSON
+
+	scheme := runtime.NewScheme()
+	for _, add := range addToScheme {
+		if err = add(scheme); err != nil {
+			return nil, fmt.Errorf("error building scheme: %w", err)
+		}
+	}
+	return client.New(restCfg, client.Options{Scheme: scheme})
+}
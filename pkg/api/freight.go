@@ -18,7 +18,7 @@ import (
 // GenerateFreightID deterministically calculates a piece of Freight's ID based
 // on its contents and returns it.
 func GenerateFreightID(f *kargoapi.Freight) string {
-	size := len(f.Commits) + len(f.Images) + len(f.Charts)
+	size := len(f.Commits) + len(f.Images) + len(f.Charts) + len(f.OCIArtifacts) + len(f.Releases)
 	artifacts := make([]string, 0, size)
 	for _, commit := range f.Commits {
 		if commit.Tag != "" {
@@ -63,6 +63,23 @@ func GenerateFreightID(f *kargoapi.Freight) string {
 			),
 		)
 	}
+	for _, art := range f.OCIArtifacts {
+		artifacts = append(
+			artifacts,
+			// As with images, BOTH tag and digest are incorporated. The oci:// prefix
+			// prevents an artifact from being confused with an image that has the
+			// same repository URL, tag, and digest.
+			fmt.Sprintf("oci://%s:%s@%s", art.RepoURL, art.Tag, art.Digest),
+		)
+	}
+	for _, release := range f.Releases {
+		artifacts = append(
+			artifacts,
+			// The release:// prefix prevents a release from being confused with a
+			// commit from the same repository.
+			fmt.Sprintf("release://%s:%s", urls.NormalizeGit(release.RepoURL), release.Tag),
+		)
+	}
 	slices.Sort(artifacts)
 	return fmt.Sprintf(
 		"%x",
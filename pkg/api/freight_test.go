@@ -51,6 +51,25 @@ func TestGenerateFreightID(t *testing.T) {
 	// Changing anything should change the result
 	freight.Commits[0].ID = "a-different-fake-commit"
 	require.NotEqual(t, expected, GenerateFreightID(&freight))
+	// Adding an OCI artifact should change the result
+	expected = GenerateFreightID(&freight)
+	freight.OCIArtifacts = []kargoapi.OCIArtifact{
+		{
+			RepoURL: "fake-oci-repo",
+			Tag:     "fake-oci-tag",
+			Digest:  "fake-oci-digest",
+		},
+	}
+	require.NotEqual(t, expected, GenerateFreightID(&freight))
+	// Adding a release should change the result
+	expected = GenerateFreightID(&freight)
+	freight.Releases = []kargoapi.Release{
+		{
+			RepoURL: "https://github.com/fake-org/fake-repo",
+			Tag:     "v1.0.0",
+		},
+	}
+	require.NotEqual(t, expected, GenerateFreightID(&freight))
 }
 
 // TODO(krancour): If we move our actual indexers to this package, we can use
@@ -2,14 +2,20 @@ package promote
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/encoding/protojson"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
 
 	v1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
 	"github.com/akuity/kargo/api/service/v1alpha1/svcv1alpha1connect"
@@ -37,6 +43,7 @@ type promotionOptions struct {
 	DownstreamFrom string
 	Abort          bool
 	Wait           bool
+	DryRun         bool
 }
 
 func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -65,6 +72,10 @@ kargo promote --project=my-project --freight=abc123 --downstream-from=qa
 # Promote a piece of freight specified by alias to stages immediately downstream from the QA stage
 kargo promote --project=my-project --freight-alias=wonky-wombat --downstream-from=qa
 
+# Preview what promoting a piece of freight to the QA stage would do, without
+# persisting a Promotion or causing any side effects
+kargo promote --project=my-project --freight=abc123 --stage=qa --dry-run
+
 # Abort a Promotion by name
 kargo promote --project=my-project --name=my-promotion --abort
 
@@ -132,6 +143,14 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 		"Abort a non-terminal promotion. If set, --%s must be set.", option.NameFlag,
 	))
 	option.Wait(cmd.Flags(), &o.Wait, false, "Wait for the promotion(s) to complete.")
+	option.DryRun(
+		cmd.Flags(), &o.DryRun,
+		fmt.Sprintf(
+			"Execute the promotion without persisting it and with any steps that have side effects "+
+				"simulated, then report the outcome. If set, --%s must be set.",
+			option.StageFlag,
+		),
+	)
 
 	cmd.MarkFlagsOneRequired(option.FreightFlag, option.FreightAliasFlag, option.NameFlag)
 	cmd.MarkFlagsMutuallyExclusive(option.FreightFlag, option.FreightAliasFlag, option.NameFlag)
@@ -140,6 +159,8 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.DownstreamFromFlag, option.AbortFlag)
 
 	cmd.MarkFlagsRequiredTogether(option.NameFlag, option.AbortFlag)
+
+	cmd.MarkFlagsMutuallyExclusive(option.DryRunFlag, option.WaitFlag)
 }
 
 // validate performs validation of the options. If the options are invalid, an
@@ -168,6 +189,9 @@ func (o *promotionOptions) validate() error {
 				fmt.Errorf("either %s or %s is required", option.StageFlag, option.DownstreamFromFlag),
 			)
 		}
+		if o.DryRun && o.Stage == "" {
+			errs = append(errs, fmt.Errorf("%s is required for a dry run", option.StageFlag))
+		}
 	}
 	return errors.Join(errs...)
 }
@@ -207,12 +231,16 @@ func (o *promotionOptions) run(ctx context.Context) error {
 					Freight:      o.FreightName,
 					FreightAlias: o.FreightAlias,
 					Stage:        o.Stage,
+					DryRun:       o.DryRun,
 				},
 			),
 		)
 		if err != nil {
 			return fmt.Errorf("promote stage: %w", err)
 		}
+		if o.DryRun {
+			return o.printDryRunReport(res.Msg.GetDryRunReport())
+		}
 		if o.Wait {
 			if err = waitForPromotion(ctx, kargoSvcCli, res.Msg.GetPromotion()); err != nil {
 				return fmt.Errorf("wait for promotion: %w", err)
@@ -248,6 +276,78 @@ func (o *promotionOptions) run(ctx context.Context) error {
 	return nil
 }
 
+// printDryRunReport prints the provided report if an output format was
+// specified, or a table of the outcome of each step followed by the diff of
+// the changes that would have been pushed otherwise.
+func (o *promotionOptions) printDryRunReport(report *v1alpha1.PromotionDryRunReport) error {
+	if o.OutputFlagSpecified != nil && o.OutputFlagSpecified() {
+		obj, err := dryRunReportToRuntimeObject(report)
+		if err != nil {
+			return err
+		}
+		printer, err := o.ToPrinter()
+		if err != nil {
+			return fmt.Errorf("new printer: %w", err)
+		}
+		return printer.PrintObj(obj, o.Out)
+	}
+
+	if err := printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(
+		newDryRunStepTable(report.GetSteps()),
+		o.Out,
+	); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(o.Out, "\nPhase: %s\n", report.GetPhase()); err != nil {
+		return err
+	}
+	if msg := report.GetMessage(); msg != "" {
+		if _, err := fmt.Fprintf(o.Out, "Message: %s\n", msg); err != nil {
+			return err
+		}
+	}
+	if diff := report.GetDiff(); diff != "" {
+		if _, err := fmt.Fprintf(o.Out, "\n%s", diff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newDryRunStepTable(steps []*v1alpha1.PromotionDryRunStep) *metav1.Table {
+	rows := make([]metav1.TableRow, len(steps))
+	for i, step := range steps {
+		rows[i] = metav1.TableRow{
+			Cells: []any{step.GetAlias(), step.GetUses(), step.GetStatus(), step.GetMessage()},
+		}
+	}
+	return &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Step", Type: "string"},
+			{Name: "Uses", Type: "string"},
+			{Name: "Status", Type: "string"},
+			{Name: "Message", Type: "string"},
+		},
+		Rows: rows,
+	}
+}
+
+func dryRunReportToRuntimeObject(report *v1alpha1.PromotionDryRunReport) (runtime.Object, error) {
+	data, err := protojson.Marshal(report)
+	if err != nil {
+		return nil, fmt.Errorf("marshal dry run report: %w", err)
+	}
+	var content map[string]any
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("unmarshal dry run report: %w", err)
+	}
+	u := &unstructured.Unstructured{}
+	u.SetUnstructuredContent(content)
+	u.SetAPIVersion(kargoapi.GroupVersion.String())
+	u.SetKind("PromotionDryRunReport")
+	return u, nil
+}
+
 func waitForPromotions(
 	ctx context.Context,
 	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
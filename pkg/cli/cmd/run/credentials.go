@@ -0,0 +1,64 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/akuity/kargo/pkg/credentials"
+)
+
+// gitCredentialHelperDB is an implementation of the credentials.Database
+// interface that obtains credentials for Git repositories from the Git
+// credential helpers configured on the local workstation. It does not provide
+// credentials of any other type.
+type gitCredentialHelperDB struct{}
+
+// Get implements the credentials.Database interface.
+func (g *gitCredentialHelperDB) Get(
+	ctx context.Context,
+	_ string,
+	credType credentials.Type,
+	repoURL string,
+) (*credentials.Credentials, error) {
+	if credType != credentials.TypeGit {
+		return nil, nil
+	}
+
+	u, err := url.Parse(repoURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+		// Credential helpers only provide credentials for HTTP(S) URLs.
+		return nil, nil
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "credential", "fill")
+	cmd.Stdin = strings.NewReader(fmt.Sprintf(
+		"protocol=%s\nhost=%s\npath=%s\n\n",
+		u.Scheme, u.Host, strings.TrimPrefix(u.Path, "/"),
+	))
+	// Never prompt the user for credentials.
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	out, err := cmd.Output()
+	if err != nil {
+		// No credential helper was able to provide credentials.
+		return nil, nil
+	}
+
+	creds := &credentials.Credentials{}
+	for _, line := range strings.Split(string(out), "\n") {
+		key, value, _ := strings.Cut(line, "=")
+		switch key {
+		case "username":
+			creds.Username = value
+		case "password":
+			creds.Password = value
+		}
+	}
+	if creds.Password == "" {
+		return nil, nil
+	}
+	return creds, nil
+}
@@ -0,0 +1,470 @@
+package run
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/cli/config"
+	cliio "github.com/akuity/kargo/pkg/cli/io"
+	"github.com/akuity/kargo/pkg/cli/kubernetes"
+	"github.com/akuity/kargo/pkg/cli/option"
+	"github.com/akuity/kargo/pkg/cli/templates"
+	"github.com/akuity/kargo/pkg/kargo"
+	"github.com/akuity/kargo/pkg/promotion"
+	_ "github.com/akuity/kargo/pkg/promotion/runner/builtin" // Register built-in step runners
+)
+
+// defaultPollInterval is the interval at which the steps of a Promotion that
+// is still running are executed again, if no step suggested otherwise.
+const defaultPollInterval = 5 * time.Second
+
+type runPromotionTemplateOptions struct {
+	genericiooptions.IOStreams
+	*genericclioptions.PrintFlags
+
+	Config config.CLIConfig
+
+	Filenames        []string
+	Recursive        bool
+	FreightFilenames []string
+	Project          string
+	Stage            string
+	WorkDir          string
+	Skip             []string
+}
+
+func newRunPromotionTemplateCommand(
+	cfg config.CLIConfig,
+	streams genericiooptions.IOStreams,
+) *cobra.Command {
+	cmdOpts := &runPromotionTemplateOptions{
+		Config:     cfg,
+		IOStreams:  streams,
+		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(kubernetes.GetScheme()),
+	}
+
+	cmd := &cobra.Command{
+		Use: "promotion-template (-f FILENAME) (--freight=FILENAME) [--stage=stage] " +
+			"[--workdir=dir] [--skip=kind-or-alias,...]",
+		Aliases: []string{"promotion-templates"},
+		Short:   "Run the promotion template of a stage locally",
+		Args:    option.NoArgs,
+		Example: templates.Example(`
+# Run the promotion template of a stage
+kargo run promotion-template -f stage.yaml --freight=freight.yaml
+
+# Run the promotion template of a stage which refers to promotion tasks
+kargo run promotion-template -f stage.yaml -f tasks.yaml --freight=freight.yaml
+
+# Run the promotion template of a stage in a specific working directory,
+# skipping steps with side effects
+kargo run promotion-template -f stage.yaml --freight=freight.yaml \
+  --workdir=./tmp --skip=git-push,argocd-update
+`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	cliio.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the run promotion template options to the
+// provided command.
+func (o *runPromotionTemplateOptions) addFlags(cmd *cobra.Command) {
+	o.AddFlags(cmd)
+
+	option.Filenames(
+		cmd.Flags(), &o.Filenames,
+		"Filename or directory of the manifests of the stage and the resources it refers to.",
+	)
+	option.Recursive(cmd.Flags(), &o.Recursive)
+	option.FreightFilenames(
+		cmd.Flags(), &o.FreightFilenames,
+		"Filename or directory of the manifests of the freight to promote.",
+	)
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project of resources without a namespace. Ignored if the stage has a namespace.",
+	)
+	option.Stage(
+		cmd.Flags(), &o.Stage,
+		"The name of the stage to run the promotion template of. Only required if the "+
+			"manifests contain more than one stage.",
+	)
+	option.WorkDir(
+		cmd.Flags(), &o.WorkDir,
+		"The working directory to execute the steps in. If not set, a temporary "+
+			"directory is used and removed afterwards.",
+	)
+	option.Skip(
+		cmd.Flags(), &o.Skip,
+		"The kinds or aliases of steps to skip.",
+	)
+
+	if err := cmd.MarkFlagRequired(option.FilenameFlag); err != nil {
+		panic(fmt.Errorf("could not mark filename flag as required: %w", err))
+	}
+	if err := cmd.MarkFlagRequired(option.FreightFlag); err != nil {
+		panic(fmt.Errorf("could not mark freight flag as required: %w", err))
+	}
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *runPromotionTemplateOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an
+	// empty string. This is a check to ensure that the flags are not empty.
+	if len(o.Filenames) == 0 {
+		errs = append(errs, fmt.Errorf("%s is required", option.FilenameFlag))
+	}
+	if len(o.FreightFilenames) == 0 {
+		errs = append(errs, fmt.Errorf("%s is required", option.FreightFlag))
+	}
+	return errors.Join(errs...)
+}
+
+// run runs the promotion template of the stage locally and prints the
+// results.
+func (o *runPromotionTemplateOptions) run(ctx context.Context) error {
+	objects, err := readObjects(o.Recursive, o.Filenames...)
+	if err != nil {
+		return fmt.Errorf("read manifests: %w", err)
+	}
+	freightObjects, err := readObjects(false, o.FreightFilenames...)
+	if err != nil {
+		return fmt.Errorf("read freight manifests: %w", err)
+	}
+
+	stage, err := o.findStage(objects)
+	if err != nil {
+		return err
+	}
+	project := stage.Namespace
+	if project == "" {
+		project = o.Project
+	}
+	if project == "" {
+		return fmt.Errorf("%s is required for a stage without a namespace", option.ProjectFlag)
+	}
+
+	freight := make([]*kargoapi.Freight, 0, len(freightObjects))
+	for _, obj := range freightObjects {
+		f, ok := obj.(*kargoapi.Freight)
+		if !ok {
+			return fmt.Errorf(
+				"expected only freight in freight manifests, got %s",
+				obj.GetObjectKind().GroupVersionKind().Kind,
+			)
+		}
+		freight = append(freight, f)
+	}
+	if len(freight) == 0 {
+		return errors.New("no freight found in freight manifests")
+	}
+
+	for _, obj := range slices.Concat(objects, freightObjects) {
+		if _, ok := obj.(*kargoapi.ClusterPromotionTask); !ok && obj.GetNamespace() == "" {
+			obj.SetNamespace(project)
+		}
+	}
+
+	// The resources the promotion template may refer to are served from memory
+	// instead of by the Kargo API server.
+	kargoClient := fake.NewClientBuilder().
+		WithScheme(kubernetes.GetScheme()).
+		WithObjects(slices.Concat(objects, freightObjects)...).
+		Build()
+
+	promo, err := o.buildPromotion(ctx, kargoClient, stage, freight)
+	if err != nil {
+		return err
+	}
+
+	steps := promotion.NewSteps(promo)
+	skipSteps(steps, o.Skip)
+
+	workDir := o.WorkDir
+	if workDir == "" {
+		if workDir, err = os.MkdirTemp("", "run-"); err != nil {
+			return fmt.Errorf("create temporary working directory: %w", err)
+		}
+		defer os.RemoveAll(workDir)
+	} else {
+		if workDir, err = filepath.Abs(workDir); err != nil {
+			return fmt.Errorf("get absolute path of working directory: %w", err)
+		}
+		if err = os.MkdirAll(workDir, 0o700); err != nil {
+			return fmt.Errorf("create working directory: %w", err)
+		}
+	}
+
+	engine := promotion.NewLocalEngine(kargoClient, nil, &gitCredentialHelperDB{}, nil)
+	promoCtx := promotion.NewContext(promo, stage, promotion.WithWorkDir(workDir))
+	for {
+		res, err := engine.Promote(ctx, promoCtx, steps)
+		promo.Status.Phase = res.Status
+		promo.Status.Message = res.Message
+		promo.Status.CurrentStep = res.CurrentStep
+		promo.Status.StepExecutionMetadata = res.StepExecutionMetadata
+		promo.Status.State = &apiextensionsv1.JSON{Raw: res.State.ToJSON()}
+		if res.Status != kargoapi.PromotionPhaseRunning {
+			break
+		}
+
+		// Like the controller would, execute the steps again after a while,
+		// starting from the step that is still running.
+		wait := defaultPollInterval
+		if res.RetryAfter != nil {
+			wait = max(*res.RetryAfter, time.Second)
+		}
+		msg := fmt.Sprintf("Step %q is running", stepAlias(res))
+		if err != nil {
+			msg = fmt.Sprintf("Step %q errored: %s", stepAlias(res), err)
+		}
+		_, _ = fmt.Fprintf(o.ErrOut, "%s; retrying in %s\n", msg, wait.Round(time.Second))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		promoCtx.StartFromStep = res.CurrentStep
+		promoCtx.StepExecutionMetadata = res.StepExecutionMetadata
+		promoCtx.State = res.State
+	}
+
+	if err = o.printResults(promo, steps); err != nil {
+		return fmt.Errorf("print results: %w", err)
+	}
+	if promo.Status.Phase != kargoapi.PromotionPhaseSucceeded {
+		return fmt.Errorf("promotion %s: %s", promo.Status.Phase, promo.Status.Message)
+	}
+	return nil
+}
+
+// findStage returns the Stage from the provided objects. If a stage name was
+// specified, the Stage with that name is returned. Otherwise, the objects
+// must contain exactly one Stage.
+func (o *runPromotionTemplateOptions) findStage(objects []client.Object) (*kargoapi.Stage, error) {
+	var stages []*kargoapi.Stage
+	for _, obj := range objects {
+		if stage, ok := obj.(*kargoapi.Stage); ok && (o.Stage == "" || stage.Name == o.Stage) {
+			stages = append(stages, stage)
+		}
+	}
+	switch {
+	case len(stages) == 1:
+		return stages[0], nil
+	case o.Stage != "":
+		return nil, fmt.Errorf("stage %q not found in manifests", o.Stage)
+	case len(stages) == 0:
+		return nil, errors.New("no stage found in manifests")
+	default:
+		return nil, fmt.Errorf("manifests contain more than one stage; %s is required", option.StageFlag)
+	}
+}
+
+// buildPromotion builds a Promotion of the first of the provided Freight to
+// the provided Stage, the same way it would be built by the Kargo API server.
+// Any Freight besides the first is included in the Promotion's Freight
+// collection, as if it had been promoted to the Stage previously.
+func (o *runPromotionTemplateOptions) buildPromotion(
+	ctx context.Context,
+	kargoClient client.Client,
+	stage *kargoapi.Stage,
+	freight []*kargoapi.Freight,
+) (*kargoapi.Promotion, error) {
+	target := freight[0]
+	builder := kargo.NewPromotionBuilder(kargoClient)
+	promo, err := builder.Build(ctx, *stage, target.Name)
+	if err != nil {
+		return nil, fmt.Errorf("build promotion: %w", err)
+	}
+	if err = builder.InflateSteps(ctx, promo); err != nil {
+		return nil, fmt.Errorf("inflate promotion steps: %w", err)
+	}
+
+	promo.Status.FreightCollection = &kargoapi.FreightCollection{}
+	for _, f := range slices.Concat(freight[1:], freight[:1]) {
+		promo.Status.FreightCollection.UpdateOrPush(kargoapi.FreightReference{
+			Name:         f.Name,
+			Commits:      f.Commits,
+			Images:       f.Images,
+			Charts:       f.Charts,
+			OCIArtifacts: f.OCIArtifacts,
+			Releases:     f.Releases,
+			Origin:       f.Origin,
+		})
+	}
+	promo.Status.Freight = &kargoapi.FreightReference{
+		Name:         target.Name,
+		Commits:      target.Commits,
+		Images:       target.Images,
+		Charts:       target.Charts,
+		OCIArtifacts: target.OCIArtifacts,
+		Releases:     target.Releases,
+		Origin:       target.Origin,
+	}
+	return promo, nil
+}
+
+// skipSteps causes those of the provided steps whose kind or alias is included
+// in the provided list to be skipped.
+func skipSteps(steps []promotion.Step, skip []string) {
+	for i := range steps {
+		if slices.Contains(skip, steps[i].Kind) || slices.Contains(skip, steps[i].Alias) {
+			steps[i].If = "${{ false }}"
+		}
+	}
+}
+
+// printResults prints the resulting Promotion if an output format was
+// specified, or a table of the status of each step followed by the outputs of
+// the steps otherwise.
+func (o *runPromotionTemplateOptions) printResults(
+	promo *kargoapi.Promotion,
+	steps []promotion.Step,
+) error {
+	if o.OutputFlagSpecified != nil && o.OutputFlagSpecified() {
+		printer, err := o.ToPrinter()
+		if err != nil {
+			return fmt.Errorf("new printer: %w", err)
+		}
+		return printer.PrintObj(promo, o.Out)
+	}
+
+	if err := printers.NewTablePrinter(printers.PrintOptions{}).PrintObj(
+		newStepExecutionTable(promo.Status.StepExecutionMetadata, steps),
+		o.Out,
+	); err != nil {
+		return err
+	}
+
+	state := promo.Status.GetState()
+	maps.DeleteFunc(state, func(_ string, v any) bool { return v == nil })
+	if len(state) == 0 {
+		return nil
+	}
+	outputs, err := yaml.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("marshal step outputs: %w", err)
+	}
+	_, err = fmt.Fprintf(o.Out, "\nOutputs:\n%s", indent(outputs))
+	return err
+}
+
+func newStepExecutionTable(
+	metas kargoapi.StepExecutionMetadataList,
+	steps []promotion.Step,
+) *metav1.Table {
+	rows := make([]metav1.TableRow, len(metas))
+	for i, meta := range metas {
+		var kind string
+		if i < len(steps) {
+			kind = steps[i].Kind
+			if steps[i].Parallel != nil {
+				kind = "(parallel)"
+			}
+		}
+		rows[i] = metav1.TableRow{
+			Cells: []any{meta.Alias, kind, meta.Status, meta.Message},
+		}
+	}
+	return &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Step", Type: "string"},
+			{Name: "Kind", Type: "string"},
+			{Name: "Status", Type: "string"},
+			{Name: "Message", Type: "string"},
+		},
+		Rows: rows,
+	}
+}
+
+// stepAlias returns the alias of the current step of the provided Result.
+func stepAlias(res promotion.Result) string {
+	if int(res.CurrentStep) < len(res.StepExecutionMetadata) {
+		return res.StepExecutionMetadata[res.CurrentStep].Alias
+	}
+	return ""
+}
+
+// indent indents each line of the provided text by two spaces.
+func indent(text []byte) []byte {
+	var buf bytes.Buffer
+	for _, line := range bytes.SplitAfter(text, []byte("\n")) {
+		if len(line) > 0 {
+			buf.WriteString("  ")
+			buf.Write(line)
+		}
+	}
+	return buf.Bytes()
+}
+
+// readObjects reads the Kubernetes manifests from the provided files and
+// decodes them into objects of the types known to the Kargo API.
+func readObjects(recursive bool, filenames ...string) ([]client.Object, error) {
+	manifest, err := option.ReadManifests(recursive, filenames...)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := serializer.NewCodecFactory(kubernetes.GetScheme()).UniversalDeserializer()
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(manifest)))
+	var objects []client.Object
+	for {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read manifest: %w", err)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+		obj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("decode manifest: %w", err)
+		}
+		clientObj, ok := obj.(client.Object)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object of type %T", obj)
+		}
+		objects = append(objects, clientObj)
+	}
+	return objects, nil
+}
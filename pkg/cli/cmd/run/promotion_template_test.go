@@ -0,0 +1,265 @@
+package run
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/cli/kubernetes"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+func Test_runPromotionTemplateOptions_findStage(t *testing.T) {
+	testStage := func(name string) *kargoapi.Stage {
+		return &kargoapi.Stage{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+	testCases := []struct {
+		name       string
+		stage      string
+		objects    []client.Object
+		assertions func(*testing.T, *kargoapi.Stage, error)
+	}{
+		{
+			name: "no stage",
+			objects: []client.Object{
+				&kargoapi.Warehouse{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.Stage, err error) {
+				require.ErrorContains(t, err, "no stage found in manifests")
+			},
+		},
+		{
+			name:    "single stage",
+			objects: []client.Object{testStage("test")},
+			assertions: func(t *testing.T, stage *kargoapi.Stage, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "test", stage.Name)
+			},
+		},
+		{
+			name:    "multiple stages without stage name",
+			objects: []client.Object{testStage("test"), testStage("prod")},
+			assertions: func(t *testing.T, _ *kargoapi.Stage, err error) {
+				require.ErrorContains(t, err, "more than one stage")
+			},
+		},
+		{
+			name:    "multiple stages with stage name",
+			stage:   "prod",
+			objects: []client.Object{testStage("test"), testStage("prod")},
+			assertions: func(t *testing.T, stage *kargoapi.Stage, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "prod", stage.Name)
+			},
+		},
+		{
+			name:    "stage name not found",
+			stage:   "uat",
+			objects: []client.Object{testStage("test"), testStage("prod")},
+			assertions: func(t *testing.T, _ *kargoapi.Stage, err error) {
+				require.ErrorContains(t, err, `stage "uat" not found in manifests`)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			o := &runPromotionTemplateOptions{Stage: testCase.stage}
+			stage, err := o.findStage(testCase.objects)
+			testCase.assertions(t, stage, err)
+		})
+	}
+}
+
+func Test_runPromotionTemplateOptions_buildPromotion(t *testing.T) {
+	const testProject = "test-project"
+	testStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-stage",
+			Namespace: testProject,
+		},
+		Spec: kargoapi.StageSpec{
+			PromotionTemplate: &kargoapi.PromotionTemplate{
+				Spec: kargoapi.PromotionTemplateSpec{
+					Steps: []kargoapi.PromotionStep{
+						{Uses: "fake-step"},
+						{
+							Task: &kargoapi.PromotionTaskReference{Name: "test-task"},
+						},
+					},
+				},
+			},
+		},
+	}
+	testTask := &kargoapi.PromotionTask{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-task",
+			Namespace: testProject,
+		},
+		Spec: kargoapi.PromotionTaskSpec{
+			Steps: []kargoapi.PromotionStep{{As: "sub-step", Uses: "fake-step"}},
+		},
+	}
+	testFreight := func(name, warehouse, commitID string) *kargoapi.Freight {
+		return &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testProject,
+			},
+			Origin: kargoapi.FreightOrigin{
+				Kind: kargoapi.FreightOriginKindWarehouse,
+				Name: warehouse,
+			},
+			Commits: []kargoapi.GitCommit{{RepoURL: "https://example.com/repo", ID: commitID}},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		objects    []client.Object
+		freight    []*kargoapi.Freight
+		assertions func(*testing.T, *kargoapi.Promotion, error)
+	}{
+		{
+			name:    "task not found",
+			stage:   testStage,
+			freight: []*kargoapi.Freight{testFreight("abc", "test-warehouse", "fake-commit")},
+			assertions: func(t *testing.T, _ *kargoapi.Promotion, err error) {
+				require.ErrorContains(t, err, "inflate promotion steps")
+			},
+		},
+		{
+			name:  "stage without promotion template",
+			stage: &kargoapi.Stage{ObjectMeta: metav1.ObjectMeta{Name: "test-stage"}},
+			freight: []*kargoapi.Freight{
+				testFreight("abc", "test-warehouse", "fake-commit"),
+			},
+			assertions: func(t *testing.T, _ *kargoapi.Promotion, err error) {
+				require.ErrorContains(t, err, "build promotion")
+			},
+		},
+		{
+			name:    "single freight",
+			stage:   testStage,
+			objects: []client.Object{testTask},
+			freight: []*kargoapi.Freight{testFreight("abc", "test-warehouse", "fake-commit")},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "test-stage", promo.Spec.Stage)
+				require.Equal(t, "abc", promo.Spec.Freight)
+
+				// The task is inflated into its steps
+				require.Len(t, promo.Spec.Steps, 2)
+				assert.Equal(t, "fake-step", promo.Spec.Steps[1].Uses)
+				assert.Equal(t, "task-2::sub-step", promo.Spec.Steps[1].As)
+
+				require.NotNil(t, promo.Status.Freight)
+				assert.Equal(t, "abc", promo.Status.Freight.Name)
+				assert.Equal(t, "fake-commit", promo.Status.Freight.Commits[0].ID)
+				require.NotNil(t, promo.Status.FreightCollection)
+				assert.Len(t, promo.Status.FreightCollection.Freight, 1)
+			},
+		},
+		{
+			name:    "additional freight from other origins",
+			stage:   testStage,
+			objects: []client.Object{testTask},
+			freight: []*kargoapi.Freight{
+				testFreight("abc", "test-warehouse", "fake-commit"),
+				testFreight("def", "other-warehouse", "other-commit"),
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "abc", promo.Status.Freight.Name)
+				freight := promo.Status.FreightCollection.Freight
+				require.Len(t, freight, 2)
+				assert.Equal(t, "abc", freight["Warehouse/test-warehouse"].Name)
+				assert.Equal(t, "def", freight["Warehouse/other-warehouse"].Name)
+			},
+		},
+		{
+			name:    "additional freight from the same origin",
+			stage:   testStage,
+			objects: []client.Object{testTask},
+			freight: []*kargoapi.Freight{
+				testFreight("abc", "test-warehouse", "fake-commit"),
+				testFreight("def", "test-warehouse", "other-commit"),
+			},
+			assertions: func(t *testing.T, promo *kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				// The Freight being promoted takes precedence
+				freight := promo.Status.FreightCollection.Freight
+				require.Len(t, freight, 1)
+				assert.Equal(t, "abc", freight["Warehouse/test-warehouse"].Name)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			objects := testCase.objects
+			for _, f := range testCase.freight {
+				objects = append(objects, f)
+			}
+			kargoClient := fake.NewClientBuilder().
+				WithScheme(kubernetes.GetScheme()).
+				WithObjects(objects...).
+				Build()
+			promo, err := (&runPromotionTemplateOptions{}).buildPromotion(
+				context.Background(),
+				kargoClient,
+				testCase.stage,
+				testCase.freight,
+			)
+			testCase.assertions(t, promo, err)
+		})
+	}
+}
+
+func Test_skipSteps(t *testing.T) {
+	testCases := []struct {
+		name     string
+		skip     []string
+		expected []string
+	}{
+		{
+			name:     "nothing skipped",
+			expected: []string{"", "", ""},
+		},
+		{
+			name:     "skip by kind",
+			skip:     []string{"git-push"},
+			expected: []string{"", "${{ false }}", "${{ false }}"},
+		},
+		{
+			name:     "skip by alias",
+			skip:     []string{"clone"},
+			expected: []string{"${{ false }}", "", ""},
+		},
+		{
+			name:     "skip by kind and alias",
+			skip:     []string{"clone", "push-2", "argocd-update"},
+			expected: []string{"${{ false }}", "", "${{ false }}"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			steps := []promotion.Step{
+				{Kind: "git-clone", Alias: "clone"},
+				{Kind: "git-push", Alias: "push-1"},
+				{Kind: "git-push", Alias: "push-2"},
+			}
+			skipSteps(steps, testCase.skip)
+			ifs := make([]string, len(steps))
+			for i, step := range steps {
+				ifs[i] = step.If
+			}
+			require.Equal(t, testCase.expected, ifs)
+		})
+	}
+}
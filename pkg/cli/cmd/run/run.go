@@ -0,0 +1,27 @@
+package run
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/pkg/cli/config"
+	"github.com/akuity/kargo/pkg/cli/option"
+	"github.com/akuity/kargo/pkg/cli/templates"
+)
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run TYPE",
+		Short: "Run Kargo processes locally",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Run the promotion template of a stage locally
+kargo run promotion-template -f stage.yaml --freight=freight.yaml
+`),
+	}
+
+	// Register subcommands.
+	cmd.AddCommand(newRunPromotionTemplateCommand(cfg, streams))
+
+	return cmd
+}
@@ -95,6 +95,7 @@ func (o *serverOptions) run(ctx context.Context) error {
 			LocalMode: true,
 		},
 		client,
+		nil,
 		rbac.NewKubernetesRolesDatabase(client),
 		rbac.NewKubernetesServiceAccountsDatabase(
 			client,
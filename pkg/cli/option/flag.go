@@ -34,6 +34,9 @@ const (
 	// DownstreamFromFlag is the flag name for the downstream-from flag.
 	DownstreamFromFlag = "downstream-from"
 
+	// DryRunFlag is the flag name for the dry-run flag.
+	DryRunFlag = "dry-run"
+
 	// FilenameFlag is the flag name for the filename flag.
 	FilenameFlag = "filename"
 	// FilenameShortFlag is the short flag name for the filename flag.
@@ -109,6 +112,9 @@ const (
 	// ServiceAccountFlag is the flag name for the service-account flag.
 	ServiceAccountFlag = "service-account"
 
+	// SkipFlag is the flag name for the skip flag.
+	SkipFlag = "skip"
+
 	// StageFlag is the flag name for the stage flag.
 	StageFlag = "stage"
 
@@ -126,6 +132,9 @@ const (
 
 	// WaitFlag is the flag name for the wait flag.
 	WaitFlag = "wait"
+
+	// WorkDirFlag is the flag name for the workdir flag.
+	WorkDirFlag = "workdir"
 )
 
 // Abort adds the AbortFlag to the provided flag set.
@@ -174,6 +183,11 @@ func DownstreamFrom(fs *pflag.FlagSet, downstreamFrom *string, usage string) {
 	fs.StringVar(downstreamFrom, DownstreamFromFlag, "", usage)
 }
 
+// DryRun adds the DryRunFlag to the provided flag set.
+func DryRun(fs *pflag.FlagSet, dryRun *bool, usage string) {
+	fs.BoolVar(dryRun, DryRunFlag, false, usage)
+}
+
 // Filenames adds the FilenameFlag and FilenameShortFlag to the provided flag set.
 func Filenames(fs *pflag.FlagSet, filenames *[]string, usage string) {
 	fs.StringSliceVarP(filenames, FilenameFlag, FilenameShortFlag, nil, usage)
@@ -184,6 +198,12 @@ func Freight(fs *pflag.FlagSet, freight *string, usage string) {
 	fs.StringVar(freight, FreightFlag, "", usage)
 }
 
+// FreightFilenames adds a multi-value FreightFlag to the provided flag set,
+// for the filenames of Freight manifests.
+func FreightFilenames(fs *pflag.FlagSet, filenames *[]string, usage string) {
+	fs.StringSliceVar(filenames, FreightFlag, nil, usage)
+}
+
 // FreightAlias adds the FreightAliasFlag to the provided flag set.
 func FreightAlias(fs *pflag.FlagSet, stage *string, usage string) {
 	fs.StringVar(stage, FreightAliasFlag, "", usage)
@@ -308,6 +328,11 @@ func ServiceAccounts(fs *pflag.FlagSet, serviceAccounts *[]string, usage string)
 	fs.StringSliceVar(serviceAccounts, ServiceAccountFlag, nil, usage)
 }
 
+// Skip adds a multi-value SkipFlag to the provided flag set.
+func Skip(fs *pflag.FlagSet, skip *[]string, usage string) {
+	fs.StringSliceVar(skip, SkipFlag, nil, usage)
+}
+
 // Stage adds the StageFlag to the provided flag set.
 func Stage(fs *pflag.FlagSet, stage *string, usage string) {
 	fs.StringVar(stage, StageFlag, "", usage)
@@ -339,3 +364,8 @@ func Verbs(fs *pflag.FlagSet, verbs *[]string, usage string) {
 func Wait(fs *pflag.FlagSet, wait *bool, defaultWait bool, usage string) {
 	fs.BoolVar(wait, WaitFlag, defaultWait, usage)
 }
+
+// WorkDir adds the WorkDirFlag to the provided flag set.
+func WorkDir(fs *pflag.FlagSet, workDir *string, usage string) {
+	fs.StringVar(workDir, WorkDirFlag, "", usage)
+}
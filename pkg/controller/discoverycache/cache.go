@@ -0,0 +1,205 @@
+package discoverycache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"sync/atomic"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/singleflight"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// DefaultTTL is the default amount of time for which results that may
+	// change over time (e.g. lists of tags) are cached.
+	DefaultTTL = time.Minute
+
+	// immutableTTL is the amount of time for which results that never change
+	// (e.g. manifests retrieved by digest) are cached. Such results remain
+	// valid indefinitely, so this only serves to bound memory use.
+	immutableTTL = 30 * time.Minute
+
+	cleanupInterval = 10 * time.Minute
+)
+
+const (
+	resultHit    = "hit"
+	resultMiss   = "miss"
+	resultShared = "shared"
+)
+
+// ttl is the amount of time for which results that may change over time are
+// cached. It is shared by all mutable caches and may be changed using SetTTL.
+var ttl atomic.Int64
+
+func init() {
+	ttl.Store(int64(DefaultTTL))
+	metrics.Registry.MustRegister(requestsTotal)
+}
+
+var requestsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "kargo",
+		Subsystem: "discovery_cache",
+		Name:      "requests_total",
+		Help: "Total number of artifact discovery cache lookups, partitioned by cache " +
+			`and result. A result of "hit" indicates the value was found in the ` +
+			`cache, "shared" that it was obtained by an identical lookup that was ` +
+			`already in progress, and "miss" that it had to be retrieved.`,
+	},
+	[]string{"cache", "result"},
+)
+
+// SetTTL sets the amount of time for which results that may change over time
+// (e.g. lists of tags) are cached by all mutable caches. A non-positive value
+// disables caching of such results, although concurrent identical lookups are
+// still deduplicated.
+func SetTTL(d time.Duration) {
+	ttl.Store(int64(d))
+}
+
+// Cache is a cache of artifact discovery results that is shared by all
+// Warehouses reconciled by a controller. In addition to caching results,
+// it ensures that concurrent identical lookups result in a single retrieval.
+type Cache struct {
+	name      string
+	immutable bool
+	fixedTTL  time.Duration
+	entries   *gocache.Cache
+	group     singleflight.Group
+}
+
+// New returns a Cache for results that may change over time. Entries expire
+// after the TTL set using SetTTL. The name is used to distinguish the cache's
+// metrics from those of other caches.
+func New(name string) *Cache {
+	return &Cache{
+		name:    name,
+		entries: gocache.New(gocache.NoExpiration, cleanupInterval),
+	}
+}
+
+// NewImmutable returns a Cache for results that never change, such as those
+// retrieved by digest. The name is used to distinguish the cache's metrics
+// from those of other caches.
+func NewImmutable(name string) *Cache {
+	c := New(name)
+	c.immutable = true
+	return c
+}
+
+// NewWithTTL returns a Cache for results that may change over time, but for
+// which staleness is tolerable for longer than the TTL set using SetTTL
+// allows. Entries expire after the provided TTL. The name is used to
+// distinguish the cache's metrics from those of other caches.
+func NewWithTTL(name string, d time.Duration) *Cache {
+	c := New(name)
+	c.fixedTTL = d
+	return c
+}
+
+func (c *Cache) ttl() time.Duration {
+	if c.fixedTTL > 0 {
+		return c.fixedTTL
+	}
+	if c.immutable {
+		return immutableTTL
+	}
+	return time.Duration(ttl.Load())
+}
+
+// Get returns the value cached under the provided key, if any. Otherwise, it
+// retrieves the value using the provided function and caches it. Concurrent
+// calls with identical keys share the result of a single retrieval. Errors
+// are never cached.
+//
+// If the provided context was derived from one returned by ContextWithRefresh,
+// cached values are ignored and the value is always retrieved anew. A nil
+// Cache caches nothing.
+func Get[T any](
+	ctx context.Context,
+	c *Cache,
+	key string,
+	fetch func() (T, error),
+) (T, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	store := func(val T) {
+		if d := c.ttl(); d > 0 {
+			c.entries.Set(key, val, d)
+		}
+	}
+
+	if isRefresh(ctx) {
+		// Neither use a cached value nor join a retrieval that may have been
+		// started before whatever change prompted the refresh.
+		requestsTotal.WithLabelValues(c.name, resultMiss).Inc()
+		val, err := fetch()
+		if err == nil {
+			store(val)
+		}
+		return val, err
+	}
+
+	if val, ok := c.entries.Get(key); ok {
+		requestsTotal.WithLabelValues(c.name, resultHit).Inc()
+		return val.(T), nil // nolint: forcetypeassert
+	}
+
+	val, err, shared := c.group.Do(key, func() (any, error) {
+		val, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+		store(val)
+		return val, nil
+	})
+	if shared {
+		requestsTotal.WithLabelValues(c.name, resultShared).Inc()
+	} else {
+		requestsTotal.WithLabelValues(c.name, resultMiss).Inc()
+	}
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return val.(T), nil // nolint: forcetypeassert
+}
+
+// Key returns a cache key derived from the provided parts. Because the parts
+// are hashed, it is safe for them to include sensitive values, such as the
+// credentials used to access a repository. Including such values ensures
+// that results retrieved using one set of credentials are never returned to
+// callers using another.
+func Key(parts ...string) string {
+	h := sha256.New()
+	for _, part := range parts {
+		// Length-prefix each part so that, e.g., ("ab", "c") and ("a", "bc")
+		// produce different keys.
+		_ = binary.Write(h, binary.BigEndian, uint64(len(part)))
+		_, _ = h.Write([]byte(part))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type refreshKey struct{}
+
+// ContextWithRefresh returns a context that causes lookups made with it to
+// ignore cached values. This is intended for use when a refresh has been
+// explicitly requested, e.g. in response to a webhook indicating that new
+// artifacts are available.
+func ContextWithRefresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, refreshKey{}, true)
+}
+
+func isRefresh(ctx context.Context) bool {
+	refresh, _ := ctx.Value(refreshKey{}).(bool)
+	return refresh
+}
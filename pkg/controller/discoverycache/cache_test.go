@@ -0,0 +1,140 @@
+package discoverycache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	t.Run("caches values", func(t *testing.T) {
+		c := New(t.Name())
+		hits, misses := lookups(c, resultHit), lookups(c, resultMiss)
+		var calls int
+		fetch := func() (string, error) {
+			calls++
+			return "value", nil
+		}
+		for range 3 {
+			val, err := Get(context.Background(), c, "key", fetch)
+			require.NoError(t, err)
+			require.Equal(t, "value", val)
+		}
+		require.Equal(t, 1, calls)
+		require.Equal(t, float64(1), lookups(c, resultMiss)-misses)
+		require.Equal(t, float64(2), lookups(c, resultHit)-hits)
+	})
+
+	t.Run("does not cache errors", func(t *testing.T) {
+		c := New(t.Name())
+		var calls int
+		fetch := func() (string, error) {
+			calls++
+			return "", errors.New("something went wrong")
+		}
+		for range 2 {
+			_, err := Get(context.Background(), c, "key", fetch)
+			require.ErrorContains(t, err, "something went wrong")
+		}
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("deduplicates concurrent lookups", func(t *testing.T) {
+		c := New(t.Name())
+		total := func() float64 {
+			return lookups(c, resultHit) + lookups(c, resultMiss) + lookups(c, resultShared)
+		}
+		before := total()
+		var calls atomic.Int32
+		release := make(chan struct{})
+		fetch := func() (string, error) {
+			calls.Add(1)
+			<-release
+			return "value", nil
+		}
+		const concurrentLookups = 5
+		var wg sync.WaitGroup
+		for range concurrentLookups {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				val, err := Get(context.Background(), c, "key", fetch)
+				require.NoError(t, err)
+				require.Equal(t, "value", val)
+			}()
+		}
+		// Wait for the first lookup to begin retrieving the value and give the
+		// others a chance to join it before releasing it.
+		require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		require.Equal(t, int32(1), calls.Load())
+		require.Equal(t, float64(concurrentLookups), total()-before)
+	})
+
+	t.Run("refresh ignores cached values", func(t *testing.T) {
+		c := New(t.Name())
+		var calls int
+		fetch := func() (int, error) {
+			calls++
+			return calls, nil
+		}
+		val, err := Get(context.Background(), c, "key", fetch)
+		require.NoError(t, err)
+		require.Equal(t, 1, val)
+		val, err = Get(ContextWithRefresh(context.Background()), c, "key", fetch)
+		require.NoError(t, err)
+		require.Equal(t, 2, val)
+		// The refreshed value should have been cached
+		val, err = Get(context.Background(), c, "key", fetch)
+		require.NoError(t, err)
+		require.Equal(t, 2, val)
+	})
+
+	t.Run("non-positive TTL disables caching", func(t *testing.T) {
+		SetTTL(0)
+		t.Cleanup(func() { SetTTL(DefaultTTL) })
+		var calls int
+		fetch := func() (string, error) {
+			calls++
+			return "value", nil
+		}
+		c := New(t.Name())
+		for range 2 {
+			_, err := Get(context.Background(), c, "key", fetch)
+			require.NoError(t, err)
+		}
+		require.Equal(t, 2, calls)
+		// Immutable caches are unaffected
+		c = NewImmutable(t.Name() + "-immutable")
+		for range 2 {
+			_, err := Get(context.Background(), c, "key", fetch)
+			require.NoError(t, err)
+		}
+		require.Equal(t, 3, calls)
+		// As are caches with their own TTL
+		c = NewWithTTL(t.Name()+"-fixed", time.Hour)
+		for range 2 {
+			_, err := Get(context.Background(), c, "key", fetch)
+			require.NoError(t, err)
+		}
+		require.Equal(t, 4, calls)
+	})
+}
+
+func TestKey(t *testing.T) {
+	require.Equal(t, Key("a", "b"), Key("a", "b"))
+	require.NotEqual(t, Key("ab", "c"), Key("a", "bc"))
+	require.NotContains(t, Key("secret"), "secret")
+}
+
+func lookups(c *Cache, result string) float64 {
+	return testutil.ToFloat64(requestsTotal.WithLabelValues(c.name, result))
+}
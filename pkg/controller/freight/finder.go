@@ -284,3 +284,143 @@ func FindChart(
 	// from the desired origin has been promoted yet.
 	return nil, nil
 }
+
+func FindOCIArtifact(
+	ctx context.Context,
+	cl client.Client,
+	project string,
+	freightReqs []kargoapi.FreightRequest,
+	desiredOrigin *kargoapi.FreightOrigin,
+	freight []kargoapi.FreightReference,
+	repoURL string,
+) (*kargoapi.OCIArtifact, error) {
+	// If no origin was explicitly identified, we need to look at all possible
+	// origins. If there's only one that could provide the artifact we're looking
+	// for, great. If there's more than one, there's ambiguity, and we need to
+	// return an error.
+	if desiredOrigin == nil {
+		for i := range freightReqs {
+			requestedFreight := freightReqs[i]
+			warehouse, err := api.GetWarehouse(
+				ctx,
+				cl,
+				types.NamespacedName{
+					Name:      requestedFreight.Origin.Name,
+					Namespace: project,
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			if warehouse == nil {
+				// nolint:staticcheck
+				return nil, fmt.Errorf(
+					"Warehouse %q not found in namespace %q",
+					requestedFreight.Origin.Name, project,
+				)
+			}
+			for _, sub := range warehouse.Spec.Subscriptions {
+				if sub.OCI != nil && sub.OCI.RepoURL == repoURL {
+					if desiredOrigin != nil {
+						return nil, fmt.Errorf(
+							"multiple requested Freight could potentially provide an OCI artifact from "+
+								"repository %s: please provide a Freight origin to disambiguate",
+							repoURL,
+						)
+					}
+					desiredOrigin = &requestedFreight.Origin
+				}
+			}
+		}
+	}
+	if desiredOrigin == nil {
+		// There is no chance of finding the artifact we're looking for. Just
+		// return nil and let the caller decide what to do.
+		return nil, nil
+	}
+	// We know exactly what we're after, so this should be easy
+	for _, f := range freight {
+		if f.Origin.Equals(desiredOrigin) {
+			for _, a := range f.OCIArtifacts {
+				if a.RepoURL == repoURL {
+					return &a, nil
+				}
+			}
+		}
+	}
+	// If we get to here, we looked at all the FreightReferences and didn't find
+	// any that came from the desired origin. This could be because no Freight
+	// from the desired origin has been promoted yet. We return nil to indicate
+	// that none was found
+	return nil, nil
+}
+
+func FindRelease(
+	ctx context.Context,
+	cl client.Client,
+	project string,
+	freightReqs []kargoapi.FreightRequest,
+	desiredOrigin *kargoapi.FreightOrigin,
+	freight []kargoapi.FreightReference,
+	repoURL string,
+) (*kargoapi.Release, error) {
+	// If no origin was explicitly identified, we need to look at all possible
+	// origins. If there's only one that could provide the release we're looking
+	// for, great. If there's more than one, there's ambiguity, and we need to
+	// return an error.
+	if desiredOrigin == nil {
+		for i := range freightReqs {
+			requestedFreight := freightReqs[i]
+			warehouse, err := api.GetWarehouse(
+				ctx,
+				cl,
+				types.NamespacedName{
+					Name:      requestedFreight.Origin.Name,
+					Namespace: project,
+				},
+			)
+			if err != nil {
+				return nil, err
+			}
+			if warehouse == nil {
+				// nolint:staticcheck
+				return nil, fmt.Errorf(
+					"Warehouse %q not found in namespace %q",
+					requestedFreight.Origin.Name, project,
+				)
+			}
+			for _, sub := range warehouse.Spec.Subscriptions {
+				if sub.Release != nil && sub.Release.RepoURL == repoURL {
+					if desiredOrigin != nil {
+						return nil, fmt.Errorf(
+							"multiple requested Freight could potentially provide a release from "+
+								"repository %s: please provide a Freight origin to disambiguate",
+							repoURL,
+						)
+					}
+					desiredOrigin = &requestedFreight.Origin
+				}
+			}
+		}
+	}
+	if desiredOrigin == nil {
+		// There is no chance of finding the release we're looking for. Just
+		// return nil and let the caller decide what to do.
+		return nil, nil
+	}
+	// We know exactly what we're after, so this should be easy
+	for _, f := range freight {
+		if f.Origin.Equals(desiredOrigin) {
+			for _, r := range f.Releases {
+				if r.RepoURL == repoURL {
+					return &r, nil
+				}
+			}
+		}
+	}
+	// If we get to here, we looked at all the FreightReferences and didn't find
+	// any that came from the desired origin. This could be because no Freight
+	// from the desired origin has been promoted yet. We return nil to indicate
+	// that none was found
+	return nil, nil
+}
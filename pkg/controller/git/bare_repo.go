@@ -21,6 +21,10 @@ type BareRepo interface {
 	Close() error
 	// Dir returns an absolute path to the repository.
 	Dir() string
+	// Fetch updates all branches and tags in the repository to match those in
+	// the remote repository. Branches and tags that no longer exist in the
+	// remote repository are removed.
+	Fetch() error
 	// HomeDir returns an absolute path to the home directory of the system user
 	// who has cloned this repo.
 	HomeDir() string
@@ -65,6 +69,11 @@ type BareCloneOptions struct {
 	// specified, the operating system's temporary directory will be used.
 	// Overriding that default is useful under certain circumstances.
 	BaseDir string
+	// Filter allows for partially cloning the repository by specifying a
+	// filter. The filter will be remembered for subsequent fetches from the
+	// remote repository. Refer to the Filter field of CloneOptions for more
+	// information.
+	Filter string
 	// InsecureSkipTLSVerify specifies whether certificate verification errors
 	// should be ignored when cloning the repository. The setting will be
 	// remembered for subsequent interactions with the remote repository.
@@ -108,7 +117,7 @@ func CloneBare(
 	if err = b.setupClient(homeDir, clientOpts); err != nil {
 		return nil, err
 	}
-	if err = b.clone(); err != nil {
+	if err = b.clone(cloneOpts); err != nil {
 		return nil, err
 	}
 	if err = b.saveDirs(); err != nil {
@@ -120,8 +129,13 @@ func CloneBare(
 	return b, nil
 }
 
-func (b *bareRepo) clone() error {
-	cmd := b.buildGitCommand("clone", "--bare", b.accessURL, b.dir)
+func (b *bareRepo) clone(opts *BareCloneOptions) error {
+	args := []string{"clone", "--bare"}
+	if opts.Filter != "" {
+		args = append(args, "--filter", opts.Filter)
+	}
+	args = append(args, b.accessURL, b.dir)
+	cmd := b.buildGitCommand(args...)
 	cmd.Dir = b.homeDir // Override the cmd.Dir that's set by r.buildGitCommand()
 	if _, err := libExec.Exec(cmd); err != nil {
 		return fmt.Errorf("error cloning repo %q into %q: %w", b.originalURL, b.dir, err)
@@ -159,6 +173,17 @@ func LoadBareRepo(path string, opts *LoadBareRepoOptions) (BareRepo, error) {
 // AddWorkTreeOptions represents options for adding a working tree to a bare
 // repository.
 type AddWorkTreeOptions struct {
+	// Detach specifies whether the working tree should be in a detached HEAD
+	// state, even if Ref specifies a branch. This permits a branch to be
+	// referenced by more than one working tree at a time. Will be ignored if
+	// Orphan is true.
+	Detach bool
+	// NoCheckout specifies whether checking out files into the working tree
+	// should be skipped. This is useful when only the history of Ref is of
+	// interest, as it avoids retrieving file contents, which, in the case of a
+	// partial clone, may not yet have been retrieved from the remote
+	// repository.
+	NoCheckout bool
 	// Orphan specifies whether the working tree should be created from a new,
 	// orphaned branch. If true, the Ref field will be ignored.
 	Orphan bool
@@ -183,9 +208,15 @@ func (b *bareRepo) AddWorkTree(path string, opts *AddWorkTreeOptions) (WorkTree,
 		return nil, fmt.Errorf("working tree already exists at %q", path)
 	}
 	args := []string{"worktree", "add", path}
+	if opts.NoCheckout {
+		args = append(args, "--no-checkout")
+	}
 	if opts.Orphan {
 		args = append(args, "--orphan")
 	} else {
+		if opts.Detach {
+			args = append(args, "--detach")
+		}
 		args = append(args, opts.Ref)
 	}
 	if _, err = libExec.Exec(b.buildGitCommand(args...)); err != nil {
@@ -219,6 +250,20 @@ func (b *bareRepo) Close() error {
 	return os.RemoveAll(b.homeDir)
 }
 
+func (b *bareRepo) Fetch() error {
+	if _, err := libExec.Exec(b.buildGitCommand(
+		"fetch",
+		"--prune",
+		"--prune-tags",
+		"origin",
+		"+refs/heads/*:refs/heads/*",
+		"+refs/tags/*:refs/tags/*",
+	)); err != nil {
+		return fmt.Errorf("error fetching from repo %q: %w", b.originalURL, err)
+	}
+	return nil
+}
+
 func (b *bareRepo) RemoveWorkTree(path string) error {
 	workTreePaths, err := b.workTrees()
 	if err != nil {
@@ -227,8 +272,11 @@ func (b *bareRepo) RemoveWorkTree(path string) error {
 	if !slices.Contains(workTreePaths, path) {
 		return fmt.Errorf("no working tree exists at %q", path)
 	}
+	// The working tree is removed regardless of whether it contains changes,
+	// which would otherwise prevent its removal. This is consistent with it
+	// being removed from the file system below.
 	if _, err := libExec.Exec(
-		b.buildGitCommand("worktree", "remove", path),
+		b.buildGitCommand("worktree", "remove", "--force", path),
 	); err != nil {
 		return fmt.Errorf("error removing working tree at %q: %w", path, err)
 	}
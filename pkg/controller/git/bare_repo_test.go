@@ -144,6 +144,46 @@ func TestBareRepo(t *testing.T) {
 		require.True(t, os.IsNotExist(err))
 	})
 
+	t.Run("can fetch", func(t *testing.T) {
+		pushRep, err := Clone(
+			testRepoURL,
+			&ClientOptions{
+				Credentials: &testRepoCreds,
+			},
+			nil,
+		)
+		require.NoError(t, err)
+		defer pushRep.Close()
+		err = os.WriteFile(fmt.Sprintf("%s/%s", pushRep.Dir(), "test.txt"), []byte("bar"), 0600)
+		require.NoError(t, err)
+		err = pushRep.AddAllAndCommit(fmt.Sprintf("second commit %s", uuid.NewString()), nil)
+		require.NoError(t, err)
+		err = pushRep.Push(nil)
+		require.NoError(t, err)
+		expectedCommitID, err := pushRep.LastCommitID()
+		require.NoError(t, err)
+
+		require.NoError(t, rep.Fetch())
+
+		workTree, err := rep.AddWorkTree(
+			filepath.Join(rep.HomeDir(), "no-checkout-working-tree"),
+			&AddWorkTreeOptions{
+				Ref:        "master",
+				Detach:     true,
+				NoCheckout: true,
+			},
+		)
+		require.NoError(t, err)
+		commits, err := workTree.ListCommits(0, 0)
+		require.NoError(t, err)
+		require.Len(t, commits, 2)
+		require.Equal(t, expectedCommitID, commits[0].ID)
+		// Nothing should have been checked out
+		_, err = os.Stat(filepath.Join(workTree.Dir(), "test.txt"))
+		require.True(t, os.IsNotExist(err))
+		require.NoError(t, rep.RemoveWorkTree(workTree.Dir()))
+	})
+
 	t.Run("can load an existing repo", func(t *testing.T) {
 		existingRepo, err := LoadBareRepo(
 			rep.Dir(),
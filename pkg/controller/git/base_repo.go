@@ -173,6 +173,17 @@ func (b *baseRepo) setupAuthor(homeDir string, author *User) error {
 				return fmt.Errorf("error configuring commit gpg signing: %w", err)
 			}
 
+			cmd = b.buildGitCommand("config", "--global", "tag.gpgsign", "true")
+			// Override the home directory set by b.buildGitCommand().
+			b.setCmdHome(cmd, homeDir)
+			// Override the cmd.Dir that's set by b.buildGitCommand(). It's normally the
+			// repository's path, but if this method was called as part of the cloning
+			// process, that path may not exist yet.
+			cmd.Dir = homeDir
+			if _, err := libExec.Exec(cmd); err != nil {
+				return fmt.Errorf("error configuring tag gpg signing: %w", err)
+			}
+
 			cmd = b.buildCommand("gpg", "--import", author.SigningKeyPath)
 			// Override the home directory set by b.buildCommand().
 			b.setCmdHome(cmd, homeDir)
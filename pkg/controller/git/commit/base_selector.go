@@ -7,6 +7,7 @@ import (
 	"github.com/expr-lang/expr/vm"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/discoverycache"
 	"github.com/akuity/kargo/pkg/controller/git"
 	"github.com/akuity/kargo/pkg/pattern"
 )
@@ -28,6 +29,11 @@ type baseSelector struct {
 		clientOpts *git.ClientOptions,
 		cloneOpts *git.CloneOptions,
 	) (git.Repo, error)
+	listRemoteRefsFn func(
+		repoURL string,
+		clientOpts *git.ClientOptions,
+		opts *git.ListRemoteRefsOptions,
+	) ([]git.RemoteRef, error)
 }
 
 func newBaseSelector(
@@ -39,7 +45,8 @@ func newBaseSelector(
 		creds:                 creds,
 		insecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
 		discoveryLimit:        int(sub.DiscoveryLimit),
-		gitCloneFn:            git.Clone,
+		gitCloneFn:            defaultRepoCache.clone,
+		listRemoteRefsFn:      git.ListRemoteRefs,
 	}
 	var err error
 	if sub.ExpressionFilter != "" {
@@ -57,6 +64,45 @@ func newBaseSelector(
 	return s, nil
 }
 
+// cloneFilter returns the filter that should be used when cloning the
+// repository. Absent any path-selection criteria, only commit metadata is
+// required, so a treeless clone suffices. Otherwise, trees are required to
+// determine which paths each commit affected.
+func (b *baseSelector) cloneFilter() string {
+	if b.includePaths == nil && b.excludePaths == nil {
+		return git.FilterTreeless
+	}
+	return git.FilterBlobless
+}
+
+// fingerprintRemoteRefs lists refs in the remote repository WITHOUT cloning it
+// and returns a value derived from the names of those refs accepted by the
+// provided function and the IDs of the commits they point to. The value
+// changes if and only if any of those refs is added, removed, or moved.
+func (b *baseSelector) fingerprintRemoteRefs(
+	opts *git.ListRemoteRefsOptions,
+	include func(ref string) bool,
+) (string, error) {
+	refs, err := b.listRemoteRefsFn(
+		b.repoURL,
+		&git.ClientOptions{
+			Credentials:           b.creds,
+			InsecureSkipTLSVerify: b.insecureSkipTLSVerify,
+		},
+		opts,
+	)
+	if err != nil {
+		return "", err
+	}
+	parts := make([]string, 0, 2*len(refs))
+	for _, ref := range refs {
+		if include(ref.Name) {
+			parts = append(parts, ref.Name, ref.CommitID)
+		}
+	}
+	return discoverycache.Key(parts...), nil
+}
+
 // getLoggerContext returns key/value pairs that can be used by any selector to
 // enrich loggers with valuable context.
 func (b *baseSelector) getLoggerContext() []any {
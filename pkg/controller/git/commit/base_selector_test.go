@@ -1,6 +1,7 @@
 package commit
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -71,6 +72,8 @@ func TestNewBaseSelector(t *testing.T) {
 				require.NotNil(t, s.includePaths)
 				require.NotNil(t, s.excludePaths)
 				require.Equal(t, 5, s.discoveryLimit)
+				require.NotNil(t, s.gitCloneFn)
+				require.NotNil(t, s.listRemoteRefsFn)
 			},
 		},
 	}
@@ -81,3 +84,64 @@ func TestNewBaseSelector(t *testing.T) {
 		})
 	}
 }
+
+func Test_baseSelector_cloneFilter(t *testing.T) {
+	s, err := newBaseSelector(kargoapi.GitSubscription{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, git.FilterTreeless, s.cloneFilter())
+
+	s, err = newBaseSelector(
+		kargoapi.GitSubscription{IncludePaths: []string{"apps/"}},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, git.FilterBlobless, s.cloneFilter())
+}
+
+func Test_baseSelector_fingerprintRemoteRefs(t *testing.T) {
+	refs := []git.RemoteRef{
+		{Name: "refs/heads/main", CommitID: "abc"},
+		{Name: "refs/heads/other", CommitID: "def"},
+	}
+	s := &baseSelector{
+		repoURL: "https://github.com/example/repo.git",
+		creds:   &git.RepoCredentials{Username: "foo", Password: "bar"},
+		listRemoteRefsFn: func(
+			repoURL string,
+			clientOpts *git.ClientOptions,
+			opts *git.ListRemoteRefsOptions,
+		) ([]git.RemoteRef, error) {
+			require.Equal(t, "https://github.com/example/repo.git", repoURL)
+			require.Equal(t, "foo", clientOpts.Credentials.Username)
+			require.True(t, opts.Heads)
+			return refs, nil
+		},
+	}
+	includeMain := func(name string) bool { return name == "refs/heads/main" }
+
+	fingerprint, err := s.fingerprintRemoteRefs(&git.ListRemoteRefsOptions{Heads: true}, includeMain)
+	require.NoError(t, err)
+	require.NotEmpty(t, fingerprint)
+
+	// Changes to refs that aren't included shouldn't affect the fingerprint
+	refs[1].CommitID = "ghi"
+	other, err := s.fingerprintRemoteRefs(&git.ListRemoteRefsOptions{Heads: true}, includeMain)
+	require.NoError(t, err)
+	require.Equal(t, fingerprint, other)
+
+	// Changes to refs that are included should
+	refs[0].CommitID = "jkl"
+	other, err = s.fingerprintRemoteRefs(&git.ListRemoteRefsOptions{Heads: true}, includeMain)
+	require.NoError(t, err)
+	require.NotEqual(t, fingerprint, other)
+
+	s.listRemoteRefsFn = func(
+		string,
+		*git.ClientOptions,
+		*git.ListRemoteRefsOptions,
+	) ([]git.RemoteRef, error) {
+		return nil, errors.New("something went wrong")
+	}
+	_, err = s.fingerprintRemoteRefs(nil, includeMain)
+	require.ErrorContains(t, err, "something went wrong")
+}
@@ -0,0 +1,147 @@
+package commit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/discoverycache"
+	"github.com/akuity/kargo/pkg/controller/git"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/urls"
+)
+
+// commitsCache is a cache of commits selected from Git repositories, shared by
+// all Selectors. Selecting commits requires cloning a repository, so caching
+// the results spares Warehouses with identical subscriptions from each cloning
+// the same repository.
+var commitsCache = discoverycache.New("git_commits")
+
+// selectionRetention is the amount of time for which the most recent
+// selection made by a Selector is retained for possible reuse.
+const selectionRetention = 24 * time.Hour
+
+// selectionsCache is a cache of the most recent selections made by Selectors,
+// each along with a fingerprint of the remote refs from which it was made.
+// Unlike commitsCache, entries are retained long after the remote refs may
+// have changed, as they are only reused if the refs are found not to have.
+var selectionsCache = gocache.New(selectionRetention, time.Hour)
+
+// refsFingerprinter is implemented by Selectors that can cheaply determine,
+// without cloning a repository, whether the commits they select may have
+// changed.
+type refsFingerprinter interface {
+	// fingerprintRefs returns a value that changes whenever any remote ref that
+	// may affect which commits are selected changes.
+	fingerprintRefs() (string, error)
+}
+
+// selection is the result of selecting commits from remote refs with a given
+// fingerprint.
+type selection struct {
+	fingerprint string
+	commits     []kargoapi.DiscoveredCommit
+}
+
+// cachingSelector is an implementation of Selector that wraps another Selector
+// and caches the commits it selects. When the cached commits have expired and
+// the wrapped Selector implements refsFingerprinter, the wrapped Selector is
+// only used to select commits anew if the relevant remote refs have changed.
+type cachingSelector struct {
+	Selector
+	// key uniquely identifies the repository, the credentials used to access
+	// it, and the criteria by which commits are selected.
+	key        string
+	cache      *discoverycache.Cache
+	selections *gocache.Cache
+}
+
+func newCachingSelector(
+	selector Selector,
+	sub kargoapi.GitSubscription,
+	creds *git.RepoCredentials,
+) (*cachingSelector, error) {
+	// Every field of the subscription may affect which commits are selected.
+	// The repository URL is accounted for separately, in normalized form, so
+	// that equivalent URLs result in the same key.
+	repoURL := sub.RepoURL
+	sub.RepoURL = ""
+	subJSON, err := json.Marshal(sub)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling subscription: %w", err)
+	}
+	if creds == nil {
+		creds = &git.RepoCredentials{}
+	}
+	return &cachingSelector{
+		Selector: selector,
+		key: discoverycache.Key(
+			urls.NormalizeGit(repoURL),
+			creds.Username,
+			creds.Password,
+			creds.SSHPrivateKey,
+			string(subJSON),
+		),
+		cache:      commitsCache,
+		selections: selectionsCache,
+	}, nil
+}
+
+// Select implements Selector.
+func (c *cachingSelector) Select(
+	ctx context.Context,
+) ([]kargoapi.DiscoveredCommit, error) {
+	commits, err := discoverycache.Get(ctx, c.cache, c.key, func() ([]kargoapi.DiscoveredCommit, error) {
+		return c.selectIfRefsChanged(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Cached commits are shared, so return copies that callers are free to
+	// modify.
+	copies := make([]kargoapi.DiscoveredCommit, len(commits))
+	for i := range commits {
+		commits[i].DeepCopyInto(&copies[i])
+	}
+	return copies, nil
+}
+
+// selectIfRefsChanged returns the commits most recently selected by the
+// wrapped Selector if it can be determined that the remote refs from which
+// they were selected have not changed since. Otherwise, it uses the wrapped
+// Selector to select commits anew.
+func (c *cachingSelector) selectIfRefsChanged(
+	ctx context.Context,
+) ([]kargoapi.DiscoveredCommit, error) {
+	fingerprinter, ok := c.Selector.(refsFingerprinter)
+	if !ok || c.selections == nil {
+		return c.Selector.Select(ctx)
+	}
+	logger := logging.LoggerFromContext(ctx)
+	fingerprint, err := fingerprinter.fingerprintRefs()
+	if err != nil {
+		return nil, fmt.Errorf("error listing remote refs: %w", err)
+	}
+	if prev, ok := c.selections.Get(c.key); ok {
+		if prev := prev.(selection); prev.fingerprint == fingerprint { // nolint: forcetypeassert
+			logger.Debug("remote refs are unchanged; reusing previously selected commits")
+			return prev.commits, nil
+		}
+	}
+	commits, err := c.Selector.Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Note: The remote refs may have changed since they were fingerprinted. If
+	// so, the fingerprint will not match the next time around and commits will
+	// simply be selected again.
+	c.selections.SetDefault(c.key, selection{
+		fingerprint: fingerprint,
+		commits:     commits,
+	})
+	return commits, nil
+}
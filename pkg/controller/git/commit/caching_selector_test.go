@@ -0,0 +1,147 @@
+package commit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/discoverycache"
+	"github.com/akuity/kargo/pkg/controller/git"
+)
+
+type mockSelector struct {
+	Selector
+	selectFn func(context.Context) ([]kargoapi.DiscoveredCommit, error)
+}
+
+func (m *mockSelector) Select(
+	ctx context.Context,
+) ([]kargoapi.DiscoveredCommit, error) {
+	return m.selectFn(ctx)
+}
+
+type mockFingerprintingSelector struct {
+	mockSelector
+	fingerprintRefsFn func() (string, error)
+}
+
+func (m *mockFingerprintingSelector) fingerprintRefs() (string, error) {
+	return m.fingerprintRefsFn()
+}
+
+func Test_newCachingSelector(t *testing.T) {
+	sub := kargoapi.GitSubscription{RepoURL: "https://github.com/example/repo"}
+	creds := &git.RepoCredentials{Username: "foo", Password: "bar"}
+
+	s, err := newCachingSelector(&mockSelector{}, sub, creds)
+	require.NoError(t, err)
+	require.Same(t, commitsCache, s.cache)
+	require.Same(t, selectionsCache, s.selections)
+
+	// Equivalent URLs should produce the same key
+	other, err := newCachingSelector(
+		&mockSelector{},
+		kargoapi.GitSubscription{RepoURL: "https://github.com/example/repo.git"},
+		creds,
+	)
+	require.NoError(t, err)
+	require.Equal(t, s.key, other.key)
+
+	// Different credentials should produce a different key
+	other, err = newCachingSelector(&mockSelector{}, sub, nil)
+	require.NoError(t, err)
+	require.NotEqual(t, s.key, other.key)
+
+	// Different selection criteria should produce a different key
+	otherSub := sub
+	otherSub.Branch = "develop"
+	other, err = newCachingSelector(&mockSelector{}, otherSub, creds)
+	require.NoError(t, err)
+	require.NotEqual(t, s.key, other.key)
+}
+
+func Test_cachingSelector_Select(t *testing.T) {
+	var calls int
+	s := &cachingSelector{
+		Selector: &mockSelector{
+			selectFn: func(context.Context) ([]kargoapi.DiscoveredCommit, error) {
+				calls++
+				return []kargoapi.DiscoveredCommit{{
+					ID:          "abc123",
+					CreatorDate: &metav1.Time{},
+				}}, nil
+			},
+		},
+		key:   "key",
+		cache: discoverycache.New(t.Name()),
+	}
+
+	commits, err := s.Select(context.Background())
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	// Modifying the returned commits should not affect the cached ones
+	commits[0].ID = "modified"
+	commits[0].CreatorDate.Time = commits[0].CreatorDate.Add(1)
+
+	commits, err = s.Select(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "abc123", commits[0].ID)
+	require.True(t, commits[0].CreatorDate.IsZero())
+	require.Equal(t, 1, calls)
+
+	// A refresh should bypass the cache
+	_, err = s.Select(discoverycache.ContextWithRefresh(context.Background()))
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+}
+
+func Test_cachingSelector_selectIfRefsChanged(t *testing.T) {
+	var calls int
+	fingerprint := "a"
+	var fingerprintErr error
+	s := &cachingSelector{
+		Selector: &mockFingerprintingSelector{
+			mockSelector: mockSelector{
+				selectFn: func(context.Context) ([]kargoapi.DiscoveredCommit, error) {
+					calls++
+					return []kargoapi.DiscoveredCommit{{ID: fingerprint}}, nil
+				},
+			},
+			fingerprintRefsFn: func() (string, error) {
+				return fingerprint, fingerprintErr
+			},
+		},
+		key:        "key",
+		selections: gocache.New(time.Hour, time.Hour),
+	}
+
+	commits, err := s.selectIfRefsChanged(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "a", commits[0].ID)
+	require.Equal(t, 1, calls)
+
+	// Refs are unchanged, so the previous selection should be reused
+	commits, err = s.selectIfRefsChanged(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "a", commits[0].ID)
+	require.Equal(t, 1, calls)
+
+	// Refs have changed, so commits should be selected anew
+	fingerprint = "b"
+	commits, err = s.selectIfRefsChanged(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "b", commits[0].ID)
+	require.Equal(t, 2, calls)
+
+	fingerprintErr = errors.New("something went wrong")
+	_, err = s.selectIfRefsChanged(context.Background())
+	require.ErrorContains(t, err, "error listing remote refs")
+	require.ErrorContains(t, err, "something went wrong")
+	require.Equal(t, 2, calls)
+}
@@ -0,0 +1,104 @@
+package commit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/git"
+	"github.com/akuity/kargo/pkg/controller/sortkey"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+func init() {
+	defaultSelectorRegistry.MustRegister(
+		selectorRegistration{
+			Predicate: func(_ context.Context, sub kargoapi.GitSubscription) (bool, error) {
+				return sub.CommitSelectionStrategy == kargoapi.CommitSelectionStrategyExpression, nil
+			},
+			Value: newExpressionSelector,
+		},
+	)
+}
+
+// expressionSelector implements the Selector interface for
+// kargoapi.CommitSelectionStrategyExpression.
+type expressionSelector struct {
+	*tagBasedSelector
+	sortKey *sortkey.Expression
+}
+
+func newExpressionSelector(
+	sub kargoapi.GitSubscription,
+	creds *git.RepoCredentials,
+) (Selector, error) {
+	if sub.SortKeyExpression == "" {
+		return nil, errors.New("sort key expression is required")
+	}
+	tagBased, err := newTagBasedSelector(sub, creds)
+	if err != nil {
+		return nil, fmt.Errorf("error building tag based selector: %w", err)
+	}
+	s := &expressionSelector{tagBasedSelector: tagBased}
+	if s.sortKey, err = sortkey.Compile(sub.SortKeyExpression); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Select implements the Selector interface.
+func (e *expressionSelector) Select(ctx context.Context) (
+	[]kargoapi.DiscoveredCommit,
+	error,
+) {
+	loggerCtx := append(
+		e.getLoggerContext(),
+		"selectionStrategy", kargoapi.CommitSelectionStrategyExpression,
+	)
+	logger := logging.LoggerFromContext(ctx).WithValues(loggerCtx...)
+	ctx = logging.ContextWithLogger(ctx, logger)
+
+	repo, err := e.clone(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = repo.Close()
+	}()
+
+	tags, err := repo.ListTags()
+	if err != nil {
+		return nil, err
+	}
+
+	tags = e.filterTags(tags)
+
+	if tags, err = e.filterTagsByExpression(tags); err != nil {
+		return nil, fmt.Errorf("error filtering tags by expression: %w", err)
+	}
+
+	if tags, err = e.sort(tags); err != nil {
+		return nil, fmt.Errorf("error sorting tags: %w", err)
+	}
+
+	if tags, err = e.filterTagsByDiffPathsFn(repo, tags); err != nil {
+		return nil, fmt.Errorf("error filtering tags by paths: %w", err)
+	}
+
+	return e.tagsToAPICommits(ctx, tags), nil
+}
+
+// sort returns the provided tags ordered from greatest to least key, as
+// extracted by the selector's sort key expression. Ties are broken lexically.
+func (e *expressionSelector) sort(
+	tags []git.TagMetadata,
+) ([]git.TagMetadata, error) {
+	tags = slices.Clone(tags)
+	slices.SortFunc(tags, func(i, j git.TagMetadata) int {
+		return strings.Compare(j.Tag, i.Tag)
+	})
+	return sortkey.Sort(e.sortKey, tags, tagExpressionEnv)
+}
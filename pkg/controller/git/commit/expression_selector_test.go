@@ -0,0 +1,148 @@
+package commit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/git"
+	"github.com/akuity/kargo/pkg/controller/sortkey"
+)
+
+func TestNewExpressionSelector(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.GitSubscription
+		assertions func(*testing.T, Selector, error)
+	}{
+		{
+			name: "no sort key expression",
+			sub:  kargoapi.GitSubscription{},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "sort key expression is required")
+			},
+		},
+		{
+			name: "error building tag based selector",
+			sub: kargoapi.GitSubscription{
+				ExpressionFilter:  "(1 + 2", // This will force an error
+				SortKeyExpression: "tag",
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error building tag based selector")
+			},
+		},
+		{
+			name: "error compiling sort key expression",
+			sub: kargoapi.GitSubscription{
+				SortKeyExpression: "(1 + 2",
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error compiling sort key expression")
+			},
+		},
+		{
+			name: "success",
+			sub: kargoapi.GitSubscription{
+				SortKeyExpression: "tag",
+			},
+			assertions: func(t *testing.T, s Selector, err error) {
+				require.NoError(t, err)
+				e, ok := s.(*expressionSelector)
+				require.True(t, ok)
+				require.NotNil(t, e.tagBasedSelector)
+				require.NotNil(t, e.sortKey)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := newExpressionSelector(testCase.sub, nil)
+			testCase.assertions(t, s, err)
+		})
+	}
+}
+
+func Test_expressionSelector_Select(t *testing.T) {
+	now := time.Now()
+	testTags := []git.TagMetadata{
+		{Tag: "2026.9.30-b1000", CreatorDate: now.Add(-time.Hour)},
+		{Tag: "2026.10.3-b99", CreatorDate: now.Add(-3 * time.Hour)},
+		{Tag: "2026.10.3-b412", CreatorDate: now.Add(-2 * time.Hour)},
+		{Tag: "latest", CreatorDate: now},
+	}
+
+	testCases := []struct {
+		name       string
+		expression string
+		limit      int
+		assertions func(*testing.T, []kargoapi.DiscoveredCommit, error)
+	}{
+		{
+			name:       "error sorting tags",
+			expression: `int(split(tag, "-b")[1])`,
+			assertions: func(t *testing.T, _ []kargoapi.DiscoveredCommit, err error) {
+				require.ErrorContains(t, err, "error sorting tags")
+			},
+		},
+		{
+			name: "tags are correctly sorted",
+			expression: `tag == "latest" ? nil : ` +
+				`[semverParse(split(tag, "-b")[0]), int(split(tag, "-b")[1])]`,
+			assertions: func(t *testing.T, commits []kargoapi.DiscoveredCommit, err error) {
+				require.NoError(t, err)
+				require.Len(t, commits, 3)
+				require.Equal(t, "2026.10.3-b412", commits[0].Tag)
+				require.Equal(t, "2026.10.3-b99", commits[1].Tag)
+				require.Equal(t, "2026.9.30-b1000", commits[2].Tag)
+			},
+		},
+		{
+			name:       "tag metadata is available to the expression",
+			expression: `creatorDate`,
+			limit:      2,
+			assertions: func(t *testing.T, commits []kargoapi.DiscoveredCommit, err error) {
+				require.NoError(t, err)
+				require.Len(t, commits, 2)
+				require.Equal(t, "latest", commits[0].Tag)
+				require.Equal(t, "2026.9.30-b1000", commits[1].Tag)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			sortKey, err := sortkey.Compile(testCase.expression)
+			require.NoError(t, err)
+			s := &expressionSelector{
+				tagBasedSelector: &tagBasedSelector{
+					baseSelector: &baseSelector{
+						gitCloneFn: func(
+							string,
+							*git.ClientOptions,
+							*git.CloneOptions,
+						) (git.Repo, error) {
+							return &git.MockRepo{
+								ListTagsFn: func() ([]git.TagMetadata, error) {
+									return testTags, nil
+								},
+							}, nil
+						},
+						discoveryLimit: testCase.limit,
+					},
+					filterTagsByDiffPathsFn: func(
+						_ git.Repo,
+						tags []git.TagMetadata,
+					) ([]git.TagMetadata, error) {
+						return tags, nil
+					},
+				},
+				sortKey: sortKey,
+			}
+			commits, err := s.Select(context.Background())
+			testCase.assertions(t, commits, err)
+		})
+	}
+}
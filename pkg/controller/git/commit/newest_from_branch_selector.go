@@ -83,6 +83,19 @@ func (n *newestFromBranchSelector) MatchesRef(ref string) bool {
 	return n.branch == branch
 }
 
+// fingerprintRefs implements refsFingerprinter. Commits are selected from the
+// history of a single branch, so only the head of that branch is relevant.
+func (n *newestFromBranchSelector) fingerprintRefs() (string, error) {
+	ref := "HEAD" // The default branch
+	if n.branch != "" {
+		ref = branchPrefix + n.branch
+	}
+	return n.fingerprintRemoteRefs(
+		&git.ListRemoteRefsOptions{Patterns: []string{ref}},
+		func(name string) bool { return name == ref },
+	)
+}
+
 // Select implements the Selector interface.
 func (n *newestFromBranchSelector) Select(ctx context.Context) (
 	[]kargoapi.DiscoveredCommit,
@@ -106,7 +119,7 @@ func (n *newestFromBranchSelector) Select(ctx context.Context) (
 		&git.CloneOptions{
 			Branch:       n.branch,
 			SingleBranch: true,
-			Filter:       git.FilterBlobless,
+			Filter:       n.cloneFilter(),
 		},
 	)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/discoverycache"
 	"github.com/akuity/kargo/pkg/controller/git"
 )
 
@@ -119,6 +120,40 @@ func Test_newestFromBranchSelector_MatchesRef(t *testing.T) {
 	}
 }
 
+func Test_newestFromBranchSelector_fingerprintRefs(t *testing.T) {
+	refs := []git.RemoteRef{
+		{Name: "HEAD", CommitID: "abc"},
+		{Name: "refs/heads/main", CommitID: "abc"},
+		{Name: "refs/heads/other", CommitID: "def"},
+	}
+	var patterns []string
+	s := &newestFromBranchSelector{
+		baseSelector: &baseSelector{
+			listRemoteRefsFn: func(
+				_ string,
+				_ *git.ClientOptions,
+				opts *git.ListRemoteRefsOptions,
+			) ([]git.RemoteRef, error) {
+				patterns = opts.Patterns
+				return refs, nil
+			},
+		},
+	}
+
+	// Default branch
+	fingerprint, err := s.fingerprintRefs()
+	require.NoError(t, err)
+	require.Equal(t, []string{"HEAD"}, patterns)
+	require.Equal(t, discoverycache.Key("HEAD", "abc"), fingerprint)
+
+	// Specific branch
+	s.branch = "other"
+	fingerprint, err = s.fingerprintRefs()
+	require.NoError(t, err)
+	require.Equal(t, []string{"refs/heads/other"}, patterns)
+	require.Equal(t, discoverycache.Key("refs/heads/other", "def"), fingerprint)
+}
+
 func Test_newestFromBranchSelector_Select(t *testing.T) {
 	testCases := []struct {
 		name       string
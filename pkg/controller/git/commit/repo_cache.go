@@ -0,0 +1,196 @@
+package commit
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/akuity/kargo/pkg/controller/discoverycache"
+	"github.com/akuity/kargo/pkg/controller/git"
+	"github.com/akuity/kargo/pkg/urls"
+)
+
+// repoRetention is the amount of time for which a repository in a repoCache
+// may go unused before it is removed.
+const repoRetention = time.Hour
+
+// defaultRepoCache is the repoCache used by all Selectors.
+var defaultRepoCache = newRepoCache()
+
+// repoCache maintains persistent, bare clones of remote Git repositories so
+// that, after the first time a repository is cloned, selecting commits from it
+// requires fetching only what has changed since the last time commits were
+// selected. Each repository is cloned separately for each set of credentials
+// and filter used to access it. Use of any given repository is serialized.
+type repoCache struct {
+	mu      sync.Mutex
+	entries map[string]*cachedRepo
+
+	cloneBareFn func(
+		repoURL string,
+		clientOpts *git.ClientOptions,
+		cloneOpts *git.BareCloneOptions,
+	) (git.BareRepo, error)
+	nowFn func() time.Time
+}
+
+// cachedRepo is an entry in a repoCache.
+type cachedRepo struct {
+	// mu is held for as long as the repository is in use.
+	mu   sync.Mutex
+	repo git.BareRepo
+	// lastUsed is guarded by the mutex of the repoCache containing the entry.
+	lastUsed time.Time
+}
+
+func newRepoCache() *repoCache {
+	return &repoCache{
+		entries:     map[string]*cachedRepo{},
+		cloneBareFn: git.CloneBare,
+		nowFn:       time.Now,
+	}
+}
+
+// clone has the same signature as git.Clone and can be used in its place. It
+// returns a working tree of a cached, bare clone of the repository at the
+// specified URL, cloning the repository only if it is not already cached and
+// otherwise fetching any changes from the remote repository. No files are
+// checked out into the working tree. i.e. The returned git.Repo is only
+// suitable for examining history. Other callers are prevented from using the
+// same cached repository until the returned git.Repo is closed.
+func (r *repoCache) clone(
+	repoURL string,
+	clientOpts *git.ClientOptions,
+	cloneOpts *git.CloneOptions,
+) (git.Repo, error) {
+	if clientOpts == nil {
+		clientOpts = &git.ClientOptions{}
+	}
+	if cloneOpts == nil {
+		cloneOpts = &git.CloneOptions{}
+	}
+	creds := clientOpts.Credentials
+	if creds == nil {
+		creds = &git.RepoCredentials{}
+	}
+	entry := r.getEntry(discoverycache.Key(
+		urls.NormalizeGit(repoURL),
+		creds.Username,
+		creds.Password,
+		creds.SSHPrivateKey,
+		strconv.FormatBool(clientOpts.InsecureSkipTLSVerify),
+		cloneOpts.Filter,
+	))
+
+	entry.mu.Lock()
+	workTree, err := entry.addWorkTree(r, repoURL, clientOpts, cloneOpts)
+	if err != nil {
+		entry.mu.Unlock()
+		return nil, err
+	}
+	return &cachedRepoWorkTree{WorkTree: workTree, entry: entry}, nil
+}
+
+// getEntry returns the entry for the specified key, creating it if necessary.
+// It also removes any other entries that have not been used recently.
+func (r *repoCache) getEntry(key string) *cachedRepo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.nowFn()
+	for k, e := range r.entries {
+		if k == key || now.Sub(e.lastUsed) < repoRetention || !e.mu.TryLock() {
+			continue
+		}
+		if e.repo != nil {
+			_ = e.repo.Close()
+		}
+		delete(r.entries, k)
+		e.mu.Unlock()
+	}
+	entry, ok := r.entries[key]
+	if !ok {
+		entry = &cachedRepo{}
+		r.entries[key] = entry
+	}
+	entry.lastUsed = now
+	return entry
+}
+
+// addWorkTree clones the entry's repository if it has not been cloned already
+// or fetches changes otherwise, then adds a working tree for the specified
+// branch, or the default branch if none is specified. The caller must hold
+// the entry's mutex.
+func (c *cachedRepo) addWorkTree(
+	r *repoCache,
+	repoURL string,
+	clientOpts *git.ClientOptions,
+	cloneOpts *git.CloneOptions,
+) (git.WorkTree, error) {
+	if c.repo == nil {
+		repo, err := r.cloneBareFn(
+			repoURL,
+			clientOpts,
+			&git.BareCloneOptions{
+				BaseDir:               cloneOpts.BaseDir,
+				Filter:                cloneOpts.Filter,
+				InsecureSkipTLSVerify: clientOpts.InsecureSkipTLSVerify,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		c.repo = repo
+	} else if err := c.repo.Fetch(); err != nil {
+		c.discard()
+		return nil, err
+	}
+	ref := "HEAD"
+	if cloneOpts.Branch != "" {
+		ref = branchPrefix + cloneOpts.Branch
+	}
+	workTree, err := c.repo.AddWorkTree(
+		filepath.Join(c.repo.HomeDir(), "work-tree"),
+		&git.AddWorkTreeOptions{
+			Ref:        ref,
+			Detach:     true,
+			NoCheckout: true,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error adding working tree for %q: %w", ref, err)
+	}
+	return workTree, nil
+}
+
+// discard closes the entry's repository, if any, so that it will be cloned
+// anew the next time it is used. The caller must hold the entry's mutex.
+func (c *cachedRepo) discard() {
+	if c.repo != nil {
+		_ = c.repo.Close()
+		c.repo = nil
+	}
+}
+
+// cachedRepoWorkTree is a working tree of a repository in a repoCache. Closing
+// it removes the working tree and releases the repository for use by others.
+type cachedRepoWorkTree struct {
+	git.WorkTree
+	entry     *cachedRepo
+	closeOnce sync.Once
+}
+
+// Close implements git.Repo.
+func (c *cachedRepoWorkTree) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		defer c.entry.mu.Unlock()
+		if err = c.WorkTree.Close(); err != nil {
+			// Rather than risk reusing the repository in an unknown state, discard
+			// it.
+			c.entry.discard()
+		}
+	})
+	return err
+}
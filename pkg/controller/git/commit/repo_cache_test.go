@@ -0,0 +1,122 @@
+package commit
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sosedoff/gitkit"
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/pkg/controller/git"
+)
+
+func Test_repoCache_clone(t *testing.T) {
+	service := gitkit.New(gitkit.Config{
+		Dir:        t.TempDir(),
+		AutoCreate: true,
+	})
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
+
+	setupRep, err := git.Clone(testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer setupRep.Close()
+	commit := func(msg string) string {
+		err := os.WriteFile(filepath.Join(setupRep.Dir(), "test.txt"), []byte(msg), 0600)
+		require.NoError(t, err)
+		require.NoError(t, setupRep.AddAllAndCommit(msg, nil))
+		require.NoError(t, setupRep.Push(nil))
+		id, err := setupRep.LastCommitID()
+		require.NoError(t, err)
+		return id
+	}
+	firstCommitID := commit("first commit")
+
+	c := newRepoCache()
+	var clones int
+	c.cloneBareFn = func(
+		repoURL string,
+		clientOpts *git.ClientOptions,
+		cloneOpts *git.BareCloneOptions,
+	) (git.BareRepo, error) {
+		clones++
+		return git.CloneBare(repoURL, clientOpts, cloneOpts)
+	}
+	defer func() {
+		for _, entry := range c.entries {
+			entry.discard()
+		}
+	}()
+
+	repo, err := c.clone(testRepoURL, nil, &git.CloneOptions{Filter: git.FilterTreeless})
+	require.NoError(t, err)
+	commits, err := repo.ListCommits(0, 0)
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	require.Equal(t, firstCommitID, commits[0].ID)
+	// No files should have been checked out
+	_, err = os.Stat(filepath.Join(repo.Dir(), "test.txt"))
+	require.True(t, os.IsNotExist(err))
+	require.NoError(t, repo.Close())
+	// Closing more than once should be harmless
+	require.NoError(t, repo.Close())
+
+	// The second time around, the cached repository should be updated instead
+	// of being cloned again.
+	secondCommitID := commit("second commit")
+	repo, err = c.clone(testRepoURL, nil, &git.CloneOptions{Filter: git.FilterTreeless})
+	require.NoError(t, err)
+	commits, err = repo.ListCommits(0, 0)
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	require.Equal(t, secondCommitID, commits[0].ID)
+	require.NoError(t, repo.Close())
+	require.Equal(t, 1, clones)
+
+	// Specifying a branch should produce a working tree for that branch
+	repo, err = c.clone(
+		testRepoURL,
+		nil,
+		&git.CloneOptions{Branch: "master", Filter: git.FilterTreeless},
+	)
+	require.NoError(t, err)
+	commits, err = repo.ListCommits(0, 0)
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	require.NoError(t, repo.Close())
+	require.Equal(t, 1, clones)
+
+	// A non-existent branch should produce an error, but the cached repository
+	// should remain usable
+	_, err = c.clone(
+		testRepoURL,
+		nil,
+		&git.CloneOptions{Branch: "non-existent", Filter: git.FilterTreeless},
+	)
+	require.ErrorContains(t, err, "error adding working tree")
+	repo, err = c.clone(testRepoURL, nil, &git.CloneOptions{Filter: git.FilterTreeless})
+	require.NoError(t, err)
+	require.NoError(t, repo.Close())
+	require.Equal(t, 1, clones)
+
+	// A different filter should result in a separate clone
+	repo, err = c.clone(testRepoURL, nil, &git.CloneOptions{Filter: git.FilterBlobless})
+	require.NoError(t, err)
+	require.NoError(t, repo.Close())
+	require.Equal(t, 2, clones)
+	require.Len(t, c.entries, 2)
+
+	// Repositories that haven't been used recently should be removed
+	c.nowFn = func() time.Time { return time.Now().Add(repoRetention + time.Minute) }
+	repo, err = c.clone(testRepoURL, nil, &git.CloneOptions{Filter: git.FilterBlobless})
+	require.NoError(t, err)
+	require.NoError(t, repo.Close())
+	require.Len(t, c.entries, 1)
+}
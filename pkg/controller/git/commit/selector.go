@@ -32,5 +32,9 @@ func NewSelector(
 		return nil, fmt.Errorf("error getting selector factory")
 	}
 	factory := reg.Value
-	return factory(sub, creds)
+	selector, err := factory(sub, creds)
+	if err != nil {
+		return nil, err
+	}
+	return newCachingSelector(selector, sub, creds)
 }
@@ -107,6 +107,18 @@ func (t *tagBasedSelector) MatchesRef(ref string) bool {
 	return t.matchesTag(ref)
 }
 
+// fingerprintRefs implements refsFingerprinter. Commits are selected on the
+// basis of tags, so only tags satisfying the selector's constraints are
+// relevant.
+func (t *tagBasedSelector) fingerprintRefs() (string, error) {
+	return t.fingerprintRemoteRefs(
+		&git.ListRemoteRefsOptions{Tags: true},
+		func(name string) bool {
+			return strings.HasPrefix(name, tagPrefix) && t.matchesTag(name)
+		},
+	)
+}
+
 // getLoggerContext returns key/value pairs that can be used by any selector
 // that selects commits on the basis of tag names or metadata to enrich loggers
 // with valuable context.
@@ -153,7 +165,7 @@ func (t *tagBasedSelector) clone(ctx context.Context) (git.Repo, error) {
 	logger.Debug("cloning repository")
 	cloneOpts := &git.CloneOptions{
 		SingleBranch: true,
-		Filter:       git.FilterBlobless,
+		Filter:       t.cloneFilter(),
 	}
 	repo, err := t.gitCloneFn(
 		t.repoURL,
@@ -193,18 +205,7 @@ func (t *tagBasedSelector) filterTagsByExpression(
 
 	filteredTags := make([]git.TagMetadata, 0, len(tags))
 	for _, tag := range tags {
-		env := map[string]any{
-			"tag":         tag.Tag,
-			"id":          tag.CommitID,
-			"creatorDate": tag.CreatorDate,
-			"author":      tag.Author,
-			"committer":   tag.Committer,
-			"subject":     tag.Subject,
-			"tagger":      tag.Tagger,
-			"annotation":  tag.Annotation,
-		}
-
-		result, err := expr.Run(t.filterExpression, env)
+		result, err := expr.Run(t.filterExpression, tagExpressionEnv(tag))
 		if err != nil {
 			return nil, fmt.Errorf("error evaluating tag filter expression: %w", err)
 		}
@@ -229,6 +230,21 @@ func (t *tagBasedSelector) filterTagsByExpression(
 	return slices.Clip(filteredTags), nil
 }
 
+// tagExpressionEnv returns the environment in which user-defined expressions
+// are evaluated against the metadata of the provided tag.
+func tagExpressionEnv(tag git.TagMetadata) map[string]any {
+	return map[string]any{
+		"tag":         tag.Tag,
+		"id":          tag.CommitID,
+		"creatorDate": tag.CreatorDate,
+		"author":      tag.Author,
+		"committer":   tag.Committer,
+		"subject":     tag.Subject,
+		"tagger":      tag.Tagger,
+		"annotation":  tag.Annotation,
+	}
+}
+
 // filterTagsByDiffPaths iterates over all provided tags, for each, retrieving
 // information about paths affected by the commit it references and evaluating
 // those paths against user-defined path-selection criteria. Only tags pointing
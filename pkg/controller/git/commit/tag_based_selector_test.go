@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/discoverycache"
 	"github.com/akuity/kargo/pkg/controller/git"
 )
 
@@ -161,6 +162,29 @@ func Test_tagBasedSelector_MatchesRef(t *testing.T) {
 	}
 }
 
+func Test_tagBasedSelector_fingerprintRefs(t *testing.T) {
+	refs := []git.RemoteRef{
+		{Name: "refs/tags/v1.0.0", CommitID: "abc"},
+		{Name: "refs/tags/ignored", CommitID: "def"},
+	}
+	s := &tagBasedSelector{
+		baseSelector: &baseSelector{
+			listRemoteRefsFn: func(
+				_ string,
+				_ *git.ClientOptions,
+				opts *git.ListRemoteRefsOptions,
+			) ([]git.RemoteRef, error) {
+				require.True(t, opts.Tags)
+				return refs, nil
+			},
+		},
+		ignoreTagsRegexes: []*regexp.Regexp{regexp.MustCompile("^ignored$")},
+	}
+	fingerprint, err := s.fingerprintRefs()
+	require.NoError(t, err)
+	require.Equal(t, discoverycache.Key("refs/tags/v1.0.0", "abc"), fingerprint)
+}
+
 func Test_tagBasedSelector_filterTagsByExpression(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -14,3 +14,15 @@ package git
 // paths to compute diffs, so these will trigger blob downloads the first time
 // they are run.
 const FilterBlobless = "blob:none"
+
+// FilterTreeless is a filter that excludes both blobs and trees from the
+// clone. When using this filter, the initial Git clone will download only
+// reachable commits, and will download trees and blobs on demand.
+//
+// A treeless clone is the smallest and fastest to produce, making it ideal for
+// exploring commit metadata with commands like `git log` (without paths).
+// Commands that must inspect the contents of a commit, such as
+// `git log -- <path>` or `git show --name-only`, will trigger tree downloads
+// and will therefore perform poorly. Where such commands are required, a
+// blobless clone is usually a better choice.
+const FilterTreeless = "tree:0"
@@ -22,3 +22,13 @@ var ErrNonFastForward = errors.New("non-fast-forward")
 func IsNonFastForward(err error) bool {
 	return errors.Is(err, ErrNonFastForward)
 }
+
+// ErrTagAlreadyExists is returned when a push is rejected because a tag by the
+// same name already exists in the remote repository.
+var ErrTagAlreadyExists = errors.New("tag already exists")
+
+// IsTagAlreadyExists returns true if the error is a tag already existing or
+// wraps one and false otherwise.
+func IsTagAlreadyExists(err error) bool {
+	return errors.Is(err, ErrTagAlreadyExists)
+}
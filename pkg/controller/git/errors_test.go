@@ -77,3 +77,38 @@ func TestIsNonFastForward(t *testing.T) {
 		})
 	}
 }
+
+func TestIsTagAlreadyExists(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "nil error",
+			err:      nil,
+			expected: false,
+		},
+		{
+			name:     "not a tag already exists error",
+			err:      errors.New("something went wrong"),
+			expected: false,
+		},
+		{
+			name:     "a tag already exists error",
+			err:      ErrTagAlreadyExists,
+			expected: true,
+		},
+		{
+			name:     "a wrapped tag already exists error",
+			err:      fmt.Errorf("an error occurred: %w", ErrTagAlreadyExists),
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := IsTagAlreadyExists(testCase.err)
+			require.Equal(t, testCase.expected, actual)
+		})
+	}
+}
@@ -13,8 +13,10 @@ type MockRepo struct {
 	CommitFn                  func(message string, opts *CommitOptions) error
 	CreateChildBranchFn       func(branch string) error
 	CreateOrphanedBranchFn    func(branch string) error
+	CreateTagFn               func(tag string, opts *CreateTagOptions) error
 	CurrentBranchFn           func() (string, error)
 	DeleteBranchFn            func(branch string) error
+	DiffFromRemoteBranchFn    func(branch string) (string, error)
 	DirFn                     func() string
 	HasDiffsFn                func() (bool, error)
 	HomeDirFn                 func() string
@@ -26,9 +28,12 @@ type MockRepo struct {
 	ListCommitsFn             func(limit, skip uint) ([]CommitMetadata, error)
 	CommitMessageFn           func(id string) (string, error)
 	PushFn                    func(*PushOptions) error
+	PushTagFn                 func(tag string, opts *PushTagOptions) error
 	RefsHaveDiffsFn           func(commit1 string, commit2 string) (bool, error)
 	RemoteBranchExistsFn      func(branch string) (bool, error)
+	RemoteTagCommitIDFn       func(tag string) (string, error)
 	ResetHardFn               func() error
+	ResolveCommitIDFn         func(rev string) (string, error)
 	URLFn                     func() string
 	UpdateSubmodulesFn        func() error
 }
@@ -75,6 +80,10 @@ func (m *MockRepo) CreateOrphanedBranch(branch string) error {
 	return m.CreateOrphanedBranchFn(branch)
 }
 
+func (m *MockRepo) CreateTag(tag string, opts *CreateTagOptions) error {
+	return m.CreateTagFn(tag, opts)
+}
+
 func (m *MockRepo) CurrentBranch() (string, error) {
 	return m.CurrentBranchFn()
 }
@@ -83,6 +92,10 @@ func (m *MockRepo) DeleteBranch(branch string) error {
 	return m.DeleteBranchFn(branch)
 }
 
+func (m *MockRepo) DiffFromRemoteBranch(branch string) (string, error) {
+	return m.DiffFromRemoteBranchFn(branch)
+}
+
 func (m *MockRepo) Dir() string {
 	return m.DirFn()
 }
@@ -129,6 +142,10 @@ func (m *MockRepo) Push(opts *PushOptions) error {
 	return m.PushFn(opts)
 }
 
+func (m *MockRepo) PushTag(tag string, opts *PushTagOptions) error {
+	return m.PushTagFn(tag, opts)
+}
+
 func (m *MockRepo) RefsHaveDiffs(
 	commit1 string,
 	commit2 string,
@@ -140,10 +157,18 @@ func (m *MockRepo) RemoteBranchExists(branch string) (bool, error) {
 	return m.RemoteBranchExistsFn(branch)
 }
 
+func (m *MockRepo) RemoteTagCommitID(tag string) (string, error) {
+	return m.RemoteTagCommitIDFn(tag)
+}
+
 func (m *MockRepo) ResetHard() error {
 	return m.ResetHardFn()
 }
 
+func (m *MockRepo) ResolveCommitID(rev string) (string, error) {
+	return m.ResolveCommitIDFn(rev)
+}
+
 func (m *MockRepo) URL() string {
 	return m.URLFn()
 }
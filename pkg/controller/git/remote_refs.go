@@ -0,0 +1,138 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	libExec "github.com/akuity/kargo/pkg/exec"
+)
+
+// peeledRefSuffix is the suffix `git ls-remote` appends to the name of an
+// annotated tag to indicate that the accompanying ID is that of the commit the
+// tag points to rather than that of the tag object itself.
+const peeledRefSuffix = "^{}"
+
+// RemoteRef represents a ref in a remote Git repository.
+type RemoteRef struct {
+	// Name is the full name of the ref. e.g. "refs/heads/main" or
+	// "refs/tags/v1.0.0".
+	Name string
+	// CommitID is the ID (sha) of the commit the ref points to. For annotated
+	// tags, this is the ID of the commit the tag points to and NOT the ID of
+	// the tag object itself.
+	CommitID string
+}
+
+// ListRemoteRefsOptions represents options for listing the refs in a remote
+// Git repository.
+type ListRemoteRefsOptions struct {
+	// BaseDir is an existing directory within which a temporary home directory
+	// will be created. If not specified, the operating system's temporary
+	// directory will be used.
+	BaseDir string
+	// Heads specifies whether the refs listed should be limited to branches.
+	// This may be combined with Tags.
+	Heads bool
+	// Tags specifies whether the refs listed should be limited to tags. This
+	// may be combined with Heads.
+	Tags bool
+	// Patterns optionally limits the refs listed to those whose names match
+	// any of the specified patterns. Refer to `git ls-remote` documentation for
+	// information on how patterns are matched.
+	Patterns []string
+}
+
+// ListRemoteRefs lists refs in the remote Git repository at the specified URL
+// WITHOUT cloning it. This is considerably less expensive than cloning the
+// repository when only the commits that refs point to are of interest. This
+// function will also perform any setup that is required for successfully
+// authenticating to the remote repository.
+func ListRemoteRefs(
+	repoURL string,
+	clientOpts *ClientOptions,
+	opts *ListRemoteRefsOptions,
+) ([]RemoteRef, error) {
+	if clientOpts == nil {
+		clientOpts = &ClientOptions{}
+	}
+	if opts == nil {
+		opts = &ListRemoteRefsOptions{}
+	}
+	homeDir, err := os.MkdirTemp(opts.BaseDir, "repo-")
+	if err != nil {
+		return nil,
+			fmt.Errorf("error creating home directory for repo %q: %w", repoURL, err)
+	}
+	defer os.RemoveAll(homeDir)
+	if homeDir, err = filepath.EvalSymlinks(homeDir); err != nil {
+		return nil,
+			fmt.Errorf("error resolving symlinks in path %s: %w", homeDir, err)
+	}
+	b := &baseRepo{
+		creds: clientOpts.Credentials,
+		// There is no repository, so commands are executed from the home
+		// directory.
+		dir:         homeDir,
+		homeDir:     homeDir,
+		originalURL: repoURL,
+		accessURL:   repoURL,
+	}
+	if err = b.setupClient(homeDir, clientOpts); err != nil {
+		return nil, err
+	}
+	args := []string{"ls-remote"}
+	if opts.Heads {
+		args = append(args, "--heads")
+	}
+	if opts.Tags {
+		args = append(args, "--tags")
+	}
+	args = append(args, b.accessURL)
+	args = append(args, opts.Patterns...)
+	res, err := libExec.Exec(b.buildGitCommand(args...))
+	if err != nil {
+		return nil, fmt.Errorf("error listing refs in repo %q: %w", repoURL, err)
+	}
+	refs, err := parseRemoteRefs(res)
+	if err != nil {
+		return nil, fmt.Errorf("error listing refs in repo %q: %w", repoURL, err)
+	}
+	return refs, nil
+}
+
+// parseRemoteRefs parses the output of `git ls-remote`. Peeled refs (i.e.
+// those describing the commit an annotated tag points to) are not returned
+// separately. Instead, the commit IDs they specify replace those of the
+// corresponding tags.
+func parseRemoteRefs(output []byte) ([]RemoteRef, error) {
+	var refs []RemoteRef
+	indices := map[string]int{}
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		id, name, ok := strings.Cut(line, "\t")
+		if !ok {
+			return nil, fmt.Errorf("unexpected ls-remote output: %q", line)
+		}
+		if peeledName, ok := strings.CutSuffix(name, peeledRefSuffix); ok {
+			if i, ok := indices[peeledName]; ok {
+				refs[i].CommitID = id
+				continue
+			}
+			name = peeledName
+		}
+		indices[name] = len(refs)
+		refs = append(refs, RemoteRef{Name: name, CommitID: id})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error scanning ls-remote output: %w", err)
+	}
+	return refs, nil
+}
@@ -0,0 +1,135 @@
+package git
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/sosedoff/gitkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListRemoteRefs(t *testing.T) {
+	service := gitkit.New(gitkit.Config{
+		Dir:        t.TempDir(),
+		AutoCreate: true,
+	})
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
+
+	setupRep, err := Clone(testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer setupRep.Close()
+	err = os.WriteFile(fmt.Sprintf("%s/%s", setupRep.Dir(), "test.txt"), []byte("foo"), 0600)
+	require.NoError(t, err)
+	err = setupRep.AddAllAndCommit("initial commit", nil)
+	require.NoError(t, err)
+	err = setupRep.Push(nil)
+	require.NoError(t, err)
+	commitID, err := setupRep.LastCommitID()
+	require.NoError(t, err)
+	err = setupRep.CreateTag("v1.0.0", nil)
+	require.NoError(t, err)
+	err = setupRep.PushTag("v1.0.0", nil)
+	require.NoError(t, err)
+
+	t.Run("all refs", func(t *testing.T) {
+		refs, err := ListRemoteRefs(testRepoURL, nil, nil)
+		require.NoError(t, err)
+		require.Contains(t, refs, RemoteRef{Name: "HEAD", CommitID: commitID})
+		require.Contains(t, refs, RemoteRef{Name: "refs/heads/master", CommitID: commitID})
+		// The tag is annotated, but the ID of the commit it points to should be
+		// returned instead of the ID of the tag object.
+		require.Contains(t, refs, RemoteRef{Name: "refs/tags/v1.0.0", CommitID: commitID})
+	})
+
+	t.Run("heads only", func(t *testing.T) {
+		refs, err := ListRemoteRefs(testRepoURL, nil, &ListRemoteRefsOptions{Heads: true})
+		require.NoError(t, err)
+		require.Equal(t, []RemoteRef{{Name: "refs/heads/master", CommitID: commitID}}, refs)
+	})
+
+	t.Run("tags only", func(t *testing.T) {
+		refs, err := ListRemoteRefs(testRepoURL, nil, &ListRemoteRefsOptions{Tags: true})
+		require.NoError(t, err)
+		require.Equal(t, []RemoteRef{{Name: "refs/tags/v1.0.0", CommitID: commitID}}, refs)
+	})
+
+	t.Run("with patterns", func(t *testing.T) {
+		refs, err := ListRemoteRefs(
+			testRepoURL,
+			nil,
+			&ListRemoteRefsOptions{Patterns: []string{"refs/heads/non-existent"}},
+		)
+		require.NoError(t, err)
+		require.Empty(t, refs)
+	})
+
+	t.Run("not a repo", func(t *testing.T) {
+		_, err := ListRemoteRefs("file://"+t.TempDir(), nil, nil)
+		require.ErrorContains(t, err, "error listing refs in repo")
+	})
+}
+
+func Test_parseRemoteRefs(t *testing.T) {
+	testCases := []struct {
+		name       string
+		output     string
+		assertions func(*testing.T, []RemoteRef, error)
+	}{
+		{
+			name:   "unexpected output",
+			output: "not ls-remote output\n",
+			assertions: func(t *testing.T, _ []RemoteRef, err error) {
+				require.ErrorContains(t, err, "unexpected ls-remote output")
+			},
+		},
+		{
+			name:   "empty output",
+			output: "",
+			assertions: func(t *testing.T, refs []RemoteRef, err error) {
+				require.NoError(t, err)
+				require.Empty(t, refs)
+			},
+		},
+		{
+			name: "peeled refs",
+			output: "aaa\tHEAD\n" +
+				"aaa\trefs/heads/main\n" +
+				"bbb\trefs/tags/v1.0.0\n" +
+				"ccc\trefs/tags/v1.0.0^{}\n" +
+				"ddd\trefs/tags/v1.1.0\n",
+			assertions: func(t *testing.T, refs []RemoteRef, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]RemoteRef{
+						{Name: "HEAD", CommitID: "aaa"},
+						{Name: "refs/heads/main", CommitID: "aaa"},
+						{Name: "refs/tags/v1.0.0", CommitID: "ccc"},
+						{Name: "refs/tags/v1.1.0", CommitID: "ddd"},
+					},
+					refs,
+				)
+			},
+		},
+		{
+			name:   "peeled ref without preceding ref",
+			output: "ccc\trefs/tags/v1.0.0^{}\n",
+			assertions: func(t *testing.T, refs []RemoteRef, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []RemoteRef{{Name: "refs/tags/v1.0.0", CommitID: "ccc"}}, refs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			refs, err := parseRemoteRefs([]byte(testCase.output))
+			testCase.assertions(t, refs, err)
+		})
+	}
+}
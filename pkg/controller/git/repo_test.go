@@ -160,6 +160,42 @@ with a body
 		require.True(t, exists)
 	})
 
+	testTag := fmt.Sprintf("test-tag-%s", uuid.NewString())
+
+	t.Run("can check remote tag commit id -- tag does not exist", func(t *testing.T) {
+		var commitID string
+		commitID, err = rep.RemoteTagCommitID(testTag)
+		require.NoError(t, err)
+		require.Empty(t, commitID)
+	})
+
+	t.Run("can create a tag", func(t *testing.T) {
+		err = rep.CreateTag(testTag, &CreateTagOptions{Message: "test tag"})
+		require.NoError(t, err)
+	})
+
+	t.Run("can push a tag", func(t *testing.T) {
+		err = rep.PushTag(testTag, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("can check remote tag commit id -- tag exists", func(t *testing.T) {
+		var commitID string
+		commitID, err = rep.RemoteTagCommitID(testTag)
+		require.NoError(t, err)
+		require.Equal(t, lastCommitID, commitID)
+	})
+
+	t.Run("cannot push a tag that already exists in the remote", func(t *testing.T) {
+		err = rep.CreateTag(testTag, &CreateTagOptions{
+			Message: "another test tag",
+			Force:   true,
+		})
+		require.NoError(t, err)
+		err = rep.PushTag(testTag, nil)
+		require.ErrorIs(t, err, ErrTagAlreadyExists)
+	})
+
 	testBranch := fmt.Sprintf("test-branch-%s", uuid.NewString())
 	err = rep.CreateChildBranch(testBranch)
 	require.NoError(t, err)
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -42,10 +43,18 @@ type WorkTree interface {
 	// CreateOrphanedBranch creates a new branch that shares no commit history
 	// with any other branch.
 	CreateOrphanedBranch(branch string) error
+	// CreateTag creates an annotated tag pointing to the specified commit, or to
+	// the head of the current branch if no commit is specified.
+	CreateTag(tag string, opts *CreateTagOptions) error
 	// CurrentBranch returns the current branch
 	CurrentBranch() (string, error)
 	// DeleteBranch deletes the specified branch
 	DeleteBranch(branch string) error
+	// DiffFromRemoteBranch returns a unified diff of the changes committed to
+	// the current branch since it diverged from the specified branch of the
+	// remote repository. If the specified branch does not exist in the remote
+	// repository, a diff of the most recent commit is returned instead.
+	DiffFromRemoteBranch(branch string) (string, error)
 	// Dir returns an absolute path to the working tree.
 	Dir() string
 	// HasDiffs returns a bool indicating whether the working tree currently
@@ -78,13 +87,24 @@ type WorkTree interface {
 	CommitMessage(id string) (string, error)
 	// Push pushes from the local repository to the remote repository.
 	Push(*PushOptions) error
+	// PushTag pushes the specified tag from the local repository to the remote
+	// repository.
+	PushTag(tag string, opts *PushTagOptions) error
 	// RefsHaveDiffs returns whether there is a diff between two commits/branches
 	RefsHaveDiffs(commit1 string, commit2 string) (bool, error)
 	// RemoteBranchExists returns a bool indicating if the specified branch exists
 	// in the remote repository.
 	RemoteBranchExists(branch string) (bool, error)
+	// RemoteTagCommitID returns the ID (sha) of the commit the specified tag
+	// points to in the remote repository. If the tag does not exist in the
+	// remote repository, an empty string is returned.
+	RemoteTagCommitID(tag string) (string, error)
 	// ResetHard performs a hard reset on the working tree.
 	ResetHard() error
+	// ResolveCommitID returns the full ID (sha) of the commit referenced by the
+	// specified revision, which may be an abbreviated ID, a branch, a tag, or
+	// any other revision understood by Git.
+	ResolveCommitID(rev string) (string, error)
 	// URL returns the remote URL of the repository.
 	URL() string
 	// UpdateSubmodules updates the submodules in the working tree.
@@ -211,17 +231,29 @@ func (w *workTree) Commit(message string, opts *CommitOptions) error {
 		opts = &CommitOptions{}
 	}
 
-	var homeDir string
-	if opts.Author != nil {
-		// This author information is specific to this commit, so we will override
-		// repository-level author information by creating a temporary home
-		// directory, configuring the author information "globally" within it, and
-		// then ensuring the git commit command uses that home directory.
-		var err error
-		if homeDir, err = os.MkdirTemp(w.homeDir, ""); err != nil {
+	cmdTokens := []string{"commit", "-m", message}
+	if opts.AllowEmpty {
+		cmdTokens = append(cmdTokens, "--allow-empty")
+	}
+
+	if err := w.execWithUser(w.buildGitCommand(cmdTokens...), opts.Author); err != nil {
+		return fmt.Errorf("error committing changes: %w", err)
+	}
+	return nil
+}
+
+// execWithUser executes the provided command. If the provided user is non-nil,
+// the command is executed using a temporary home directory in which the user's
+// information (including any signing key) has been configured "globally." This
+// overrides any repository-level user information for the duration of the
+// command only.
+func (w *workTree) execWithUser(cmd *exec.Cmd, user *User) error {
+	if user != nil {
+		homeDir, err := os.MkdirTemp(w.homeDir, "")
+		if err != nil {
 			return fmt.Errorf(
-				"error creating virtual home directory %q for commit command: %w",
-				homeDir, err,
+				"error creating virtual home directory %q for %s command: %w",
+				homeDir, cmd.Args[1], err,
 			)
 		}
 		defer func() {
@@ -230,27 +262,17 @@ func (w *workTree) Commit(message string, opts *CommitOptions) error {
 					Error(cleanErr, "error removing virtual home directory", "path", homeDir)
 			}
 		}()
-		if err = w.setupAuthor(homeDir, opts.Author); err != nil {
+		if err = w.setupAuthor(homeDir, user); err != nil {
 			return fmt.Errorf(
-				"error setting up author information for commit command: %w", err,
+				"error setting up user information for %s command: %w",
+				cmd.Args[1], err,
 			)
 		}
-	}
-
-	cmdTokens := []string{"commit", "-m", message}
-	if opts.AllowEmpty {
-		cmdTokens = append(cmdTokens, "--allow-empty")
-	}
-
-	cmd := w.buildGitCommand(cmdTokens...)
-	if homeDir != "" {
 		// Override the home directory set by b.buildGitCommand().
 		w.setCmdHome(cmd, homeDir)
 	}
-	if _, err := libExec.Exec(cmd); err != nil {
-		return fmt.Errorf("error committing changes: %w", err)
-	}
-	return nil
+	_, err := libExec.Exec(cmd)
+	return err
 }
 
 func (w *workTree) CommitMessage(id string) (string, error) {
@@ -296,6 +318,48 @@ func (w *workTree) CreateOrphanedBranch(branch string) error {
 	return w.Clean()
 }
 
+// CreateTagOptions represents options for creating a tag in a git repository.
+type CreateTagOptions struct {
+	// Commit is the ID (sha) of the commit the tag should point to. If empty,
+	// the tag will point to the head of the current branch.
+	Commit string
+	// Force indicates whether an existing local tag by the same name should be
+	// replaced.
+	Force bool
+	// Message is the annotation of the tag. If empty, the name of the tag is
+	// used as its annotation.
+	Message string
+	// Tagger is the creator of the tag. If nil, the default user already
+	// configured in the git repository will be used. If the tagger has a
+	// signing key, the tag will be signed.
+	Tagger *User
+}
+
+func (w *workTree) CreateTag(tag string, opts *CreateTagOptions) error {
+	if opts == nil {
+		opts = &CreateTagOptions{}
+	}
+	message := opts.Message
+	if message == "" {
+		message = tag
+	}
+	cmdTokens := []string{"tag", "--annotate", "--message", message}
+	if opts.Force {
+		cmdTokens = append(cmdTokens, "--force")
+	}
+	cmdTokens = append(cmdTokens, tag)
+	if opts.Commit != "" {
+		cmdTokens = append(cmdTokens, opts.Commit)
+	}
+	if err := w.execWithUser(w.buildGitCommand(cmdTokens...), opts.Tagger); err != nil {
+		return fmt.Errorf(
+			"error creating tag %q for repo %q: %w",
+			tag, w.originalURL, err,
+		)
+	}
+	return nil
+}
+
 func (w *workTree) CurrentBranch() (string, error) {
 	res, err := libExec.Exec(w.buildGitCommand("branch", "--show-current"))
 	if err != nil {
@@ -336,6 +400,32 @@ func (w *workTree) GetDiffPathsForCommitID(commitID string) ([]string, error) {
 	return paths, nil
 }
 
+func (w *workTree) DiffFromRemoteBranch(branch string) (string, error) {
+	exists, err := w.RemoteBranchExists(branch)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		res, err := libExec.Exec(w.buildGitCommand(
+			"diff-tree", "--patch", "--root", "--no-commit-id", "--no-color", "HEAD",
+		))
+		if err != nil {
+			return "", fmt.Errorf("error getting diff of last commit: %w", err)
+		}
+		return string(res), nil
+	}
+	if _, err = libExec.Exec(w.buildGitCommand("fetch", "origin", branch)); err != nil {
+		return "", fmt.Errorf("error fetching branch %q: %w", branch, err)
+	}
+	// Using three dots yields only the changes made on the current branch since
+	// it diverged from the remote branch.
+	res, err := libExec.Exec(w.buildGitCommand("diff", "--no-color", "FETCH_HEAD...HEAD"))
+	if err != nil {
+		return "", fmt.Errorf("error getting diff from branch %q: %w", branch, err)
+	}
+	return string(res), nil
+}
+
 func (w *workTree) HasDiffs() (bool, error) {
 	resBytes, err := libExec.Exec(w.buildGitCommand("status", "-s"))
 	if err != nil {
@@ -391,6 +481,16 @@ func (w *workTree) LastCommitID() (string, error) {
 	return strings.TrimSpace(string(shaBytes)), nil
 }
 
+func (w *workTree) ResolveCommitID(rev string) (string, error) {
+	shaBytes, err := libExec.Exec(
+		w.buildGitCommand("rev-parse", "--verify", "--end-of-options", rev+"^{commit}"),
+	)
+	if err != nil {
+		return "", fmt.Errorf("error resolving commit ID for %q: %w", rev, err)
+	}
+	return strings.TrimSpace(string(shaBytes)), nil
+}
+
 type CommitMetadata struct {
 	// CommitID is the ID (sha) of the commit.
 	ID string
@@ -635,6 +735,35 @@ func (w *workTree) Push(opts *PushOptions) error {
 	return nil
 }
 
+// PushTagOptions represents options for pushing a tag to a remote git
+// repository.
+type PushTagOptions struct {
+	// Force indicates whether an existing remote tag by the same name should be
+	// overwritten.
+	Force bool
+}
+
+// tagAlreadyExistsRegex matches the output of a push that was rejected because
+// the tag already exists in the remote repository.
+var tagAlreadyExistsRegex = regexp.MustCompile(`(?m)^\s*!\s+\[(?:remote )?rejected].+\(already exists\)\s*$`)
+
+func (w *workTree) PushTag(tag string, opts *PushTagOptions) error {
+	if opts == nil {
+		opts = &PushTagOptions{}
+	}
+	args := []string{"push", "origin", fmt.Sprintf("refs/tags/%s", tag)}
+	if opts.Force {
+		args = append(args, "--force")
+	}
+	if res, err := libExec.Exec(w.buildGitCommand(args...)); err != nil {
+		if tagAlreadyExistsRegex.MatchString(string(res)) {
+			return fmt.Errorf("error pushing tag %q: %w", tag, ErrTagAlreadyExists)
+		}
+		return fmt.Errorf("error pushing tag %q: %w", tag, err)
+	}
+	return nil
+}
+
 func (w *workTree) RefsHaveDiffs(commit1 string, commit2 string) (bool, error) {
 	// `git diff --quiet` returns 0 if no diff, 1 if diff, and non-zero/one for any other error
 	_, err := libExec.Exec(w.buildGitCommand(
@@ -651,6 +780,41 @@ func (w *workTree) RefsHaveDiffs(commit1 string, commit2 string) (bool, error) {
 	return false, fmt.Errorf("error diffing commits %s..%s: %w", commit1, commit2, err)
 }
 
+func (w *workTree) RemoteTagCommitID(tag string) (string, error) {
+	ref := fmt.Sprintf("refs/tags/%s", tag)
+	// For annotated tags, the output includes the tag object itself AND the
+	// commit it points to (suffixed with "^{}"). For lightweight tags, only the
+	// former is included and it already refers to a commit.
+	res, err := libExec.Exec(w.buildGitCommand(
+		"ls-remote",
+		"--tags",
+		w.accessURL,
+		ref,
+		ref+"^{}",
+	))
+	if err != nil {
+		return "", fmt.Errorf(
+			"error checking for existence of tag %q in remote repo %q: %w",
+			tag, w.originalURL, err,
+		)
+	}
+	var commitID string
+	scanner := bufio.NewScanner(bytes.NewReader(res))
+	for scanner.Scan() {
+		parts := strings.Fields(scanner.Text())
+		if len(parts) != 2 {
+			continue
+		}
+		switch parts[1] {
+		case ref + "^{}":
+			return parts[0], nil
+		case ref:
+			commitID = parts[0]
+		}
+	}
+	return commitID, nil
+}
+
 func (w *workTree) ResetHard() error {
 	if _, err := libExec.Exec(w.buildGitCommand("reset", "--hard")); err != nil {
 		return fmt.Errorf("error resetting branch working tree: %w", err)
@@ -29,6 +29,17 @@ func TestNonFastForwardRegex(t *testing.T) {
 	testingPkg.ValidateRegularExpression(t, nonFastForwardRegex, testCases)
 }
 
+func TestTagAlreadyExistsRegex(t *testing.T) {
+	testCases := map[string]bool{
+		" ! [rejected]        v1.0.0 -> v1.0.0 (already exists)":               true,
+		" ! [remote rejected] v1.0.0 -> v1.0.0 (already exists)":               true,
+		" ! [rejected]        main -> main (fetch first)":                      false,
+		" ! [rejected]        krancour/foo -> krancour/foo (non-fast-forward)": false,
+	}
+
+	testingPkg.ValidateRegularExpression(t, tagAlreadyExistsRegex, testCases)
+}
+
 func TestWorkTree(t *testing.T) {
 	testRepoCreds := RepoCredentials{
 		Username: "fake-username",
@@ -110,6 +121,24 @@ func TestWorkTree(t *testing.T) {
 		require.Equal(t, workTree, existingWorkTree)
 	})
 
+	t.Run("can diff from remote branch", func(t *testing.T) {
+		err := os.WriteFile(filepath.Join(workTree.Dir(), "test.txt"), []byte("bar"), 0600)
+		require.NoError(t, err)
+		require.NoError(t, workTree.AddAllAndCommit("update test.txt", nil))
+
+		diff, err := workTree.DiffFromRemoteBranch("master")
+		require.NoError(t, err)
+		require.Contains(t, diff, "diff --git a/test.txt b/test.txt")
+		require.Contains(t, diff, "-foo")
+		require.Contains(t, diff, "+bar")
+
+		// A branch that does not exist in the remote repository yields the diff
+		// of the most recent commit
+		diff, err = workTree.DiffFromRemoteBranch("non-existent")
+		require.NoError(t, err)
+		require.Contains(t, diff, "+bar")
+	})
+
 	t.Run("can close working tree", func(t *testing.T) {
 		require.NoError(t, workTree.Close())
 		_, err := os.Stat(workTree.Dir())
@@ -30,7 +30,6 @@ import (
 	"github.com/akuity/kargo/pkg/kubeclient"
 	libEvent "github.com/akuity/kargo/pkg/kubernetes/event"
 	"github.com/akuity/kargo/pkg/logging"
-	intpredicate "github.com/akuity/kargo/pkg/predicate"
 	"github.com/akuity/kargo/pkg/promotion"
 )
 
@@ -129,7 +128,9 @@ func SetupReconcilerWithManager(
 			IsDefaultController: cfg.IsDefaultController,
 			ShardName:           cfg.ShardName,
 		}).
-		WithEventFilter(intpredicate.IgnoreDelete[client.Object]{}).
+		// Delete events are not ignored, so that anything still held for
+		// executing the steps of a deleted Promotion (e.g. a worker Pod) is
+		// released.
 		WithEventFilter(predicate.Or(
 			predicate.GenerationChangedPredicate{},
 			kargo.RefreshRequested{},
@@ -229,6 +230,12 @@ func (r *reconciler) Reconcile(
 	if promo == nil || promo.Status.Phase.IsTerminal() {
 		// Ignore if not found or already finished. Promo might be nil if the
 		// Promotion was deleted after the current reconciliation request was issued.
+		// Either way, release anything still held for executing its steps; e.g.
+		// the worker Pod of a Promotion deleted while running, or one that could
+		// not be released when the Promotion finished.
+		if err = r.promoEngine.Cleanup(ctx, req.Namespace, req.Name); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error cleaning up after Promotion: %w", err)
+		}
 		return ctrl.Result{}, nil
 	}
 
@@ -395,6 +402,16 @@ func (r *reconciler) Reconcile(
 		}
 	}
 
+	// Only once a terminal phase has been persisted is it safe to release what
+	// was held for executing the Promotion's steps. Were the result reported by
+	// a worker Pod discarded any sooner, a failure to persist it would cause the
+	// steps to be executed all over again.
+	if err == nil && newStatus.Phase.IsTerminal() {
+		if err = r.promoEngine.Cleanup(ctx, promo.Namespace, promo.Name); err != nil {
+			logger.Error(err, "error cleaning up after Promotion")
+		}
+	}
+
 	// Record event after patching status if new phase is terminal
 	if newStatus.Phase.IsTerminal() {
 		stage, getStageErr := r.getStageFn(
@@ -506,11 +523,13 @@ func (r *reconciler) promote(
 	logger = logger.WithValues("targetFreight", targetFreight.Name)
 
 	targetFreightRef := kargoapi.FreightReference{
-		Name:    targetFreight.Name,
-		Commits: targetFreight.Commits,
-		Images:  targetFreight.Images,
-		Charts:  targetFreight.Charts,
-		Origin:  targetFreight.Origin,
+		Name:         targetFreight.Name,
+		Commits:      targetFreight.Commits,
+		Images:       targetFreight.Images,
+		Charts:       targetFreight.Charts,
+		OCIArtifacts: targetFreight.OCIArtifacts,
+		Releases:     targetFreight.Releases,
+		Origin:       targetFreight.Origin,
 	}
 
 	// Make a deep copy of the Promotion to pass to the promotion steps execution
@@ -692,11 +711,22 @@ func (r *reconciler) terminatePromotion(
 		logger.Error(err, "error sending Promotion aborted event")
 	}
 
+	// Stop the execution of the Promotion's steps if it is still in progress;
+	// e.g. in a worker Pod.
+	if err := r.promoEngine.Cleanup(ctx, promo.Namespace, promo.Name); err != nil {
+		return fmt.Errorf("error cleaning up after terminated Promotion: %w", err)
+	}
+
 	return nil
 }
 
 var defaultRequeueInterval = 5 * time.Minute
 
+// minRequeueInterval is the minimum interval after which a Promotion with a
+// step that is backing off is requeued. A zero interval would not cause the
+// Promotion to be requeued at all.
+var minRequeueInterval = time.Second
+
 func calculateRequeueInterval(
 	ctx context.Context,
 	p *kargoapi.Promotion,
@@ -708,11 +738,25 @@ func calculateRequeueInterval(
 	}
 
 	// Ensure we have a step for the current step index.
-	if int(p.Status.CurrentStep) >= len(p.Spec.Steps) {
+	steps := p.Spec.GetSteps()
+	if int(p.Status.CurrentStep) >= len(steps) {
 		return requeueInterval
 	}
 
-	step := p.Spec.Steps[p.Status.CurrentStep]
+	// If the current step is backing off after an errored or failed attempt,
+	// requeue once the next attempt is due.
+	if int(p.Status.CurrentStep) < len(p.Status.StepExecutionMetadata) {
+		if next := p.Status.StepExecutionMetadata[p.Status.CurrentStep].NextAttemptAt; next != nil {
+			return max(time.Until(next.Time), minRequeueInterval)
+		}
+	}
+
+	step := steps[p.Status.CurrentStep]
+	if step.Parallel != nil {
+		// The steps in a parallel group each have their own timeout. We do not
+		// attempt to determine which of them would time out first.
+		return requeueInterval
+	}
 	reg, err := promotion.DefaultStepRunnerRegistry.Get(step.Uses)
 	if err != nil {
 		logging.LoggerFromContext(ctx).Error(err, err.Error())
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -305,6 +306,14 @@ func TestReconcile(t *testing.T) {
 			recorder := fakeevent.NewEventRecorder(1)
 			r := newFakeReconciler(t, recorder, tc.promos...)
 
+			var cleanedUp []types.NamespacedName
+			r.promoEngine = &promotion.MockEngine{
+				CleanupFn: func(_ context.Context, project, promo string) error {
+					cleanedUp = append(cleanedUp, types.NamespacedName{Namespace: project, Name: promo})
+					return nil
+				},
+			}
+
 			promoteWasCalled := false
 			r.promoteFn = func(
 				ctx context.Context,
@@ -355,6 +364,17 @@ func TestReconcile(t *testing.T) {
 			require.Equal(t, tc.expectTerminateFnCalled, terminateWasCalled,
 				"terminateFn called: %t, expected %t", terminateWasCalled, tc.expectTerminateFnCalled)
 
+			// Anything held for executing the Promotion's steps must be
+			// released once, and only once, it is finished or deleted.
+			var reconciledPromo kargoapi.Promotion
+			err = r.kargoClient.Get(ctx, req.NamespacedName, &reconciledPromo)
+			if apierrors.IsNotFound(err) || reconciledPromo.Status.Phase.IsTerminal() {
+				require.Equal(t, []types.NamespacedName{req.NamespacedName}, cleanedUp)
+			} else {
+				require.NoError(t, err)
+				require.Empty(t, cleanedUp)
+			}
+
 			if tc.expectedPhase != "" {
 				var updatedPromo kargoapi.Promotion
 				err = r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo)
@@ -380,6 +400,7 @@ func Test_reconciler_terminatePromotion(t *testing.T) {
 		promo       *kargoapi.Promotion
 		freight     *kargoapi.Freight
 		interceptor interceptor.Funcs
+		cleanupErr  error
 		assertions  func(*testing.T, *fakeevent.EventRecorder, *kargoapi.Promotion, error)
 	}{
 		{
@@ -481,6 +502,23 @@ func Test_reconciler_terminatePromotion(t *testing.T) {
 				require.Len(t, recorder.Events, 0)
 			},
 		},
+		{
+			name: "cleanup error",
+			promo: newPromo(
+				"fake-namespace",
+				"fake-promo",
+				"fake-stage",
+				kargoapi.PromotionPhaseRunning,
+				now,
+			),
+			cleanupErr: errors.New("something went wrong"),
+			assertions: func(t *testing.T, _ *fakeevent.EventRecorder, promo *kargoapi.Promotion, err error) {
+				require.ErrorContains(t, err, "error cleaning up after terminated Promotion")
+				require.ErrorContains(t, err, "something went wrong")
+				// The Promotion must have been terminated regardless
+				require.Equal(t, kargoapi.PromotionPhaseAborted, promo.Status.Phase)
+			},
+		},
 		{
 			name: "status patch error",
 			promo: newPromo(
@@ -519,14 +557,24 @@ func Test_reconciler_terminatePromotion(t *testing.T) {
 				Build()
 			recorder := fakeevent.NewEventRecorder(1)
 
+			var cleanedUp bool
 			r := &reconciler{
 				kargoClient: c,
 				sender:      k8sevent.NewEventSender(recorder),
+				promoEngine: &promotion.MockEngine{
+					CleanupFn: func(context.Context, string, string) error {
+						cleanedUp = true
+						return tt.cleanupErr
+					},
+				},
 			}
 
 			req := tt.req
 			err := r.terminatePromotion(context.Background(), &req, tt.promo, tt.freight)
 			tt.assertions(t, recorder, tt.promo, err)
+			// Execution must only be stopped once the Promotion has been
+			// terminated
+			require.Equal(t, tt.promo.Status.Phase == kargoapi.PromotionPhaseAborted, cleanedUp)
 		})
 	}
 }
@@ -623,6 +671,49 @@ func Test_calculateRequeueInterval(t *testing.T) {
 				require.Equal(t, defaultRequeueInterval, requeueInterval)
 			},
 		},
+		{
+			name:                     "step is backing off",
+			suggestedRequeueInterval: ptr.To(time.Minute),
+			promo: &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{{
+						Uses: testStepKindWithTimeout,
+					}},
+				},
+				Status: kargoapi.PromotionStatus{
+					CurrentStep: 0,
+					StepExecutionMetadata: []kargoapi.StepExecutionMetadata{{
+						StartedAt:     &metav1.Time{Time: time.Now()},
+						NextAttemptAt: &metav1.Time{Time: time.Now().Add(2 * time.Minute)},
+					}},
+				},
+			},
+			assertions: func(t *testing.T, requeueInterval time.Duration) {
+				// The request should be requeued when the next attempt is due.
+				require.Greater(t, requeueInterval, time.Minute)
+				require.LessOrEqual(t, requeueInterval, 2*time.Minute)
+			},
+		},
+		{
+			name: "next attempt is overdue",
+			promo: &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{{
+						Uses: testStepKindWithoutTimeout,
+					}},
+				},
+				Status: kargoapi.PromotionStatus{
+					CurrentStep: 0,
+					StepExecutionMetadata: []kargoapi.StepExecutionMetadata{{
+						StartedAt:     &metav1.Time{Time: time.Now()},
+						NextAttemptAt: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+					}},
+				},
+			},
+			assertions: func(t *testing.T, requeueInterval time.Duration) {
+				require.Equal(t, minRequeueInterval, requeueInterval)
+			},
+		},
 		{
 			name: "no timeout",
 			promo: &kargoapi.Promotion{
@@ -0,0 +1,188 @@
+package sortkey
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+
+	"github.com/akuity/kargo/pkg/expressions/function"
+)
+
+// Expression is a compiled, user-defined expression that extracts a sortable
+// key from an artifact's metadata (e.g. an image tag, a Git tag, or a chart
+// version). The key may be a number, a string, a time, a semantic version (as
+// returned by the semverParse() function), or a list of any of these, in
+// which case keys are compared element by element.
+type Expression struct {
+	program *vm.Program
+}
+
+// Compile compiles the provided expression.
+func Compile(expression string) (*Expression, error) {
+	program, err := expr.Compile(expression, function.SemverParse())
+	if err != nil {
+		return nil, fmt.Errorf("error compiling sort key expression: %w", err)
+	}
+	return &Expression{program: program}, nil
+}
+
+// Sort evaluates the expression against the environment returned by envFn for
+// each of the provided items and returns a new slice containing those items
+// ordered from greatest to least key. Items for which the expression evaluates
+// to nil are excluded. Items with equal keys retain their relative order. An
+// error is returned if the expression cannot be evaluated for any item or if
+// the keys it returns cannot be compared to one another.
+func Sort[T any](
+	e *Expression,
+	items []T,
+	envFn func(T) map[string]any,
+) ([]T, error) {
+	type keyedItem struct {
+		item T
+		key  any
+	}
+	keyed := make([]keyedItem, 0, len(items))
+	for _, item := range items {
+		result, err := expr.Run(e.program, envFn(item))
+		if err != nil {
+			return nil, fmt.Errorf("error evaluating sort key expression: %w", err)
+		}
+		if result == nil {
+			continue
+		}
+		key, err := normalize(result)
+		if err != nil {
+			return nil, err
+		}
+		keyed = append(keyed, keyedItem{item: item, key: key})
+	}
+
+	var sortErr error
+	slices.SortStableFunc(keyed, func(lhs, rhs keyedItem) int {
+		res, err := compare(rhs.key, lhs.key)
+		if err != nil && sortErr == nil {
+			sortErr = err
+		}
+		return res
+	})
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	sorted := make([]T, len(keyed))
+	for i, k := range keyed {
+		sorted[i] = k.item
+	}
+	return sorted, nil
+}
+
+// normalize converts the result of evaluating a sort key expression into one
+// of a small number of comparable types: int64, float64, string, time.Time,
+// *semver.Version, or []any containing any of these.
+func normalize(val any) (any, error) {
+	switch v := val.(type) {
+	case int:
+		return int64(v), nil
+	case int8:
+		return int64(v), nil
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint:
+		return float64(v), nil
+	case uint8:
+		return int64(v), nil
+	case uint16:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		return float64(v), nil
+	case float32:
+		return float64(v), nil
+	case float64, string, time.Time:
+		return v, nil
+	case *time.Time:
+		if v == nil {
+			return nil, fmt.Errorf("sort key must not contain nil values")
+		}
+		return *v, nil
+	case *semver.Version:
+		if v == nil {
+			return nil, fmt.Errorf("sort key must not contain nil values")
+		}
+		return v, nil
+	case semver.Version:
+		return &v, nil
+	case []any:
+		elems := make([]any, len(v))
+		for i, elem := range v {
+			var err error
+			if elems[i], err = normalize(elem); err != nil {
+				return nil, err
+			}
+		}
+		return elems, nil
+	case nil:
+		return nil, fmt.Errorf("sort key must not contain nil values")
+	default:
+		return nil, fmt.Errorf(
+			"sort key of type %T is not supported; sort keys must be numbers, "+
+				"strings, times, semantic versions, or lists thereof",
+			val,
+		)
+	}
+}
+
+// compare compares two normalized sort keys, returning a negative number if
+// lhs < rhs, a positive number if lhs > rhs, and zero if they are equal. An
+// error is returned if the keys are of incomparable types.
+func compare(lhs, rhs any) (int, error) {
+	switch l := lhs.(type) {
+	case int64:
+		switch r := rhs.(type) {
+		case int64:
+			return cmp.Compare(l, r), nil
+		case float64:
+			return cmp.Compare(float64(l), r), nil
+		}
+	case float64:
+		switch r := rhs.(type) {
+		case int64:
+			return cmp.Compare(l, float64(r)), nil
+		case float64:
+			return cmp.Compare(l, r), nil
+		}
+	case string:
+		if r, ok := rhs.(string); ok {
+			return strings.Compare(l, r), nil
+		}
+	case time.Time:
+		if r, ok := rhs.(time.Time); ok {
+			return l.Compare(r), nil
+		}
+	case *semver.Version:
+		if r, ok := rhs.(*semver.Version); ok {
+			return l.Compare(r), nil
+		}
+	case []any:
+		if r, ok := rhs.([]any); ok {
+			for i := 0; i < len(l) && i < len(r); i++ {
+				if res, err := compare(l[i], r[i]); err != nil || res != 0 {
+					return res, err
+				}
+			}
+			return cmp.Compare(len(l), len(r)), nil
+		}
+	}
+	return 0, fmt.Errorf("cannot compare sort keys of types %T and %T", lhs, rhs)
+}
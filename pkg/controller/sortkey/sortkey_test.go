@@ -0,0 +1,146 @@
+package sortkey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	_, err := Compile("tag +")
+	require.ErrorContains(t, err, "error compiling sort key expression")
+
+	e, err := Compile(`semverParse(tag)`)
+	require.NoError(t, err)
+	require.NotNil(t, e)
+}
+
+func TestSort(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expression string
+		tags       []string
+		assertions func(*testing.T, []string, error)
+	}{
+		{
+			name:       "evaluation error",
+			expression: `int(tag)`,
+			tags:       []string{"1", "abc"},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "error evaluating sort key expression")
+			},
+		},
+		{
+			name:       "unsupported key type",
+			expression: `tag == "1"`,
+			tags:       []string{"1", "2"},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "sort key of type bool is not supported")
+			},
+		},
+		{
+			name:       "incomparable keys",
+			expression: `tag == "1" ? 1 : tag`,
+			tags:       []string{"1", "2"},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "cannot compare sort keys of types")
+			},
+		},
+		{
+			name:       "numeric keys",
+			expression: `int(split(tag, "-b")[1])`,
+			tags:       []string{"1-b9", "1-b412", "1-b10"},
+			assertions: func(t *testing.T, tags []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"1-b412", "1-b10", "1-b9"}, tags)
+			},
+		},
+		{
+			name:       "mixed integer and float keys",
+			expression: `tag == "b" ? 1.5 : int(tag)`,
+			tags:       []string{"1", "b", "2"},
+			assertions: func(t *testing.T, tags []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"2", "b", "1"}, tags)
+			},
+		},
+		{
+			name:       "string keys",
+			expression: `split(tag, "-")[1]`,
+			tags:       []string{"x-a", "y-c", "z-b"},
+			assertions: func(t *testing.T, tags []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"y-c", "z-b", "x-a"}, tags)
+			},
+		},
+		{
+			name:       "time keys",
+			expression: `date(tag, "2006-01-02")`,
+			tags:       []string{"2026-01-02", "2026-10-01", "2025-12-31"},
+			assertions: func(t *testing.T, tags []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"2026-10-01", "2026-01-02", "2025-12-31"}, tags)
+			},
+		},
+		{
+			name:       "semver keys",
+			expression: `semverParse(tag)`,
+			tags:       []string{"1.9.0", "1.10.0", "1.2.0"},
+			assertions: func(t *testing.T, tags []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"1.10.0", "1.9.0", "1.2.0"}, tags)
+			},
+		},
+		{
+			name:       "list keys",
+			expression: `[semverParse(split(tag, "-b")[0]), int(split(tag, "-b")[1])]`,
+			tags: []string{
+				"2026.9.30-b1000",
+				"2026.10.3-b99",
+				"2026.10.3-b412",
+				"2026.10.1-b500",
+			},
+			assertions: func(t *testing.T, tags []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"2026.10.3-b412",
+						"2026.10.3-b99",
+						"2026.10.1-b500",
+						"2026.9.30-b1000",
+					},
+					tags,
+				)
+			},
+		},
+		{
+			name:       "nil keys are excluded",
+			expression: `tag startsWith "v" ? nil : tag`,
+			tags:       []string{"a", "v1", "b"},
+			assertions: func(t *testing.T, tags []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"b", "a"}, tags)
+			},
+		},
+		{
+			name:       "ties retain relative order",
+			expression: `len(tag)`,
+			tags:       []string{"ab", "c", "de"},
+			assertions: func(t *testing.T, tags []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"ab", "de", "c"}, tags)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			e, err := Compile(testCase.expression)
+			require.NoError(t, err)
+			tags, err := Sort(e, testCase.tags, func(tag string) map[string]any {
+				return map[string]any{"tag": tag}
+			})
+			testCase.assertions(t, tags, err)
+		})
+	}
+}
@@ -8,9 +8,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/expr-lang/expr"
 	"github.com/google/uuid"
 	"github.com/kelseyhightower/envconfig"
 	gocache "github.com/patrickmn/go-cache"
+	batchv1 "k8s.io/api/batch/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -46,6 +48,7 @@ import (
 	"github.com/akuity/kargo/pkg/pattern"
 	intpredicate "github.com/akuity/kargo/pkg/predicate"
 	"github.com/akuity/kargo/pkg/rollouts"
+	"github.com/akuity/kargo/pkg/verification"
 )
 
 // ReconcilerConfig represents configuration for the stage reconciler.
@@ -82,6 +85,8 @@ type RegularStageReconciler struct {
 	healthChecker  health.AggregatingChecker
 	shardPredicate controller.ResponsibleFor[kargoapi.Stage]
 
+	verificationRunner *verification.Runner
+
 	backoffCfg wait.Backoff
 }
 
@@ -117,6 +122,7 @@ func (r *RegularStageReconciler) SetupWithManager(
 ) error {
 	// Configure client and event recorder using manager.
 	r.client = kargoMgr.GetClient()
+	r.verificationRunner = verification.NewRunner(r.client)
 	r.eventSender = k8sevent.NewEventSender(
 		libEvent.NewRecorder(ctx, kargoMgr.GetScheme(), kargoMgr.GetClient(), r.cfg.Name()),
 	)
@@ -395,7 +401,20 @@ func (r *RegularStageReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	}
 	// Otherwise, requeue after a delay.
 	// TODO: Make the requeue delay configurable.
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	requeueAfter := 5 * time.Minute
+	// If the current Freight is being verified by checks performed by the
+	// controller itself, nothing will trigger a reconciliation when the next
+	// measurement is due, so we need to requeue in time for it.
+	if curFreight := newStatus.FreightHistory.Current(); curFreight != nil {
+		if d, ok := verification.RequeueAfter(
+			stage.Spec.Verification,
+			curFreight.VerificationHistory.Current(),
+			time.Now(),
+		); ok && d < requeueAfter {
+			requeueAfter = max(d, 100*time.Millisecond)
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *RegularStageReconciler) reconcile(
@@ -1100,7 +1119,11 @@ func (r *RegularStageReconciler) verifyStageFreight(
 				logger.Debug("aborting verification of Stage Freight")
 
 				// Abort the verification.
-				newVI, err = r.abortVerification(ctx, *curFreight, abortReq)
+				if lastVerification.HasChecks() {
+					newVI, err = r.abortVerificationChecks(ctx, stage, *curFreight, abortReq)
+				} else {
+					newVI, err = r.abortVerification(ctx, *curFreight, abortReq)
+				}
 				if newVI != nil {
 					newStatus.FreightHistory.Current().VerificationHistory.UpdateOrPush(*newVI)
 				}
@@ -1114,7 +1137,11 @@ func (r *RegularStageReconciler) verifyStageFreight(
 			}
 
 			// Get the latest result of the verification.
-			newVI, err = r.getVerificationResult(ctx, *curFreight)
+			if lastVerification.HasChecks() {
+				newVI, err = r.getVerificationChecksResult(ctx, stage, *curFreight)
+			} else {
+				newVI, err = r.getVerificationResult(ctx, *curFreight)
+			}
 			if newVI != nil {
 				newStatus.FreightHistory.Current().VerificationHistory.UpdateOrPush(*newVI)
 
@@ -1163,7 +1190,11 @@ func (r *RegularStageReconciler) verifyStageFreight(
 	}
 
 	// Start a new (re-)verification.
-	newVI, err = r.startVerification(ctx, stage, *curFreight, reverifyReq, startTime)
+	if len(stage.Spec.Verification.Checks) > 0 {
+		newVI = r.startVerificationChecks(ctx, stage, *curFreight, reverifyReq, startTime)
+	} else {
+		newVI, err = r.startVerification(ctx, stage, *curFreight, reverifyReq, startTime)
+	}
 	if newVI != nil {
 		newStatus.FreightHistory.Current().VerificationHistory.UpdateOrPush(*newVI)
 
@@ -1405,29 +1436,9 @@ func (r *RegularStageReconciler) startVerification(
 			kargoapi.LabelKeyFreightCollection: freight.ID,
 		}),
 		rollouts.WithArgumentEvaluationConfig{
-			Env: map[string]any{
-				"ctx": map[string]any{
-					"project": stage.Namespace,
-					"stage":   stage.Name,
-				},
-			},
-			Options: slices.Concat(
-				exprfn.DataOperations(
-					ctx,
-					r.client,
-					gocache.New(gocache.NoExpiration, gocache.NoExpiration),
-					stage.Namespace,
-				),
-				exprfn.FreightOperations(
-					ctx,
-					r.client,
-					stage.Namespace,
-					stage.Spec.RequestedFreight,
-					freight.References(),
-				),
-				exprfn.UtilityOperations(),
-			),
-			Vars: stage.Spec.Vars,
+			Env:     verificationExprEnv(stage),
+			Options: r.verificationExprOptions(ctx, stage, freight),
+			Vars:    stage.Spec.Vars,
 		},
 	}
 
@@ -1487,6 +1498,150 @@ func (r *RegularStageReconciler) startVerification(
 	return newVI, nil
 }
 
+// startVerificationChecks starts a new verification for the Freight that is
+// associated with the Stage, implemented by the checks of the Stage's
+// Verification. Unlike verifications implemented by AnalysisRuns, this does
+// not depend on the Rollouts integration. The first measurement of each check
+// is made immediately.
+func (r *RegularStageReconciler) startVerificationChecks(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight kargoapi.FreightCollection,
+	req *kargoapi.VerificationRequest,
+	startTime time.Time,
+) *kargoapi.VerificationInfo {
+	newVI := kargoapi.VerificationInfo{
+		ID:        uuid.NewString(),
+		StartTime: &metav1.Time{Time: startTime},
+		Phase:     kargoapi.VerificationPhasePending,
+	}
+
+	// If we have a verification request, we should enrich the information
+	// with the actor who requested the verification.
+	if curVI := freight.VerificationHistory.Current(); curVI != nil && req.ForID(curVI.ID) {
+		newVI.Actor = req.Actor
+	}
+
+	newVI = r.verificationRunner.Run(ctx, verification.Request{
+		Stage:   stage,
+		Freight: freight,
+		Info:    newVI,
+		ExpressionConfig: verification.ExpressionConfig{
+			Env:     verificationExprEnv(stage),
+			Vars:    stage.Spec.Vars,
+			Options: r.verificationExprOptions(ctx, stage, freight),
+		},
+	}, startTime)
+	return &newVI
+}
+
+// getVerificationChecksResult advances the verification for the current
+// Freight of a Stage that is implemented by the checks of the Stage's
+// Verification, making any measurements that are due, and returns its latest
+// result.
+func (r *RegularStageReconciler) getVerificationChecksResult(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight kargoapi.FreightCollection,
+) (*kargoapi.VerificationInfo, error) {
+	currentVI := freight.VerificationHistory.Current()
+	if currentVI == nil {
+		return nil, fmt.Errorf("no current verification info for Freight collection %q", freight.ID)
+	}
+	newVI := r.verificationRunner.Run(ctx, verification.Request{
+		Stage:   stage,
+		Freight: freight,
+		Info:    *currentVI,
+		ExpressionConfig: verification.ExpressionConfig{
+			Env:     verificationExprEnv(stage),
+			Vars:    stage.Spec.Vars,
+			Options: r.verificationExprOptions(ctx, stage, freight),
+		},
+	}, time.Now())
+	return &newVI, nil
+}
+
+// abortVerificationChecks aborts the verification for the current Freight of
+// a Stage that is implemented by the checks of the Stage's Verification. Any
+// Jobs implementing in-progress measurements are deleted.
+func (r *RegularStageReconciler) abortVerificationChecks(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight kargoapi.FreightCollection,
+	req *kargoapi.VerificationRequest,
+) (*kargoapi.VerificationInfo, error) {
+	currentVI := freight.VerificationHistory.Current()
+	if currentVI == nil {
+		return nil, fmt.Errorf("no current verification info for Freight collection %q", freight.ID)
+	}
+
+	// If the current verification is already terminal, then there is no need
+	// to abort it.
+	if currentVI.Phase.IsTerminal() {
+		return currentVI, nil
+	}
+
+	newVI := currentVI.DeepCopy()
+	if req.ForID(currentVI.ID) {
+		newVI.Actor = req.Actor
+	}
+	newVI.FinishTime = ptr.To(metav1.Now())
+	if err := r.verificationRunner.Abort(ctx, stage.Namespace, *currentVI); err != nil {
+		newVI.Phase = kargoapi.VerificationPhaseError
+		newVI.Message = fmt.Sprintf("error aborting verification: %s", err)
+		return newVI, nil
+	}
+
+	// Mirror the behavior of verifications implemented by AnalysisRuns, which
+	// are marked as Failed when aborted.
+	newVI.Phase = kargoapi.VerificationPhaseFailed
+	newVI.Message = "Verification aborted by user"
+	for i := range newVI.Checks {
+		if !newVI.Checks[i].Phase.IsTerminal() {
+			newVI.Checks[i].Phase = kargoapi.VerificationPhaseAborted
+			newVI.Checks[i].Job = ""
+		}
+	}
+	return newVI, nil
+}
+
+// verificationExprEnv returns the environment for the evaluation of
+// expressions contained in the Verification of the provided Stage.
+func verificationExprEnv(stage *kargoapi.Stage) map[string]any {
+	return map[string]any{
+		"ctx": map[string]any{
+			"project": stage.Namespace,
+			"stage":   stage.Name,
+		},
+	}
+}
+
+// verificationExprOptions returns the options for the evaluation of
+// expressions contained in the Verification of the provided Stage, for the
+// provided FreightCollection.
+func (r *RegularStageReconciler) verificationExprOptions(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight kargoapi.FreightCollection,
+) []expr.Option {
+	return slices.Concat(
+		exprfn.DataOperations(
+			ctx,
+			r.client,
+			gocache.New(gocache.NoExpiration, gocache.NoExpiration),
+			stage.Namespace,
+		),
+		exprfn.FreightOperations(
+			ctx,
+			r.client,
+			stage.Namespace,
+			stage.Spec.RequestedFreight,
+			freight.References(),
+		),
+		exprfn.UtilityOperations(),
+	)
+}
+
 // getVerificationResult gets the result of the verification for the current
 // Freight of a Stage.
 //
@@ -2046,6 +2201,7 @@ func (r *RegularStageReconciler) handleDelete(ctx context.Context, stage *kargoa
 		r.clearVerifications,
 		r.clearApprovals,
 		r.clearAnalysisRuns,
+		r.clearVerificationJobs,
 	}
 	var errs []error
 	for _, c := range toClear {
@@ -2178,6 +2334,32 @@ func (r *RegularStageReconciler) clearAnalysisRuns(ctx context.Context, stage *k
 	return nil
 }
 
+// clearVerificationJobs clears all Jobs that were created by the checks of the
+// Verification of the given Stage. This is only done if the Stage's
+// Verification has checks.
+func (r *RegularStageReconciler) clearVerificationJobs(ctx context.Context, stage *kargoapi.Stage) error {
+	if stage.Spec.Verification == nil || len(stage.Spec.Verification.Checks) == 0 {
+		return nil
+	}
+
+	if err := r.client.DeleteAllOf(
+		ctx,
+		&batchv1.Job{},
+		client.InNamespace(stage.Namespace),
+		client.MatchingLabels(map[string]string{
+			kargoapi.LabelKeyStage: kubernetes.ShortenLabelValue(stage.Name),
+		}),
+		client.PropagationPolicy(metav1.DeletePropagationBackground),
+	); err != nil {
+		return fmt.Errorf("error deleting verification Jobs for Stage %q in namespace %q: %w",
+			stage.Name,
+			stage.Namespace,
+			err,
+		)
+	}
+	return nil
+}
+
 // summarizeConditions summarizes the conditions of the given Stage. It sets the
 // Ready condition based on the Promoting, Healthy, and Verified conditions.
 // If there is an error, the Ready condition is set to False until the error is
@@ -74,6 +74,10 @@ func (r *reconciler) discoverCommits(
 			continue
 		}
 
+		if sub.PullRequestLookup != nil {
+			lookUpPullRequests(ctx, sub, creds, commits)
+		}
+
 		results = append(results, kargoapi.GitDiscoveryResult{
 			RepoURL: sub.RepoURL,
 			Commits: commits,
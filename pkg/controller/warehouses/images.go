@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/credentials"
 	"github.com/akuity/kargo/pkg/image"
@@ -48,7 +51,18 @@ func (r *reconciler) discoverImages(
 			logger.Debug("found no credentials for image repo")
 		}
 
-		selector, err := image.NewSelector(ctx, sub, regCreds)
+		var verifier *image.Verifier
+		if sub.Verification != nil {
+			if verifier, err = r.getImageVerifier(ctx, namespace, *sub.Verification); err != nil {
+				return nil, fmt.Errorf(
+					"error building verifier for image %q: %w",
+					sub.RepoURL,
+					err,
+				)
+			}
+		}
+
+		selector, err := image.NewSelector(ctx, sub, regCreds, verifier)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"error obtaining selector for image %q: %w",
@@ -65,10 +79,21 @@ func (r *reconciler) discoverImages(
 			)
 		}
 
+		var rejected []kargoapi.RejectedImageReference
+		if verifier != nil {
+			if rejected = verifier.Rejected(); len(rejected) > 0 {
+				logger.Debug(
+					"rejected images that did not satisfy verification policy",
+					"count", len(rejected),
+				)
+			}
+		}
+
 		if len(images) == 0 {
 			results = append(results, kargoapi.ImageDiscoveryResult{
 				RepoURL:  sub.RepoURL,
 				Platform: sub.Platform,
+				Rejected: rejected,
 			})
 			logger.Debug("discovered no images")
 			continue
@@ -78,6 +103,7 @@ func (r *reconciler) discoverImages(
 			RepoURL:    sub.RepoURL,
 			Platform:   sub.Platform,
 			References: images,
+			Rejected:   rejected,
 		})
 		logger.Debug(
 			"discovered images",
@@ -87,3 +113,73 @@ func (r *reconciler) discoverImages(
 
 	return results, nil
 }
+
+// getImageVerifier resolves the key material referenced by the provided
+// verification policy from Secrets in the specified namespace and returns an
+// image.Verifier that enforces the policy.
+func (r *reconciler) getImageVerifier(
+	ctx context.Context,
+	namespace string,
+	policy kargoapi.ImageVerificationPolicy,
+) (*image.Verifier, error) {
+	material := image.VerificationMaterial{}
+	for _, key := range policy.PublicKeys {
+		dataKey := key.Key
+		if dataKey == "" {
+			dataKey = "cosign.pub"
+		}
+		data, err := r.getSecretData(ctx, namespace, key.SecretRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		keyPEM, ok := data[dataKey]
+		if !ok {
+			return nil, fmt.Errorf(
+				"no key %q found in Secret %q in namespace %q",
+				dataKey, key.SecretRef.Name, namespace,
+			)
+		}
+		material.PublicKeys = append(material.PublicKeys, keyPEM)
+	}
+	if policy.Keyless != nil {
+		secretName := policy.Keyless.TrustedRootsSecretRef.Name
+		data, err := r.getSecretData(ctx, namespace, secretName)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		if material.TrustedRoots, ok = data["ca.crt"]; !ok {
+			return nil, fmt.Errorf(
+				"no key %q found in Secret %q in namespace %q",
+				"ca.crt", secretName, namespace,
+			)
+		}
+		if material.TransparencyLogKeys, ok = data["rekor.pub"]; !ok {
+			return nil, fmt.Errorf(
+				"no key %q found in Secret %q in namespace %q",
+				"rekor.pub", secretName, namespace,
+			)
+		}
+	}
+	return image.NewVerifier(policy, material)
+}
+
+// getSecretData returns the data of the specified Secret.
+func (r *reconciler) getSecretData(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (map[string][]byte, error) {
+	secret := &corev1.Secret{}
+	if err := r.client.Get(
+		ctx,
+		client.ObjectKey{Namespace: namespace, Name: name},
+		secret,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error getting Secret %q in namespace %q: %w",
+			name, namespace, err,
+		)
+	}
+	return secret.Data, nil
+}
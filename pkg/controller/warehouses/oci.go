@@ -0,0 +1,88 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/image"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+// discoverOCIArtifacts discovers the latest suitable artifacts for the given
+// OCI subscriptions. It returns a list of artifact discovery results, one for
+// each subscription.
+func (r *reconciler) discoverOCIArtifacts(
+	ctx context.Context,
+	namespace string,
+	subs []kargoapi.RepoSubscription,
+) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+	results := make([]kargoapi.OCIArtifactDiscoveryResult, 0, len(subs))
+
+	for _, s := range subs {
+		if s.OCI == nil {
+			continue
+		}
+		sub := *s.OCI
+
+		logger := logging.LoggerFromContext(ctx).WithValues("repo", sub.RepoURL)
+
+		// OCI registries are accessed using the same credentials as are used for
+		// container image repositories.
+		creds, err := r.credentialsDB.Get(ctx, namespace, credentials.TypeImage, sub.RepoURL)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining credentials for OCI repo %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+		var regCreds *image.Credentials
+		if creds != nil {
+			regCreds = &image.Credentials{
+				Username: creds.Username,
+				Password: creds.Password,
+			}
+			logger.Debug("obtained credentials for OCI repo")
+		} else {
+			logger.Debug("found no credentials for OCI repo")
+		}
+
+		selector, err := image.NewArtifactSelector(sub, regCreds)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining selector for OCI artifact %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+		artifacts, err := selector.Select(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error discovering newest applicable OCI artifacts %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+
+		if len(artifacts) == 0 {
+			results = append(results, kargoapi.OCIArtifactDiscoveryResult{
+				RepoURL: sub.RepoURL,
+			})
+			logger.Debug("discovered no OCI artifacts")
+			continue
+		}
+
+		results = append(results, kargoapi.OCIArtifactDiscoveryResult{
+			RepoURL:    sub.RepoURL,
+			References: artifacts,
+		})
+		logger.Debug(
+			"discovered OCI artifacts",
+			"count", len(artifacts),
+		)
+	}
+
+	return results, nil
+}
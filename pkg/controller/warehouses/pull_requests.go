@@ -0,0 +1,120 @@
+package warehouses
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/discoverycache"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/gitprovider"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/urls"
+)
+
+// pullRequestsTTL is the amount of time for which the pull requests containing
+// a commit are cached. Every lookup consumes the Git hosting provider's API
+// rate limit, so this is considerably longer than the TTL of other discovery
+// results. Pull request metadata is informational only, so the staleness this
+// permits is tolerable, and an explicit refresh bypasses the cache anyway.
+const pullRequestsTTL = 30 * time.Minute
+
+// pullRequestsCache caches the pull requests containing each commit. The pull
+// requests containing a commit, as well as their titles and labels, can change
+// over time, so entries do expire.
+var pullRequestsCache = discoverycache.NewWithTTL(
+	"git_commit_pull_requests",
+	pullRequestsTTL,
+)
+
+// lookUpPullRequests records, on the latest of the provided commits, the pull
+// requests containing it, as reported by the Git hosting provider's API. Only
+// the latest commit is ever used to build new Freight, so lookups for the
+// others would needlessly consume the provider's API rate limit.
+// Pull request metadata is informational only, so failures are logged rather
+// than returned and never prevent the discovery of the commits themselves.
+func lookUpPullRequests(
+	ctx context.Context,
+	sub kargoapi.GitSubscription,
+	creds *credentials.Credentials,
+	commits []kargoapi.DiscoveredCommit,
+) {
+	logger := logging.LoggerFromContext(ctx).WithValues("repo", sub.RepoURL)
+
+	// As with release discovery, the password is used as the token for the Git
+	// hosting provider's API.
+	gpOpts := &gitprovider.Options{
+		Name:                  sub.PullRequestLookup.Provider,
+		InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+	}
+	if creds != nil {
+		gpOpts.Token = creds.Password
+	}
+	gitProvider, err := gitprovider.New(sub.RepoURL, gpOpts)
+	if err != nil {
+		logger.Error(err, "error creating Git provider for pull request lookup")
+		return
+	}
+
+	setPullRequests(
+		logging.ContextWithLogger(ctx, logger),
+		gitProvider,
+		discoverycache.Key(urls.NormalizeGit(sub.RepoURL), gpOpts.Name, gpOpts.Token),
+		commits[:min(len(commits), 1)],
+	)
+}
+
+// setPullRequests uses the provided Git provider to look up the pull requests
+// containing each of the provided commits and records them on the commits.
+// Results are cached under keys derived from the provided repository key,
+// which must uniquely identify the repository, provider, and credentials used.
+func setPullRequests(
+	ctx context.Context,
+	gitProvider gitprovider.Interface,
+	repoKey string,
+	commits []kargoapi.DiscoveredCommit,
+) {
+	logger := logging.LoggerFromContext(ctx)
+	for i := range commits {
+		commit := &commits[i]
+		prs, err := discoverycache.Get(
+			ctx,
+			pullRequestsCache,
+			discoverycache.Key(repoKey, commit.ID),
+			func() ([]kargoapi.PullRequest, error) {
+				gpPRs, err := gitProvider.ListPullRequestsForCommit(ctx, commit.ID)
+				if err != nil {
+					return nil, err
+				}
+				prs := make([]kargoapi.PullRequest, 0, len(gpPRs))
+				for _, gpPR := range gpPRs {
+					prs = append(prs, kargoapi.PullRequest{
+						Number: gpPR.Number,
+						Title:  gpPR.Title,
+						URL:    gpPR.URL,
+						Labels: gpPR.Labels,
+					})
+				}
+				return prs, nil
+			},
+		)
+		if err != nil {
+			if errors.Is(err, errors.ErrUnsupported) {
+				// No other lookup can succeed either
+				logger.Error(err, "pull request lookup is not supported for Git provider")
+				return
+			}
+			logger.Error(err, "error looking up pull requests for commit", "commit", commit.ID)
+			continue
+		}
+		if len(prs) == 0 {
+			continue
+		}
+		// Cached pull requests are shared, so record copies
+		commit.PullRequests = make([]kargoapi.PullRequest, len(prs))
+		for j := range prs {
+			prs[j].DeepCopyInto(&commit.PullRequests[j])
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package warehouses
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/gitprovider"
+)
+
+func Test_setPullRequests(t *testing.T) {
+	testCases := []struct {
+		name        string
+		repoKey     string
+		listFn      func(context.Context, string) ([]gitprovider.PullRequest, error)
+		expectedPRs [][]kargoapi.PullRequest
+		expectCalls int
+	}{
+		{
+			name:    "pull requests found",
+			repoKey: "found",
+			listFn: func(_ context.Context, commitID string) ([]gitprovider.PullRequest, error) {
+				if commitID == "fake-commit-2" {
+					return nil, nil
+				}
+				return []gitprovider.PullRequest{{
+					Number: 42,
+					Title:  "fake-title",
+					URL:    "fake-url",
+					Labels: []string{"fake-label"},
+					Open:   true,
+				}}, nil
+			},
+			expectedPRs: [][]kargoapi.PullRequest{
+				{{Number: 42, Title: "fake-title", URL: "fake-url", Labels: []string{"fake-label"}}},
+				nil,
+			},
+			expectCalls: 2,
+		},
+		{
+			name:    "error looking up one commit",
+			repoKey: "error",
+			listFn: func(_ context.Context, commitID string) ([]gitprovider.PullRequest, error) {
+				if commitID == "fake-commit-1" {
+					return nil, errors.New("something went wrong")
+				}
+				return []gitprovider.PullRequest{{Number: 43}}, nil
+			},
+			expectedPRs: [][]kargoapi.PullRequest{nil, {{Number: 43}}},
+			expectCalls: 2,
+		},
+		{
+			name:    "unsupported",
+			repoKey: "unsupported",
+			listFn: func(context.Context, string) ([]gitprovider.PullRequest, error) {
+				return nil, fmt.Errorf("not supported: %w", errors.ErrUnsupported)
+			},
+			expectedPRs: [][]kargoapi.PullRequest{nil, nil},
+			expectCalls: 1,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var calls int
+			gitProvider := &gitprovider.Fake{
+				ListPullRequestsForCommitFn: func(
+					ctx context.Context,
+					commitID string,
+				) ([]gitprovider.PullRequest, error) {
+					calls++
+					return testCase.listFn(ctx, commitID)
+				},
+			}
+			commits := []kargoapi.DiscoveredCommit{
+				{ID: "fake-commit-1"},
+				{ID: "fake-commit-2"},
+			}
+			setPullRequests(context.Background(), gitProvider, testCase.repoKey, commits)
+			require.Equal(t, testCase.expectCalls, calls)
+			for i, commit := range commits {
+				require.Equal(t, testCase.expectedPRs[i], commit.PullRequests)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libSemver "github.com/akuity/kargo/pkg/controller/semver"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/gitprovider"
+	"github.com/akuity/kargo/pkg/logging"
+
+	_ "github.com/akuity/kargo/pkg/gitprovider/gitea"  // Gitea provider registration
+	_ "github.com/akuity/kargo/pkg/gitprovider/github" // GitHub provider registration
+	_ "github.com/akuity/kargo/pkg/gitprovider/gitlab" // GitLab provider registration
+)
+
+// discoverReleases discovers the latest suitable releases for the given
+// release subscriptions. It returns a list of release discovery results, one
+// for each subscription.
+func (r *reconciler) discoverReleases(
+	ctx context.Context,
+	namespace string,
+	subs []kargoapi.RepoSubscription,
+) ([]kargoapi.ReleaseDiscoveryResult, error) {
+	results := make([]kargoapi.ReleaseDiscoveryResult, 0, len(subs))
+
+	for _, s := range subs {
+		if s.Release == nil {
+			continue
+		}
+		sub := *s.Release
+
+		logger := logging.LoggerFromContext(ctx).WithValues("repo", sub.RepoURL)
+
+		// Git forges are accessed using the same credentials as are used for the
+		// Git repository itself. As with the pull request related promotion
+		// steps, the password is used as the token for the forge's API.
+		creds, err := r.credentialsDB.Get(ctx, namespace, credentials.TypeGit, sub.RepoURL)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining credentials for Git repo %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+		gpOpts := &gitprovider.Options{
+			Name:                  sub.Provider,
+			InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+		}
+		if creds != nil {
+			gpOpts.Token = creds.Password
+			logger.Debug("obtained credentials for Git repo")
+		} else {
+			logger.Debug("found no credentials for Git repo")
+		}
+
+		gitProvider, err := gitprovider.New(sub.RepoURL, gpOpts)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error creating Git provider for repo %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+		releases, err := gitProvider.ListReleases(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error listing releases for repo %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+
+		selected, err := selectReleases(sub, releases)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error selecting releases for repo %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+
+		results = append(results, kargoapi.ReleaseDiscoveryResult{
+			RepoURL:  sub.RepoURL,
+			Releases: selected,
+		})
+		if len(selected) == 0 {
+			logger.Debug("discovered no releases")
+			continue
+		}
+		logger.Debug(
+			"discovered releases",
+			"count", len(selected),
+		)
+	}
+
+	return results, nil
+}
+
+// selectReleases filters the provided releases according to the criteria of
+// the given subscription and returns the remaining releases, ordered from
+// greatest to least semantic version and truncated to the subscription's
+// discovery limit.
+func selectReleases(
+	sub kargoapi.ReleaseSubscription,
+	releases []gitprovider.Release,
+) ([]kargoapi.DiscoveredRelease, error) {
+	var constraint *semver.Constraints
+	if sub.SemverConstraint != "" {
+		var err error
+		if constraint, err = semver.NewConstraint(sub.SemverConstraint); err != nil {
+			return nil, fmt.Errorf(
+				"error parsing semver constraint %q: %w",
+				sub.SemverConstraint, err,
+			)
+		}
+	}
+
+	type semverRelease struct {
+		release gitprovider.Release
+		semver  *semver.Version
+	}
+	candidates := make([]semverRelease, 0, len(releases))
+	for _, release := range releases {
+		sv := libSemver.Parse(release.Tag, sub.StrictSemvers)
+		if sv == nil {
+			// The tag wasn't parseable as a semantic version.
+			continue
+		}
+		if sub.ExcludePrereleases && (release.Prerelease || sv.Prerelease() != "") {
+			continue
+		}
+		if constraint != nil && !constraint.Check(sv) {
+			continue
+		}
+		candidates = append(candidates, semverRelease{
+			release: release,
+			semver:  sv,
+		})
+	}
+
+	slices.SortFunc(candidates, func(i, j semverRelease) int {
+		if comp := j.semver.Compare(i.semver); comp != 0 {
+			return comp
+		}
+		// If the semvers tie, break the tie lexically using the original strings
+		// used to construct the semvers. This ensures a deterministic comparison
+		// of equivalent semvers, e.g., 1.0 and 1.0.0.
+		return strings.Compare(j.semver.Original(), i.semver.Original())
+	})
+
+	limit := int(sub.DiscoveryLimit)
+	if limit > 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	discovered := make([]kargoapi.DiscoveredRelease, 0, len(candidates))
+	for _, candidate := range candidates {
+		release := candidate.release
+		d := kargoapi.DiscoveredRelease{
+			Tag:        release.Tag,
+			Name:       release.Name,
+			URL:        release.URL,
+			Prerelease: release.Prerelease,
+		}
+		for _, asset := range release.Assets {
+			d.Assets = append(d.Assets, kargoapi.ReleaseAsset{
+				Name: asset.Name,
+				URL:  asset.URL,
+			})
+		}
+		if release.PublishedAt != nil {
+			d.PublishedAt = &metav1.Time{Time: *release.PublishedAt}
+		}
+		discovered = append(discovered, d)
+	}
+	return discovered, nil
+}
@@ -0,0 +1,108 @@
+package warehouses
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/gitprovider"
+)
+
+func Test_selectReleases(t *testing.T) {
+	testReleases := []gitprovider.Release{
+		{Tag: "v1.0.0"},
+		{Tag: "v1.2.0-rc.1"},
+		{Tag: "v1.1.0", Assets: []gitprovider.ReleaseAsset{{Name: "fake-asset", URL: "fake-url"}}},
+		{Tag: "v1.1.1", Prerelease: true},
+		{Tag: "v2"},
+		{Tag: "not-a-semver"},
+	}
+
+	testCases := []struct {
+		name       string
+		sub        kargoapi.ReleaseSubscription
+		assertions func(*testing.T, []kargoapi.DiscoveredRelease, error)
+	}{
+		{
+			name: "invalid semver constraint",
+			sub: kargoapi.ReleaseSubscription{
+				SemverConstraint: "invalid",
+			},
+			assertions: func(t *testing.T, _ []kargoapi.DiscoveredRelease, err error) {
+				require.ErrorContains(t, err, "error parsing semver constraint")
+			},
+		},
+		{
+			name: "strict semvers",
+			sub: kargoapi.ReleaseSubscription{
+				StrictSemvers: true,
+			},
+			assertions: func(t *testing.T, releases []kargoapi.DiscoveredRelease, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"v1.2.0-rc.1", "v1.1.1", "v1.1.0", "v1.0.0"}, releaseTags(releases))
+				require.Equal(
+					t,
+					[]kargoapi.ReleaseAsset{{Name: "fake-asset", URL: "fake-url"}},
+					releases[2].Assets,
+				)
+			},
+		},
+		{
+			name: "non-strict semvers",
+			sub:  kargoapi.ReleaseSubscription{},
+			assertions: func(t *testing.T, releases []kargoapi.DiscoveredRelease, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"v2", "v1.2.0-rc.1", "v1.1.1", "v1.1.0", "v1.0.0"}, releaseTags(releases))
+			},
+		},
+		{
+			name: "prereleases excluded",
+			sub: kargoapi.ReleaseSubscription{
+				StrictSemvers:      true,
+				ExcludePrereleases: true,
+			},
+			assertions: func(t *testing.T, releases []kargoapi.DiscoveredRelease, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"v1.1.0", "v1.0.0"}, releaseTags(releases))
+			},
+		},
+		{
+			name: "semver constraint",
+			sub: kargoapi.ReleaseSubscription{
+				StrictSemvers:    true,
+				SemverConstraint: "~1.1",
+			},
+			assertions: func(t *testing.T, releases []kargoapi.DiscoveredRelease, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"v1.1.1", "v1.1.0"}, releaseTags(releases))
+			},
+		},
+		{
+			name: "discovery limit",
+			sub: kargoapi.ReleaseSubscription{
+				StrictSemvers:  true,
+				DiscoveryLimit: 2,
+			},
+			assertions: func(t *testing.T, releases []kargoapi.DiscoveredRelease, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"v1.2.0-rc.1", "v1.1.1"}, releaseTags(releases))
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			releases, err := selectReleases(testCase.sub, testReleases)
+			testCase.assertions(t, releases, err)
+		})
+	}
+}
+
+func releaseTags(releases []kargoapi.DiscoveredRelease) []string {
+	tags := make([]string, len(releases))
+	for i, release := range releases {
+		tags[i] = release.Tag
+	}
+	return tags
+}
@@ -0,0 +1,148 @@
+package warehouses
+
+import (
+	"fmt"
+	"time"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/cron"
+)
+
+// discoverySchedule determines when a Warehouse with a Schedule should
+// periodically discover artifacts.
+type discoverySchedule struct {
+	schedule        *cron.Schedule
+	minInterval     time.Duration
+	blackoutWindows []kargoapi.BlackoutWindow
+}
+
+// newDiscoverySchedule returns a discoverySchedule for the provided
+// WarehouseSpec. If the spec does not specify a Schedule, nil is returned.
+func newDiscoverySchedule(
+	spec *kargoapi.WarehouseSpec,
+	minInterval time.Duration,
+) (*discoverySchedule, error) {
+	if spec.Schedule == "" {
+		return nil, nil
+	}
+	loc := time.UTC
+	if spec.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(spec.TimeZone); err != nil {
+			return nil, fmt.Errorf("error loading time zone %q: %w", spec.TimeZone, err)
+		}
+	}
+	schedule, err := cron.Parse(spec.Schedule, loc)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing schedule %q: %w", spec.Schedule, err)
+	}
+	return &discoverySchedule{
+		schedule:        schedule,
+		minInterval:     minInterval,
+		blackoutWindows: spec.BlackoutWindows,
+	}, nil
+}
+
+// next returns the earliest time, after the time at which artifacts were last
+// discovered, at which discovery is scheduled to occur. Scheduled times that
+// are too soon after the last discovery to respect the minimum interval or
+// that fall within a blackout window are skipped. If there is no such time,
+// the zero time is returned.
+func (d *discoverySchedule) next(lastDiscovered time.Time) time.Time {
+	after := lastDiscovered
+	if d.minInterval > 0 {
+		// The schedule's next time is strictly after the time provided, so
+		// back off slightly to permit a scheduled time that coincides exactly
+		// with the end of the minimum interval.
+		after = lastDiscovered.Add(d.minInterval - time.Nanosecond)
+	}
+	// Each iteration either returns or moves past the end of a blackout window,
+	// so no more than one iteration per window (plus one) is ever needed.
+	for range len(d.blackoutWindows) + 1 {
+		next := d.schedule.Next(after)
+		if next.IsZero() {
+			return next
+		}
+		end := endOfBlackout(d.blackoutWindows, next)
+		if end.Equal(next) {
+			return next
+		}
+		after = end.Add(-time.Nanosecond)
+	}
+	return time.Time{}
+}
+
+// activeBlackoutWindow returns the first of the provided BlackoutWindows in
+// effect at the specified time, if any.
+func activeBlackoutWindow(
+	windows []kargoapi.BlackoutWindow,
+	t time.Time,
+) *kargoapi.BlackoutWindow {
+	for i := range windows {
+		if windows[i].Contains(t) {
+			return &windows[i]
+		}
+	}
+	return nil
+}
+
+// endOfBlackout returns the earliest time, no earlier than the specified time,
+// that does not fall within any of the provided BlackoutWindows. Overlapping
+// and adjoining windows are treated as a single window. If the specified time
+// does not fall within any window, it is returned unchanged.
+func endOfBlackout(windows []kargoapi.BlackoutWindow, t time.Time) time.Time {
+	for range windows {
+		window := activeBlackoutWindow(windows, t)
+		if window == nil {
+			break
+		}
+		t = window.End.Time
+	}
+	return t
+}
+
+// nextDiscoveryTime returns the next time at which a Warehouse with the
+// provided generation and status will discover artifacts. A Warehouse that has
+// yet to discover artifacts for its current generation will do so as soon as
+// no blackout window is in effect. Otherwise, discovery will occur at the next
+// scheduled time.
+func (d *discoverySchedule) nextDiscoveryTime(
+	generation int64,
+	status *kargoapi.WarehouseStatus,
+	now time.Time,
+) time.Time {
+	if status.DiscoveredArtifacts == nil ||
+		status.DiscoveredArtifacts.DiscoveredAt.IsZero() ||
+		generation > status.ObservedGeneration {
+		return endOfBlackout(d.blackoutWindows, now)
+	}
+	return d.next(status.DiscoveredArtifacts.DiscoveredAt.Time)
+}
+
+// getRequeueInterval returns the amount of time after which the Warehouse
+// should be reconciled again in order to discover artifacts, based on the
+// provided, updated status. A Warehouse with a Schedule that will never again
+// call for discovery should not be requeued, in which case zero is returned.
+func getRequeueInterval(
+	warehouse *kargoapi.Warehouse,
+	status *kargoapi.WarehouseStatus,
+	minInterval time.Duration,
+) time.Duration {
+	now := time.Now()
+	var next time.Time
+	switch {
+	case status.NextDiscoveryTime != nil:
+		next = status.NextDiscoveryTime.Time
+	case warehouse.Spec.Schedule != "":
+		return 0
+	default:
+		next = endOfBlackout(
+			warehouse.Spec.BlackoutWindows,
+			now.Add(warehouse.GetInterval(minInterval)),
+		)
+	}
+	if interval := next.Sub(now); interval > 0 {
+		return interval
+	}
+	return 100 * time.Millisecond
+}
@@ -0,0 +1,261 @@
+package warehouses
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_newDiscoverySchedule(t *testing.T) {
+	testCases := []struct {
+		name       string
+		spec       kargoapi.WarehouseSpec
+		assertions func(*testing.T, *discoverySchedule, error)
+	}{
+		{
+			name: "no schedule",
+			assertions: func(t *testing.T, schedule *discoverySchedule, err error) {
+				require.NoError(t, err)
+				require.Nil(t, schedule)
+			},
+		},
+		{
+			name: "invalid time zone",
+			spec: kargoapi.WarehouseSpec{
+				Schedule: "0 2 * * *",
+				TimeZone: "Nowhere/Special",
+			},
+			assertions: func(t *testing.T, _ *discoverySchedule, err error) {
+				require.ErrorContains(t, err, "error loading time zone")
+			},
+		},
+		{
+			name: "invalid schedule",
+			spec: kargoapi.WarehouseSpec{
+				Schedule: "0 2 * *",
+			},
+			assertions: func(t *testing.T, _ *discoverySchedule, err error) {
+				require.ErrorContains(t, err, "error parsing schedule")
+			},
+		},
+		{
+			name: "success",
+			spec: kargoapi.WarehouseSpec{
+				Schedule: "0 2 * * *",
+				TimeZone: "America/New_York",
+			},
+			assertions: func(t *testing.T, schedule *discoverySchedule, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, schedule)
+				require.Equal(t, "America/New_York", schedule.schedule.Location().String())
+				require.Equal(t, time.Minute, schedule.minInterval)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			schedule, err := newDiscoverySchedule(&testCase.spec, time.Minute)
+			testCase.assertions(t, schedule, err)
+		})
+	}
+}
+
+func Test_discoverySchedule_next(t *testing.T) {
+	// A Friday
+	friday := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name           string
+		spec           kargoapi.WarehouseSpec
+		minInterval    time.Duration
+		lastDiscovered time.Time
+		expected       time.Time
+	}{
+		{
+			name:           "next scheduled time",
+			spec:           kargoapi.WarehouseSpec{Schedule: "0 2 * * 1-5"},
+			lastDiscovered: friday,
+			expected:       friday.Add(2 * time.Hour),
+		},
+		{
+			name:           "minimum interval respected",
+			spec:           kargoapi.WarehouseSpec{Schedule: "*/5 * * * *"},
+			minInterval:    10 * time.Minute,
+			lastDiscovered: friday,
+			expected:       friday.Add(10 * time.Minute),
+		},
+		{
+			name: "scheduled times in blackout windows skipped",
+			spec: kargoapi.WarehouseSpec{
+				Schedule: "0 2 * * 1-5",
+				BlackoutWindows: []kargoapi.BlackoutWindow{
+					{
+						Start: metav1.NewTime(friday.Add(time.Hour)),
+						End:   metav1.NewTime(friday.Add(3 * 24 * time.Hour)),
+					},
+					{
+						// Adjoins the previous window
+						Start: metav1.NewTime(friday.Add(3 * 24 * time.Hour)),
+						End:   metav1.NewTime(friday.Add(3*24*time.Hour + 2*time.Hour)),
+					},
+				},
+			},
+			lastDiscovered: friday,
+			// The window ends exactly at 02:00 on Monday, which is permitted
+			expected: friday.Add(3*24*time.Hour + 2*time.Hour),
+		},
+		{
+			name:           "never",
+			spec:           kargoapi.WarehouseSpec{Schedule: "0 0 30 2 *"},
+			lastDiscovered: friday,
+			expected:       time.Time{},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			schedule, err := newDiscoverySchedule(&testCase.spec, testCase.minInterval)
+			require.NoError(t, err)
+			next := schedule.next(testCase.lastDiscovered)
+			require.True(
+				t,
+				testCase.expected.Equal(next),
+				"expected %s; got %s", testCase.expected, next,
+			)
+		})
+	}
+}
+
+func Test_discoverySchedule_nextDiscoveryTime(t *testing.T) {
+	now := time.Date(2025, 1, 6, 3, 0, 0, 0, time.UTC)
+	windowEnd := now.Add(time.Hour)
+	schedule, err := newDiscoverySchedule(
+		&kargoapi.WarehouseSpec{
+			Schedule: "0 2 * * *",
+			BlackoutWindows: []kargoapi.BlackoutWindow{{
+				Start: metav1.NewTime(now.Add(-time.Hour)),
+				End:   metav1.NewTime(windowEnd),
+			}},
+		},
+		0,
+	)
+	require.NoError(t, err)
+
+	t.Run("never discovered", func(t *testing.T) {
+		next := schedule.nextDiscoveryTime(1, &kargoapi.WarehouseStatus{}, now)
+		require.Equal(t, windowEnd, next)
+	})
+
+	t.Run("generation not yet observed", func(t *testing.T) {
+		next := schedule.nextDiscoveryTime(
+			2,
+			&kargoapi.WarehouseStatus{
+				ObservedGeneration: 1,
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					DiscoveredAt: metav1.NewTime(now.Add(-time.Hour)),
+				},
+			},
+			now,
+		)
+		require.Equal(t, windowEnd, next)
+	})
+
+	t.Run("next scheduled time", func(t *testing.T) {
+		next := schedule.nextDiscoveryTime(
+			1,
+			&kargoapi.WarehouseStatus{
+				ObservedGeneration: 1,
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					DiscoveredAt: metav1.NewTime(now.Add(-time.Hour)),
+				},
+			},
+			now,
+		)
+		require.Equal(t, now.Add(23*time.Hour), next)
+	})
+}
+
+func Test_getRequeueInterval(t *testing.T) {
+	testCases := []struct {
+		name       string
+		warehouse  *kargoapi.Warehouse
+		status     *kargoapi.WarehouseStatus
+		assertions func(*testing.T, time.Duration)
+	}{
+		{
+			name: "next discovery time",
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{Schedule: "0 2 * * *"},
+			},
+			status: &kargoapi.WarehouseStatus{
+				NextDiscoveryTime: &metav1.Time{Time: time.Now().Add(time.Hour)},
+			},
+			assertions: func(t *testing.T, interval time.Duration) {
+				require.Greater(t, interval, 59*time.Minute)
+				require.LessOrEqual(t, interval, time.Hour)
+			},
+		},
+		{
+			name: "next discovery time has passed",
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{Schedule: "0 2 * * *"},
+			},
+			status: &kargoapi.WarehouseStatus{
+				NextDiscoveryTime: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+			},
+			assertions: func(t *testing.T, interval time.Duration) {
+				require.Equal(t, 100*time.Millisecond, interval)
+			},
+		},
+		{
+			name: "schedule without next discovery time",
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{Schedule: "0 0 30 2 *"},
+			},
+			status: &kargoapi.WarehouseStatus{},
+			assertions: func(t *testing.T, interval time.Duration) {
+				require.Zero(t, interval)
+			},
+		},
+		{
+			name: "interval",
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					Interval: metav1.Duration{Duration: 5 * time.Minute},
+				},
+			},
+			status: &kargoapi.WarehouseStatus{},
+			assertions: func(t *testing.T, interval time.Duration) {
+				require.Greater(t, interval, 4*time.Minute)
+				require.LessOrEqual(t, interval, 5*time.Minute)
+			},
+		},
+		{
+			name: "interval ending in blackout window",
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					Interval: metav1.Duration{Duration: 5 * time.Minute},
+					BlackoutWindows: []kargoapi.BlackoutWindow{{
+						Start: metav1.NewTime(time.Now()),
+						End:   metav1.NewTime(time.Now().Add(time.Hour)),
+					}},
+				},
+			},
+			status: &kargoapi.WarehouseStatus{},
+			assertions: func(t *testing.T, interval time.Duration) {
+				require.Greater(t, interval, 59*time.Minute)
+				require.LessOrEqual(t, interval, time.Hour)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				getRequeueInterval(testCase.warehouse, testCase.status, 0),
+			)
+		})
+	}
+}
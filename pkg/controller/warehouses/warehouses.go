@@ -20,6 +20,7 @@ import (
 	"github.com/akuity/kargo/pkg/api"
 	"github.com/akuity/kargo/pkg/conditions"
 	"github.com/akuity/kargo/pkg/controller"
+	"github.com/akuity/kargo/pkg/controller/discoverycache"
 	"github.com/akuity/kargo/pkg/credentials"
 	"github.com/akuity/kargo/pkg/expressions/function"
 	"github.com/akuity/kargo/pkg/kargo"
@@ -33,6 +34,7 @@ type ReconcilerConfig struct {
 	ShardName                 string        `envconfig:"SHARD_NAME"`
 	MaxConcurrentReconciles   int           `envconfig:"MAX_CONCURRENT_WAREHOUSE_RECONCILES" default:"4"`
 	MinReconciliationInterval time.Duration `envconfig:"MIN_WAREHOUSE_RECONCILIATION_INTERVAL"`
+	DiscoveryCacheTTL         time.Duration `envconfig:"DISCOVERY_CACHE_TTL" default:"1m"`
 }
 
 func ReconcilerConfigFromEnv() ReconcilerConfig {
@@ -58,6 +60,18 @@ type reconciler struct {
 
 	discoverChartsFn func(context.Context, string, []kargoapi.RepoSubscription) ([]kargoapi.ChartDiscoveryResult, error)
 
+	discoverOCIArtifactsFn func(
+		context.Context,
+		string,
+		[]kargoapi.RepoSubscription,
+	) ([]kargoapi.OCIArtifactDiscoveryResult, error)
+
+	discoverReleasesFn func(
+		context.Context,
+		string,
+		[]kargoapi.RepoSubscription,
+	) ([]kargoapi.ReleaseDiscoveryResult, error)
+
 	buildFreightFromLatestArtifactsFn func(string, *kargoapi.DiscoveredArtifacts) (*kargoapi.Freight, error)
 
 	createFreightFn func(context.Context, client.Object, ...client.CreateOption) error
@@ -73,6 +87,10 @@ func SetupReconcilerWithManager(
 	credentialsDB credentials.Database,
 	cfg ReconcilerConfig,
 ) error {
+	// Discovery results are shared by all Warehouses reconciled by this
+	// controller.
+	discoverycache.SetTTL(cfg.DiscoveryCacheTTL)
+
 	if err := ctrl.NewControllerManagedBy(mgr).
 		For(&kargoapi.Warehouse{}).
 		WithEventFilter(controller.ResponsibleFor[client.Object]{
@@ -94,6 +112,7 @@ func SetupReconcilerWithManager(
 	logging.LoggerFromContext(ctx).Info(
 		"Initialized Warehouse reconciler",
 		"maxConcurrentReconciles", cfg.MaxConcurrentReconciles,
+		"discoveryCacheTTL", cfg.DiscoveryCacheTTL,
 	)
 
 	return nil
@@ -119,6 +138,8 @@ func newReconciler(
 	r.discoverCommitsFn = r.discoverCommits
 	r.discoverImagesFn = r.discoverImages
 	r.discoverChartsFn = r.discoverCharts
+	r.discoverOCIArtifactsFn = r.discoverOCIArtifacts
+	r.discoverReleasesFn = r.discoverReleases
 	r.buildFreightFromLatestArtifactsFn = r.buildFreightFromLatestArtifacts
 	r.patchStatusFn = r.patchStatus
 	return r
@@ -160,6 +181,19 @@ func (r *reconciler) Reconcile(
 		logger.Error(err, "error syncing Warehouse")
 	}
 
+	// Record when the Warehouse is next scheduled to discover artifacts. An
+	// invalid schedule will already have been reflected in the conditions.
+	newStatus.NextDiscoveryTime = nil
+	if schedule, _ := newDiscoverySchedule(
+		&warehouse.Spec,
+		r.cfg.MinReconciliationInterval,
+	); schedule != nil {
+		next := schedule.nextDiscoveryTime(warehouse.Generation, &newStatus, time.Now())
+		if !next.IsZero() {
+			newStatus.NextDiscoveryTime = &metav1.Time{Time: next}
+		}
+	}
+
 	updateErr := r.patchStatusFn(
 		ctx,
 		warehouse,
@@ -185,9 +219,14 @@ func (r *reconciler) Reconcile(
 		return ctrl.Result{}, err
 	}
 
-	// Everything succeeded, look for new changes on the defined interval.
+	// Everything succeeded, look for new changes on the defined interval or
+	// schedule.
 	return ctrl.Result{
-		RequeueAfter: warehouse.GetInterval(r.cfg.MinReconciliationInterval),
+		RequeueAfter: getRequeueInterval(
+			warehouse,
+			&newStatus,
+			r.cfg.MinReconciliationInterval,
+		),
 	}, nil
 }
 
@@ -199,13 +238,67 @@ func (r *reconciler) syncWarehouse(
 
 	status := *warehouse.Status.DeepCopy()
 
-	// Record the current refresh token as having been handled.
+	refreshToken := status.LastHandledRefresh
 	if token, ok := api.RefreshAnnotationValue(warehouse.GetAnnotations()); ok {
-		status.LastHandledRefresh = token
+		refreshToken = token
+	}
+
+	schedule, err := newDiscoverySchedule(
+		&warehouse.Spec,
+		r.cfg.MinReconciliationInterval,
+	)
+	if err != nil {
+		msg := fmt.Sprintf("Invalid discovery schedule: %s", err.Error())
+		conditions.Set(
+			&status,
+			&metav1.Condition{
+				Type:               kargoapi.ConditionTypeHealthy,
+				Status:             metav1.ConditionFalse,
+				Reason:             "InvalidSchedule",
+				Message:            msg,
+				ObservedGeneration: warehouse.GetGeneration(),
+			},
+			&metav1.Condition{
+				Type:               kargoapi.ConditionTypeReady,
+				Status:             metav1.ConditionFalse,
+				Reason:             "InvalidSchedule",
+				Message:            msg,
+				ObservedGeneration: warehouse.GetGeneration(),
+			},
+		)
+		conditions.Delete(&status, kargoapi.ConditionTypeReconciling)
+		// Return a nil error to avoid a requeue loop since subsequent retries
+		// are not going to make the schedule any more valid.
+		return status, nil
+	}
+
+	now := time.Now()
+	discover := shouldDiscoverArtifacts(
+		warehouse,
+		refreshToken,
+		schedule,
+		now,
+	)
+
+	// Neither discover artifacts nor produce Freight during a blackout window.
+	if !discover {
+		if window := activeBlackoutWindow(warehouse.Spec.BlackoutWindows, now); window != nil {
+			logger.Debug(
+				"skipping artifact discovery during blackout window",
+				"end", window.End.Time,
+				"reason", window.Reason,
+			)
+			return status, nil
+		}
 	}
 
 	// Discover the latest artifacts.
-	if shouldDiscoverArtifacts(warehouse, status.LastHandledRefresh) {
+	if discover {
+		// Record the current refresh token as having been handled only now that
+		// artifacts are actually being discovered. A refresh requested during a
+		// blackout window or deferred to the schedule remains pending until then.
+		status.LastHandledRefresh = refreshToken
+
 		// As this is a long-running operation, we need to update the status
 		// conditions to reflect that we are currently reconciling.
 		conditions.Set(
@@ -243,8 +336,15 @@ func (r *reconciler) syncWarehouse(
 			logger.Error(err, "error updating Warehouse status")
 		}
 
+		// A manual refresh is typically requested because new artifacts are
+		// known to be available, so cached discovery results must not be used.
+		discoveryCtx := ctx
+		if status.LastHandledRefresh != warehouse.Status.LastHandledRefresh {
+			discoveryCtx = discoverycache.ContextWithRefresh(ctx)
+		}
+
 		// Discover the latest artifacts.
-		discoveredArtifacts, err := r.discoverArtifactsFn(ctx, warehouse)
+		discoveredArtifacts, err := r.discoverArtifactsFn(discoveryCtx, warehouse)
 		if err != nil {
 			// Mark the Warehouse as unhealthy and not ready if we failed to
 			// discover artifacts.
@@ -272,6 +372,10 @@ func (r *reconciler) syncWarehouse(
 		// Update the status with the discovered artifacts, and mark the
 		// Warehouse as healthy.
 		status.DiscoveredArtifacts = discoveredArtifacts
+
+		// Surface any images that were rejected for not satisfying their
+		// subscription's verification policy.
+		setImagesVerifiedCondition(warehouse, &status)
 	}
 
 	// At this point, we have successfully discovered the latest artifacts
@@ -525,11 +629,23 @@ func (r *reconciler) discoverArtifacts(
 		return nil, fmt.Errorf("error discovering charts: %w", err)
 	}
 
+	ociArtifacts, err := r.discoverOCIArtifactsFn(ctx, warehouse.Namespace, warehouse.Spec.Subscriptions)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering OCI artifacts: %w", err)
+	}
+
+	releases, err := r.discoverReleasesFn(ctx, warehouse.Namespace, warehouse.Spec.Subscriptions)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering releases: %w", err)
+	}
+
 	return &kargoapi.DiscoveredArtifacts{
 		DiscoveredAt: metav1.Now(),
 		Git:          commits,
 		Images:       images,
 		Charts:       charts,
+		OCIArtifacts: ociArtifacts,
+		Releases:     releases,
 	}, nil
 }
 
@@ -553,13 +669,14 @@ func (r *reconciler) buildFreightFromLatestArtifacts(
 		}
 		latestCommit := result.Commits[0]
 		freight.Commits = append(freight.Commits, kargoapi.GitCommit{
-			RepoURL:   result.RepoURL,
-			ID:        latestCommit.ID,
-			Branch:    latestCommit.Branch,
-			Tag:       latestCommit.Tag,
-			Message:   latestCommit.Subject,
-			Author:    latestCommit.Author,
-			Committer: latestCommit.Committer,
+			RepoURL:      result.RepoURL,
+			ID:           latestCommit.ID,
+			Branch:       latestCommit.Branch,
+			Tag:          latestCommit.Tag,
+			Message:      latestCommit.Subject,
+			Author:       latestCommit.Author,
+			Committer:    latestCommit.Committer,
+			PullRequests: latestCommit.PullRequests,
 		})
 	}
 
@@ -592,6 +709,34 @@ func (r *reconciler) buildFreightFromLatestArtifacts(
 		})
 	}
 
+	for _, result := range artifacts.OCIArtifacts {
+		if len(result.References) == 0 {
+			return nil, fmt.Errorf("no OCI artifacts discovered for repository %q", result.RepoURL)
+		}
+		latestArtifact := result.References[0]
+		freight.OCIArtifacts = append(freight.OCIArtifacts, kargoapi.OCIArtifact{
+			RepoURL:      result.RepoURL,
+			Tag:          latestArtifact.Tag,
+			Digest:       latestArtifact.Digest,
+			ArtifactType: latestArtifact.ArtifactType,
+			Annotations:  latestArtifact.Annotations,
+		})
+	}
+
+	for _, result := range artifacts.Releases {
+		if len(result.Releases) == 0 {
+			return nil, fmt.Errorf("no releases discovered for repository %q", result.RepoURL)
+		}
+		latestRelease := result.Releases[0]
+		freight.Releases = append(freight.Releases, kargoapi.Release{
+			RepoURL: result.RepoURL,
+			Tag:     latestRelease.Tag,
+			Name:    latestRelease.Name,
+			URL:     latestRelease.URL,
+			Assets:  latestRelease.Assets,
+		})
+	}
+
 	// Generate a unique ID for the Freight based on its contents.
 	freight.Name = api.GenerateFreightID(freight)
 
@@ -615,7 +760,8 @@ func validateDiscoveredArtifacts(
 ) bool {
 	artifacts := newStatus.DiscoveredArtifacts
 
-	if artifacts == nil || len(artifacts.Git)+len(artifacts.Images)+len(artifacts.Charts) == 0 {
+	if artifacts == nil || len(artifacts.Git)+len(artifacts.Images)+len(artifacts.Charts)+
+		len(artifacts.OCIArtifacts)+len(artifacts.Releases) == 0 {
 		message := "No artifacts discovered"
 		conditions.Set(
 			newStatus,
@@ -674,6 +820,12 @@ func validateDiscoveredArtifacts(
 
 		if count == 0 {
 			message := fmt.Sprintf("No references discovered for image repository %q", artifact.RepoURL)
+			if len(artifact.Rejected) > 0 {
+				message = fmt.Sprintf(
+					"%s; %d rejected for not satisfying verification policy",
+					message, len(artifact.Rejected),
+				)
+			}
 			conditions.Set(
 				newStatus,
 				&metav1.Condition{
@@ -733,9 +885,128 @@ func validateDiscoveredArtifacts(
 		charts += count
 	}
 
+	var ociArtifacts int
+	for _, artifact := range artifacts.OCIArtifacts {
+		count := len(artifact.References)
+
+		if count == 0 {
+			message := fmt.Sprintf("No artifacts discovered for OCI repository %q", artifact.RepoURL)
+			conditions.Set(
+				newStatus,
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeHealthy,
+					Status:             metav1.ConditionFalse,
+					Reason:             "NoOCIArtifactsDiscovered",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             "MissingOCIArtifacts",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+			)
+			return false
+		}
+
+		subscriptions++
+		ociArtifacts += count
+	}
+
+	var releases int
+	for _, artifact := range artifacts.Releases {
+		count := len(artifact.Releases)
+
+		if count == 0 {
+			message := fmt.Sprintf("No releases discovered for repository %q", artifact.RepoURL)
+			conditions.Set(
+				newStatus,
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeHealthy,
+					Status:             metav1.ConditionFalse,
+					Reason:             "NoReleasesDiscovered",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             "MissingReleases",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+			)
+			return false
+		}
+
+		subscriptions++
+		releases += count
+	}
+
 	return true
 }
 
+// setImagesVerifiedCondition sets the ImagesVerified condition of the provided
+// status to reflect any images that were rejected during discovery for not
+// satisfying their ImageSubscription's verification policy. The condition is
+// removed if no ImageSubscription specifies a verification policy.
+func setImagesVerifiedCondition(
+	warehouse *kargoapi.Warehouse,
+	newStatus *kargoapi.WarehouseStatus,
+) {
+	var verified bool
+	for _, sub := range warehouse.Spec.Subscriptions {
+		if sub.Image != nil && sub.Image.Verification != nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		conditions.Delete(newStatus, kargoapi.ConditionTypeImagesVerified)
+		return
+	}
+
+	var rejections []string
+	if artifacts := newStatus.DiscoveredArtifacts; artifacts != nil {
+		for _, result := range artifacts.Images {
+			for _, rejected := range result.Rejected {
+				rejections = append(rejections, fmt.Sprintf(
+					"%s:%s (%s): %s",
+					result.RepoURL, rejected.Tag, rejected.Digest, rejected.Reason,
+				))
+			}
+		}
+	}
+	if len(rejections) == 0 {
+		conditions.Set(
+			newStatus,
+			&metav1.Condition{
+				Type:               kargoapi.ConditionTypeImagesVerified,
+				Status:             metav1.ConditionTrue,
+				Reason:             "AllImagesVerified",
+				Message:            "All images considered for selection satisfied their verification policy",
+				ObservedGeneration: warehouse.GetGeneration(),
+			},
+		)
+		return
+	}
+	conditions.Set(
+		newStatus,
+		&metav1.Condition{
+			Type:   kargoapi.ConditionTypeImagesVerified,
+			Status: metav1.ConditionFalse,
+			Reason: "ImagesRejected",
+			Message: fmt.Sprintf(
+				"%d image(s) rejected for not satisfying verification policy: %s",
+				len(rejections), strings.Join(rejections, "; "),
+			),
+			ObservedGeneration: warehouse.GetGeneration(),
+		},
+	)
+}
+
 // freightCreationCriteriaSatisfied evaluates the freight creation criteria
 // expression, if defined, and returns true if the expression is satisfied,
 // no expression is defined, or no discovered artifacts are present.
@@ -758,7 +1029,8 @@ func freightCreationCriteriaSatisfied(
 		return true, nil
 	}
 
-	if artifacts == nil || (len(artifacts.Git) == 0 && len(artifacts.Images) == 0 && len(artifacts.Charts) == 0) {
+	if artifacts == nil || (len(artifacts.Git) == 0 && len(artifacts.Images) == 0 &&
+		len(artifacts.Charts) == 0 && len(artifacts.OCIArtifacts) == 0 && len(artifacts.Releases) == 0) {
 		logger.Trace("no artifacts discovered")
 		return true, nil
 	}
@@ -797,12 +1069,26 @@ func freightCreationCriteriaSatisfied(
 //
 //   - The Warehouse has not yet discovered any artifacts.
 //   - The Warehouse has been updated since the last time we discovered artifacts.
-//   - The interval has passed since the last time we discovered artifacts.
-//   - A manual refresh was requested.
+//   - The interval has passed since the last time we discovered artifacts or,
+//     if the Warehouse has a schedule, a scheduled time has been reached.
+//   - A manual refresh was requested and the Warehouse has no schedule.
+//
+// None of these apply while a blackout window is in effect. A manual refresh
+// is always honored if the Warehouse is configured to let refreshes bypass its
+// schedule and blackout windows.
 func shouldDiscoverArtifacts(
 	warehouse *kargoapi.Warehouse,
 	refreshToken string,
+	schedule *discoverySchedule,
+	now time.Time,
 ) bool {
+	refreshRequested := warehouse.Status.LastHandledRefresh != refreshToken
+	if refreshRequested && warehouse.Spec.RefreshesBypassSchedule {
+		return true
+	}
+	if activeBlackoutWindow(warehouse.Spec.BlackoutWindows, now) != nil {
+		return false
+	}
 	switch {
 	// We have not yet discovered any artifacts.
 	case warehouse.Status.DiscoveredArtifacts == nil:
@@ -815,14 +1101,18 @@ func shouldDiscoverArtifacts(
 	// artifacts.
 	case warehouse.Generation > warehouse.Status.ObservedGeneration:
 		return true
-	// A manual refresh was requested.
-	case warehouse.Status.LastHandledRefresh != refreshToken:
+	// A manual refresh was requested and there is no schedule to defer it to.
+	case refreshRequested && schedule == nil:
 		return true
+	// A scheduled time has been reached since we last discovered artifacts.
+	case schedule != nil:
+		next := schedule.next(warehouse.Status.DiscoveredArtifacts.DiscoveredAt.Time)
+		return !next.IsZero() && !next.After(now)
 	// We have discovered artifacts, but it's been longer than the interval
 	// since we last did so.
 	case warehouse.Status.DiscoveredArtifacts.DiscoveredAt.Add(
 		warehouse.Spec.Interval.Duration,
-	).Before(metav1.Now().Time):
+	).Before(now):
 		return true
 	default:
 		return false
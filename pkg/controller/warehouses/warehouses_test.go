@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -41,6 +42,8 @@ func TestNewReconciler(t *testing.T) {
 	require.NotNil(t, e.discoverCommitsFn)
 	require.NotNil(t, e.discoverImagesFn)
 	require.NotNil(t, e.discoverChartsFn)
+	require.NotNil(t, e.discoverOCIArtifactsFn)
+	require.NotNil(t, e.discoverReleasesFn)
 	require.NotNil(t, e.buildFreightFromLatestArtifactsFn)
 	require.NotNil(t, e.createFreightFn)
 	require.NotNil(t, e.patchStatusFn)
@@ -601,6 +604,97 @@ func TestSyncWarehouse(t *testing.T) {
 				require.Equal(t, "ReconciliationSucceeded", healthyCondition.Reason)
 			},
 		},
+		{
+			name:       "invalid schedule",
+			reconciler: &reconciler{},
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec: kargoapi.WarehouseSpec{
+					Schedule: "not a schedule",
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.Nil(t, status.DiscoveredArtifacts)
+
+				readyCondition := conditions.Get(&status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "InvalidSchedule", readyCondition.Reason)
+				require.Contains(t, readyCondition.Message, "error parsing schedule")
+
+				healthyCondition := conditions.Get(&status, kargoapi.ConditionTypeHealthy)
+				require.NotNil(t, healthyCondition)
+				require.Equal(t, metav1.ConditionFalse, healthyCondition.Status)
+				require.Equal(t, "InvalidSchedule", healthyCondition.Reason)
+			},
+		},
+		{
+			name: "blackout window in effect",
+			reconciler: &reconciler{
+				discoverArtifactsFn: func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error) {
+					return nil, errors.New("artifacts should not have been discovered")
+				},
+				createFreightFn: func(context.Context, client.Object, ...client.CreateOption) error {
+					return errors.New("Freight should not have been created")
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Spec: kargoapi.WarehouseSpec{
+					BlackoutWindows: []kargoapi.BlackoutWindow{{
+						Start: metav1.NewTime(time.Now().Add(-time.Hour)),
+						End:   metav1.NewTime(time.Now().Add(time.Hour)),
+					}},
+				},
+				Status: kargoapi.WarehouseStatus{
+					ObservedGeneration:  1,
+					DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				// The new generation must not be recorded as observed until
+				// artifacts have been discovered for it.
+				require.Equal(t, int64(1), status.ObservedGeneration)
+				require.Empty(t, status.GetConditions())
+			},
+		},
+		{
+			name: "refresh requested during blackout window",
+			reconciler: &reconciler{
+				discoverArtifactsFn: func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error) {
+					return nil, errors.New("artifacts should not have been discovered")
+				},
+				createFreightFn: func(context.Context, client.Object, ...client.CreateOption) error {
+					return errors.New("Freight should not have been created")
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						kargoapi.AnnotationKeyRefresh: "new",
+					},
+					Generation: 1,
+				},
+				Spec: kargoapi.WarehouseSpec{
+					BlackoutWindows: []kargoapi.BlackoutWindow{{
+						Start: metav1.NewTime(time.Now().Add(-time.Hour)),
+						End:   metav1.NewTime(time.Now().Add(time.Hour)),
+					}},
+				},
+				Status: kargoapi.WarehouseStatus{
+					ObservedGeneration:  1,
+					LastHandledRefresh:  "old",
+					DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				// The refresh must remain pending until it is performed
+				require.Equal(t, "old", status.LastHandledRefresh)
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -683,6 +777,80 @@ func TestDiscoverArtifacts(t *testing.T) {
 				require.Nil(t, discoveredArtifacts)
 			},
 		},
+		{
+			name: "error discovering OCI artifacts",
+			reconciler: &reconciler{
+				discoverCommitsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.GitDiscoveryResult, error) {
+					return []kargoapi.GitDiscoveryResult{}, nil
+				},
+				discoverImagesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ImageDiscoveryResult, error) {
+					return []kargoapi.ImageDiscoveryResult{}, nil
+				},
+				discoverChartsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ChartDiscoveryResult, error) {
+					return []kargoapi.ChartDiscoveryResult{}, nil
+				},
+				discoverOCIArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.ErrorContains(t, err, "error discovering OCI artifacts")
+				require.Nil(t, discoveredArtifacts)
+			},
+		},
+		{
+			name: "error discovering releases",
+			reconciler: &reconciler{
+				discoverCommitsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.GitDiscoveryResult, error) {
+					return []kargoapi.GitDiscoveryResult{}, nil
+				},
+				discoverImagesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ImageDiscoveryResult, error) {
+					return []kargoapi.ImageDiscoveryResult{}, nil
+				},
+				discoverChartsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ChartDiscoveryResult, error) {
+					return []kargoapi.ChartDiscoveryResult{}, nil
+				},
+				discoverOCIArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+					return []kargoapi.OCIArtifactDiscoveryResult{}, nil
+				},
+				discoverReleasesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ReleaseDiscoveryResult, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.ErrorContains(t, err, "error discovering releases")
+				require.Nil(t, discoveredArtifacts)
+			},
+		},
 		{
 			name: "success",
 			reconciler: &reconciler{
@@ -716,12 +884,34 @@ func TestDiscoverArtifacts(t *testing.T) {
 						}},
 					}, nil
 				},
+				discoverOCIArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+					return []kargoapi.OCIArtifactDiscoveryResult{
+						{RepoURL: "fake-repo", References: []kargoapi.DiscoveredOCIArtifact{
+							{Tag: "fake-tag"},
+						}},
+					}, nil
+				},
+				discoverReleasesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ReleaseDiscoveryResult, error) {
+					return []kargoapi.ReleaseDiscoveryResult{
+						{RepoURL: "fake-repo", Releases: []kargoapi.DiscoveredRelease{
+							{Tag: "fake-tag"},
+						}},
+					}, nil
+				},
 			},
 			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
 				require.NoError(t, err)
 				require.Len(t, discoveredArtifacts.Git, 1)
 				require.Len(t, discoveredArtifacts.Images, 1)
 				require.Len(t, discoveredArtifacts.Charts, 1)
+				require.Len(t, discoveredArtifacts.OCIArtifacts, 1)
+				require.Len(t, discoveredArtifacts.Releases, 1)
 			},
 		},
 	}
@@ -796,12 +986,39 @@ func TestBuildFreightFromLatestArtifacts(t *testing.T) {
 				require.Nil(t, freight)
 			},
 		},
+		{
+			name: "no OCI artifacts discovered",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredOCIArtifact{}},
+				},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "no OCI artifacts discovered for repository")
+				require.Nil(t, freight)
+			},
+		},
+		{
+			name: "no releases discovered",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Releases: []kargoapi.ReleaseDiscoveryResult{
+					{RepoURL: "fake-repo", Releases: []kargoapi.DiscoveredRelease{}},
+				},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "no releases discovered for repository")
+				require.Nil(t, freight)
+			},
+		},
 		{
 			name: "success",
 			artifacts: &kargoapi.DiscoveredArtifacts{
 				Git: []kargoapi.GitDiscoveryResult{
 					{RepoURL: "fake-repo", Commits: []kargoapi.DiscoveredCommit{{ID: "fake-commit"}}},
-					{RepoURL: "fake-repo", Commits: []kargoapi.DiscoveredCommit{{ID: "fake-commit"}}},
+					{RepoURL: "fake-repo", Commits: []kargoapi.DiscoveredCommit{{
+						ID:           "fake-commit",
+						PullRequests: []kargoapi.PullRequest{{Number: 42, Title: "fake-title"}},
+					}}},
 				},
 				Images: []kargoapi.ImageDiscoveryResult{
 					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredImageReference{{Tag: "fake-tag"}}},
@@ -811,13 +1028,46 @@ func TestBuildFreightFromLatestArtifacts(t *testing.T) {
 					{RepoURL: "fake-repo", Versions: []string{"fake-version"}},
 					{RepoURL: "fake-repo", Versions: []string{"fake-version"}},
 				},
+				OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredOCIArtifact{{
+						Tag:          "fake-tag",
+						Digest:       "fake-digest",
+						ArtifactType: "fake-type",
+					}}},
+				},
+				Releases: []kargoapi.ReleaseDiscoveryResult{
+					{RepoURL: "fake-repo", Releases: []kargoapi.DiscoveredRelease{{
+						Tag:    "fake-tag",
+						Name:   "fake-name",
+						URL:    "fake-url",
+						Assets: []kargoapi.ReleaseAsset{{Name: "fake-asset", URL: "fake-asset-url"}},
+					}}},
+				},
 			},
 			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
 				require.NoError(t, err)
 				require.NotNil(t, freight)
 				require.Len(t, freight.Commits, 2)
+				require.Equal(
+					t,
+					[]kargoapi.PullRequest{{Number: 42, Title: "fake-title"}},
+					freight.Commits[1].PullRequests,
+				)
 				require.Len(t, freight.Images, 2)
 				require.Len(t, freight.Charts, 2)
+				require.Equal(t, []kargoapi.OCIArtifact{{
+					RepoURL:      "fake-repo",
+					Tag:          "fake-tag",
+					Digest:       "fake-digest",
+					ArtifactType: "fake-type",
+				}}, freight.OCIArtifacts)
+				require.Equal(t, []kargoapi.Release{{
+					RepoURL: "fake-repo",
+					Tag:     "fake-tag",
+					Name:    "fake-name",
+					URL:     "fake-url",
+					Assets:  []kargoapi.ReleaseAsset{{Name: "fake-asset", URL: "fake-asset-url"}},
+				}}, freight.Releases)
 			},
 		},
 	}
@@ -972,6 +1222,74 @@ func TestValidateDiscoveredArtifacts(t *testing.T) {
 				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
 			},
 		},
+		{
+			name: "OCI repository with no artifacts",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+			},
+			newStatus: &kargoapi.WarehouseStatus{
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+						{RepoURL: "ghcr.io/example/manifests"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result bool, status *kargoapi.WarehouseStatus) {
+				require.False(t, result)
+
+				require.Len(t, status.GetConditions(), 2)
+
+				// Ensure that the Ready condition is set to False.
+				readyCondition := conditions.Get(status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "MissingOCIArtifacts", readyCondition.Reason)
+				require.Contains(t, readyCondition.Message, "No artifacts discovered for OCI repository")
+				require.Equal(t, int64(1), readyCondition.ObservedGeneration)
+
+				// Ensure that the Healthy condition is set to False.
+				healthyCondition := conditions.Get(status, kargoapi.ConditionTypeHealthy)
+				require.NotNil(t, healthyCondition)
+				require.Equal(t, metav1.ConditionFalse, healthyCondition.Status)
+				require.Equal(t, "NoOCIArtifactsDiscovered", healthyCondition.Reason)
+				require.Contains(t, healthyCondition.Message, "No artifacts discovered for OCI repository")
+				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
+			},
+		},
+		{
+			name: "release repository with no releases",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+			},
+			newStatus: &kargoapi.WarehouseStatus{
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					Releases: []kargoapi.ReleaseDiscoveryResult{
+						{RepoURL: "https://github.com/example/repo"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result bool, status *kargoapi.WarehouseStatus) {
+				require.False(t, result)
+
+				require.Len(t, status.GetConditions(), 2)
+
+				// Ensure that the Ready condition is set to False.
+				readyCondition := conditions.Get(status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "MissingReleases", readyCondition.Reason)
+				require.Contains(t, readyCondition.Message, "No releases discovered for repository")
+				require.Equal(t, int64(1), readyCondition.ObservedGeneration)
+
+				// Ensure that the Healthy condition is set to False.
+				healthyCondition := conditions.Get(status, kargoapi.ConditionTypeHealthy)
+				require.NotNil(t, healthyCondition)
+				require.Equal(t, metav1.ConditionFalse, healthyCondition.Status)
+				require.Equal(t, "NoReleasesDiscovered", healthyCondition.Reason)
+				require.Contains(t, healthyCondition.Message, "No releases discovered for repository")
+				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
+			},
+		},
 		{
 			name: "successful discovery with all artifact types",
 			warehouse: &kargoapi.Warehouse{
@@ -995,6 +1313,16 @@ func TestValidateDiscoveredArtifacts(t *testing.T) {
 					Charts: []kargoapi.ChartDiscoveryResult{
 						{RepoURL: "https://charts.example.com", Name: "mychart", Versions: []string{"1.0.0", "1.1.0"}},
 					},
+					OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+						{RepoURL: "ghcr.io/example/manifests", References: []kargoapi.DiscoveredOCIArtifact{
+							{Tag: "1.0.0"},
+						}},
+					},
+					Releases: []kargoapi.ReleaseDiscoveryResult{
+						{RepoURL: "https://github.com/example/repo3", Releases: []kargoapi.DiscoveredRelease{
+							{Tag: "v1.0.0"},
+						}},
+					},
 				},
 			},
 			assertions: func(t *testing.T, result bool, status *kargoapi.WarehouseStatus) {
@@ -1015,8 +1343,115 @@ func TestValidateDiscoveredArtifacts(t *testing.T) {
 	}
 }
 
+func TestSetImagesVerifiedCondition(t *testing.T) {
+	verifiedSubs := []kargoapi.RepoSubscription{{
+		Image: &kargoapi.ImageSubscription{
+			RepoURL: "example/image",
+			Verification: &kargoapi.ImageVerificationPolicy{
+				PublicKeys: []kargoapi.ImageVerificationPublicKey{{
+					SecretRef: corev1.LocalObjectReference{Name: "cosign"},
+				}},
+			},
+		},
+	}}
+
+	testCases := []struct {
+		name       string
+		warehouse  *kargoapi.Warehouse
+		newStatus  *kargoapi.WarehouseStatus
+		assertions func(*testing.T, *kargoapi.WarehouseStatus)
+	}{
+		{
+			name: "no verification policies",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec: kargoapi.WarehouseSpec{
+					Subscriptions: []kargoapi.RepoSubscription{{
+						Image: &kargoapi.ImageSubscription{RepoURL: "example/image"},
+					}},
+				},
+			},
+			newStatus: &kargoapi.WarehouseStatus{
+				Conditions: []metav1.Condition{{
+					Type:   kargoapi.ConditionTypeImagesVerified,
+					Status: metav1.ConditionFalse,
+				}},
+			},
+			assertions: func(t *testing.T, status *kargoapi.WarehouseStatus) {
+				require.Nil(t, conditions.Get(status, kargoapi.ConditionTypeImagesVerified))
+			},
+		},
+		{
+			name: "no images rejected",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec:       kargoapi.WarehouseSpec{Subscriptions: verifiedSubs},
+			},
+			newStatus: &kargoapi.WarehouseStatus{
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					Images: []kargoapi.ImageDiscoveryResult{{
+						RepoURL:    "example/image",
+						References: []kargoapi.DiscoveredImageReference{{Tag: "v1.0.0"}},
+					}},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.WarehouseStatus) {
+				condition := conditions.Get(status, kargoapi.ConditionTypeImagesVerified)
+				require.NotNil(t, condition)
+				require.Equal(t, metav1.ConditionTrue, condition.Status)
+				require.Equal(t, "AllImagesVerified", condition.Reason)
+				require.Equal(t, int64(1), condition.ObservedGeneration)
+			},
+		},
+		{
+			name: "images rejected",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec:       kargoapi.WarehouseSpec{Subscriptions: verifiedSubs},
+			},
+			newStatus: &kargoapi.WarehouseStatus{
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					Images: []kargoapi.ImageDiscoveryResult{{
+						RepoURL:    "example/image",
+						References: []kargoapi.DiscoveredImageReference{{Tag: "v1.0.0"}},
+						Rejected: []kargoapi.RejectedImageReference{{
+							Tag:    "v1.1.0",
+							Digest: "sha256:abc",
+							Reason: "no signatures found",
+						}},
+					}},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.WarehouseStatus) {
+				condition := conditions.Get(status, kargoapi.ConditionTypeImagesVerified)
+				require.NotNil(t, condition)
+				require.Equal(t, metav1.ConditionFalse, condition.Status)
+				require.Equal(t, "ImagesRejected", condition.Reason)
+				require.Equal(
+					t,
+					"1 image(s) rejected for not satisfying verification policy: "+
+						"example/image:v1.1.0 (sha256:abc): no signatures found",
+					condition.Message,
+				)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setImagesVerifiedCondition(tc.warehouse, tc.newStatus)
+			tc.assertions(t, tc.newStatus)
+		})
+	}
+}
+
 func TestShouldDiscoverArtifacts(t *testing.T) {
-	now := metav1.Now()
+	// A Monday
+	now := metav1.NewTime(time.Date(2025, 1, 6, 3, 0, 0, 0, time.UTC))
+	blackoutWindows := []kargoapi.BlackoutWindow{{
+		Start: metav1.NewTime(now.Add(-time.Hour)),
+		End:   metav1.NewTime(now.Add(time.Hour)),
+	}}
 
 	tests := []struct {
 		name         string
@@ -1106,11 +1541,119 @@ func TestShouldDiscoverArtifacts(t *testing.T) {
 			refreshToken: "token",
 			expected:     false,
 		},
+		{
+			name: "scheduled time reached since last discovery",
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					Schedule: "0 2 * * 1-5",
+				},
+				Status: kargoapi.WarehouseStatus{
+					DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+						// The previous Friday
+						DiscoveredAt: metav1.NewTime(now.Add(-3*24*time.Hour - time.Hour)),
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "scheduled time not yet reached",
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					// Interval should have no effect
+					Interval: metav1.Duration{Duration: time.Minute},
+					Schedule: "0 2 * * 1-5",
+				},
+				Status: kargoapi.WarehouseStatus{
+					DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+						DiscoveredAt: metav1.NewTime(now.Add(-time.Hour)),
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "manual refresh deferred by schedule",
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					Schedule: "0 2 * * 1-5",
+				},
+				Status: kargoapi.WarehouseStatus{
+					LastHandledRefresh: "old-token",
+					DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+						DiscoveredAt: metav1.NewTime(now.Add(-time.Hour)),
+					},
+				},
+			},
+			refreshToken: "new-token",
+			expected:     false,
+		},
+		{
+			name: "manual refresh bypasses schedule",
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					Schedule:                "0 2 * * 1-5",
+					RefreshesBypassSchedule: true,
+				},
+				Status: kargoapi.WarehouseStatus{
+					LastHandledRefresh: "old-token",
+					DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+						DiscoveredAt: metav1.NewTime(now.Add(-time.Hour)),
+					},
+				},
+			},
+			refreshToken: "new-token",
+			expected:     true,
+		},
+		{
+			name: "blackout window in effect",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 2,
+				},
+				Spec: kargoapi.WarehouseSpec{
+					BlackoutWindows: blackoutWindows,
+				},
+				Status: kargoapi.WarehouseStatus{
+					ObservedGeneration: 1,
+					LastHandledRefresh: "old-token",
+					DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+						DiscoveredAt: metav1.NewTime(now.Add(-24 * time.Hour)),
+					},
+				},
+			},
+			refreshToken: "new-token",
+			expected:     false,
+		},
+		{
+			name: "manual refresh bypasses blackout window",
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					BlackoutWindows:         blackoutWindows,
+					RefreshesBypassSchedule: true,
+				},
+				Status: kargoapi.WarehouseStatus{
+					LastHandledRefresh: "old-token",
+					DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+						DiscoveredAt: metav1.NewTime(now.Add(-time.Minute)),
+					},
+				},
+			},
+			refreshToken: "new-token",
+			expected:     true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := shouldDiscoverArtifacts(tt.warehouse, tt.refreshToken)
+			schedule, err := newDiscoverySchedule(&tt.warehouse.Spec, 0)
+			require.NoError(t, err)
+			result := shouldDiscoverArtifacts(
+				tt.warehouse,
+				tt.refreshToken,
+				schedule,
+				now.Time,
+			)
 			require.Equal(t, tt.expected, result)
 		})
 	}
@@ -1215,6 +1758,20 @@ func TestReconcile(t *testing.T) {
 					) ([]kargoapi.ChartDiscoveryResult, error) {
 						return []kargoapi.ChartDiscoveryResult{}, nil
 					},
+					discoverOCIArtifactsFn: func(
+						context.Context,
+						string,
+						[]kargoapi.RepoSubscription,
+					) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+						return []kargoapi.OCIArtifactDiscoveryResult{}, nil
+					},
+					discoverReleasesFn: func(
+						context.Context,
+						string,
+						[]kargoapi.RepoSubscription,
+					) ([]kargoapi.ReleaseDiscoveryResult, error) {
+						return []kargoapi.ReleaseDiscoveryResult{}, nil
+					},
 					buildFreightFromLatestArtifactsFn: func(
 						string,
 						*kargoapi.DiscoveredArtifacts,
@@ -0,0 +1,76 @@
+package cron
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	// Schedules are commonly evaluated in named time zones. Embed the time zone
+	// database so they can be loaded even where the system provides none.
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+)
+
+// parser parses standard five field cron expressions as well as descriptors
+// such as @daily.
+var parser = cron.NewParser(
+	cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
+// Schedule is a parsed cron expression that is evaluated in a specific
+// location (time zone).
+type Schedule struct {
+	schedule *cron.SpecSchedule
+	spec     string
+}
+
+// Parse parses a standard five field cron expression (minute, hour, day of
+// month, month, and day of week) or one of the descriptors @yearly,
+// @annually, @monthly, @weekly, @daily, @midnight, or @hourly. The returned
+// Schedule is evaluated in the specified location or UTC if no location is
+// specified. Because the location is specified separately, expressions may not
+// be prefixed with a time zone (e.g. "CRON_TZ=America/New_York").
+func Parse(spec string, loc *time.Location) (*Schedule, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	expr := strings.TrimSpace(spec)
+	if strings.HasPrefix(expr, "TZ=") || strings.HasPrefix(expr, "CRON_TZ=") {
+		return nil, fmt.Errorf("time zone prefix is not supported in cron expression %q", spec)
+	}
+	parsed, err := parser.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing cron expression %q: %w", spec, err)
+	}
+	schedule, ok := parsed.(*cron.SpecSchedule)
+	if !ok {
+		// e.g. "@every 1h", which describes an interval rather than a schedule
+		return nil, fmt.Errorf("unsupported cron expression %q", spec)
+	}
+	schedule.Location = loc
+	return &Schedule{
+		schedule: schedule,
+		spec:     spec,
+	}, nil
+}
+
+// Location returns the location in which the Schedule is evaluated.
+func (s *Schedule) Location() *time.Location {
+	return s.schedule.Location
+}
+
+// String returns the expression the Schedule was parsed from.
+func (s *Schedule) String() string {
+	return s.spec
+}
+
+// Next returns the earliest time strictly after the specified time that
+// matches the Schedule. The returned time is in the Schedule's location. If no
+// such time exists within the next five years, the zero time is returned.
+func (s *Schedule) Next(t time.Time) time.Time {
+	next := s.schedule.Next(t)
+	if next.IsZero() {
+		return next
+	}
+	return next.In(s.schedule.Location)
+}
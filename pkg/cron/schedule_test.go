@@ -0,0 +1,193 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name       string
+		spec       string
+		assertions func(*testing.T, *Schedule, error)
+	}{
+		{
+			name: "too few fields",
+			spec: "0 2 * *",
+			assertions: func(t *testing.T, _ *Schedule, err error) {
+				require.ErrorContains(t, err, "expected exactly 5 fields")
+			},
+		},
+		{
+			name: "too many fields",
+			spec: "0 0 2 * * *",
+			assertions: func(t *testing.T, _ *Schedule, err error) {
+				require.ErrorContains(t, err, "expected exactly 5 fields")
+			},
+		},
+		{
+			name: "unrecognized descriptor",
+			spec: "@fortnightly",
+			assertions: func(t *testing.T, _ *Schedule, err error) {
+				require.ErrorContains(t, err, "unrecognized descriptor")
+			},
+		},
+		{
+			name: "interval descriptor",
+			spec: "@every 1h",
+			assertions: func(t *testing.T, _ *Schedule, err error) {
+				require.ErrorContains(t, err, "unsupported cron expression")
+			},
+		},
+		{
+			name: "time zone prefix",
+			spec: "CRON_TZ=America/New_York 0 2 * * *",
+			assertions: func(t *testing.T, _ *Schedule, err error) {
+				require.ErrorContains(t, err, "time zone prefix is not supported")
+			},
+		},
+		{
+			name: "value out of range",
+			spec: "60 * * * *",
+			assertions: func(t *testing.T, _ *Schedule, err error) {
+				require.ErrorContains(t, err, "above maximum")
+			},
+		},
+		{
+			name: "invalid value",
+			spec: "* * * foo *",
+			assertions: func(t *testing.T, _ *Schedule, err error) {
+				require.ErrorContains(t, err, "foo")
+			},
+		},
+		{
+			name: "invalid range",
+			spec: "* 5-1 * * *",
+			assertions: func(t *testing.T, _ *Schedule, err error) {
+				require.ErrorContains(t, err, "beyond end of range")
+			},
+		},
+		{
+			name: "invalid step",
+			spec: "*/0 * * * *",
+			assertions: func(t *testing.T, _ *Schedule, err error) {
+				require.ErrorContains(t, err, "step")
+			},
+		},
+		{
+			name: "standard expression",
+			spec: "0,30 2 * * MON-fri",
+			assertions: func(t *testing.T, s *Schedule, err error) {
+				require.NoError(t, err)
+				require.Equal(t, time.UTC, s.Location())
+				require.Equal(t, "0,30 2 * * MON-fri", s.String())
+			},
+		},
+		{
+			name: "descriptor",
+			spec: "@daily",
+			assertions: func(t *testing.T, s *Schedule, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "@daily", s.String())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := Parse(testCase.spec, nil)
+			testCase.assertions(t, s, err)
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		spec     string
+		location *time.Location
+		after    time.Time
+		expected time.Time
+	}{
+		{
+			name:     "every minute",
+			spec:     "* * * * *",
+			after:    time.Date(2025, 1, 1, 10, 15, 30, 0, time.UTC),
+			expected: time.Date(2025, 1, 1, 10, 16, 0, 0, time.UTC),
+		},
+		{
+			name:     "strictly after",
+			spec:     "0 2 * * *",
+			after:    time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 2, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "weekdays only",
+			spec: "0 2 * * 1-5",
+			// A Friday
+			after:    time.Date(2025, 1, 3, 3, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 6, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "day of month or day of week",
+			spec:     "0 0 15 * MON",
+			after:    time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "steps",
+			spec:     "*/20 5/6 * * *",
+			after:    time.Date(2025, 1, 1, 11, 45, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 1, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "rolls over year",
+			spec:     "@yearly",
+			after:    time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "leap day",
+			spec:     "0 0 29 2 *",
+			after:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "never",
+			spec:     "0 0 30 2 *",
+			after:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Time{},
+		},
+		{
+			name:     "in location",
+			spec:     "0 2 * * *",
+			location: newYork,
+			after:    time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 1, 1, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "skipped by daylight saving time",
+			spec:     "30 2 * * *",
+			location: newYork,
+			// 2:30 does not exist on March 9, 2025 in New York
+			after:    time.Date(2025, 3, 9, 5, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, 3, 10, 2, 30, 0, 0, newYork),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := Parse(testCase.spec, testCase.location)
+			require.NoError(t, err)
+			next := s.Next(testCase.after)
+			require.True(
+				t,
+				testCase.expected.Equal(next),
+				"expected %s; got %s", testCase.expected, next,
+			)
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package dotenv
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Update represents a discrete update to be made to a .env file.
+type Update struct {
+	// Key is the name of the variable to update.
+	Key string
+	// Value is the new value to set for the variable. It must be a string, a
+	// boolean, or a number.
+	Value any
+}
+
+// Parse parses the provided .env file contents and returns the variables it
+// defines. Values are returned verbatim, without any variable expansion. If a
+// variable is defined more than once, the last definition wins.
+func Parse(inBytes []byte) (map[string]string, error) {
+	entries, err := scan(string(inBytes))
+	if err != nil {
+		return nil, err
+	}
+	vars := make(map[string]string, len(entries))
+	for _, e := range entries {
+		vars[e.key] = e.value
+	}
+	return vars, nil
+}
+
+// SetValuesInFile overwrites the specified file with the changes specified by
+// the list of Updates. Variables that are not already defined in the file are
+// appended to it. Importantly, all comments, ordering, and formatting in the
+// file are preserved.
+func SetValuesInFile(file string, updates []Update) error {
+	inBytes, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("error reading file %q: %w", file, err)
+	}
+	outBytes, err := SetValuesInBytes(inBytes, updates)
+	if err != nil {
+		return fmt.Errorf("error mutating bytes: %w", err)
+	}
+	// This file should always exist already, so the permissions we choose here
+	// don't really matter. We went with 0600 just to appease the gosec linter.
+	if err = os.WriteFile(file, outBytes, 0600); err != nil {
+		return fmt.Errorf("error writing mutated bytes to file %q: %w", file, err)
+	}
+	return nil
+}
+
+// SetValuesInBytes returns a copy of the provided bytes with the changes
+// specified by Updates applied. Variables that are not already defined are
+// appended. If a variable is defined more than once, only its last definition
+// (i.e. the effective one) is updated. Importantly, all comments, ordering,
+// and formatting in the input bytes are preserved in the output.
+func SetValuesInBytes(inBytes []byte, updates []Update) ([]byte, error) {
+	doc := string(inBytes)
+	entries, err := scan(doc)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing input: %w", err)
+	}
+	lastEntries := make(map[string]entry, len(entries))
+	for _, e := range entries {
+		lastEntries[e.key] = e
+	}
+
+	type change struct {
+		start, end int
+		newValue   string
+	}
+	var changes []change
+	var appended []string
+	appendedIdx := map[string]int{}
+	for _, update := range updates {
+		if !isValidKey(update.Key) {
+			return nil, fmt.Errorf("invalid variable name %q", update.Key)
+		}
+		val, err := stringify(update.Value)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding value for variable %q: %w", update.Key, err)
+		}
+		e, ok := lastEntries[update.Key]
+		if !ok {
+			line := update.Key + "=" + encode(val, 0)
+			if i, ok := appendedIdx[update.Key]; ok {
+				appended[i] = line
+			} else {
+				appendedIdx[update.Key] = len(appended)
+				appended = append(appended, line)
+			}
+			continue
+		}
+		changes = slices.DeleteFunc(changes, func(c change) bool {
+			return c.start == e.valueStart
+		})
+		changes = append(changes, change{
+			start:    e.valueStart,
+			end:      e.valueEnd,
+			newValue: encode(val, e.quote),
+		})
+	}
+
+	// Apply changes from the end of the document to the beginning so that the
+	// offsets of yet-to-be-applied changes remain valid.
+	slices.SortFunc(changes, func(a, b change) int {
+		return b.start - a.start
+	})
+	for _, c := range changes {
+		doc = doc[:c.start] + c.newValue + doc[c.end:]
+	}
+
+	if len(appended) > 0 {
+		if doc != "" && !strings.HasSuffix(doc, "\n") {
+			doc += "\n"
+		}
+		doc += strings.Join(appended, "\n") + "\n"
+	}
+	return []byte(doc), nil
+}
+
+// entry describes a variable definition within a .env file.
+type entry struct {
+	key   string
+	value string
+	// quote is the quote character used for the value, or 0 if the value is
+	// not quoted.
+	quote byte
+	// valueStart is the offset of the first byte of the raw value, including
+	// any quotes.
+	valueStart int
+	// valueEnd is the offset of the byte following the last byte of the raw
+	// value, including any quotes.
+	valueEnd int
+}
+
+// scan returns all variable definitions in the provided .env file contents in
+// the order in which they appear.
+func scan(doc string) ([]entry, error) {
+	var entries []entry
+	pos := 0
+	lineNum := 1
+	for pos < len(doc) {
+		lineEnd := strings.IndexByte(doc[pos:], '\n')
+		if lineEnd < 0 {
+			lineEnd = len(doc)
+		} else {
+			lineEnd += pos
+		}
+		line := doc[pos:lineEnd]
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			pos = lineEnd + 1
+			lineNum++
+			continue
+		}
+
+		i := pos + len(line) - len(strings.TrimLeft(line, " \t"))
+		if rest := doc[i:lineEnd]; strings.HasPrefix(rest, "export ") || strings.HasPrefix(rest, "export\t") {
+			i += len("export")
+			for i < lineEnd && (doc[i] == ' ' || doc[i] == '\t') {
+				i++
+			}
+		}
+		eq := strings.IndexByte(doc[i:lineEnd], '=')
+		if eq < 0 {
+			return nil, fmt.Errorf("line %d: expected '='", lineNum)
+		}
+		key := strings.TrimRight(doc[i:i+eq], " \t")
+		if !isValidKey(key) {
+			return nil, fmt.Errorf("line %d: invalid variable name %q", lineNum, key)
+		}
+		i += eq + 1
+		for i < lineEnd && (doc[i] == ' ' || doc[i] == '\t') {
+			i++
+		}
+
+		e := entry{key: key, valueStart: i}
+		switch {
+		case i < len(doc) && (doc[i] == '"' || doc[i] == '\''):
+			// Quoted values may span multiple lines
+			e.quote = doc[i]
+			end, value, err := scanQuoted(doc, i)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNum, err)
+			}
+			e.value = value
+			e.valueEnd = end
+			lineNum += strings.Count(doc[i:end], "\n")
+			if lineEnd = strings.IndexByte(doc[end:], '\n'); lineEnd < 0 {
+				lineEnd = len(doc)
+			} else {
+				lineEnd += end
+			}
+		default:
+			raw := doc[i:lineEnd]
+			// An unquoted value ends at an inline comment, which must be
+			// preceded by whitespace.
+			for j := 1; j < len(raw); j++ {
+				if raw[j] == '#' && (raw[j-1] == ' ' || raw[j-1] == '\t') {
+					raw = raw[:j]
+					break
+				}
+			}
+			raw = strings.TrimRight(raw, " \t\r")
+			e.value = raw
+			e.valueEnd = i + len(raw)
+		}
+		entries = append(entries, e)
+		pos = lineEnd + 1
+		lineNum++
+	}
+	return entries, nil
+}
+
+// scanQuoted scans the quoted value starting at the specified offset and
+// returns the offset of the byte following the closing quote along with the
+// unquoted value.
+func scanQuoted(doc string, start int) (int, string, error) {
+	quote := doc[start]
+	var b strings.Builder
+	for i := start + 1; i < len(doc); i++ {
+		c := doc[i]
+		switch {
+		case c == quote:
+			return i + 1, b.String(), nil
+		case c == '\\' && quote == '"' && i+1 < len(doc):
+			i++
+			switch doc[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case '"', '\\', '$':
+				b.WriteByte(doc[i])
+			default:
+				b.WriteByte('\\')
+				b.WriteByte(doc[i])
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return 0, "", fmt.Errorf("unterminated quoted value")
+}
+
+// encode returns the provided value in a form suitable for use in a .env file.
+// The quote character used by the value being replaced (if any) is preserved
+// where possible. Unquoted values are quoted only if necessary.
+func encode(val string, quote byte) string {
+	switch {
+	case quote == '\'' && !strings.Contains(val, "'"):
+		return "'" + val + "'"
+	case quote == 0 && val != "" && !strings.ContainsAny(val, " \t\r\n#\"'\\$`"):
+		return val
+	}
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(val); i++ {
+		switch c := val[i]; c {
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '"', '\\', '$':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// stringify returns the string representation of the provided scalar value.
+func stringify(val any) (string, error) {
+	switch v := val.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", val)
+	}
+}
+
+// isValidKey returns true if the provided string is a valid variable name.
+func isValidKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		switch {
+		case c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+		case i > 0 && ((c >= '0' && c <= '9') || c == '.' || c == '-'):
+		default:
+			return false
+		}
+	}
+	return true
+}
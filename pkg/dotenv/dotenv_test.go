@@ -0,0 +1,174 @@
+package dotenv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name       string
+		inBytes    []byte
+		assertions func(*testing.T, map[string]string, error)
+	}{
+		{
+			name:    "empty",
+			inBytes: []byte(""),
+			assertions: func(t *testing.T, vars map[string]string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, vars)
+			},
+		},
+		{
+			name:    "missing equals sign",
+			inBytes: []byte("FOO=bar\nBAZ\n"),
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorContains(t, err, "line 2: expected '='")
+			},
+		},
+		{
+			name:    "invalid variable name",
+			inBytes: []byte("1FOO=bar\n"),
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorContains(t, err, "invalid variable name")
+			},
+		},
+		{
+			name:    "unterminated quoted value",
+			inBytes: []byte(`FOO="bar`),
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorContains(t, err, "unterminated quoted value")
+			},
+		},
+		{
+			name: "various forms",
+			inBytes: []byte(`# A comment
+FOO=bar
+export EXPORTED=yes
+SPACED = value with spaces # and a comment
+EMPTY=
+HASH=abc#def
+DOUBLE="line1\nline2 \"quoted\" \$HOME"
+SINGLE='literal \n $HOME'
+MULTI="first
+second"
+FOO=overridden
+`),
+			assertions: func(t *testing.T, vars map[string]string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, map[string]string{
+					"FOO":      "overridden",
+					"EXPORTED": "yes",
+					"SPACED":   "value with spaces",
+					"EMPTY":    "",
+					"HASH":     "abc#def",
+					"DOUBLE":   "line1\nline2 \"quoted\" $HOME",
+					"SINGLE":   `literal \n $HOME`,
+					"MULTI":    "first\nsecond",
+				}, vars)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			vars, err := Parse(testCase.inBytes)
+			testCase.assertions(t, vars, err)
+		})
+	}
+}
+
+func TestSetValuesInBytes(t *testing.T) {
+	testCases := []struct {
+		name       string
+		inBytes    []byte
+		updates    []Update
+		assertions func(*testing.T, []byte, error)
+	}{
+		{
+			name:    "invalid input",
+			inBytes: []byte("FOO\n"),
+			updates: []Update{{Key: "FOO", Value: "bar"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "error parsing input")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name:    "invalid variable name",
+			inBytes: []byte("FOO=bar\n"),
+			updates: []Update{{Key: "NOT VALID", Value: "bar"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "invalid variable name")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name:    "unsupported value type",
+			inBytes: []byte("FOO=bar\n"),
+			updates: []Update{{Key: "FOO", Value: map[string]any{}}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "unsupported value type")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name: "preserves comments, ordering, and formatting",
+			inBytes: []byte(`# Images
+export IMAGE_TAG=1.0.0 # current tag
+REPLICAS = 2
+DOUBLE="old value"
+SINGLE='old'
+MULTI="first
+second"
+IMAGE_TAG=1.0.1
+`),
+			updates: []Update{
+				{Key: "IMAGE_TAG", Value: "2.0.0"},
+				{Key: "REPLICAS", Value: float64(3)},
+				{Key: "DOUBLE", Value: `new "value"`},
+				{Key: "SINGLE", Value: "it's"},
+				{Key: "MULTI", Value: "single"},
+			},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, `# Images
+export IMAGE_TAG=1.0.0 # current tag
+REPLICAS = 3
+DOUBLE="new \"value\""
+SINGLE="it's"
+MULTI="single"
+IMAGE_TAG=2.0.0
+`, string(bytes))
+			},
+		},
+		{
+			name:    "quotes unquoted values if necessary",
+			inBytes: []byte("FOO=bar # comment\n"),
+			updates: []Update{{Key: "FOO", Value: "has # hash"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "FOO=\"has # hash\" # comment\n", string(bytes))
+			},
+		},
+		{
+			name:    "appends undefined variables",
+			inBytes: []byte("FOO=bar"),
+			updates: []Update{
+				{Key: "BAZ", Value: true},
+				{Key: "QUX", Value: "a b"},
+				{Key: "BAZ", Value: false},
+			},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "FOO=bar\nBAZ=false\nQUX=\"a b\"\n", string(bytes))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			bytes, err := SetValuesInBytes(testCase.inBytes, testCase.updates)
+			testCase.assertions(t, bytes, err)
+		})
+	}
+}
@@ -143,22 +143,26 @@ func TestFreight_MarshalAnnotationsTo(t *testing.T) {
 	}{
 		"complete freight": {
 			freight: Freight{
-				Name:       "test-freight",
-				StageName:  "test-stage",
-				CreateTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
-				Alias:      ptr.To("v1.0.0"),
-				Commits:    []kargoapi.GitCommit{{ID: "abc123", Tag: "v1.0.0"}},
-				Images:     []kargoapi.Image{{RepoURL: "example.com/app", Tag: "v1.0.0"}},
-				Charts:     []kargoapi.Chart{{Name: "my-chart", Version: "1.0.0"}},
+				Name:         "test-freight",
+				StageName:    "test-stage",
+				CreateTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				Alias:        ptr.To("v1.0.0"),
+				Commits:      []kargoapi.GitCommit{{ID: "abc123", Tag: "v1.0.0"}},
+				Images:       []kargoapi.Image{{RepoURL: "example.com/app", Tag: "v1.0.0"}},
+				Charts:       []kargoapi.Chart{{Name: "my-chart", Version: "1.0.0"}},
+				OCIArtifacts: []kargoapi.OCIArtifact{{RepoURL: "example.com/manifests", Tag: "v1.0.0"}},
+				Releases:     []kargoapi.Release{{RepoURL: "https://github.com/example/app", Tag: "v1.0.0"}},
 			},
 			expected: map[string]string{
-				kargoapi.AnnotationKeyEventFreightName:       "test-freight",
-				kargoapi.AnnotationKeyEventFreightCreateTime: "2024-01-01T00:00:00Z",
-				kargoapi.AnnotationKeyEventStageName:         "test-stage",
-				kargoapi.AnnotationKeyEventFreightAlias:      "v1.0.0",
-				kargoapi.AnnotationKeyEventFreightCommits:    `[{"id":"abc123","tag":"v1.0.0"}]`,
-				kargoapi.AnnotationKeyEventFreightImages:     `[{"repoURL":"example.com/app","tag":"v1.0.0"}]`,
-				kargoapi.AnnotationKeyEventFreightCharts:     `[{"name":"my-chart","version":"1.0.0"}]`,
+				kargoapi.AnnotationKeyEventFreightName:         "test-freight",
+				kargoapi.AnnotationKeyEventFreightCreateTime:   "2024-01-01T00:00:00Z",
+				kargoapi.AnnotationKeyEventStageName:           "test-stage",
+				kargoapi.AnnotationKeyEventFreightAlias:        "v1.0.0",
+				kargoapi.AnnotationKeyEventFreightCommits:      `[{"id":"abc123","tag":"v1.0.0"}]`,
+				kargoapi.AnnotationKeyEventFreightImages:       `[{"repoURL":"example.com/app","tag":"v1.0.0"}]`,
+				kargoapi.AnnotationKeyEventFreightCharts:       `[{"name":"my-chart","version":"1.0.0"}]`,
+				kargoapi.AnnotationKeyEventFreightOCIArtifacts: `[{"repoURL":"example.com/manifests","tag":"v1.0.0"}]`,
+				kargoapi.AnnotationKeyEventFreightReleases:     `[{"repoURL":"https://github.com/example/app","tag":"v1.0.0"}]`,
 			},
 		},
 		"minimal freight": {
@@ -333,22 +337,26 @@ func TestUnmarshalFreightAnnotations(t *testing.T) {
 	}{
 		"complete annotations": {
 			annotations: map[string]string{
-				kargoapi.AnnotationKeyEventFreightName:       "test-freight",
-				kargoapi.AnnotationKeyEventFreightCreateTime: "2024-01-01T00:00:00Z",
-				kargoapi.AnnotationKeyEventStageName:         "test-stage",
-				kargoapi.AnnotationKeyEventFreightAlias:      "v1.0.0",
-				kargoapi.AnnotationKeyEventFreightCommits:    `[{"id":"abc123","tag":"v1.0.0"}]`,
-				kargoapi.AnnotationKeyEventFreightImages:     `[{"repoURL":"example.com/app","tag":"v1.0.0"}]`,
-				kargoapi.AnnotationKeyEventFreightCharts:     `[{"name":"my-chart","version":"1.0.0"}]`,
+				kargoapi.AnnotationKeyEventFreightName:         "test-freight",
+				kargoapi.AnnotationKeyEventFreightCreateTime:   "2024-01-01T00:00:00Z",
+				kargoapi.AnnotationKeyEventStageName:           "test-stage",
+				kargoapi.AnnotationKeyEventFreightAlias:        "v1.0.0",
+				kargoapi.AnnotationKeyEventFreightCommits:      `[{"id":"abc123","tag":"v1.0.0"}]`,
+				kargoapi.AnnotationKeyEventFreightImages:       `[{"repoURL":"example.com/app","tag":"v1.0.0"}]`,
+				kargoapi.AnnotationKeyEventFreightCharts:       `[{"name":"my-chart","version":"1.0.0"}]`,
+				kargoapi.AnnotationKeyEventFreightOCIArtifacts: `[{"repoURL":"example.com/manifests","tag":"v1.0.0"}]`,
+				kargoapi.AnnotationKeyEventFreightReleases:     `[{"repoURL":"https://github.com/example/app","tag":"v1.0.0"}]`,
 			},
 			expected: Freight{
-				Name:       "test-freight",
-				CreateTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
-				StageName:  "test-stage",
-				Alias:      ptr.To("v1.0.0"),
-				Commits:    []kargoapi.GitCommit{{ID: "abc123", Tag: "v1.0.0"}},
-				Images:     []kargoapi.Image{{RepoURL: "example.com/app", Tag: "v1.0.0"}},
-				Charts:     []kargoapi.Chart{{Name: "my-chart", Version: "1.0.0"}},
+				Name:         "test-freight",
+				CreateTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				StageName:    "test-stage",
+				Alias:        ptr.To("v1.0.0"),
+				Commits:      []kargoapi.GitCommit{{ID: "abc123", Tag: "v1.0.0"}},
+				Images:       []kargoapi.Image{{RepoURL: "example.com/app", Tag: "v1.0.0"}},
+				Charts:       []kargoapi.Chart{{Name: "my-chart", Version: "1.0.0"}},
+				OCIArtifacts: []kargoapi.OCIArtifact{{RepoURL: "example.com/manifests", Tag: "v1.0.0"}},
+				Releases:     []kargoapi.Release{{RepoURL: "https://github.com/example/app", Tag: "v1.0.0"}},
 			},
 		},
 		"minimal annotations": {
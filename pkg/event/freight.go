@@ -12,13 +12,15 @@ import (
 
 // Freight is a struct that contains common fields for freight-related events.
 type Freight struct {
-	Name       string               `json:"name"`
-	StageName  string               `json:"stageName"`
-	CreateTime time.Time            `json:"createTime"`
-	Alias      *string              `json:"alias,omitempty"`
-	Commits    []kargoapi.GitCommit `json:"commits,omitempty"`
-	Images     []kargoapi.Image     `json:"images,omitempty"`
-	Charts     []kargoapi.Chart     `json:"charts,omitempty"`
+	Name         string                 `json:"name"`
+	StageName    string                 `json:"stageName"`
+	CreateTime   time.Time              `json:"createTime"`
+	Alias        *string                `json:"alias,omitempty"`
+	Commits      []kargoapi.GitCommit   `json:"commits,omitempty"`
+	Images       []kargoapi.Image       `json:"images,omitempty"`
+	Charts       []kargoapi.Chart       `json:"charts,omitempty"`
+	OCIArtifacts []kargoapi.OCIArtifact `json:"ociArtifacts,omitempty"`
+	Releases     []kargoapi.Release     `json:"releases,omitempty"`
 }
 
 func (f Freight) GetName() string {
@@ -321,6 +323,16 @@ func (f *Freight) MarshalAnnotationsTo(annotations map[string]string) {
 			annotations[kargoapi.AnnotationKeyEventFreightCharts] = string(data)
 		}
 	}
+	if len(f.OCIArtifacts) > 0 {
+		if data, err := json.Marshal(f.OCIArtifacts); err == nil {
+			annotations[kargoapi.AnnotationKeyEventFreightOCIArtifacts] = string(data)
+		}
+	}
+	if len(f.Releases) > 0 {
+		if data, err := json.Marshal(f.Releases); err == nil {
+			annotations[kargoapi.AnnotationKeyEventFreightReleases] = string(data)
+		}
+	}
 }
 
 func (f *FreightVerificationSucceeded) MarshalAnnotations() map[string]string {
@@ -420,6 +432,20 @@ func UnmarshalFreightAnnotations(annotations map[string]string) (Freight, error)
 		}
 		evt.Charts = cs
 	}
+	if ociArtifacts, ok := annotations[kargoapi.AnnotationKeyEventFreightOCIArtifacts]; ok {
+		var as []kargoapi.OCIArtifact
+		if err := json.Unmarshal([]byte(ociArtifacts), &as); err != nil {
+			return evt, fmt.Errorf("failed to unmarshal freight OCI artifacts: %w", err)
+		}
+		evt.OCIArtifacts = as
+	}
+	if releases, ok := annotations[kargoapi.AnnotationKeyEventFreightReleases]; ok {
+		var rs []kargoapi.Release
+		if err := json.Unmarshal([]byte(releases), &rs); err != nil {
+			return evt, fmt.Errorf("failed to unmarshal freight releases: %w", err)
+		}
+		evt.Releases = rs
+	}
 	return evt, nil
 }
 
@@ -628,5 +654,11 @@ func newFreight(freight *kargoapi.Freight, stageName string) Freight {
 	if len(freight.Charts) > 0 {
 		evt.Charts = freight.Charts
 	}
+	if len(freight.OCIArtifacts) > 0 {
+		evt.OCIArtifacts = freight.OCIArtifacts
+	}
+	if len(freight.Releases) > 0 {
+		evt.Releases = freight.Releases
+	}
 	return evt
 }
@@ -420,7 +420,7 @@ func newPromotion(
 	// expressions or convert types, we just skip. Originally we logged this, but this library
 	// doesn't have logging, so this retains the same behavior. If we want to log these errors, we
 	// can add a logger to the context and use that here.
-	for _, step := range promotion.Spec.Steps {
+	for _, step := range promotion.Spec.GetSteps() {
 		if step.Uses != "argocd-update" || step.Config == nil {
 			continue
 		}
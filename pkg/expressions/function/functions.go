@@ -28,10 +28,10 @@ type exprFn func(params ...any) (any, error)
 // FreightOperations returns a slice of expr.Option containing functions for
 // Freight operations.
 //
-// It provides `warehouse()`, `commitFrom()`, `imageFrom()`, and `chartFrom()`
-// functions that can be used within expressions. The functions operate within
-// the context of a given project with the provided freight requests and
-// references.
+// It provides `warehouse()`, `commitFrom()`, `imageFrom()`, `chartFrom()`,
+// `ociArtifactFrom()`, and `releaseFrom()` functions that can be used within
+// expressions. The functions operate within the context of a given project
+// with the provided freight requests and references.
 func FreightOperations(
 	ctx context.Context,
 	c client.Client,
@@ -44,6 +44,8 @@ func FreightOperations(
 		CommitFromFreight(ctx, c, project, freightRequests, freightRefs),
 		ImageFromFreight(ctx, c, project, freightRequests, freightRefs),
 		ChartFromFreight(ctx, c, project, freightRequests, freightRefs),
+		OCIArtifactFromFreight(ctx, c, project, freightRequests, freightRefs),
+		ReleaseFromFreight(ctx, c, project, freightRequests, freightRefs),
 		FreightMetadata(ctx, c, project),
 	}
 }
@@ -51,17 +53,19 @@ func FreightOperations(
 // DiscoveredArtifactsOperations returns a slice of expr.Option containing
 // functions for retrieving artifacts from a Warehouse's discovered artifacts.
 //
-// It provides `commitFrom()`, `imageFrom()`, and `chartFrom()` functions for
-// use in the context of expressions defining criteria that permit or block
-// automatic Freight creation after artifact discovery. These functions behave
-// identically to functions of the same names used within the context of a
-// Promotion process, however, they are implemented differently since they
-// resolve artifacts from different data.
+// It provides `commitFrom()`, `imageFrom()`, `chartFrom()`, `ociArtifactFrom()`,
+// and `releaseFrom()` functions for use in the context of expressions defining
+// criteria that permit or block automatic Freight creation after artifact
+// discovery. These functions behave identically to functions of the same names
+// used within the context of a Promotion process, however, they are
+// implemented differently since they resolve artifacts from different data.
 func DiscoveredArtifactsOperations(artifacts *kargoapi.DiscoveredArtifacts) []expr.Option {
 	return []expr.Option{
 		CommitFromDiscoveredArtifacts(artifacts),
 		ImageFromDiscoveredArtifacts(artifacts),
 		ChartFromDiscoveredArtifacts(artifacts),
+		OCIArtifactFromDiscoveredArtifacts(artifacts),
+		ReleaseFromDiscoveredArtifacts(artifacts),
 	}
 }
 
@@ -221,6 +225,77 @@ func ChartFromDiscoveredArtifacts(artifacts *kargoapi.DiscoveredArtifacts) expr.
 	)
 }
 
+// OCIArtifactFromFreight returns an expr.Option that provides an
+// `ociArtifactFrom()` function for use in expressions.
+//
+// The ociArtifactFrom function finds OCI artifacts based on repository URL and
+// optional origin, using the provided freight requests and references within
+// the project context.
+func OCIArtifactFromFreight(
+	ctx context.Context,
+	c client.Client,
+	project string,
+	freightReqs []kargoapi.FreightRequest,
+	freightRefs []kargoapi.FreightReference,
+) expr.Option {
+	return expr.Function(
+		"ociArtifactFrom",
+		getOCIArtifactFromFreight(ctx, c, project, freightReqs, freightRefs),
+		new(func(repoURL string, origin kargoapi.FreightOrigin) kargoapi.OCIArtifact),
+		new(func(repoURL string) kargoapi.OCIArtifact),
+	)
+}
+
+// OCIArtifactFromDiscoveredArtifacts returns an expr.Option that provides an
+// `ociArtifactFrom()` function for use, specifically, in expressions that
+// define criteria that permit or block automatic Freight creation after
+// artifact discovery.
+//
+// The ociArtifactFrom function finds the latest OCI artifact based on
+// repository URL.
+func OCIArtifactFromDiscoveredArtifacts(artifacts *kargoapi.DiscoveredArtifacts) expr.Option {
+	return expr.Function(
+		"ociArtifactFrom",
+		getOCIArtifactFromDiscoveredArtifacts(artifacts),
+		new(func(repoURL string) kargoapi.DiscoveredOCIArtifact),
+	)
+}
+
+// ReleaseFromFreight returns an expr.Option that provides a `releaseFrom()`
+// function for use in expressions.
+//
+// The releaseFrom function finds Git forge releases based on repository URL
+// and optional origin, using the provided freight requests and references
+// within the project context.
+func ReleaseFromFreight(
+	ctx context.Context,
+	c client.Client,
+	project string,
+	freightReqs []kargoapi.FreightRequest,
+	freightRefs []kargoapi.FreightReference,
+) expr.Option {
+	return expr.Function(
+		"releaseFrom",
+		getReleaseFromFreight(ctx, c, project, freightReqs, freightRefs),
+		new(func(repoURL string, origin kargoapi.FreightOrigin) kargoapi.Release),
+		new(func(repoURL string) kargoapi.Release),
+	)
+}
+
+// ReleaseFromDiscoveredArtifacts returns an expr.Option that provides a
+// `releaseFrom()` function for use, specifically, in expressions that define
+// criteria that permit or block automatic Freight creation after artifact
+// discovery.
+//
+// The releaseFrom function finds the latest release based on repository URL.
+func ReleaseFromDiscoveredArtifacts(artifacts *kargoapi.DiscoveredArtifacts) expr.Option {
+	return expr.Function(
+		"releaseFrom",
+		getReleaseFromDiscoveredArtifacts(artifacts),
+		new(func(repoURL string) kargoapi.DiscoveredRelease),
+	)
+}
+
 func FreightMetadata(
 	ctx context.Context,
 	c client.Client,
@@ -769,6 +844,152 @@ func getChartFromDiscoveredArtifacts(artifacts *kargoapi.DiscoveredArtifacts) ex
 	}
 }
 
+// getOCIArtifactFromFreight returns a function that finds OCI artifacts based
+// on repository URL and optional origin.
+//
+// The returned function uses freight requests and references to locate the
+// appropriate artifact within the project context.
+func getOCIArtifactFromFreight(
+	ctx context.Context,
+	c client.Client,
+	project string,
+	freightRequests []kargoapi.FreightRequest,
+	freightRefs []kargoapi.FreightReference,
+) exprFn {
+	return func(a ...any) (any, error) {
+		if len(a) == 0 || len(a) > 2 {
+			return nil, fmt.Errorf("expected 1-2 arguments, got %d", len(a))
+		}
+
+		repoURL, ok := a[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("first argument must be string, got %T", a[0])
+		}
+
+		var desiredOrigin *kargoapi.FreightOrigin
+		if len(a) == 2 {
+			origin, ok := a[1].(kargoapi.FreightOrigin)
+			if !ok {
+				return nil, fmt.Errorf("second argument must be FreightOrigin, got %T", a[1])
+			}
+			desiredOrigin = &origin
+		}
+
+		return freight.FindOCIArtifact(
+			ctx,
+			c,
+			project,
+			freightRequests,
+			desiredOrigin,
+			freightRefs,
+			repoURL,
+		)
+	}
+}
+
+// getOCIArtifactFromDiscoveredArtifacts returns a function that finds the
+// latest OCI artifact based on repository URL.
+func getOCIArtifactFromDiscoveredArtifacts(artifacts *kargoapi.DiscoveredArtifacts) exprFn {
+	return func(a ...any) (any, error) {
+		if len(a) != 1 {
+			return nil, fmt.Errorf("expected 1 argument, got %d", len(a))
+		}
+
+		repoURL, ok := a[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("first argument must be string, got %T", a[0])
+		}
+
+		if artifacts == nil {
+			return nil, nil
+		}
+
+		repoURL = urls.NormalizeImage(repoURL)
+		for _, oa := range artifacts.OCIArtifacts {
+			if urls.NormalizeImage(oa.RepoURL) != repoURL {
+				continue
+			}
+			if len(oa.References) > 0 {
+				return oa.References[0], nil
+			}
+		}
+		return nil, nil
+	}
+}
+
+// getReleaseFromFreight returns a function that finds Git forge releases based
+// on repository URL and optional origin.
+//
+// The returned function uses freight requests and references to locate the
+// appropriate release within the project context.
+func getReleaseFromFreight(
+	ctx context.Context,
+	c client.Client,
+	project string,
+	freightRequests []kargoapi.FreightRequest,
+	freightRefs []kargoapi.FreightReference,
+) exprFn {
+	return func(a ...any) (any, error) {
+		if len(a) == 0 || len(a) > 2 {
+			return nil, fmt.Errorf("expected 1-2 arguments, got %d", len(a))
+		}
+
+		repoURL, ok := a[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("first argument must be string, got %T", a[0])
+		}
+
+		var desiredOrigin *kargoapi.FreightOrigin
+		if len(a) == 2 {
+			origin, ok := a[1].(kargoapi.FreightOrigin)
+			if !ok {
+				return nil, fmt.Errorf("second argument must be FreightOrigin, got %T", a[1])
+			}
+			desiredOrigin = &origin
+		}
+
+		return freight.FindRelease(
+			ctx,
+			c,
+			project,
+			freightRequests,
+			desiredOrigin,
+			freightRefs,
+			repoURL,
+		)
+	}
+}
+
+// getReleaseFromDiscoveredArtifacts returns a function that finds the latest
+// Git forge release based on repository URL.
+func getReleaseFromDiscoveredArtifacts(artifacts *kargoapi.DiscoveredArtifacts) exprFn {
+	return func(a ...any) (any, error) {
+		if len(a) != 1 {
+			return nil, fmt.Errorf("expected 1 argument, got %d", len(a))
+		}
+
+		repoURL, ok := a[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("first argument must be string, got %T", a[0])
+		}
+
+		if artifacts == nil {
+			return nil, nil
+		}
+
+		repoURL = urls.NormalizeGit(repoURL)
+		for _, ra := range artifacts.Releases {
+			if urls.NormalizeGit(ra.RepoURL) != repoURL {
+				continue
+			}
+			if len(ra.Releases) > 0 {
+				return ra.Releases[0], nil
+			}
+		}
+		return nil, nil
+	}
+}
+
 // getConfigMap returns a function that retrieves a ConfigMap by its name
 // within the specified project namespace. If the ConfigMap is not found,
 // it returns an empty map.
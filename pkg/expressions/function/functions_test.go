@@ -540,6 +540,454 @@ func Test_getImageFromDiscoveredArtifacts(t *testing.T) {
 	}
 }
 
+func Test_getOCIArtifactFromFreight(t *testing.T) {
+	const testProject = "fake-project"
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, kargoapi.AddToScheme(scheme))
+
+	tests := []struct {
+		name        string
+		objects     []client.Object
+		freightReqs []kargoapi.FreightRequest
+		freightRefs []kargoapi.FreightReference
+		args        []any
+		assertions  func(t *testing.T, result any, err error)
+	}{
+		{
+			name: "repo URL only",
+			objects: []client.Object{
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-warehouse",
+						Namespace: testProject,
+					},
+					Spec: kargoapi.WarehouseSpec{
+						Subscriptions: []kargoapi.RepoSubscription{
+							{
+								OCI: &kargoapi.OCISubscription{
+									RepoURL: "registry.example.com/manifests",
+								},
+							},
+						},
+					},
+				},
+			},
+			freightReqs: []kargoapi.FreightRequest{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+				},
+			},
+			freightRefs: []kargoapi.FreightReference{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+					OCIArtifacts: []kargoapi.OCIArtifact{
+						{
+							RepoURL: "registry.example.com/manifests",
+						},
+					},
+				},
+			},
+			args: []any{"registry.example.com/manifests"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.NoError(t, err)
+				art, ok := result.(*kargoapi.OCIArtifact)
+				assert.True(t, ok)
+				assert.Equal(t, "registry.example.com/manifests", art.RepoURL)
+			},
+		},
+		{
+			name: "repo URL and origin",
+			freightReqs: []kargoapi.FreightRequest{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+				},
+			},
+			freightRefs: []kargoapi.FreightReference{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+					OCIArtifacts: []kargoapi.OCIArtifact{
+						{
+							RepoURL: "registry.example.com/manifests",
+						},
+					},
+				},
+			},
+			args: []any{"registry.example.com/manifests", kargoapi.FreightOrigin{
+				Kind: "Warehouse",
+				Name: "fake-warehouse",
+			}},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.NoError(t, err)
+				art, ok := result.(*kargoapi.OCIArtifact)
+				assert.True(t, ok)
+				assert.Equal(t, "registry.example.com/manifests", art.RepoURL)
+			},
+		},
+		{
+			name: "no arguments",
+			args: []any{},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "expected 1-2 arguments")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "too many arguments",
+			args: []any{"registry.example.com/manifests", kargoapi.FreightOrigin{}, "extra"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "expected 1-2 arguments")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "invalid first argument type",
+			args: []any{123},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "first argument must be string")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "invalid second argument type",
+			args: []any{"registry.example.com/manifests", "invalid"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "second argument must be FreightOrigin")
+				assert.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.objects...).
+				Build()
+
+			fn := getOCIArtifactFromFreight(
+				ctx,
+				c,
+				testProject,
+				tt.freightReqs,
+				tt.freightRefs,
+			)
+
+			result, err := fn(tt.args...)
+			tt.assertions(t, result, err)
+		})
+	}
+}
+
+func Test_getOCIArtifactFromDiscoveredArtifacts(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		artifacts  *kargoapi.DiscoveredArtifacts
+		args       []any
+		assertions func(t *testing.T, result any, err error)
+	}{
+		{
+			name: "wrong number of args",
+			args: []any{"one", "two"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.ErrorContains(t, err, "expected 1 argument, got 2")
+			},
+		},
+		{
+			name: "invalid arg type",
+			args: []any{1},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.ErrorContains(t, err, "first argument must be string, got int")
+			},
+		},
+		{
+			name: "success",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+					{
+						RepoURL: "ghcr.io/example/manifests",
+						References: []kargoapi.DiscoveredOCIArtifact{
+							{Tag: "v1.0.0"},
+							{Tag: "v1.1.0"},
+						},
+					},
+				},
+			},
+			args: []any{"ghcr.io/example/manifests"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				art, ok := result.(kargoapi.DiscoveredOCIArtifact)
+				require.True(t, ok)
+				require.Equal(t, "v1.0.0", art.Tag)
+			},
+		},
+		{
+			name: "nil artifacts",
+			args: []any{"ghcr.io/example/manifests"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "no artifacts found",
+			artifacts: &kargoapi.DiscoveredArtifacts{},
+			args:      []any{"ghcr.io/example/manifests"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fn := getOCIArtifactFromDiscoveredArtifacts(tc.artifacts)
+			result, err := fn(tc.args...)
+			tc.assertions(t, result, err)
+		})
+	}
+}
+
+func Test_getReleaseFromFreight(t *testing.T) {
+	const testProject = "fake-project"
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, kargoapi.AddToScheme(scheme))
+
+	tests := []struct {
+		name        string
+		objects     []client.Object
+		freightReqs []kargoapi.FreightRequest
+		freightRefs []kargoapi.FreightReference
+		args        []any
+		assertions  func(t *testing.T, result any, err error)
+	}{
+		{
+			name: "repo URL only",
+			objects: []client.Object{
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-warehouse",
+						Namespace: testProject,
+					},
+					Spec: kargoapi.WarehouseSpec{
+						Subscriptions: []kargoapi.RepoSubscription{
+							{
+								Release: &kargoapi.ReleaseSubscription{
+									RepoURL: "https://github.com/example/repo",
+								},
+							},
+						},
+					},
+				},
+			},
+			freightReqs: []kargoapi.FreightRequest{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+				},
+			},
+			freightRefs: []kargoapi.FreightReference{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+					Releases: []kargoapi.Release{
+						{
+							RepoURL: "https://github.com/example/repo",
+						},
+					},
+				},
+			},
+			args: []any{"https://github.com/example/repo"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.NoError(t, err)
+				release, ok := result.(*kargoapi.Release)
+				assert.True(t, ok)
+				assert.Equal(t, "https://github.com/example/repo", release.RepoURL)
+			},
+		},
+		{
+			name: "repo URL and origin",
+			freightReqs: []kargoapi.FreightRequest{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+				},
+			},
+			freightRefs: []kargoapi.FreightReference{
+				{
+					Origin: kargoapi.FreightOrigin{
+						Name: "fake-warehouse",
+						Kind: "Warehouse",
+					},
+					Releases: []kargoapi.Release{
+						{
+							RepoURL: "https://github.com/example/repo",
+						},
+					},
+				},
+			},
+			args: []any{"https://github.com/example/repo", kargoapi.FreightOrigin{
+				Kind: "Warehouse",
+				Name: "fake-warehouse",
+			}},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.NoError(t, err)
+				release, ok := result.(*kargoapi.Release)
+				assert.True(t, ok)
+				assert.Equal(t, "https://github.com/example/repo", release.RepoURL)
+			},
+		},
+		{
+			name: "no arguments",
+			args: []any{},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "expected 1-2 arguments")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "too many arguments",
+			args: []any{"https://github.com/example/repo", kargoapi.FreightOrigin{}, "extra"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "expected 1-2 arguments")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "invalid first argument type",
+			args: []any{123},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "first argument must be string")
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name: "invalid second argument type",
+			args: []any{"https://github.com/example/repo", "invalid"},
+			assertions: func(t *testing.T, result any, err error) {
+				assert.ErrorContains(t, err, "second argument must be FreightOrigin")
+				assert.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.objects...).
+				Build()
+
+			fn := getReleaseFromFreight(
+				ctx,
+				c,
+				testProject,
+				tt.freightReqs,
+				tt.freightRefs,
+			)
+
+			result, err := fn(tt.args...)
+			tt.assertions(t, result, err)
+		})
+	}
+}
+
+func Test_getReleaseFromDiscoveredArtifacts(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		artifacts  *kargoapi.DiscoveredArtifacts
+		args       []any
+		assertions func(t *testing.T, result any, err error)
+	}{
+		{
+			name: "wrong number of args",
+			args: []any{"one", "two"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.ErrorContains(t, err, "expected 1 argument, got 2")
+			},
+		},
+		{
+			name: "invalid arg type",
+			args: []any{1},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.ErrorContains(t, err, "first argument must be string, got int")
+			},
+		},
+		{
+			name: "success",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Releases: []kargoapi.ReleaseDiscoveryResult{
+					{
+						RepoURL: "https://github.com/example/repo",
+						Releases: []kargoapi.DiscoveredRelease{
+							{Tag: "v1.0.0"},
+							{Tag: "v1.1.0"},
+						},
+					},
+				},
+			},
+			args: []any{"https://github.com/example/repo"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, result)
+				release, ok := result.(kargoapi.DiscoveredRelease)
+				require.True(t, ok)
+				require.Equal(t, "v1.0.0", release.Tag)
+			},
+		},
+		{
+			name: "nil artifacts",
+			args: []any{"https://github.com/example/repo"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:      "no releases found",
+			artifacts: &kargoapi.DiscoveredArtifacts{},
+			args:      []any{"https://github.com/example/repo"},
+			assertions: func(t *testing.T, result any, err error) {
+				require.Nil(t, result)
+				require.NoError(t, err)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fn := getReleaseFromDiscoveredArtifacts(tc.artifacts)
+			result, err := fn(tc.args...)
+			tc.assertions(t, result, err)
+		})
+	}
+}
+
 func Test_getChartFromFreight(t *testing.T) {
 	const testProject = "fake-project"
 
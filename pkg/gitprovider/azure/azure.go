@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -259,6 +260,25 @@ func (p *provider) MergePullRequest(
 	return pr, true, nil
 }
 
+// ListReleases implements gitprovider.Interface. Azure DevOps has no concept
+// of releases that are attached to a Git repository, so this always returns an
+// error.
+func (p *provider) ListReleases(context.Context) ([]gitprovider.Release, error) {
+	return nil, fmt.Errorf("listing releases is not supported for Azure DevOps: %w", errors.ErrUnsupported)
+}
+
+// ListPullRequestsForCommit implements gitprovider.Interface. This is not
+// currently supported for Azure DevOps, so this always returns an error.
+func (p *provider) ListPullRequestsForCommit(
+	context.Context,
+	string,
+) ([]gitprovider.PullRequest, error) {
+	return nil, fmt.Errorf(
+		"listing pull requests for a commit is not supported for Azure DevOps: %w",
+		errors.ErrUnsupported,
+	)
+}
+
 // GetCommitURL implements gitprovider.Interface.
 func (p *provider) GetCommitURL(repoURL string, sha string) (string, error) {
 	normalizedURL := urls.NormalizeGit(repoURL)
@@ -294,6 +314,7 @@ func convertADOPullRequest(pr *adogit.GitPullRequest) (*gitprovider.PullRequest,
 	mergeCommit := ptr.Deref(pr.LastMergeCommit, adogit.GitCommitRef{})
 	return &gitprovider.PullRequest{
 		Number:         int64(ptr.Deref(pr.PullRequestId, 0)),
+		Title:          ptr.Deref(pr.Title, ""),
 		URL:            ptr.Deref(pr.Url, ""),
 		Open:           ptr.Deref(pr.Status, "notSet") == "active",
 		Merged:         ptr.Deref(pr.Status, "notSet") == "completed",
@@ -3,6 +3,7 @@ package bitbucket
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -377,6 +378,24 @@ func (p *provider) MergePullRequest(
 	return toProviderPR(mergedBBPR, mergeResp), true, nil
 }
 
+// ListReleases implements gitprovider.Interface. Bitbucket has no concept of
+// releases, so this always returns an error.
+func (p *provider) ListReleases(context.Context) ([]gitprovider.Release, error) {
+	return nil, fmt.Errorf("listing releases is not supported for Bitbucket: %w", errors.ErrUnsupported)
+}
+
+// ListPullRequestsForCommit implements gitprovider.Interface. This is not
+// currently supported for Bitbucket, so this always returns an error.
+func (p *provider) ListPullRequestsForCommit(
+	context.Context,
+	string,
+) ([]gitprovider.PullRequest, error) {
+	return nil, fmt.Errorf(
+		"listing pull requests for a commit is not supported for Bitbucket: %w",
+		errors.ErrUnsupported,
+	)
+}
+
 // GetCommitURL implements gitprovider.Interface.
 func (p *provider) GetCommitURL(repoURL string, sha string) (string, error) {
 	normalizedURL := urls.NormalizeGit(repoURL)
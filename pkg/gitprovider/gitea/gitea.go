@@ -3,12 +3,14 @@ package gitea
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/hashicorp/go-cleanhttp"
+	"k8s.io/utils/ptr"
 
 	"github.com/akuity/kargo/pkg/gitprovider"
 	"github.com/akuity/kargo/pkg/urls"
@@ -75,6 +77,13 @@ type giteaClient interface {
 		number int,
 		labels []string,
 	) ([]*gitea.Label, *gitea.Response, error)
+
+	ListReleases(
+		ctx context.Context,
+		owner string,
+		repo string,
+		opts *gitea.ListReleasesOptions,
+	) ([]*gitea.Release, *gitea.Response, error)
 }
 
 // provider is a Gitea implementation of gitprovider.Interface.
@@ -178,6 +187,15 @@ func (g giteaClientWrapper) AddLabelsToIssue(
 	return g.client.AddIssueLabels(owner, repo, int64(number), gitea.IssueLabelsOption{})
 }
 
+func (g giteaClientWrapper) ListReleases(
+	_ context.Context,
+	owner string,
+	repo string,
+	opts *gitea.ListReleasesOptions,
+) ([]*gitea.Release, *gitea.Response, error) {
+	return g.client.ListReleases(owner, repo, *opts)
+}
+
 // CreatePullRequest implements gitprovider.Interface.
 func (p *provider) CreatePullRequest(
 	ctx context.Context,
@@ -334,15 +352,79 @@ func (p *provider) GetCommitURL(repoURL string, sha string) (string, error) {
 	return commitURL, nil
 }
 
+// ListReleases implements gitprovider.Interface.
+func (p *provider) ListReleases(
+	ctx context.Context,
+) ([]gitprovider.Release, error) {
+	listOpts := gitea.ListReleasesOptions{
+		IsDraft: ptr.To(false),
+	}
+	var releases []gitprovider.Release
+	for {
+		giteaReleases, res, err := p.client.ListReleases(ctx, p.owner, p.repo, &listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, giteaRelease := range giteaReleases {
+			if !giteaRelease.IsDraft {
+				releases = append(releases, convertGiteaRelease(*giteaRelease))
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		listOpts.Page = res.NextPage
+	}
+	return releases, nil
+}
+
+// ListPullRequestsForCommit implements gitprovider.Interface. The Gitea API
+// offers no means of looking up the pull requests containing a commit, so this
+// always returns an error.
+func (p *provider) ListPullRequestsForCommit(
+	context.Context,
+	string,
+) ([]gitprovider.PullRequest, error) {
+	return nil, fmt.Errorf(
+		"listing pull requests for a commit is not supported for Gitea: %w",
+		errors.ErrUnsupported,
+	)
+}
+
+func convertGiteaRelease(giteaRelease gitea.Release) gitprovider.Release {
+	release := gitprovider.Release{
+		Tag:        giteaRelease.TagName,
+		Name:       giteaRelease.Title,
+		URL:        giteaRelease.HTMLURL,
+		Prerelease: giteaRelease.IsPrerelease,
+	}
+	if !giteaRelease.PublishedAt.IsZero() {
+		release.PublishedAt = &giteaRelease.PublishedAt
+	}
+	for _, attachment := range giteaRelease.Attachments {
+		release.Assets = append(release.Assets, gitprovider.ReleaseAsset{
+			Name: attachment.Name,
+			URL:  attachment.DownloadURL,
+		})
+	}
+	return release
+}
+
 func convertGiteaPR(giteaPR gitea.PullRequest) gitprovider.PullRequest {
 	pr := gitprovider.PullRequest{
 		Number:  giteaPR.Index,
+		Title:   giteaPR.Title,
 		URL:     giteaPR.URL,
 		Open:    giteaPR.State == gitea.StateOpen,
 		Merged:  giteaPR.HasMerged,
 		Object:  giteaPR,
 		HeadSHA: giteaPR.Head.Sha,
 	}
+	for _, label := range giteaPR.Labels {
+		if label != nil {
+			pr.Labels = append(pr.Labels, label.Name)
+		}
+	}
 	if giteaPR.MergedCommitID != nil {
 		pr.MergeCommitSHA = *giteaPR.MergedCommitID
 	}
@@ -187,6 +187,24 @@ func (m *mockGiteaClient) CreatePullRequest(
 	return pr, resp, args.Error(2)
 }
 
+func (m *mockGiteaClient) ListReleases(
+	ctx context.Context,
+	owner string,
+	repo string,
+	opts *gitea.ListReleasesOptions,
+) ([]*gitea.Release, *gitea.Response, error) {
+	args := m.Called(ctx, owner, repo, opts)
+	releases, ok := args.Get(0).([]*gitea.Release)
+	if !ok {
+		return nil, nil, args.Error(2)
+	}
+	resp, ok := args.Get(1).(*gitea.Response)
+	if !ok {
+		return releases, nil, args.Error(2)
+	}
+	return releases, resp, args.Error(2)
+}
+
 func TestCreatePullRequestWithLabels(t *testing.T) {
 	opts := gitprovider.CreatePullRequestOpts{
 		Head:        "feature-branch",
@@ -634,3 +652,66 @@ func TestGetCommitURL(t *testing.T) {
 		})
 	}
 }
+
+func TestListReleases(t *testing.T) {
+	publishedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockClient := &mockGiteaClient{}
+	mockClient.
+		On("ListReleases", mock.Anything, testRepoOwner, testRepoName, mock.Anything).
+		Return(
+			[]*gitea.Release{
+				{
+					TagName:     "v1.1.0",
+					Title:       "Release 1.1.0",
+					HTMLURL:     "https://gitea.com/akuity/kargo/releases/tag/v1.1.0",
+					PublishedAt: publishedAt,
+					Attachments: []*gitea.Attachment{{
+						Name:        "kargo-linux-amd64",
+						DownloadURL: "https://example.com/kargo-linux-amd64",
+					}},
+				},
+				{
+					TagName:      "v1.1.0-rc.1",
+					IsPrerelease: true,
+				},
+				{
+					TagName: "v1.2.0",
+					IsDraft: true,
+				},
+			},
+			&gitea.Response{},
+			nil,
+		)
+
+	g := provider{
+		owner:  testRepoOwner,
+		repo:   testRepoName,
+		client: mockClient,
+	}
+
+	releases, err := g.ListReleases(context.Background())
+	require.NoError(t, err)
+	mockClient.AssertExpectations(t)
+
+	require.Equal(
+		t,
+		[]gitprovider.Release{
+			{
+				Tag:         "v1.1.0",
+				Name:        "Release 1.1.0",
+				URL:         "https://gitea.com/akuity/kargo/releases/tag/v1.1.0",
+				PublishedAt: &publishedAt,
+				Assets: []gitprovider.ReleaseAsset{{
+					Name: "kargo-linux-amd64",
+					URL:  "https://example.com/kargo-linux-amd64",
+				}},
+			},
+			{
+				Tag:        "v1.1.0-rc.1",
+				Prerelease: true,
+			},
+		},
+		releases,
+	)
+}
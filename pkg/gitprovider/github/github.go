@@ -86,6 +86,21 @@ type githubClient interface {
 		number int,
 		labels []string,
 	) ([]*github.Label, *github.Response, error)
+
+	ListReleases(
+		ctx context.Context,
+		owner string,
+		repo string,
+		opts *github.ListOptions,
+	) ([]*github.RepositoryRelease, *github.Response, error)
+
+	ListPullRequestsWithCommit(
+		ctx context.Context,
+		owner string,
+		repo string,
+		sha string,
+		opts *github.ListOptions,
+	) ([]*github.PullRequest, *github.Response, error)
 }
 
 // provider is a GitHub implementation of gitprovider.Interface.
@@ -191,6 +206,25 @@ func (g githubClientWrapper) AddLabelsToIssue(
 	return g.client.Issues.AddLabelsToIssue(ctx, owner, repo, number, labels)
 }
 
+func (g githubClientWrapper) ListReleases(
+	ctx context.Context,
+	owner string,
+	repo string,
+	opts *github.ListOptions,
+) ([]*github.RepositoryRelease, *github.Response, error) {
+	return g.client.Repositories.ListReleases(ctx, owner, repo, opts)
+}
+
+func (g githubClientWrapper) ListPullRequestsWithCommit(
+	ctx context.Context,
+	owner string,
+	repo string,
+	sha string,
+	opts *github.ListOptions,
+) ([]*github.PullRequest, *github.Response, error) {
+	return g.client.PullRequests.ListPullRequestsWithCommit(ctx, owner, repo, sha, opts)
+}
+
 // CreatePullRequest implements gitprovider.Interface.
 func (p *provider) CreatePullRequest(
 	ctx context.Context,
@@ -366,9 +400,86 @@ func (p *provider) GetCommitURL(
 	return commitURL, nil
 }
 
+// ListReleases implements gitprovider.Interface.
+func (p *provider) ListReleases(
+	ctx context.Context,
+) ([]gitprovider.Release, error) {
+	listOpts := github.ListOptions{
+		PerPage: 100, // Max
+	}
+	var releases []gitprovider.Release
+	for {
+		ghReleases, res, err := p.client.ListReleases(ctx, p.owner, p.repo, &listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, ghRelease := range ghReleases {
+			// Draft releases are only visible to users with push access and are not
+			// considered published.
+			if !ptr.Deref(ghRelease.Draft, false) {
+				releases = append(releases, convertGithubRelease(*ghRelease))
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		listOpts.Page = res.NextPage
+	}
+	return releases, nil
+}
+
+// ListPullRequestsForCommit implements gitprovider.Interface.
+func (p *provider) ListPullRequestsForCommit(
+	ctx context.Context,
+	commitID string,
+) ([]gitprovider.PullRequest, error) {
+	listOpts := github.ListOptions{
+		PerPage: 100, // Max
+	}
+	var prs []gitprovider.PullRequest
+	for {
+		ghPRs, res, err := p.client.ListPullRequestsWithCommit(
+			ctx, p.owner, p.repo, commitID, &listOpts,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, ghPR := range ghPRs {
+			if ghPR != nil {
+				prs = append(prs, convertGithubPR(*ghPR))
+			}
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		listOpts.Page = res.NextPage
+	}
+	return prs, nil
+}
+
+func convertGithubRelease(ghRelease github.RepositoryRelease) gitprovider.Release {
+	release := gitprovider.Release{
+		Tag:        ptr.Deref(ghRelease.TagName, ""),
+		Name:       ptr.Deref(ghRelease.Name, ""),
+		URL:        ptr.Deref(ghRelease.HTMLURL, ""),
+		Prerelease: ptr.Deref(ghRelease.Prerelease, false),
+	}
+	if ghRelease.PublishedAt != nil {
+		release.PublishedAt = &ghRelease.PublishedAt.Time
+	}
+	for _, asset := range ghRelease.Assets {
+		release.Assets = append(release.Assets, gitprovider.ReleaseAsset{
+			Name: ptr.Deref(asset.Name, ""),
+			URL:  ptr.Deref(asset.BrowserDownloadURL, ""),
+		})
+	}
+	return release
+}
+
 func convertGithubPR(ghPR github.PullRequest) gitprovider.PullRequest {
 	pr := gitprovider.PullRequest{
 		Number:         int64(ptr.Deref(ghPR.Number, 0)),
+		Title:          ptr.Deref(ghPR.Title, ""),
 		URL:            ptr.Deref(ghPR.HTMLURL, ""),
 		Open:           ptr.Deref(ghPR.State, prStateClosed) == prStateOpen,
 		Merged:         ghPR.MergedAt != nil,
@@ -379,6 +490,11 @@ func convertGithubPR(ghPR github.PullRequest) gitprovider.PullRequest {
 	if ghPR.CreatedAt != nil {
 		pr.CreatedAt = &ghPR.CreatedAt.Time
 	}
+	for _, label := range ghPR.Labels {
+		if label != nil && label.Name != nil {
+			pr.Labels = append(pr.Labels, *label.Name)
+		}
+	}
 	return pr
 }
 
@@ -201,6 +201,43 @@ func (m *mockGithubClient) CreatePullRequest(
 	return pr, resp, args.Error(2)
 }
 
+func (m *mockGithubClient) ListReleases(
+	ctx context.Context,
+	owner string,
+	repo string,
+	opts *github.ListOptions,
+) ([]*github.RepositoryRelease, *github.Response, error) {
+	args := m.Called(ctx, owner, repo, opts)
+	releases, ok := args.Get(0).([]*github.RepositoryRelease)
+	if !ok {
+		return nil, nil, args.Error(2)
+	}
+	resp, ok := args.Get(1).(*github.Response)
+	if !ok {
+		return releases, nil, args.Error(2)
+	}
+	return releases, resp, args.Error(2)
+}
+
+func (m *mockGithubClient) ListPullRequestsWithCommit(
+	ctx context.Context,
+	owner string,
+	repo string,
+	sha string,
+	opts *github.ListOptions,
+) ([]*github.PullRequest, *github.Response, error) {
+	args := m.Called(ctx, owner, repo, sha, opts)
+	prs, ok := args.Get(0).([]*github.PullRequest)
+	if !ok {
+		return nil, nil, args.Error(2)
+	}
+	resp, ok := args.Get(1).(*github.Response)
+	if !ok {
+		return prs, nil, args.Error(2)
+	}
+	return prs, resp, args.Error(2)
+}
+
 func TestCreatePullRequestWithLabels(t *testing.T) {
 	opts := gitprovider.CreatePullRequestOpts{
 		Head:        "feature-branch",
@@ -701,3 +738,151 @@ func TestGetCommitURL(t *testing.T) {
 		})
 	}
 }
+
+func TestListReleases(t *testing.T) {
+	publishedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockClient := &mockGithubClient{}
+	mockClient.
+		On("ListReleases", mock.Anything, testRepoOwner, testRepoName, mock.MatchedBy(
+			func(opts *github.ListOptions) bool { return opts.Page == 0 },
+		)).
+		Return(
+			[]*github.RepositoryRelease{
+				{
+					TagName:     github.Ptr("v1.1.0"),
+					Name:        github.Ptr("Release 1.1.0"),
+					HTMLURL:     github.Ptr("https://github.com/akuity/kargo/releases/tag/v1.1.0"),
+					PublishedAt: &github.Timestamp{Time: publishedAt},
+					Assets: []*github.ReleaseAsset{{
+						Name:               github.Ptr("kargo-linux-amd64"),
+						BrowserDownloadURL: github.Ptr("https://example.com/kargo-linux-amd64"),
+					}},
+				},
+				{
+					TagName: github.Ptr("v1.2.0"),
+					Draft:   github.Ptr(true),
+				},
+			},
+			&github.Response{NextPage: 2},
+			nil,
+		).
+		Once()
+	mockClient.
+		On("ListReleases", mock.Anything, testRepoOwner, testRepoName, mock.MatchedBy(
+			func(opts *github.ListOptions) bool { return opts.Page == 2 },
+		)).
+		Return(
+			[]*github.RepositoryRelease{{
+				TagName:    github.Ptr("v1.1.0-rc.1"),
+				Prerelease: github.Ptr(true),
+			}},
+			&github.Response{},
+			nil,
+		).
+		Once()
+
+	g := provider{
+		owner:  testRepoOwner,
+		repo:   testRepoName,
+		client: mockClient,
+	}
+
+	releases, err := g.ListReleases(context.Background())
+	require.NoError(t, err)
+	mockClient.AssertExpectations(t)
+
+	require.Equal(
+		t,
+		[]gitprovider.Release{
+			{
+				Tag:         "v1.1.0",
+				Name:        "Release 1.1.0",
+				URL:         "https://github.com/akuity/kargo/releases/tag/v1.1.0",
+				PublishedAt: &publishedAt,
+				Assets: []gitprovider.ReleaseAsset{{
+					Name: "kargo-linux-amd64",
+					URL:  "https://example.com/kargo-linux-amd64",
+				}},
+			},
+			{
+				Tag:        "v1.1.0-rc.1",
+				Prerelease: true,
+			},
+		},
+		releases,
+	)
+}
+
+func TestListPullRequestsForCommit(t *testing.T) {
+	const testCommitID = "abc123"
+
+	mockClient := &mockGithubClient{}
+	mockClient.
+		On(
+			"ListPullRequestsWithCommit",
+			mock.Anything,
+			testRepoOwner,
+			testRepoName,
+			testCommitID,
+			mock.MatchedBy(func(opts *github.ListOptions) bool { return opts.Page == 0 }),
+		).
+		Return(
+			[]*github.PullRequest{{
+				Number:   github.Ptr(42),
+				Title:    github.Ptr("Add a feature"),
+				HTMLURL:  github.Ptr("https://github.com/akuity/kargo/pull/42"),
+				State:    github.Ptr(prStateClosed),
+				MergedAt: &github.Timestamp{},
+				Head:     &github.PullRequestBranch{SHA: github.Ptr("def456")},
+				Labels: []*github.Label{
+					{Name: github.Ptr("kind/enhancement")},
+					{Name: github.Ptr("area/controller")},
+				},
+			}},
+			&github.Response{NextPage: 2},
+			nil,
+		).
+		Once()
+	mockClient.
+		On(
+			"ListPullRequestsWithCommit",
+			mock.Anything,
+			testRepoOwner,
+			testRepoName,
+			testCommitID,
+			mock.MatchedBy(func(opts *github.ListOptions) bool { return opts.Page == 2 }),
+		).
+		Return(
+			[]*github.PullRequest{{
+				Number:  github.Ptr(43),
+				Title:   github.Ptr("Backport a feature"),
+				HTMLURL: github.Ptr("https://github.com/akuity/kargo/pull/43"),
+				State:   github.Ptr(prStateOpen),
+				Head:    &github.PullRequestBranch{SHA: github.Ptr("ghi789")},
+			}},
+			&github.Response{},
+			nil,
+		).
+		Once()
+
+	g := provider{
+		owner:  testRepoOwner,
+		repo:   testRepoName,
+		client: mockClient,
+	}
+
+	prs, err := g.ListPullRequestsForCommit(context.Background(), testCommitID)
+	require.NoError(t, err)
+	mockClient.AssertExpectations(t)
+
+	require.Len(t, prs, 2)
+	require.Equal(t, int64(42), prs[0].Number)
+	require.Equal(t, "Add a feature", prs[0].Title)
+	require.Equal(t, "https://github.com/akuity/kargo/pull/42", prs[0].URL)
+	require.True(t, prs[0].Merged)
+	require.Equal(t, []string{"kind/enhancement", "area/controller"}, prs[0].Labels)
+	require.Equal(t, int64(43), prs[1].Number)
+	require.True(t, prs[1].Open)
+	require.Empty(t, prs[1].Labels)
+}
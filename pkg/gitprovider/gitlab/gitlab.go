@@ -68,10 +68,28 @@ type mergeRequestClient interface {
 	) (*gitlab.MergeRequest, *gitlab.Response, error)
 }
 
+type releaseClient interface {
+	ListReleases(
+		pid any,
+		opt *gitlab.ListReleasesOptions,
+		options ...gitlab.RequestOptionFunc,
+	) ([]*gitlab.Release, *gitlab.Response, error)
+}
+
+type commitClient interface {
+	ListMergeRequestsByCommit(
+		pid any,
+		sha string,
+		options ...gitlab.RequestOptionFunc,
+	) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error)
+}
+
 // provider is a GitLab-based implementation of gitprovider.Interface.
 type provider struct { // nolint: revive
-	projectName string
-	client      mergeRequestClient
+	projectName   string
+	client        mergeRequestClient
+	releaseClient releaseClient
+	commitClient  commitClient
 }
 
 // NewProvider returns a GitLab-based implementation of gitprovider.Interface.
@@ -113,8 +131,10 @@ func NewProvider(
 	}
 
 	return &provider{
-		projectName: projectName,
-		client:      client.MergeRequests,
+		projectName:   projectName,
+		client:        client.MergeRequests,
+		releaseClient: client.Releases,
+		commitClient:  client.Commits,
 	}, nil
 }
 
@@ -259,9 +279,77 @@ func (p *provider) GetCommitURL(repoURL string, sha string) (string, error) {
 	return commitURL, nil
 }
 
+// ListReleases implements gitprovider.Interface.
+func (p *provider) ListReleases(
+	_ context.Context,
+) ([]gitprovider.Release, error) {
+	listOpts := &gitlab.ListReleasesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
+	}
+	var releases []gitprovider.Release
+	for {
+		glReleases, res, err := p.releaseClient.ListReleases(p.projectName, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, glRelease := range glReleases {
+			releases = append(releases, convertGitlabRelease(*glRelease))
+		}
+		if res == nil || res.NextPage == 0 {
+			break
+		}
+		listOpts.Page = res.NextPage
+	}
+	return releases, nil
+}
+
+// ListPullRequestsForCommit implements gitprovider.Interface.
+func (p *provider) ListPullRequestsForCommit(
+	_ context.Context,
+	commitID string,
+) ([]gitprovider.PullRequest, error) {
+	glMRs, _, err := p.commitClient.ListMergeRequestsByCommit(p.projectName, commitID)
+	if err != nil {
+		return nil, err
+	}
+	prs := make([]gitprovider.PullRequest, 0, len(glMRs))
+	for _, glMR := range glMRs {
+		if glMR != nil {
+			prs = append(prs, convertGitlabMR(*glMR))
+		}
+	}
+	return prs, nil
+}
+
+// convertGitlabRelease converts a GitLab release to a gitprovider.Release.
+// GitLab has no concept of prereleases, so the returned release is never
+// marked as one.
+func convertGitlabRelease(glRelease gitlab.Release) gitprovider.Release {
+	release := gitprovider.Release{
+		Tag:         glRelease.TagName,
+		Name:        glRelease.Name,
+		URL:         glRelease.Links.Self,
+		PublishedAt: glRelease.ReleasedAt,
+	}
+	for _, link := range glRelease.Assets.Links {
+		assetURL := link.DirectAssetURL
+		if assetURL == "" {
+			assetURL = link.URL
+		}
+		release.Assets = append(release.Assets, gitprovider.ReleaseAsset{
+			Name: link.Name,
+			URL:  assetURL,
+		})
+	}
+	return release
+}
+
 func convertGitlabMR(glMR gitlab.BasicMergeRequest) gitprovider.PullRequest {
 	return gitprovider.PullRequest{
 		Number:         glMR.IID,
+		Title:          glMR.Title,
 		URL:            glMR.WebURL,
 		Open:           isMROpen(glMR),
 		Merged:         glMR.State == "merged",
@@ -269,6 +357,7 @@ func convertGitlabMR(glMR gitlab.BasicMergeRequest) gitprovider.PullRequest {
 		Object:         glMR,
 		HeadSHA:        glMR.SHA,
 		CreatedAt:      glMR.CreatedAt,
+		Labels:         glMR.Labels,
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
@@ -73,6 +74,38 @@ func (m *mockGitLabClient) AcceptMergeRequest(
 	return m.mr, nil, nil
 }
 
+type mockReleaseClient struct {
+	releases []*gitlab.Release
+	pid      any
+	listOpts *gitlab.ListReleasesOptions
+}
+
+func (m *mockReleaseClient) ListReleases(
+	pid any,
+	opt *gitlab.ListReleasesOptions,
+	_ ...gitlab.RequestOptionFunc,
+) ([]*gitlab.Release, *gitlab.Response, error) {
+	m.pid = pid
+	m.listOpts = opt
+	return m.releases, nil, nil
+}
+
+type mockCommitClient struct {
+	mergeRequests []*gitlab.BasicMergeRequest
+	pid           any
+	sha           string
+}
+
+func (m *mockCommitClient) ListMergeRequestsByCommit(
+	pid any,
+	sha string,
+	_ ...gitlab.RequestOptionFunc,
+) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+	m.pid = pid
+	m.sha = sha
+	return m.mergeRequests, nil, nil
+}
+
 func TestCreatePullRequest(t *testing.T) {
 	mockClient := &mockGitLabClient{
 		mr: &gitlab.MergeRequest{
@@ -463,3 +496,93 @@ func TestGetCommitURL(t *testing.T) {
 		})
 	}
 }
+
+func TestListReleases(t *testing.T) {
+	releasedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockClient := &mockReleaseClient{
+		releases: []*gitlab.Release{{
+			TagName:    "v1.1.0",
+			Name:       "Release 1.1.0",
+			ReleasedAt: &releasedAt,
+			Assets: gitlab.ReleaseAssets{
+				Links: []*gitlab.ReleaseLink{
+					{
+						Name:           "kargo-linux-amd64",
+						URL:            "https://example.com/kargo-linux-amd64",
+						DirectAssetURL: "https://gitlab.com/group/project/-/releases/v1.1.0/downloads/kargo-linux-amd64",
+					},
+					{
+						Name: "kargo-darwin-arm64",
+						URL:  "https://example.com/kargo-darwin-arm64",
+					},
+				},
+			},
+			Links: gitlab.ReleaseLinks{
+				Self: "https://gitlab.com/group/project/-/releases/v1.1.0",
+			},
+		}},
+	}
+
+	g := provider{
+		projectName:   testProjectName,
+		releaseClient: mockClient,
+	}
+
+	releases, err := g.ListReleases(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, testProjectName, mockClient.pid)
+	require.Equal(t, int64(100), mockClient.listOpts.PerPage)
+	require.Equal(
+		t,
+		[]gitprovider.Release{{
+			Tag:         "v1.1.0",
+			Name:        "Release 1.1.0",
+			URL:         "https://gitlab.com/group/project/-/releases/v1.1.0",
+			PublishedAt: &releasedAt,
+			Assets: []gitprovider.ReleaseAsset{
+				{
+					Name: "kargo-linux-amd64",
+					URL:  "https://gitlab.com/group/project/-/releases/v1.1.0/downloads/kargo-linux-amd64",
+				},
+				{
+					Name: "kargo-darwin-arm64",
+					URL:  "https://example.com/kargo-darwin-arm64",
+				},
+			},
+		}},
+		releases,
+	)
+}
+
+func TestListPullRequestsForCommit(t *testing.T) {
+	mockClient := &mockCommitClient{
+		mergeRequests: []*gitlab.BasicMergeRequest{{
+			IID:            42,
+			Title:          "Add a feature",
+			WebURL:         "https://gitlab.com/group/project/-/merge_requests/42",
+			State:          "merged",
+			MergeCommitSHA: "abc123",
+			SHA:            "def456",
+			Labels:         gitlab.Labels{"kind/enhancement"},
+		}},
+	}
+
+	g := provider{
+		projectName:  testProjectName,
+		commitClient: mockClient,
+	}
+
+	prs, err := g.ListPullRequestsForCommit(context.Background(), "abc123")
+	require.NoError(t, err)
+
+	require.Equal(t, testProjectName, mockClient.pid)
+	require.Equal(t, "abc123", mockClient.sha)
+	require.Len(t, prs, 1)
+	require.Equal(t, int64(42), prs[0].Number)
+	require.Equal(t, "Add a feature", prs[0].Title)
+	require.Equal(t, "https://gitlab.com/group/project/-/merge_requests/42", prs[0].URL)
+	require.True(t, prs[0].Merged)
+	require.Equal(t, []string{"kind/enhancement"}, prs[0].Labels)
+}
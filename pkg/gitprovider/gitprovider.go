@@ -62,6 +62,20 @@ type Interface interface {
 	// GetCommitURL returns a commit URL inferred from the provided repository URL
 	// and commit ID.
 	GetCommitURL(repoURL string, commitID string) (string, error)
+
+	// ListReleases lists the repository's published (i.e. non-draft) releases.
+	// Implementations have no obligation to sort the results in any particular
+	// order. It is the responsibility of the caller to sort the results as
+	// needed. Implementations for Git hosting providers that have no concept of
+	// releases return an error wrapping errors.ErrUnsupported.
+	ListReleases(context.Context) ([]Release, error)
+
+	// ListPullRequestsForCommit lists pull requests containing the commit with
+	// the provided ID. Implementations have no obligation to sort the results
+	// in any particular order. Implementations for Git hosting providers whose
+	// APIs offer no means of looking up pull requests by commit return an error
+	// wrapping errors.ErrUnsupported.
+	ListPullRequestsForCommit(ctx context.Context, commitID string) ([]PullRequest, error)
 }
 
 // CreatePullRequestOpts encapsulates the options used when creating a pull
@@ -102,6 +116,8 @@ type PullRequest struct {
 	// Number is the pull request number, which is unique only within a single
 	// repository.
 	Number int64 `json:"id"`
+	// Title is the title of the pull request.
+	Title string `json:"title"`
 	// URL is the URL to the pull request.
 	URL string `json:"url"`
 	// Open is true if the pull request is logically open. Depending on the
@@ -118,6 +134,35 @@ type PullRequest struct {
 	HeadSHA string `json:"headSHA"`
 	// CreatedAt is the time the pull request was created.
 	CreatedAt *time.Time `json:"createdAt"`
+	// Labels are the labels applied to the pull request.
+	Labels []string `json:"labels"`
+}
+
+// Release is an abstracted representation of a Git hosting provider's release
+// object.
+type Release struct {
+	// Tag is the name of the tag the release was created from.
+	Tag string `json:"tag"`
+	// Name is the name (title) of the release.
+	Name string `json:"name"`
+	// URL is the URL to the release's web page.
+	URL string `json:"url"`
+	// Prerelease is true if the release is marked as a prerelease. Not all Git
+	// hosting providers support this concept.
+	Prerelease bool `json:"prerelease"`
+	// Assets are the assets attached to the release.
+	Assets []ReleaseAsset `json:"assets"`
+	// PublishedAt is the time the release was published.
+	PublishedAt *time.Time `json:"publishedAt"`
+}
+
+// ReleaseAsset is an abstracted representation of an asset attached to a Git
+// hosting provider's release object.
+type ReleaseAsset struct {
+	// Name is the name of the asset.
+	Name string `json:"name"`
+	// URL is the URL from which the asset can be downloaded.
+	URL string `json:"url"`
 }
 
 // Fake is a fake implementation of the provider Interface used to facilitate
@@ -141,6 +186,11 @@ type Fake struct {
 	MergePullRequestFn func(context.Context, int64) (*PullRequest, bool, error)
 	// GetCommitURLFn defines the functionality of the GetCommitURL method.
 	GetCommitURLFn func(repoURL string, commitID string) (string, error)
+	// ListReleasesFn defines the functionality of the ListReleases method.
+	ListReleasesFn func(context.Context) ([]Release, error)
+	// ListPullRequestsForCommitFn defines the functionality of the
+	// ListPullRequestsForCommit method.
+	ListPullRequestsForCommitFn func(context.Context, string) ([]PullRequest, error)
 }
 
 // CreatePullRequest implements gitprovider.Interface.
@@ -179,3 +229,16 @@ func (f *Fake) MergePullRequest(
 func (f *Fake) GetCommitURL(repoURL string, sha string) (string, error) {
 	return f.GetCommitURLFn(repoURL, sha)
 }
+
+// ListReleases implements gitprovider.Interface.
+func (f *Fake) ListReleases(ctx context.Context) ([]Release, error) {
+	return f.ListReleasesFn(ctx)
+}
+
+// ListPullRequestsForCommit implements gitprovider.Interface.
+func (f *Fake) ListPullRequestsForCommit(
+	ctx context.Context,
+	commitID string,
+) ([]PullRequest, error) {
+	return f.ListPullRequestsForCommitFn(ctx, commitID)
+}
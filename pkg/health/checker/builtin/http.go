@@ -0,0 +1,364 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/hashicorp/go-cleanhttp"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	xhttp "github.com/akuity/kargo/pkg/http"
+)
+
+const (
+	// HTTPCheckerName is the name of the Checker that assesses health by
+	// issuing an HTTP request.
+	HTTPCheckerName = "http-health"
+
+	httpResponseKey = "httpResponse"
+
+	httpHealthRequestTimeoutDefault = 10 * time.Second
+)
+
+// HTTPHealthInput is the input for a health check that issues an HTTP request
+// and evaluates the response.
+type HTTPHealthInput struct {
+	// Method is the HTTP method of the request. Defaults to GET.
+	Method string `json:"method,omitempty"`
+	// URL is the URL to send the request to.
+	URL string `json:"url"`
+	// Headers are headers to include in the request.
+	Headers []HTTPHealthHeader `json:"headers,omitempty"`
+	// QueryParams are query parameters to include in the request.
+	QueryParams []HTTPHealthQueryParam `json:"queryParams,omitempty"`
+	// Body is the body of the request.
+	Body string `json:"body,omitempty"`
+	// InsecureSkipTLSVerify indicates whether to skip TLS verification.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// CACertFrom references a PEM-encoded CA certificate bundle used to verify
+	// the server's certificate in addition to the system's trusted CAs.
+	CACertFrom *HTTPHealthValueSource `json:"caCertFrom,omitempty"`
+	// Timeout is the maximum duration of the request. Defaults to 10s.
+	Timeout string `json:"timeout,omitempty"`
+	// ResponseContentType optionally overrides the Content-Type header of the
+	// response for the purpose of parsing its body.
+	ResponseContentType string `json:"responseContentType,omitempty"`
+	// SuccessExpression is an expression that, when it evaluates to true,
+	// indicates the checked endpoint is healthy.
+	SuccessExpression string `json:"successExpression,omitempty"`
+	// FailureExpression is an expression that, when it evaluates to true,
+	// indicates the checked endpoint is unhealthy.
+	FailureExpression string `json:"failureExpression,omitempty"`
+}
+
+// HTTPHealthHeader is a header to include in the request issued by an HTTP
+// health check.
+type HTTPHealthHeader struct {
+	// Name is the name of the header.
+	Name string `json:"name"`
+	// Value is the value of the header. Mutually exclusive with ValueFrom.
+	Value string `json:"value,omitempty"`
+	// ValueFrom references the value of the header. Mutually exclusive with
+	// Value.
+	ValueFrom *HTTPHealthValueSource `json:"valueFrom,omitempty"`
+}
+
+// HTTPHealthQueryParam is a query parameter to include in the request issued
+// by an HTTP health check.
+type HTTPHealthQueryParam struct {
+	// Name is the name of the query parameter.
+	Name string `json:"name"`
+	// Value is the value of the query parameter.
+	Value string `json:"value"`
+}
+
+// HTTPHealthValueSource is a source for a value that should not be stored in
+// the health check input itself.
+type HTTPHealthValueSource struct {
+	// SecretKeyRef references a key of a Secret in the Project namespace.
+	SecretKeyRef *HTTPHealthSecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// HTTPHealthSecretKeySelector selects a key of a Secret in the Project
+// namespace.
+type HTTPHealthSecretKeySelector struct {
+	// Name is the name of the Secret.
+	Name string `json:"name"`
+	// Key is the key of the Secret's data to select.
+	Key string `json:"key"`
+}
+
+type httpChecker struct {
+	kargoClient client.Client
+}
+
+// newHTTPChecker returns an implementation of the Checker interface that
+// assesses health by issuing an HTTP request and evaluating the response using
+// the same criteria as the http promotion step. Values referenced from Secrets
+// are retrieved from the Project namespace using the provided client.
+func newHTTPChecker(kargoClient client.Client) *httpChecker {
+	return &httpChecker{kargoClient: kargoClient}
+}
+
+// Name implements the Checker interface.
+func (h *httpChecker) Name() string {
+	return HTTPCheckerName
+}
+
+// Check implements the Checker interface.
+func (h *httpChecker) Check(
+	ctx context.Context,
+	project string,
+	_ string,
+	criteria health.Criteria,
+) health.Result {
+	input, err := health.InputToStruct[HTTPHealthInput](criteria.Input)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				fmt.Sprintf(
+					"could not convert opaque input into %s health check input: %s",
+					h.Name(), err.Error(),
+				),
+			},
+		}
+	}
+	return h.check(ctx, project, input)
+}
+
+func (h *httpChecker) check(
+	ctx context.Context,
+	project string,
+	input HTTPHealthInput,
+) health.Result {
+	req, err := h.buildRequest(ctx, project, input)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{fmt.Sprintf("error building HTTP request: %s", err)},
+		}
+	}
+	httpClient, err := h.getClient(ctx, project, input)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{fmt.Sprintf("error creating HTTP client: %s", err)},
+		}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{fmt.Sprintf("error sending HTTP request to %s: %s", input.URL, err)},
+		}
+	}
+	defer resp.Body.Close()
+
+	res := health.Result{
+		Issues: make([]string, 0),
+		Output: map[string]any{
+			httpResponseKey: map[string]any{
+				"url":    input.URL,
+				"status": int64(resp.StatusCode),
+			},
+		},
+	}
+
+	env, err := xhttp.BuildResponseExprEnv(ctx, resp, input.ResponseContentType)
+	if err != nil {
+		res.Status = kargoapi.HealthStateUnknown
+		res.Issues = append(
+			res.Issues,
+			fmt.Sprintf("error building expression context from HTTP response: %s", err),
+		)
+		return res
+	}
+	success, err := evaluateHTTPHealthExpression("success", input.SuccessExpression, env)
+	if err != nil {
+		res.Status = kargoapi.HealthStateUnknown
+		res.Issues = append(res.Issues, err.Error())
+		return res
+	}
+	failure, err := evaluateHTTPHealthExpression("failure", input.FailureExpression, env)
+	if err != nil {
+		res.Status = kargoapi.HealthStateUnknown
+		res.Issues = append(res.Issues, err.Error())
+		return res
+	}
+
+	switch {
+	case failure != nil && *failure:
+		res.Status = kargoapi.HealthStateUnhealthy
+		res.Issues = append(res.Issues, fmt.Sprintf(
+			"HTTP (%d) response from %s met failure criteria",
+			resp.StatusCode, input.URL,
+		))
+	case success != nil && *success:
+		res.Status = kargoapi.HealthStateHealthy
+	case success == nil && failure == nil:
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			res.Status = kargoapi.HealthStateHealthy
+			break
+		}
+		res.Status = kargoapi.HealthStateUnhealthy
+		res.Issues = append(res.Issues, fmt.Sprintf(
+			"HTTP request to %s returned non-2xx status %d",
+			input.URL, resp.StatusCode,
+		))
+	default:
+		// Criteria are defined, but none of them are met (yet).
+		res.Status = kargoapi.HealthStateProgressing
+		res.Issues = append(res.Issues, fmt.Sprintf(
+			"HTTP (%d) response from %s has not met success criteria",
+			resp.StatusCode, input.URL,
+		))
+	}
+	return res
+}
+
+// evaluateHTTPHealthExpression evaluates the provided expression over the
+// provided environment. If the expression is empty, it returns nil.
+func evaluateHTTPHealthExpression(
+	name string,
+	expression string,
+	env map[string]any,
+) (*bool, error) {
+	if expression == "" {
+		return nil, nil
+	}
+	program, err := expr.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling %s expression %q: %w", name, expression, err)
+	}
+	resAny, err := expr.Run(program, env)
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating %s expression %q: %w", name, expression, err)
+	}
+	res, ok := resAny.(bool)
+	if !ok {
+		return nil, fmt.Errorf(
+			"%s expression %q did not evaluate to a boolean (got %T)",
+			name, expression, resAny,
+		)
+	}
+	return &res, nil
+}
+
+func (h *httpChecker) buildRequest(
+	ctx context.Context,
+	project string,
+	input HTTPHealthInput,
+) (*http.Request, error) {
+	method := input.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	req, err := http.NewRequestWithContext(
+		ctx,
+		method,
+		input.URL,
+		bytes.NewBufferString(input.Body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, header := range input.Headers {
+		value := header.Value
+		if header.ValueFrom != nil {
+			if value, err = h.resolveValue(ctx, project, *header.ValueFrom); err != nil {
+				return nil, fmt.Errorf("error resolving value of header %q: %w", header.Name, err)
+			}
+		}
+		req.Header.Add(header.Name, value)
+	}
+	if len(input.QueryParams) > 0 {
+		q := req.URL.Query()
+		for _, queryParam := range input.QueryParams {
+			q.Add(queryParam.Name, queryParam.Value)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+	return req, nil
+}
+
+func (h *httpChecker) getClient(
+	ctx context.Context,
+	project string,
+	input HTTPHealthInput,
+) (*http.Client, error) {
+	httpTransport := cleanhttp.DefaultTransport()
+	if input.InsecureSkipTLSVerify || input.CACertFrom != nil {
+		tlsConfig := &tls.Config{
+			InsecureSkipVerify: input.InsecureSkipTLSVerify, // nolint: gosec
+		}
+		if input.CACertFrom != nil {
+			caCert, err := h.resolveValue(ctx, project, *input.CACertFrom)
+			if err != nil {
+				return nil, fmt.Errorf("error resolving CA certificate: %w", err)
+			}
+			if tlsConfig.RootCAs, err = x509.SystemCertPool(); err != nil {
+				tlsConfig.RootCAs = x509.NewCertPool()
+			}
+			if !tlsConfig.RootCAs.AppendCertsFromPEM([]byte(caCert)) {
+				return nil, fmt.Errorf("no valid PEM-encoded certificates found in CA certificate")
+			}
+		}
+		httpTransport.TLSClientConfig = tlsConfig
+	}
+	timeout := httpHealthRequestTimeoutDefault
+	if input.Timeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(input.Timeout); err != nil {
+			return nil, fmt.Errorf("error parsing timeout: %w", err)
+		}
+	}
+	return &http.Client{
+		Transport: httpTransport,
+		Timeout:   timeout,
+	}, nil
+}
+
+// resolveValue resolves the value referenced by the provided
+// HTTPHealthValueSource.
+func (h *httpChecker) resolveValue(
+	ctx context.Context,
+	project string,
+	source HTTPHealthValueSource,
+) (string, error) {
+	ref := source.SecretKeyRef
+	if ref == nil {
+		return "", fmt.Errorf("no value source specified")
+	}
+	if h.kargoClient == nil {
+		return "", fmt.Errorf("no client is available to retrieve Secret %q", ref.Name)
+	}
+	secret := &corev1.Secret{}
+	if err := h.kargoClient.Get(
+		ctx,
+		client.ObjectKey{Namespace: project, Name: ref.Name},
+		secret,
+	); err != nil {
+		return "", fmt.Errorf(
+			"error getting Secret %q in namespace %q: %w",
+			ref.Name, project, err,
+		)
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf(
+			"Secret %q in namespace %q has no key %q", // nolint:staticcheck
+			ref.Name, project, ref.Key,
+		)
+	}
+	return string(value), nil
+}
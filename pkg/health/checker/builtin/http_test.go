@@ -0,0 +1,175 @@
+package builtin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+func Test_httpChecker_Name(t *testing.T) {
+	require.Equal(t, HTTPCheckerName, newHTTPChecker(nil).Name())
+}
+
+func Test_httpChecker_Check(t *testing.T) {
+	const testProject = "fake-project"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/healthz":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"version":"v1.2.3"}`))
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	kargoClient := fake.NewClientBuilder().WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testProject, Name: "token"},
+			Data:       map[string][]byte{"token": []byte("Bearer s3cret")},
+		},
+	).Build()
+	authHeader := map[string]any{
+		"name": "Authorization",
+		"valueFrom": map[string]any{
+			"secretKeyRef": map[string]any{"name": "token", "key": "token"},
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		input      health.Input
+		assertions func(*testing.T, health.Result)
+	}{
+		{
+			name:  "invalid input",
+			input: health.Input{"headers": "not a list"},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Contains(t, res.Issues[0], "could not convert opaque input")
+			},
+		},
+		{
+			name: "Secret not found",
+			input: health.Input{
+				"url": srv.URL + "/healthz",
+				"headers": []any{map[string]any{
+					"name": "Authorization",
+					"valueFrom": map[string]any{
+						"secretKeyRef": map[string]any{"name": "missing", "key": "token"},
+					},
+				}},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Contains(t, res.Issues[0], `error getting Secret "missing"`)
+			},
+		},
+		{
+			name:  "no criteria and 2xx response",
+			input: health.Input{"url": srv.URL + "/healthz", "headers": []any{authHeader}},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+				require.Equal(
+					t,
+					map[string]any{"url": srv.URL + "/healthz", "status": int64(http.StatusOK)},
+					res.Output[httpResponseKey],
+				)
+			},
+		},
+		{
+			name:  "no criteria and non-2xx response",
+			input: health.Input{"url": srv.URL + "/healthz"},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, res.Status)
+				require.Contains(t, res.Issues[0], "returned non-2xx status 401")
+			},
+		},
+		{
+			name: "success criteria met",
+			input: health.Input{
+				"url":               srv.URL + "/healthz",
+				"headers":           []any{authHeader},
+				"successExpression": `response.body.version == "v1.2.3"`,
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+			},
+		},
+		{
+			name: "success criteria not met",
+			input: health.Input{
+				"url":               srv.URL + "/healthz",
+				"headers":           []any{authHeader},
+				"successExpression": `response.body.version == "v1.2.4"`,
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateProgressing, res.Status)
+				require.Contains(t, res.Issues[0], "has not met success criteria")
+			},
+		},
+		{
+			name: "failure criteria met",
+			input: health.Input{
+				"url":               srv.URL + "/unavailable",
+				"headers":           []any{authHeader},
+				"successExpression": `response.status == 200`,
+				"failureExpression": `response.status >= 500`,
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, res.Status)
+				require.Contains(t, res.Issues[0], "met failure criteria")
+			},
+		},
+		{
+			name: "expression does not evaluate to a boolean",
+			input: health.Input{
+				"url":               srv.URL + "/healthz",
+				"headers":           []any{authHeader},
+				"successExpression": `response.body.version`,
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Contains(t, res.Issues[0], "did not evaluate to a boolean")
+			},
+		},
+		{
+			name:  "request error",
+			input: health.Input{"url": "http://127.0.0.1:0/healthz"},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Contains(t, res.Issues[0], "error sending HTTP request")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			checker := newHTTPChecker(kargoClient)
+			testCase.assertions(
+				t,
+				checker.Check(
+					context.Background(),
+					testProject,
+					"fake-stage",
+					health.Criteria{Kind: HTTPCheckerName, Input: testCase.input},
+				),
+			)
+		})
+	}
+}
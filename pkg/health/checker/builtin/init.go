@@ -11,10 +11,14 @@ import (
 var initialized atomic.Uint32
 
 // Initialize registers all built-in Checkers with the health package's internal
-// Checker registry.
-func Initialize(argocdClient client.Client) {
+// Checker registry. The kargoClient is used to retrieve Secrets from Project
+// namespaces. The kubeClient is used to assess the health of arbitrary
+// Kubernetes resources and should not be backed by a cache.
+func Initialize(kargoClient, argocdClient, kubeClient client.Client) {
 	if !initialized.CompareAndSwap(0, 1) {
 		panic("built-in health checkers already initialized")
 	}
 	health.RegisterChecker(newArgocdChecker(argocdClient))
+	health.RegisterChecker(newKubernetesChecker(kubeClient))
+	health.RegisterChecker(newHTTPChecker(kargoClient))
 }
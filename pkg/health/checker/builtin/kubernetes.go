@@ -0,0 +1,307 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/cli-utils/pkg/kstatus/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+const (
+	// KubernetesCheckerName is the name of the Checker that assesses the health
+	// of arbitrary Kubernetes resources.
+	KubernetesCheckerName = "kubernetes-health"
+
+	resourceStatusesKey = "resourceStatuses"
+)
+
+// KubernetesHealthInput is the input for a health check on arbitrary
+// Kubernetes resources.
+type KubernetesHealthInput struct {
+	// Resources is a list of references to the Kubernetes resources to check.
+	Resources []KubernetesResourceHealthCheck `json:"resources"`
+}
+
+// KubernetesResourceHealthCheck is a reference to one or more Kubernetes
+// resources whose health should be checked. Exactly one of Name or
+// LabelSelector should be specified.
+type KubernetesResourceHealthCheck struct {
+	// APIVersion is the API version of the resource(s) to check.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the resource(s) to check.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the resource(s) to check. It must be empty
+	// for cluster-scoped resources.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the resource to check.
+	Name string `json:"name,omitempty"`
+	// LabelSelector is a label selector (e.g. "app=guestbook") matching the
+	// resources to check.
+	LabelSelector string `json:"labelSelector,omitempty"`
+}
+
+// KubernetesResourceStatus describes the observed status of a single
+// Kubernetes resource.
+type KubernetesResourceStatus struct {
+	// APIVersion is the API version of the resource.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the resource.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the resource.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name"`
+	// Status is the kstatus status of the resource. i.e. One of Current,
+	// InProgress, Failed, Terminating, NotFound, or Unknown.
+	Status status.Status `json:"status"`
+	// Message is a human-readable description of the status of the resource.
+	Message string `json:"message,omitempty"`
+}
+
+type kubernetesChecker struct {
+	client client.Client
+}
+
+// newKubernetesChecker returns an implementation of the Checker interface that
+// assesses the health of arbitrary Kubernetes resources using the conventions
+// established by kstatus. i.e. Based on their observedGeneration and standard
+// conditions (Ready, Reconciling, Stalled) or, for well-known kinds such as
+// Deployments, their kind-specific status fields.
+func newKubernetesChecker(c client.Client) *kubernetesChecker {
+	return &kubernetesChecker{client: c}
+}
+
+// Name implements the Checker interface.
+func (k *kubernetesChecker) Name() string {
+	return KubernetesCheckerName
+}
+
+// Check implements the Checker interface.
+func (k *kubernetesChecker) Check(
+	ctx context.Context,
+	project string,
+	stage string,
+	criteria health.Criteria,
+) health.Result {
+	input, err := health.InputToStruct[KubernetesHealthInput](criteria.Input)
+	if err != nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				fmt.Sprintf(
+					"could not convert opaque input into %s health check input: %s",
+					k.Name(), err.Error(),
+				),
+			},
+		}
+	}
+	return k.check(ctx, project, stage, input)
+}
+
+func (k *kubernetesChecker) check(
+	ctx context.Context,
+	project string,
+	stage string,
+	input KubernetesHealthInput,
+) health.Result {
+	if k.client == nil {
+		return health.Result{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				"no Kubernetes client is available to this controller; cannot " +
+					"assess the health of Kubernetes resources",
+			},
+		}
+	}
+	res := health.Result{
+		Status: kargoapi.HealthStateHealthy,
+		Issues: make([]string, 0),
+	}
+	resourceStatuses := make([]KubernetesResourceStatus, 0, len(input.Resources))
+	for _, ref := range input.Resources {
+		statuses, err := k.getResourceStatuses(ctx, project, stage, ref)
+		if err != nil {
+			res.Status = res.Status.Merge(kargoapi.HealthStateUnknown)
+			res.Issues = append(res.Issues, err.Error())
+			continue
+		}
+		for _, s := range statuses {
+			state := healthStateForResourceStatus(s.Status)
+			res.Status = res.Status.Merge(state)
+			if state != kargoapi.HealthStateHealthy {
+				res.Issues = append(res.Issues, resourceStatusIssue(s))
+			}
+		}
+		resourceStatuses = append(resourceStatuses, statuses...)
+	}
+	res.Output = map[string]any{
+		resourceStatusesKey: resourceStatuses,
+	}
+	return res
+}
+
+// getResourceStatuses returns the statuses of all resources referenced by the
+// provided KubernetesResourceHealthCheck that the specified Stage is permitted
+// to check. An error is returned if the reference is invalid, the resources
+// could not be retrieved, or a label selector did not match any such
+// resources.
+func (k *kubernetesChecker) getResourceStatuses(
+	ctx context.Context,
+	project string,
+	stage string,
+	ref KubernetesResourceHealthCheck,
+) ([]KubernetesResourceStatus, error) {
+	if (ref.Name == "") == (ref.LabelSelector == "") {
+		return nil, fmt.Errorf(
+			"exactly one of name or labelSelector must be specified for %s resources",
+			ref.Kind,
+		)
+	}
+	gvk := schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind)
+
+	if ref.Name != "" {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		err := k.client.Get(
+			ctx,
+			client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name},
+			obj,
+		)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf(
+				"error getting %s %q in namespace %q: %w",
+				ref.Kind, ref.Name, ref.Namespace, err,
+			)
+		}
+		if ref.Namespace != project && (err != nil || !isAuthorized(project, stage, obj)) {
+			// Whether a resource outside the Project's namespace exists is not
+			// disclosed to Stages that are not permitted to check it.
+			return nil, fmt.Errorf(
+				"%s %q in namespace %q does not exist or does not permit health "+
+					"checks by Stage %q in namespace %q",
+				ref.Kind, ref.Name, ref.Namespace, stage, project,
+			)
+		}
+		if err != nil {
+			return []KubernetesResourceStatus{{
+				APIVersion: ref.APIVersion,
+				Kind:       ref.Kind,
+				Namespace:  ref.Namespace,
+				Name:       ref.Name,
+				Status:     status.NotFoundStatus,
+				Message:    "Resource not found",
+			}}, nil
+		}
+		return []KubernetesResourceStatus{computeResourceStatus(obj)}, nil
+	}
+
+	selector, err := labels.Parse(ref.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error parsing label selector %q for %s resources: %w",
+			ref.LabelSelector, ref.Kind, err,
+		)
+	}
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err = k.client.List(
+		ctx,
+		list,
+		client.InNamespace(ref.Namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error listing %s resources matching %q in namespace %q: %w",
+			ref.Kind, ref.LabelSelector, ref.Namespace, err,
+		)
+	}
+	statuses := make([]KubernetesResourceStatus, 0, len(list.Items))
+	for i := range list.Items {
+		// Resources the Stage is not permitted to check are silently excluded
+		if isAuthorized(project, stage, &list.Items[i]) {
+			statuses = append(statuses, computeResourceStatus(&list.Items[i]))
+		}
+	}
+	if len(statuses) == 0 {
+		return nil, fmt.Errorf(
+			"no %s resources matching %q that permit health checks by Stage %q "+
+				"in namespace %q found in namespace %q",
+			ref.Kind, ref.LabelSelector, stage, project, ref.Namespace,
+		)
+	}
+	return statuses, nil
+}
+
+// isAuthorized returns a boolean indicating whether the specified Stage is
+// permitted to check the health of the provided resource. Resources in the
+// Stage's own Project namespace may always be checked. Any other resource must
+// explicitly permit it by means of the kargo.akuity.io/authorized-stage
+// annotation, just as Argo CD Applications must permit their mutation by a
+// Stage.
+func isAuthorized(project, stage string, obj *unstructured.Unstructured) bool {
+	if obj.GetNamespace() == project {
+		return true
+	}
+	return obj.GetAnnotations()[kargoapi.AnnotationKeyAuthorizedStage] ==
+		fmt.Sprintf("%s:%s", project, stage)
+}
+
+// computeResourceStatus computes the kstatus status of the provided resource.
+func computeResourceStatus(obj *unstructured.Unstructured) KubernetesResourceStatus {
+	s := KubernetesResourceStatus{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+	res, err := status.Compute(obj)
+	if err != nil {
+		s.Status = status.UnknownStatus
+		s.Message = fmt.Sprintf("error computing status: %s", err)
+		return s
+	}
+	s.Status = res.Status
+	s.Message = res.Message
+	return s
+}
+
+// healthStateForResourceStatus maps the kstatus status of a resource to a
+// HealthState.
+func healthStateForResourceStatus(s status.Status) kargoapi.HealthState {
+	switch s {
+	case status.CurrentStatus:
+		return kargoapi.HealthStateHealthy
+	case status.InProgressStatus:
+		return kargoapi.HealthStateProgressing
+	case status.FailedStatus, status.TerminatingStatus:
+		return kargoapi.HealthStateUnhealthy
+	default:
+		return kargoapi.HealthStateUnknown
+	}
+}
+
+// resourceStatusIssue returns a human-readable description of a resource that
+// is not healthy.
+func resourceStatusIssue(s KubernetesResourceStatus) string {
+	var issue string
+	if s.Namespace == "" {
+		issue = fmt.Sprintf("%s %q has status %q", s.Kind, s.Name, s.Status)
+	} else {
+		issue = fmt.Sprintf(
+			"%s %q in namespace %q has status %q",
+			s.Kind, s.Name, s.Namespace, s.Status,
+		)
+	}
+	if s.Message != "" {
+		issue = fmt.Sprintf("%s: %s", issue, s.Message)
+	}
+	return issue
+}
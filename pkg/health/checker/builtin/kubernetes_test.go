@@ -0,0 +1,403 @@
+package builtin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cli-utils/pkg/kstatus/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+)
+
+func Test_kubernetesChecker_Name(t *testing.T) {
+	require.Equal(t, KubernetesCheckerName, newKubernetesChecker(nil).Name())
+}
+
+func Test_kubernetesChecker_Check(t *testing.T) {
+	const (
+		testProject = "guestbook"
+		testStage   = "test"
+	)
+	newDeployment := func(name string, ready bool) *appsv1.Deployment {
+		d := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  testProject,
+				Name:       name,
+				Generation: 2,
+				Labels:     map[string]string{"app": "guestbook"},
+			},
+			Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)},
+			Status: appsv1.DeploymentStatus{
+				ObservedGeneration: 2,
+				Replicas:           2,
+				UpdatedReplicas:    2,
+				ReadyReplicas:      2,
+				AvailableReplicas:  2,
+				Conditions: []appsv1.DeploymentCondition{
+					{
+						Type:   appsv1.DeploymentAvailable,
+						Status: corev1.ConditionTrue,
+					},
+					{
+						Type:   appsv1.DeploymentProgressing,
+						Status: corev1.ConditionTrue,
+						Reason: "NewReplicaSetAvailable",
+					},
+				},
+			},
+		}
+		if !ready {
+			d.Status.ReadyReplicas = 1
+			d.Status.AvailableReplicas = 1
+		}
+		return d
+	}
+	// newOtherDeployment returns a healthy Deployment outside the Project's
+	// namespace, which may optionally permit health checks by a Stage.
+	newOtherDeployment := func(name string, authorizedStage string) *appsv1.Deployment {
+		d := newDeployment(name, true)
+		d.Namespace = "other"
+		if authorizedStage != "" {
+			d.Annotations = map[string]string{
+				kargoapi.AnnotationKeyAuthorizedStage: authorizedStage,
+			}
+		}
+		return d
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, appsv1.AddToScheme(scheme))
+
+	testCases := []struct {
+		name       string
+		client     client.Client
+		input      health.Input
+		assertions func(*testing.T, health.Result)
+	}{
+		{
+			name:  "no client",
+			input: health.Input{},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "no Kubernetes client")
+			},
+		},
+		{
+			name:   "invalid input",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			input:  health.Input{"resources": "not a list"},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Contains(t, res.Issues[0], "could not convert opaque input")
+			},
+		},
+		{
+			name:   "neither name nor label selector",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			input: health.Input{
+				"resources": []any{
+					map[string]any{"apiVersion": "apps/v1", "kind": "Deployment"},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Contains(t, res.Issues[0], "exactly one of name or labelSelector")
+			},
+		},
+		{
+			name: "healthy resource",
+			client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(newDeployment("guestbook", true)).
+				Build(),
+			input: health.Input{
+				"resources": []any{
+					map[string]any{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"namespace":  "guestbook",
+						"name":       "guestbook",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+				statuses, ok := res.Output[resourceStatusesKey].([]KubernetesResourceStatus)
+				require.True(t, ok)
+				require.Len(t, statuses, 1)
+				require.Equal(t, status.CurrentStatus, statuses[0].Status)
+			},
+		},
+		{
+			name: "progressing resource",
+			client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(newDeployment("guestbook", false)).
+				Build(),
+			input: health.Input{
+				"resources": []any{
+					map[string]any{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"namespace":  "guestbook",
+						"name":       "guestbook",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateProgressing, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(
+					t,
+					res.Issues[0],
+					`Deployment "guestbook" in namespace "guestbook" has status "InProgress"`,
+				)
+			},
+		},
+		{
+			name:   "resource not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			input: health.Input{
+				"resources": []any{
+					map[string]any{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"namespace":  "guestbook",
+						"name":       "guestbook",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], `has status "NotFound"`)
+			},
+		},
+		{
+			name: "resources selected by label",
+			client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					newDeployment("frontend", true),
+					newDeployment("backend", false),
+				).
+				Build(),
+			input: health.Input{
+				"resources": []any{
+					map[string]any{
+						"apiVersion":    "apps/v1",
+						"kind":          "Deployment",
+						"namespace":     "guestbook",
+						"labelSelector": "app=guestbook",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateProgressing, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], `Deployment "backend"`)
+				statuses, ok := res.Output[resourceStatusesKey].([]KubernetesResourceStatus)
+				require.True(t, ok)
+				require.Len(t, statuses, 2)
+			},
+		},
+		{
+			name:   "label selector matches nothing",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			input: health.Input{
+				"resources": []any{
+					map[string]any{
+						"apiVersion":    "apps/v1",
+						"kind":          "Deployment",
+						"namespace":     "guestbook",
+						"labelSelector": "app=guestbook",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "no Deployment resources matching")
+			},
+		},
+		{
+			name: "resource outside project namespace without authorization",
+			client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(newOtherDeployment("guestbook", "")).
+				Build(),
+			input: health.Input{
+				"resources": []any{
+					map[string]any{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"namespace":  "other",
+						"name":       "guestbook",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "does not exist or does not permit health checks")
+				statuses, ok := res.Output[resourceStatusesKey].([]KubernetesResourceStatus)
+				require.True(t, ok)
+				require.Empty(t, statuses)
+			},
+		},
+		{
+			name: "resource outside project namespace authorized for other Stage",
+			client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(newOtherDeployment("guestbook", "other-project:test")).
+				Build(),
+			input: health.Input{
+				"resources": []any{
+					map[string]any{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"namespace":  "other",
+						"name":       "guestbook",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "does not exist or does not permit health checks")
+			},
+		},
+		{
+			name:   "resource outside project namespace not found",
+			client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+			input: health.Input{
+				"resources": []any{
+					map[string]any{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"namespace":  "other",
+						"name":       "guestbook",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				// Indistinguishable from a resource that exists
+				require.Contains(t, res.Issues[0], "does not exist or does not permit health checks")
+			},
+		},
+		{
+			name: "resource outside project namespace with authorization",
+			client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(newOtherDeployment("guestbook", testProject+":"+testStage)).
+				Build(),
+			input: health.Input{
+				"resources": []any{
+					map[string]any{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"namespace":  "other",
+						"name":       "guestbook",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+			},
+		},
+		{
+			name: "resources outside project namespace selected by label",
+			client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					newOtherDeployment("frontend", testProject+":"+testStage),
+					newOtherDeployment("backend", ""),
+				).
+				Build(),
+			input: health.Input{
+				"resources": []any{
+					map[string]any{
+						"apiVersion":    "apps/v1",
+						"kind":          "Deployment",
+						"namespace":     "other",
+						"labelSelector": "app=guestbook",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				statuses, ok := res.Output[resourceStatusesKey].([]KubernetesResourceStatus)
+				require.True(t, ok)
+				// Only the authorized resource is checked
+				require.Len(t, statuses, 1)
+				require.Equal(t, "frontend", statuses[0].Name)
+			},
+		},
+		{
+			name: "no resources outside project namespace selected by label are authorized",
+			client: fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(newOtherDeployment("backend", "")).
+				Build(),
+			input: health.Input{
+				"resources": []any{
+					map[string]any{
+						"apiVersion":    "apps/v1",
+						"kind":          "Deployment",
+						"namespace":     "other",
+						"labelSelector": "app=guestbook",
+					},
+				},
+			},
+			assertions: func(t *testing.T, res health.Result) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "that permit health checks")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			checker := newKubernetesChecker(testCase.client)
+			testCase.assertions(
+				t,
+				checker.Check(
+					context.Background(),
+					testProject,
+					testStage,
+					health.Criteria{Kind: KubernetesCheckerName, Input: testCase.input},
+				),
+			)
+		})
+	}
+}
+
+func Test_healthStateForResourceStatus(t *testing.T) {
+	testCases := map[status.Status]kargoapi.HealthState{
+		status.CurrentStatus:     kargoapi.HealthStateHealthy,
+		status.InProgressStatus:  kargoapi.HealthStateProgressing,
+		status.FailedStatus:      kargoapi.HealthStateUnhealthy,
+		status.TerminatingStatus: kargoapi.HealthStateUnhealthy,
+		status.NotFoundStatus:    kargoapi.HealthStateUnknown,
+		status.UnknownStatus:     kargoapi.HealthStateUnknown,
+	}
+	for s, expected := range testCases {
+		t.Run(string(s), func(t *testing.T) {
+			require.Equal(t, expected, healthStateForResourceStatus(s))
+		})
+	}
+}
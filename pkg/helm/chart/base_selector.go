@@ -1,22 +1,39 @@
 package chart
 
 import (
+	"errors"
 	"fmt"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/sortkey"
 )
 
 // baseSelector is a base implementation of Selector that provides common
 // functionality for all Selector implementations. It is not intended to be used
 // directly.
 type baseSelector struct {
-	repoURL        string
-	constraint     *semver.Constraints
-	discoveryLimit int
+	repoURL          string
+	constraint       *semver.Constraints
+	filterExpression *vm.Program
+	sortKey          *sortkey.Expression
+	discoveryLimit   int
+}
+
+// chartMetadata represents the subset of a chart's metadata that is made
+// available to filter expressions. It can be unmarshaled from both the
+// entries of a classic repository's index and the config blob of a chart
+// stored in an OCI registry.
+type chartMetadata struct {
+	Version     string            `json:"version,omitempty" yaml:"version,omitempty"`
+	AppVersion  string            `json:"appVersion,omitempty" yaml:"appVersion,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
 }
 
 func newBaseSelector(
@@ -35,6 +52,25 @@ func newBaseSelector(
 				fmt.Errorf("error parsing constraint %q: %w", sub.SemverConstraint, err)
 		}
 	}
+	if sub.ExpressionFilter != "" {
+		var err error
+		if s.filterExpression, err = expr.Compile(sub.ExpressionFilter); err != nil {
+			return nil, fmt.Errorf("error compiling filter expression: %w", err)
+		}
+	}
+	switch sub.SelectionStrategy {
+	case kargoapi.ChartSelectionStrategySemVer, "":
+	case kargoapi.ChartSelectionStrategyExpression:
+		if sub.SortKeyExpression == "" {
+			return nil, errors.New("sort key expression is required")
+		}
+		var err error
+		if s.sortKey, err = sortkey.Compile(sub.SortKeyExpression); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported selection strategy %q", sub.SelectionStrategy)
+	}
 	return s, nil
 }
 
@@ -63,8 +99,42 @@ func (b *baseSelector) filterSemvers(
 	return slices.Clip(filtered)
 }
 
-// sort sorts the provided semantic versions from greatest to least in place.
-func (b *baseSelector) sort(semvers semver.Collection) {
+// matchesFilterExpression evaluates the selector's filter expression, if any,
+// against the provided chart metadata and returns a boolean indicating whether
+// the chart version should be considered.
+func (b *baseSelector) matchesFilterExpression(metadata chartMetadata) (bool, error) {
+	if b.filterExpression == nil {
+		return true, nil
+	}
+
+	env := map[string]any{
+		"version":     metadata.Version,
+		"appVersion":  metadata.AppVersion,
+		"annotations": metadata.Annotations,
+	}
+
+	result, err := expr.Run(b.filterExpression, env)
+	if err != nil {
+		return false, fmt.Errorf("error evaluating chart filter expression: %w", err)
+	}
+
+	switch result := result.(type) {
+	case bool:
+		return result, nil
+	default:
+		parsedBool, err := strconv.ParseBool(fmt.Sprintf("%v", result))
+		if err != nil {
+			return false, fmt.Errorf("error parsing expression result: %w", err)
+		}
+		return parsedBool, nil
+	}
+}
+
+// sort returns the provided semantic versions ordered from greatest to least.
+// If the selector has a sort key expression, versions are ordered by the keys
+// it extracts instead, with ties broken by semantic version.
+func (b *baseSelector) sort(semvers semver.Collection) (semver.Collection, error) {
+	semvers = slices.Clone(semvers)
 	slices.SortFunc(semvers, func(lhs, rhs *semver.Version) int {
 		if comp := rhs.Compare(lhs); comp != 0 {
 			return comp
@@ -75,6 +145,20 @@ func (b *baseSelector) sort(semvers semver.Collection) {
 		// sort does not do this!
 		return strings.Compare(rhs.Original(), lhs.Original())
 	})
+	if b.sortKey == nil {
+		return semvers, nil
+	}
+	sorted, err := sortkey.Sort(
+		b.sortKey,
+		semvers,
+		func(sv *semver.Version) map[string]any {
+			return map[string]any{"version": sv.Original()}
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error sorting versions: %w", err)
+	}
+	return sorted, nil
 }
 
 // semversToVersionStrings converts the provided list of semantic versions into
@@ -4,8 +4,11 @@ import (
 	"testing"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/expr-lang/expr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/pkg/controller/sortkey"
 )
 
 func Test_baseSelector_semversToVersionStrings(t *testing.T) {
@@ -131,3 +134,144 @@ func Test_baseSelector_filterSemvers(t *testing.T) {
 		})
 	}
 }
+
+func Test_baseSelector_matchesFilterExpression(t *testing.T) {
+	testMetadata := chartMetadata{
+		Version:    "1.2.3",
+		AppVersion: "v4.5.6",
+		Annotations: map[string]string{
+			"example.com/channel": "stable",
+		},
+	}
+	testCases := []struct {
+		name       string
+		expression string
+		assertions func(*testing.T, bool, error)
+	}{
+		{
+			name: "no expression",
+			assertions: func(t *testing.T, matches bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matches)
+			},
+		},
+		{
+			name:       "expression matches",
+			expression: `version == "1.2.3" && appVersion startsWith "v4" && annotations["example.com/channel"] == "stable"`,
+			assertions: func(t *testing.T, matches bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matches)
+			},
+		},
+		{
+			name:       "expression does not match",
+			expression: `annotations["example.com/channel"] == "beta"`,
+			assertions: func(t *testing.T, matches bool, err error) {
+				require.NoError(t, err)
+				require.False(t, matches)
+			},
+		},
+		{
+			name:       "non-boolean result parsed as boolean",
+			expression: `appVersion == "v4.5.6" ? "true" : "false"`,
+			assertions: func(t *testing.T, matches bool, err error) {
+				require.NoError(t, err)
+				require.True(t, matches)
+			},
+		},
+		{
+			name:       "result cannot be parsed as boolean",
+			expression: `version`,
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.ErrorContains(t, err, "error parsing expression result")
+			},
+		},
+		{
+			name:       "error evaluating expression",
+			expression: `annotations.foo.bar()`,
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.ErrorContains(t, err, "error evaluating chart filter expression")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := &baseSelector{}
+			if testCase.expression != "" {
+				var err error
+				s.filterExpression, err = expr.Compile(testCase.expression)
+				require.NoError(t, err)
+			}
+			matches, err := s.matchesFilterExpression(testMetadata)
+			testCase.assertions(t, matches, err)
+		})
+	}
+}
+
+func Test_baseSelector_sort(t *testing.T) {
+	testVersions := semver.Collection{
+		semver.MustParse("2026.9.30-b1000"),
+		semver.MustParse("2026.10.3-b99"),
+		semver.MustParse("2026.10.3-b412"),
+		semver.MustParse("2026.10.1-b500"),
+	}
+	testCases := []struct {
+		name       string
+		expression string
+		assertions func(*testing.T, semver.Collection, error)
+	}{
+		{
+			name: "no sort key expression",
+			assertions: func(t *testing.T, semvers semver.Collection, err error) {
+				require.NoError(t, err)
+				// Prerelease identifiers are compared lexically
+				require.Equal(
+					t,
+					[]string{
+						"2026.10.3-b99",
+						"2026.10.3-b412",
+						"2026.10.1-b500",
+						"2026.9.30-b1000",
+					},
+					(&baseSelector{}).semversToVersionStrings(semvers),
+				)
+			},
+		},
+		{
+			name:       "sort key expression",
+			expression: `[semverParse(split(version, "-b")[0]), int(split(version, "-b")[1])]`,
+			assertions: func(t *testing.T, semvers semver.Collection, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"2026.10.3-b412",
+						"2026.10.3-b99",
+						"2026.10.1-b500",
+						"2026.9.30-b1000",
+					},
+					(&baseSelector{}).semversToVersionStrings(semvers),
+				)
+			},
+		},
+		{
+			name:       "error evaluating sort key expression",
+			expression: `version == "2026.10.3-b99" ? 1 : version`,
+			assertions: func(t *testing.T, _ semver.Collection, err error) {
+				require.ErrorContains(t, err, "error sorting versions")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := &baseSelector{}
+			if testCase.expression != "" {
+				var err error
+				s.sortKey, err = sortkey.Compile(testCase.expression)
+				require.NoError(t, err)
+			}
+			semvers, err := s.sort(testVersions)
+			testCase.assertions(t, semvers, err)
+		})
+	}
+}
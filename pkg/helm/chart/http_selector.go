@@ -11,9 +11,23 @@ import (
 	"gopkg.in/yaml.v3"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/discoverycache"
 	"github.com/akuity/kargo/pkg/helm"
+	"github.com/akuity/kargo/pkg/urls"
 )
 
+// indexCache is a cache of classic chart repository indices shared by all
+// httpSelectors. Indices can be large and each describes every chart in a
+// repository, so caching them spares Warehouses subscribed to the same
+// repository from each retrieving and parsing the same index.
+var indexCache = discoverycache.New("chart_repository_indices")
+
+// chartIndex is the subset of a classic chart repository's index that is
+// relevant to selectors.
+type chartIndex struct {
+	Entries map[string][]chartMetadata `json:"entries,omitempty"`
+}
+
 // httpSelector is an implementation of Selector that interacts with classic
 // (http/s-based) Helm chart repositories.
 type httpSelector struct {
@@ -21,6 +35,10 @@ type httpSelector struct {
 	indexURL  string
 	chartName string
 	creds     *helm.Credentials
+	// indexCacheKey uniquely identifies the repository index and the
+	// credentials used to retrieve it.
+	indexCacheKey string
+	indexCache    *discoverycache.Cache
 }
 
 func newHTTPSelector(
@@ -31,6 +49,10 @@ func newHTTPSelector(
 	if err != nil {
 		return nil, fmt.Errorf("error building base selector: %w", err)
 	}
+	var username, password string
+	if creds != nil {
+		username, password = creds.Username, creds.Password
+	}
 	return &httpSelector{
 		baseSelector: base,
 		indexURL: fmt.Sprintf(
@@ -39,12 +61,59 @@ func newHTTPSelector(
 		),
 		chartName: sub.Name,
 		creds:     creds,
+		indexCacheKey: discoverycache.Key(
+			urls.NormalizeChart(sub.RepoURL),
+			username,
+			password,
+		),
+		indexCache: indexCache,
 	}, nil
 }
 
 // Select implements Selector.
-func (h *httpSelector) Select(context.Context) ([]string, error) {
-	req, err := http.NewRequest(http.MethodGet, h.indexURL, nil)
+func (h *httpSelector) Select(ctx context.Context) ([]string, error) {
+	index, err := discoverycache.Get(
+		ctx,
+		h.indexCache,
+		h.indexCacheKey,
+		func() (*chartIndex, error) { return h.getIndex(ctx) },
+	)
+	if err != nil {
+		return nil, err
+	}
+	entries, ok := index.Entries[h.chartName]
+	if !ok {
+		return nil, nil
+	}
+	semvers := make(semver.Collection, 0, len(entries))
+	for _, entry := range entries {
+		sv, err := semver.NewVersion(entry.Version)
+		if err != nil {
+			continue
+		}
+		// The index already contains each version's metadata, so the filter
+		// expression can be evaluated up front.
+		matches, err := h.matchesFilterExpression(entry)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error filtering version %q of chart %q: %w",
+				entry.Version, h.chartName, err,
+			)
+		}
+		if matches {
+			semvers = append(semvers, sv)
+		}
+	}
+	if semvers, err = h.sort(h.filterSemvers(semvers)); err != nil {
+		return nil, err
+	}
+	return h.semversToVersionStrings(semvers), nil
+}
+
+// getIndex retrieves and parses the repository's index. The returned index
+// may be shared with other selectors and must not be modified.
+func (h *httpSelector) getIndex(ctx context.Context) (*chartIndex, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.indexURL, nil)
 	if err != nil {
 		return nil,
 			fmt.Errorf("error preparing HTTP/S request to %q: %w", h.indexURL, err)
@@ -70,29 +139,12 @@ func (h *httpSelector) Select(context.Context) ([]string, error) {
 		return nil,
 			fmt.Errorf("error reading repository index from %q: %w", h.indexURL, err)
 	}
-	index := struct {
-		Entries map[string][]struct {
-			Version string `json:"version,omitempty"`
-		} `json:"entries,omitempty"`
-	}{}
-	if err = yaml.Unmarshal(resBodyBytes, &index); err != nil {
+	index := &chartIndex{}
+	if err = yaml.Unmarshal(resBodyBytes, index); err != nil {
 		return nil, fmt.Errorf(
 			"error unmarshaling repository index from %q: %w",
 			h.indexURL, err,
 		)
 	}
-	entries, ok := index.Entries[h.chartName]
-	if !ok {
-		return nil, nil
-	}
-	semvers := make(semver.Collection, 0, len(entries))
-	for _, entry := range entries {
-		sv, err := semver.NewVersion(entry.Version)
-		if err == nil {
-			semvers = append(semvers, sv)
-		}
-	}
-	semvers = h.filterSemvers(semvers)
-	h.sort(semvers)
-	return h.semversToVersionStrings(semvers), nil
+	return index, nil
 }
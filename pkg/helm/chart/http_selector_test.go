@@ -6,11 +6,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 
+	"github.com/expr-lang/expr"
 	"github.com/stretchr/testify/require"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/discoverycache"
 	"github.com/akuity/kargo/pkg/helm"
 )
 
@@ -30,6 +33,43 @@ func TestNewHTTPSelector(t *testing.T) {
 				require.ErrorContains(t, err, "error building base selector")
 			},
 		},
+		{
+			name: "error compiling filter expression",
+			sub: kargoapi.ChartSubscription{
+				ExpressionFilter: "version ==", // This will force an error
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error compiling filter expression")
+			},
+		},
+		{
+			name: "unsupported selection strategy",
+			sub: kargoapi.ChartSubscription{
+				SelectionStrategy: "Bogus",
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "unsupported selection strategy")
+			},
+		},
+		{
+			name: "no sort key expression",
+			sub: kargoapi.ChartSubscription{
+				SelectionStrategy: kargoapi.ChartSelectionStrategyExpression,
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "sort key expression is required")
+			},
+		},
+		{
+			name: "error compiling sort key expression",
+			sub: kargoapi.ChartSubscription{
+				SelectionStrategy: kargoapi.ChartSelectionStrategyExpression,
+				SortKeyExpression: "version ==", // This will force an error
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error compiling sort key expression")
+			},
+		},
 		{
 			name: "success",
 			sub: kargoapi.ChartSubscription{
@@ -58,6 +98,8 @@ func TestNewHTTPSelector(t *testing.T) {
 					},
 					h.creds,
 				)
+				require.NotEmpty(t, h.indexCacheKey)
+				require.Same(t, indexCache, h.indexCache)
 			},
 		},
 	}
@@ -86,8 +128,15 @@ func Test_httpSelector_Select(t *testing.T) {
 					_, err := w.Write([]byte(`entries:
   fake-chart:
     - version: 1.0.0
+      appVersion: v1.0.0
+      annotations:
+        example.com/channel: stable
     - version: 1.1.0
+      appVersion: v1.1.0
     - version: 1.2.0
+      appVersion: v1.2.0
+      annotations:
+        example.com/channel: stable
 `))
 					require.NoError(t, err)
 				default:
@@ -101,6 +150,7 @@ func Test_httpSelector_Select(t *testing.T) {
 		name       string
 		repoURL    string
 		chart      string
+		filter     string
 		assertions func(t *testing.T, versions []string, err error)
 	}{
 		{
@@ -136,6 +186,25 @@ func Test_httpSelector_Select(t *testing.T) {
 				require.Equal(t, []string{"1.2.0", "1.1.0", "1.0.0"}, versions)
 			},
 		},
+		{
+			name:    "success with filter expression",
+			repoURL: fmt.Sprintf("%s/fake-repo", testServer.URL),
+			chart:   "fake-chart",
+			filter:  `annotations["example.com/channel"] == "stable" && appVersion != "v1.0.0"`,
+			assertions: func(t *testing.T, versions []string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"1.2.0"}, versions)
+			},
+		},
+		{
+			name:    "error evaluating filter expression",
+			repoURL: fmt.Sprintf("%s/fake-repo", testServer.URL),
+			chart:   "fake-chart",
+			filter:  `version`,
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "error filtering version")
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -147,8 +216,62 @@ func Test_httpSelector_Select(t *testing.T) {
 				),
 				chartName: testCase.chart,
 			}
+			if testCase.filter != "" {
+				var err error
+				s.filterExpression, err = expr.Compile(testCase.filter)
+				require.NoError(t, err)
+			}
 			versions, err := s.Select(context.Background())
 			testCase.assertions(t, versions, err)
 		})
 	}
 }
+
+func Test_httpSelector_Select_cachesIndex(t *testing.T) {
+	var requests atomic.Int32
+	testServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				defer r.Body.Close()
+				requests.Add(1)
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`entries:
+  fake-chart:
+    - version: 1.0.0
+  other-chart:
+    - version: 2.0.0
+`))
+				require.NoError(t, err)
+			},
+		),
+	)
+	defer testServer.Close()
+
+	cache := discoverycache.New(t.Name())
+	newSelector := func(chart string) *httpSelector {
+		return &httpSelector{
+			baseSelector:  &baseSelector{repoURL: testServer.URL},
+			indexURL:      testServer.URL + "/index.yaml",
+			chartName:     chart,
+			indexCacheKey: discoverycache.Key(testServer.URL),
+			indexCache:    cache,
+		}
+	}
+
+	// Selectors for different charts in the same repository should share a
+	// single retrieval of the index.
+	versions, err := newSelector("fake-chart").Select(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"1.0.0"}, versions)
+	versions, err = newSelector("other-chart").Select(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"2.0.0"}, versions)
+	require.Equal(t, int32(1), requests.Load())
+
+	// A refresh should bypass the cache.
+	_, err = newSelector("fake-chart").Select(
+		discoverycache.ContextWithRefresh(context.Background()),
+	)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), requests.Load())
+}
@@ -2,15 +2,29 @@ package chart
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/registry"
 	"oras.land/oras-go/v2/registry/remote"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/discoverycache"
 	"github.com/akuity/kargo/pkg/helm"
+	"github.com/akuity/kargo/pkg/urls"
+)
+
+var (
+	// tagsCache is a cache of OCI chart repository tags shared by all
+	// ociSelectors.
+	tagsCache = discoverycache.New("chart_tags")
+	// metadataCache is a cache of chart metadata, keyed by manifest digest,
+	// shared by all ociSelectors.
+	metadataCache = discoverycache.NewImmutable("chart_metadata_by_digest")
 )
 
 // ociSelector is an implementation of Selector that interacts with OCI Helm
@@ -18,6 +32,11 @@ import (
 type ociSelector struct {
 	*baseSelector
 	repo *remote.Repository
+	// identity uniquely identifies the repository and the credentials used to
+	// access it. It is used to derive cache keys.
+	identity      string
+	tagsCache     *discoverycache.Cache
+	metadataCache *discoverycache.Cache
 }
 
 func newOCISelector(
@@ -46,27 +65,65 @@ func newOCISelector(
 		}
 	}
 
+	var username, password string
+	if creds != nil {
+		username, password = creds.Username, creds.Password
+	}
+
 	return &ociSelector{
 		baseSelector: base,
 		repo: &remote.Repository{
 			Reference: ref,
 			Client:    authorizer,
 		},
+		identity: discoverycache.Key(
+			urls.NormalizeChart(sub.RepoURL),
+			username,
+			password,
+		),
+		tagsCache:     tagsCache,
+		metadataCache: metadataCache,
 	}, nil
 }
 
 // Select implements Selector.
 func (o *ociSelector) Select(ctx context.Context) ([]string, error) {
-	semvers := make(semver.Collection, 0, o.repo.TagListPageSize)
-	if err := o.repo.Tags(ctx, "", func(tags []string) error {
-		for _, tag := range tags {
-			// OCI artifact tags are not allowed to contain the "+" character, which is
-			// used by SemVer to separate the version from the build metadata. To work
-			// around this, Helm uses "_" instead of "+".
-			if sv, err := semver.StrictNewVersion(strings.ReplaceAll(tag, "_", "+")); err == nil {
-				semvers = append(semvers, sv)
-			}
+	tags, err := discoverycache.Get(
+		ctx,
+		o.tagsCache,
+		o.identity,
+		func() ([]string, error) { return o.getTags(ctx) },
+	)
+	if err != nil {
+		return nil, err
+	}
+	semvers := make(semver.Collection, 0, len(tags))
+	for _, tag := range tags {
+		// OCI artifact tags are not allowed to contain the "+" character, which is
+		// used by SemVer to separate the version from the build metadata. To work
+		// around this, Helm uses "_" instead of "+".
+		if sv, err := semver.StrictNewVersion(strings.ReplaceAll(tag, "_", "+")); err == nil {
+			semvers = append(semvers, sv)
 		}
+	}
+	semvers, err = o.sort(o.filterSemvers(semvers))
+	if err != nil {
+		return nil, err
+	}
+	if o.filterExpression != nil {
+		if semvers, err = o.filterSemversByExpression(ctx, semvers); err != nil {
+			return nil, err
+		}
+	}
+	return o.semversToVersionStrings(semvers), nil
+}
+
+// getTags retrieves all tags from the repository. The returned slice may be
+// shared with other selectors and must not be modified.
+func (o *ociSelector) getTags(ctx context.Context) ([]string, error) {
+	tags := make([]string, 0, o.repo.TagListPageSize)
+	if err := o.repo.Tags(ctx, "", func(page []string) error {
+		tags = append(tags, page...)
 		return nil
 	}); err != nil {
 		return nil, fmt.Errorf(
@@ -75,7 +132,118 @@ func (o *ociSelector) Select(ctx context.Context) ([]string, error) {
 			err,
 		)
 	}
-	semvers = o.filterSemvers(semvers)
-	o.sort(semvers)
-	return o.semversToVersionStrings(semvers), nil
+	return tags, nil
+}
+
+// filterSemversByExpression retrieves the metadata of the chart versions
+// represented by the provided, already sorted, semantic versions SEQUENTIALLY
+// and discards any that do not match the selector's filter expression. This
+// repeats until the provided semantic versions have been exhausted or an
+// amount of matching versions equal to the selector's discovery limit has been
+// found.
+func (o *ociSelector) filterSemversByExpression(
+	ctx context.Context,
+	semvers semver.Collection,
+) (semver.Collection, error) {
+	limit := o.discoveryLimit
+	if limit == 0 || limit > len(semvers) {
+		limit = len(semvers)
+	}
+	filtered := make(semver.Collection, 0, limit)
+	for _, sv := range semvers {
+		if len(filtered) >= limit {
+			break
+		}
+		metadata, err := o.getChartMetadata(ctx, sv)
+		if err != nil {
+			return nil, err
+		}
+		matches, err := o.matchesFilterExpression(*metadata)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error filtering version %q of chart from repository %q: %w",
+				sv.Original(), o.repoURL, err,
+			)
+		}
+		if matches {
+			filtered = append(filtered, sv)
+		}
+	}
+	return filtered, nil
+}
+
+// getChartMetadata retrieves the metadata of the chart version represented by
+// the provided semantic version from the config blob of its manifest. Because
+// a tag may be moved from one manifest to another, the tag is always resolved
+// to a digest, but the metadata itself is cached by digest.
+func (o *ociSelector) getChartMetadata(
+	ctx context.Context,
+	sv *semver.Version,
+) (*chartMetadata, error) {
+	// Reverse the "+" to "_" substitution Helm applies to tags.
+	tag := strings.ReplaceAll(sv.Original(), "+", "_")
+	manifestDesc, err := o.repo.Resolve(ctx, tag)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error resolving version %q of chart from repository %q: %w",
+			sv.Original(), o.repoURL, err,
+		)
+	}
+	return discoverycache.Get(
+		ctx,
+		o.metadataCache,
+		discoverycache.Key(o.identity, manifestDesc.Digest.String()),
+		func() (*chartMetadata, error) {
+			return o.getChartMetadataByDigest(ctx, sv, manifestDesc)
+		},
+	)
+}
+
+// getChartMetadataByDigest retrieves the metadata of the chart version
+// represented by the provided semantic version from the config blob of the
+// manifest described by the provided descriptor.
+func (o *ociSelector) getChartMetadataByDigest(
+	ctx context.Context,
+	sv *semver.Version,
+	manifestDesc ocispec.Descriptor,
+) (*chartMetadata, error) {
+	manifestReader, err := o.repo.Fetch(ctx, manifestDesc)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving manifest for version %q of chart from repository %q: %w",
+			sv.Original(), o.repoURL, err,
+		)
+	}
+	defer manifestReader.Close()
+	manifestBytes, err := content.ReadAll(manifestReader, manifestDesc)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error reading manifest for version %q of chart from repository %q: %w",
+			sv.Original(), o.repoURL, err,
+		)
+	}
+	manifest := ocispec.Manifest{}
+	if err = json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, fmt.Errorf(
+			"error unmarshaling manifest for version %q of chart from repository %q: %w",
+			sv.Original(), o.repoURL, err,
+		)
+	}
+	// Helm stores the chart's metadata (i.e. the contents of Chart.yaml) as
+	// JSON in the manifest's config blob.
+	configBytes, err := content.FetchAll(ctx, o.repo, manifest.Config)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error retrieving metadata for version %q of chart from repository %q: %w",
+			sv.Original(), o.repoURL, err,
+		)
+	}
+	metadata := &chartMetadata{}
+	if err = json.Unmarshal(configBytes, metadata); err != nil {
+		return nil, fmt.Errorf(
+			"error unmarshaling metadata for version %q of chart from repository %q: %w",
+			sv.Original(), o.repoURL, err,
+		)
+	}
+	return metadata, nil
 }
@@ -45,6 +45,9 @@ func TestNewOCISelector(t *testing.T) {
 				require.True(t, ok)
 				require.NotNil(t, o.baseSelector)
 				require.NotNil(t, o.repo)
+				require.NotEmpty(t, o.identity)
+				require.Same(t, tagsCache, o.tagsCache)
+				require.Same(t, metadataCache, o.metadataCache)
 			},
 		},
 	}
@@ -0,0 +1,144 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/akuity/kargo/pkg/io"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+const (
+	// MaxResponseBytes is the maximum number of bytes of a response body that
+	// BuildResponseExprEnv will read.
+	MaxResponseBytes = 2 << 20
+
+	contentTypeHeader = "Content-Type"
+
+	contentTypeJSON      = "application/json"
+	contentTypeYAML      = "application/yaml"
+	contentTypeYAMLAlt   = "text/yaml"
+	contentTypeYAMLX     = "application/x-yaml"
+	contentTypeTextPlain = "text/plain"
+)
+
+// ResponseParseMode identifies how an HTTP response should be parsed.
+type ResponseParseMode string
+
+const (
+	ResponseParseModeJSON ResponseParseMode = "JSON"
+	ResponseParseModeYAML ResponseParseMode = "YAML"
+	ResponseParseModeText ResponseParseMode = "text"
+)
+
+// BuildResponseExprEnv builds an environment for the evaluation of
+// expressions over the provided HTTP response. The environment contains a
+// single "response" key with the following fields:
+//
+//   - status: The status code of the response.
+//   - header: A function returning the first value of a response header.
+//   - headers: All response headers.
+//   - body: The parsed response body.
+//
+// If the provided contentType is empty, the response body is parsed according
+// to the Content-Type header of the response, falling back to JSON.
+func BuildResponseExprEnv(
+	ctx context.Context,
+	resp *http.Response,
+	contentType string,
+) (map[string]any, error) {
+	// Early check of Content-Length if available
+	if contentLength := resp.ContentLength; contentLength > MaxResponseBytes {
+		return nil, fmt.Errorf("response body size %d exceeds limit of %d bytes", contentLength, MaxResponseBytes)
+	}
+
+	// Read the response body up to the maximum allowed size
+	bodyBytes, err := io.LimitRead(resp.Body, MaxResponseBytes)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %w", err)
+	}
+
+	// TODO(hidde): It has proven to be difficult to figure out why a HTTP step
+	// fails or is not working as expected. To remediate this, we log the
+	// response body and headers at trace level. This is a temporary solution
+	// until we have a better way to present this information to the user, e.g.
+	// as part of the step output or error message.
+	logging.LoggerFromContext(ctx).Trace(
+		"HTTP request response",
+		"status", resp.StatusCode,
+		"header", resp.Header,
+		"body", string(bodyBytes),
+	)
+
+	response := map[string]any{
+		// TODO(krancour): Casting as an int64 is a short-term fix here because
+		// deep copy of the output map will panic if any value is an int. This is
+		// a near-term fix and a better solution will be PR'ed soon.
+		"status":  int64(resp.StatusCode),
+		"header":  resp.Header.Get,
+		"headers": resp.Header,
+		"body":    map[string]any{},
+	}
+
+	if contentType == "" {
+		contentType, _, _ = mime.ParseMediaType(resp.Header.Get(contentTypeHeader))
+	}
+
+	if len(bodyBytes) > 0 {
+		parseMode := DetermineResponseParseMode(contentType)
+
+		switch parseMode {
+		case ResponseParseModeJSON:
+			if contentType != contentTypeJSON {
+				if !json.Valid(bodyBytes) {
+					logging.LoggerFromContext(ctx).Debug(
+						"unrecognized content type is not valid JSON, ignoring response body",
+						"contentType", contentType,
+					)
+					break
+				}
+			}
+			var parsedBody any
+			if err = json.Unmarshal(bodyBytes, &parsedBody); err != nil {
+				return nil, fmt.Errorf("failed to parse JSON response: %w", err)
+			}
+			response["body"] = parsedBody
+		case ResponseParseModeYAML:
+			var parsedBody any
+			if err = yaml.Unmarshal(bodyBytes, &parsedBody); err != nil {
+				return nil, fmt.Errorf("failed to parse YAML response: %w", err)
+			}
+			response["body"] = parsedBody
+		case ResponseParseModeText:
+			response["body"] = string(bodyBytes)
+		}
+	}
+
+	return map[string]any{
+		"response": response,
+	}, nil
+}
+
+// DetermineResponseParseMode determines how to parse a response body based on
+// the provided MIME media type.
+func DetermineResponseParseMode(contentType string) ResponseParseMode {
+	switch {
+	case strings.EqualFold(contentType, contentTypeJSON):
+		return ResponseParseModeJSON
+	case strings.EqualFold(contentType, contentTypeYAML),
+		strings.EqualFold(contentType, contentTypeYAMLAlt),
+		strings.EqualFold(contentType, contentTypeYAMLX):
+		return ResponseParseModeYAML
+	case strings.EqualFold(contentType, contentTypeTextPlain):
+		return ResponseParseModeText
+	default:
+		// Fallback: try to parse as JSON
+		return ResponseParseModeJSON
+	}
+}
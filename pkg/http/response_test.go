@@ -0,0 +1,521 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildResponseExprEnv(t *testing.T) {
+	testCases := []struct {
+		name                string
+		resp                *http.Response
+		responseContentType string
+		assertions          func(*testing.T, map[string]any, error)
+	}{
+		{
+			name: "response body Content-Length exceeds limit",
+			resp: &http.Response{
+				StatusCode:    http.StatusOK,
+				ContentLength: (2 << 20) + 1,
+				Header:        http.Header{"Content-Type": []string{"application/json"}},
+				Body:          io.NopCloser(strings.NewReader(`{"foo": "bar"}`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.Error(t, err)
+				require.ErrorContains(t, err, "response body size")
+				require.Nil(t, env)
+			},
+		},
+		{
+			name: "without body",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader("")),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				statusAny, ok := env["response"].(map[string]any)["status"]
+				require.True(t, ok)
+				status, ok := statusAny.(int64)
+				require.True(t, ok)
+				require.Equal(t, int64(http.StatusOK), status)
+				headerFnAny, ok := env["response"].(map[string]any)["header"]
+				require.True(t, ok)
+				headerFn, ok := headerFnAny.(func(string) string)
+				require.True(t, ok)
+				require.Equal(t, "application/json", headerFn("Content-Type"))
+				headersAny, ok := env["response"].(map[string]any)["headers"]
+				require.True(t, ok)
+				headers, ok := headersAny.(http.Header)
+				require.True(t, ok)
+				require.Equal(t, http.Header{"Content-Type": []string{"application/json"}}, headers)
+				bodyAny, ok := env["response"].(map[string]any)["body"]
+				require.True(t, ok)
+				body, ok := bodyAny.(map[string]any)
+				require.True(t, ok)
+				require.Empty(t, body)
+			},
+		},
+		{
+			name: "with body",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"foo": "bar"}`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				bodyAny, ok := env["response"].(map[string]any)["body"]
+				require.True(t, ok)
+				body, ok := bodyAny.(map[string]any)
+				require.True(t, ok)
+				require.Equal(t, map[string]any{"foo": "bar"}, body)
+			},
+		},
+		{
+			name: "with body as an array",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`[{"foo1": "bar1"}, {"foo2": "bar2"}]`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				bodyAny, ok := env["response"].(map[string]any)["body"]
+				require.True(t, ok)
+
+				// Check if interface is of type []any
+				body, ok := bodyAny.([]any)
+				require.True(t, ok)
+				require.Len(t, body, 2)
+
+				firstItem, ok := body[0].(map[string]any)
+				require.True(t, ok)
+				require.Equal(t, map[string]any{"foo1": "bar1"}, firstItem)
+			},
+		},
+		{
+			name: "invalid JSON body",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"foo":`)),
+			},
+			assertions: func(t *testing.T, _ map[string]any, err error) {
+				require.Error(t, err)
+				require.ErrorContains(t, err, "failed to parse JSON response")
+			},
+		},
+		{
+			name: "JSON string response succeeds",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`"foo"`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"] // nolint:forcetypeassert
+				require.Equal(t, "foo", body)
+			},
+		},
+		{
+			name: "JSON number response succeeds",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`42`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"] // nolint:forcetypeassert
+				require.Equal(t, float64(42), body)
+			},
+		},
+		{
+			name: "JSON boolean response succeeds",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`true`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"] // nolint:forcetypeassert
+				require.Equal(t, true, body)
+			},
+		},
+		{
+			name: "JSON null response succeeds",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`null`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"] // nolint:forcetypeassert
+				require.Nil(t, body)
+			},
+		},
+		{
+			name: "case-insensitive JSON content-type",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"APPLICATION/JSON"}},
+				Body:       io.NopCloser(strings.NewReader(`{"foo": "bar"}`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"].(map[string]any) // nolint:forcetypeassert
+				require.Equal(t, "bar", body["foo"])
+			},
+		},
+		{
+			name: "unknown content-type with invalid JSON leaves body empty",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"text/html"}},
+				Body:       io.NopCloser(strings.NewReader(`<html>hello</html>`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"] // nolint:forcetypeassert
+				require.Equal(t, map[string]any{}, body)
+			},
+		},
+		{
+			name: "text/plain with JSON-like content stays as string",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"text/plain"}},
+				Body:       io.NopCloser(strings.NewReader(`{"key": "value"}`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"] // nolint:forcetypeassert
+				// Should be a string, not parsed as JSON
+				require.Equal(t, `{"key": "value"}`, body)
+			},
+		},
+		{
+			name: "empty content-type with non-JSON body leaves body empty",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`hello world`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"] // nolint:forcetypeassert
+				require.Equal(t, map[string]any{}, body)
+			},
+		},
+		{
+			name: "missing content-type but valid JSON body",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{}, // No Content-Type header
+				Body:       io.NopCloser(strings.NewReader(`{"foo": "bar"}`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				bodyAny, ok := env["response"].(map[string]any)["body"]
+				require.True(t, ok)
+
+				body, ok := bodyAny.(map[string]any)
+				require.True(t, ok)
+				require.Equal(t, map[string]any{"foo": "bar"}, body)
+			},
+		},
+		{
+			name: "text/plain with numeric content",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"text/plain; charset=utf-8"}},
+				Body:       io.NopCloser(strings.NewReader(`1`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				bodyAny, ok := env["response"].(map[string]any)["body"]
+				require.True(t, ok)
+				body, ok := bodyAny.(string)
+				require.True(t, ok)
+				require.Equal(t, "1", body)
+			},
+		},
+		{
+			name: "text/plain with float content",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"text/plain; charset=utf-8"}},
+				Body:       io.NopCloser(strings.NewReader(`3.14`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				bodyAny, ok := env["response"].(map[string]any)["body"]
+				require.True(t, ok)
+				body, ok := bodyAny.(string)
+				require.True(t, ok)
+				require.Equal(t, "3.14", body)
+			},
+		},
+		{
+			name: "text/plain with word content",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"text/plain; charset=utf-8"}},
+				Body:       io.NopCloser(strings.NewReader(`one`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				bodyAny, ok := env["response"].(map[string]any)["body"]
+				require.True(t, ok)
+				body, ok := bodyAny.(string)
+				require.True(t, ok)
+				require.Equal(t, "one", body)
+			},
+		},
+		{
+			name: "text/plain with sentence content",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"text/plain; charset=utf-8"}},
+				Body:       io.NopCloser(strings.NewReader(`this is not json`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				bodyAny, ok := env["response"].(map[string]any)["body"]
+				require.True(t, ok)
+				body, ok := bodyAny.(string)
+				require.True(t, ok)
+				require.Equal(t, "this is not json", body)
+			},
+		},
+		{
+			name: "application/yaml content-type parses as YAML",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/yaml"}},
+				Body:       io.NopCloser(strings.NewReader("foo: bar\nbaz: 42\n")),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"].(map[string]any) // nolint:forcetypeassert
+				require.Equal(t, "bar", body["foo"])
+				// sigs.k8s.io/yaml converts YAML to JSON first, so integers become float64
+				require.Equal(t, float64(42), body["baz"])
+			},
+		},
+		{
+			name: "text/yaml content-type parses as YAML",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"text/yaml"}},
+				Body:       io.NopCloser(strings.NewReader("items:\n  - one\n  - two\n")),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"].(map[string]any) // nolint:forcetypeassert
+				items := body["items"].([]any)                                    // nolint:forcetypeassert
+				require.Len(t, items, 2)
+				require.Equal(t, "one", items[0])
+				require.Equal(t, "two", items[1])
+			},
+		},
+		{
+			name: "application/x-yaml content-type parses as YAML",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/x-yaml"}},
+				Body:       io.NopCloser(strings.NewReader("key: value\n")),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"].(map[string]any) // nolint:forcetypeassert
+				require.Equal(t, "value", body["key"])
+			},
+		},
+		{
+			name: "invalid YAML body returns error",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/yaml"}},
+				Body:       io.NopCloser(strings.NewReader("foo: [bar\n")),
+			},
+			assertions: func(t *testing.T, _ map[string]any, err error) {
+				require.Error(t, err)
+				require.ErrorContains(t, err, "failed to parse YAML response")
+			},
+		},
+		// responseContentType config override tests
+		{
+			name: "responseContentType override forces JSON parsing",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"text/plain"}},
+				Body:       io.NopCloser(strings.NewReader(`{"foo": "bar"}`)),
+			},
+			responseContentType: "application/json",
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"].(map[string]any) // nolint:forcetypeassert
+				require.Equal(t, "bar", body["foo"])
+			},
+		},
+		{
+			name: "responseContentType override forces YAML parsing",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"text/plain"}},
+				Body:       io.NopCloser(strings.NewReader("foo: bar\n")),
+			},
+			responseContentType: "application/yaml",
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"].(map[string]any) // nolint:forcetypeassert
+				require.Equal(t, "bar", body["foo"])
+			},
+		},
+		{
+			name: "responseContentType override forces text/plain parsing",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"foo": "bar"}`)),
+			},
+			responseContentType: "text/plain",
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"] // nolint:forcetypeassert
+				// Should be a string, not parsed as JSON
+				require.Equal(t, `{"foo": "bar"}`, body)
+			},
+		},
+		{
+			name: "responseContentType override text/yaml works",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"text/html"}},
+				Body:       io.NopCloser(strings.NewReader("key: value\n")),
+			},
+			responseContentType: "text/yaml",
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"].(map[string]any) // nolint:forcetypeassert
+				require.Equal(t, "value", body["key"])
+			},
+		},
+		{
+			name: "unknown content-type with valid JSON falls back to JSON",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/octet-stream"}},
+				Body:       io.NopCloser(strings.NewReader(`{"success": true}`)),
+			},
+			assertions: func(t *testing.T, env map[string]any, err error) {
+				require.NoError(t, err)
+				body := env["response"].(map[string]any)["body"].(map[string]any) // nolint:forcetypeassert
+				require.Equal(t, true, body["success"])
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			env, err := BuildResponseExprEnv(context.Background(), testCase.resp, testCase.responseContentType)
+			testCase.assertions(t, env, err)
+		})
+	}
+}
+
+func TestDetermineResponseParseMode(t *testing.T) {
+	testCases := []struct {
+		name        string
+		contentType string
+		expected    ResponseParseMode
+	}{
+		{
+			name:        "application/json returns JSON mode",
+			contentType: "application/json",
+			expected:    ResponseParseModeJSON,
+		},
+		{
+			name:        "APPLICATION/JSON (uppercase) returns JSON mode",
+			contentType: "APPLICATION/JSON",
+			expected:    ResponseParseModeJSON,
+		},
+		{
+			name:        "Application/Json (mixed case) returns JSON mode",
+			contentType: "Application/Json",
+			expected:    ResponseParseModeJSON,
+		},
+		{
+			name:        "application/yaml returns YAML mode",
+			contentType: "application/yaml",
+			expected:    ResponseParseModeYAML,
+		},
+		{
+			name:        "text/yaml returns YAML mode",
+			contentType: "text/yaml",
+			expected:    ResponseParseModeYAML,
+		},
+		{
+			name:        "application/x-yaml returns YAML mode",
+			contentType: "application/x-yaml",
+			expected:    ResponseParseModeYAML,
+		},
+		{
+			name:        "APPLICATION/YAML (uppercase) returns YAML mode",
+			contentType: "APPLICATION/YAML",
+			expected:    ResponseParseModeYAML,
+		},
+		{
+			name:        "TEXT/YAML (uppercase) returns YAML mode",
+			contentType: "TEXT/YAML",
+			expected:    ResponseParseModeYAML,
+		},
+		{
+			name:        "text/plain returns text mode",
+			contentType: "text/plain",
+			expected:    ResponseParseModeText,
+		},
+		{
+			name:        "TEXT/PLAIN (uppercase) returns text mode",
+			contentType: "TEXT/PLAIN",
+			expected:    ResponseParseModeText,
+		},
+		{
+			name:        "empty string falls back to JSON mode",
+			contentType: "",
+			expected:    ResponseParseModeJSON,
+		},
+		{
+			name:        "text/html falls back to JSON mode",
+			contentType: "text/html",
+			expected:    ResponseParseModeJSON,
+		},
+		{
+			name:        "application/octet-stream falls back to JSON mode",
+			contentType: "application/octet-stream",
+			expected:    ResponseParseModeJSON,
+		},
+		{
+			name:        "unknown/type falls back to JSON mode",
+			contentType: "unknown/type",
+			expected:    ResponseParseModeJSON,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := DetermineResponseParseMode(tc.contentType)
+			require.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -0,0 +1,354 @@
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+// ArtifactSelector is an interface for selecting arbitrary (i.e. non-image)
+// artifacts from a repository within an OCI registry.
+type ArtifactSelector interface {
+	// Select selects artifacts from a repository within an OCI registry.
+	Select(context.Context) ([]kargoapi.DiscoveredOCIArtifact, error)
+}
+
+// artifact is a representation of an arbitrary OCI artifact.
+type artifact struct {
+	Tag             string
+	Digest          string
+	ArtifactType    string
+	LayerMediaTypes []string
+	Annotations     map[string]string
+	CreatedAt       *time.Time
+}
+
+// artifactManifest is the subset of an OCI image manifest or index that is
+// relevant to the selection of artifacts.
+type artifactManifest struct {
+	ArtifactType string `json:"artifactType,omitempty"`
+	Config       struct {
+		MediaType string `json:"mediaType,omitempty"`
+	} `json:"config"`
+	Layers []struct {
+		MediaType string `json:"mediaType,omitempty"`
+	} `json:"layers,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// artifactSelector implements the ArtifactSelector interface. It reuses the
+// tag filtering and ordering logic of the tag-based image Selector
+// implementations, but does not make any assumptions about the contents of
+// the artifacts it selects.
+type artifactSelector struct {
+	*tagBasedSelector
+	strategy      kargoapi.ImageSelectionStrategy
+	mutableTag    string
+	artifactTypes []string
+	mediaTypes    []string
+	// orderTags filters the provided tags and orders them from most to least
+	// preferred.
+	orderTags func([]string) []string
+}
+
+// NewArtifactSelector returns an implementation of the ArtifactSelector
+// interface that selects artifacts from a repository within an OCI registry
+// based on the provided subscription.
+func NewArtifactSelector(
+	sub kargoapi.OCISubscription,
+	creds *Credentials,
+) (ArtifactSelector, error) {
+	tagBased, err := newTagBasedSelector(
+		kargoapi.ImageSubscription{
+			RepoURL:               sub.RepoURL,
+			AllowTagsRegexes:      sub.AllowTagsRegexes,
+			IgnoreTagsRegexes:     sub.IgnoreTagsRegexes,
+			InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+			DiscoveryLimit:        sub.DiscoveryLimit,
+		},
+		creds,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error building tag based selector: %w", err)
+	}
+	s := &artifactSelector{
+		tagBasedSelector: tagBased,
+		strategy:         sub.SelectionStrategy,
+		artifactTypes:    sub.ArtifactTypes,
+		mediaTypes:       sub.MediaTypes,
+	}
+	switch sub.SelectionStrategy {
+	case kargoapi.ImageSelectionStrategySemVer, "":
+		semverSel := &semverSelector{
+			tagBasedSelector: tagBased,
+			strictSemvers:    sub.StrictSemvers,
+		}
+		if sub.Constraint != "" {
+			if semverSel.constraint, err = semver.NewConstraint(sub.Constraint); err != nil {
+				return nil, fmt.Errorf(
+					"error parsing semver constraint %q: %w",
+					sub.Constraint, err,
+				)
+			}
+		}
+		s.orderTags = func(tags []string) []string {
+			return semverSel.sort(semverSel.filterTags(tags))
+		}
+	case kargoapi.ImageSelectionStrategyLexical:
+		s.orderTags = func(tags []string) []string {
+			tags = tagBased.filterTags(tags)
+			slices.Sort(tags)
+			slices.Reverse(tags)
+			return tags
+		}
+	case kargoapi.ImageSelectionStrategyNewestBuild:
+		// Artifacts are ordered by creation time after they have been retrieved.
+		s.orderTags = tagBased.filterTags
+	case kargoapi.ImageSelectionStrategyDigest:
+		s.mutableTag = sub.Constraint
+	default:
+		return nil, fmt.Errorf("unsupported selection strategy %q", sub.SelectionStrategy)
+	}
+	return s, nil
+}
+
+// Select implements the ArtifactSelector interface.
+func (a *artifactSelector) Select(
+	ctx context.Context,
+) ([]kargoapi.DiscoveredOCIArtifact, error) {
+	loggerCtx := append(
+		a.getLoggerContext(),
+		"selectionStrategy", a.strategy,
+		"artifactTypeConstrained", len(a.artifactTypes) > 0,
+		"mediaTypeConstrained", len(a.mediaTypes) > 0,
+	)
+	logger := logging.LoggerFromContext(ctx).WithValues(loggerCtx...)
+	ctx = logging.ContextWithLogger(ctx, logger)
+
+	logger.Trace("discovering artifacts")
+
+	if a.strategy == kargoapi.ImageSelectionStrategyDigest {
+		return a.selectByMutableTag(ctx)
+	}
+
+	tags, err := a.repoClient.getTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags: %w", err)
+	}
+	if len(tags) == 0 {
+		logger.Trace("found no tags")
+		return nil, nil
+	}
+	logger.Trace("got all tags")
+
+	tags = a.orderTags(tags)
+	if len(tags) == 0 {
+		logger.Trace("no tags matched criteria")
+		return nil, nil
+	}
+	logger.Trace(
+		"tags matched initial criteria",
+		"count", len(tags),
+	)
+
+	limit := a.discoveryLimit
+	if a.strategy == kargoapi.ImageSelectionStrategyNewestBuild {
+		// All artifacts need to be retrieved before they can be ordered.
+		limit = 0
+	}
+	artifacts, err := a.getArtifactsByTags(ctx, tags, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting artifacts by tags: %w", err)
+	}
+	if len(artifacts) == 0 {
+		logger.Trace("no artifacts matched criteria")
+		return nil, nil
+	}
+
+	if a.strategy == kargoapi.ImageSelectionStrategyNewestBuild {
+		logger.Trace("sorting artifacts by creation time")
+		slices.SortStableFunc(artifacts, func(lhs, rhs artifact) int {
+			switch {
+			case lhs.CreatedAt == nil && rhs.CreatedAt == nil:
+				return 0
+			case lhs.CreatedAt == nil:
+				return 1
+			case rhs.CreatedAt == nil:
+				return -1
+			default:
+				return rhs.CreatedAt.Compare(*lhs.CreatedAt)
+			}
+		})
+	}
+
+	logger.Trace(
+		"discovered artifacts",
+		"count", len(artifacts),
+	)
+
+	return artifactsToAPIArtifacts(artifacts, a.discoveryLimit), nil
+}
+
+// selectByMutableTag selects the artifact currently referenced by the
+// selector's mutable tag.
+func (a *artifactSelector) selectByMutableTag(
+	ctx context.Context,
+) ([]kargoapi.DiscoveredOCIArtifact, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues("tag", a.mutableTag)
+	art, err := a.repoClient.getArtifactByTag(ctx, a.mutableTag)
+	if err != nil {
+		var te *transport.Error
+		if errors.As(err, &te) && te.StatusCode == http.StatusNotFound {
+			logger.Trace("found no artifact with tag")
+			return nil, nil
+		}
+		return nil, fmt.Errorf(
+			"error retrieving artifact with tag %q: %w", a.mutableTag, err,
+		)
+	}
+	if !a.matchesArtifact(*art) {
+		logger.Trace("artifact with tag did not match criteria")
+		return nil, nil
+	}
+	logger.Trace("found artifact with tag")
+	return artifactsToAPIArtifacts([]artifact{*art}, 0), nil
+}
+
+// getArtifactsByTags retrieves artifacts for the provided tags SEQUENTIALLY.
+// It discards any that do not match the selector's criteria. This repeats
+// until the list of provided tags has been exhausted or it has found an amount
+// of artifacts equal to the provided limit. A limit of zero means no limit.
+func (a *artifactSelector) getArtifactsByTags(
+	ctx context.Context,
+	tags []string,
+	limit int,
+) ([]artifact, error) {
+	logger := logging.LoggerFromContext(ctx)
+
+	if limit == 0 || limit > len(tags) {
+		limit = len(tags)
+	}
+	artifacts := make([]artifact, 0, limit)
+	for _, tag := range tags {
+		if len(artifacts) >= limit {
+			break
+		}
+		art, err := a.repoClient.getArtifactByTag(ctx, tag)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving artifact with tag %q: %w", tag, err)
+		}
+		if !a.matchesArtifact(*art) {
+			logger.Trace(
+				"artifact was found, but did not match criteria",
+				"tag", tag,
+				"artifactType", art.ArtifactType,
+			)
+			continue
+		}
+		logger.Trace(
+			"discovered artifact",
+			"tag", art.Tag,
+			"digest", art.Digest,
+		)
+		artifacts = append(artifacts, *art)
+	}
+	return slices.Clip(artifacts), nil
+}
+
+// matchesArtifact returns a boolean value indicating whether the provided
+// artifact satisfies the selector's artifact type and media type constraints.
+func (a *artifactSelector) matchesArtifact(art artifact) bool {
+	if len(a.artifactTypes) > 0 && !slices.Contains(a.artifactTypes, art.ArtifactType) {
+		return false
+	}
+	if len(a.mediaTypes) == 0 {
+		return true
+	}
+	for _, mediaType := range art.LayerMediaTypes {
+		if slices.Contains(a.mediaTypes, mediaType) {
+			return true
+		}
+	}
+	return false
+}
+
+// artifactsToAPIArtifacts converts a slice of internal artifacts to a slice of
+// kargoapi.DiscoveredOCIArtifact, which can be directly used by a caller
+// performing artifact discovery. If the number of artifacts provided exceeds
+// the provided limit, the slice returned will be truncated so as not to exceed
+// that limit.
+func artifactsToAPIArtifacts(
+	artifacts []artifact,
+	limit int,
+) []kargoapi.DiscoveredOCIArtifact {
+	if limit <= 0 || limit > len(artifacts) {
+		limit = len(artifacts)
+	}
+	apiArtifacts := make([]kargoapi.DiscoveredOCIArtifact, limit)
+	for i, art := range artifacts[:limit] {
+		apiArtifacts[i] = kargoapi.DiscoveredOCIArtifact{
+			Tag:          art.Tag,
+			Digest:       art.Digest,
+			ArtifactType: art.ArtifactType,
+			Annotations:  art.Annotations,
+		}
+		if art.CreatedAt != nil {
+			apiArtifacts[i].CreatedAt = &metav1.Time{Time: *art.CreatedAt}
+		}
+	}
+	return apiArtifacts
+}
+
+// getArtifactByTag retrieves an arbitrary artifact by tag. This function uses
+// no cache since tags can be mutable.
+func (r *repositoryClient) getArtifactByTag(
+	ctx context.Context,
+	tag string,
+) (*artifact, error) {
+	repoRef := r.repoRef.Context().Tag(tag)
+	opts := append(r.remoteOptions, remote.WithContext(ctx))
+	desc, err := r.remoteGetFn(repoRef, opts...)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error getting descriptor for tag %q from repo URL %s: %w",
+			tag, r.repoURL, err,
+		)
+	}
+	manifest := artifactManifest{}
+	if err = json.Unmarshal(desc.Manifest, &manifest); err != nil {
+		return nil, fmt.Errorf(
+			"error parsing manifest for tag %q from repo URL %s: %w",
+			tag, r.repoURL, err,
+		)
+	}
+	art := &artifact{
+		Tag:          tag,
+		Digest:       desc.Digest.String(),
+		ArtifactType: manifest.ArtifactType,
+		Annotations:  manifest.Annotations,
+		CreatedAt:    getCreationTime([]map[string]string{manifest.Annotations}, nil),
+	}
+	if art.ArtifactType == "" {
+		// Per the OCI image spec, the media type of the config is to be used as
+		// the artifact type when the artifactType field is not set.
+		art.ArtifactType = manifest.Config.MediaType
+	}
+	art.LayerMediaTypes = make([]string, len(manifest.Layers))
+	for i, layer := range manifest.Layers {
+		art.LayerMediaTypes[i] = layer.MediaType
+	}
+	return art, nil
+}
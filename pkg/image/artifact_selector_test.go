@@ -0,0 +1,241 @@
+package image
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewArtifactSelector(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.OCISubscription
+		assertions func(*testing.T, ArtifactSelector, error)
+	}{
+		{
+			name: "error building tag based selector",
+			sub:  kargoapi.OCISubscription{}, // No RepoURL
+			assertions: func(t *testing.T, _ ArtifactSelector, err error) {
+				require.ErrorContains(t, err, "error building tag based selector")
+			},
+		},
+		{
+			name: "error parsing semver constraint",
+			sub: kargoapi.OCISubscription{
+				RepoURL:    "example/artifact",
+				Constraint: "invalid",
+			},
+			assertions: func(t *testing.T, _ ArtifactSelector, err error) {
+				require.ErrorContains(t, err, "error parsing semver constraint")
+			},
+		},
+		{
+			name: "unsupported selection strategy",
+			sub: kargoapi.OCISubscription{
+				RepoURL:           "example/artifact",
+				SelectionStrategy: "Bogus",
+			},
+			assertions: func(t *testing.T, _ ArtifactSelector, err error) {
+				require.ErrorContains(t, err, `unsupported selection strategy "Bogus"`)
+			},
+		},
+		{
+			name: "success",
+			sub: kargoapi.OCISubscription{
+				RepoURL:           "example/artifact",
+				SelectionStrategy: kargoapi.ImageSelectionStrategyDigest,
+				Constraint:        "latest",
+				ArtifactTypes:     []string{"application/vnd.example.config.v1+json"},
+			},
+			assertions: func(t *testing.T, sel ArtifactSelector, err error) {
+				require.NoError(t, err)
+				s, ok := sel.(*artifactSelector)
+				require.True(t, ok)
+				require.Equal(t, "latest", s.mutableTag)
+				require.Equal(
+					t,
+					[]string{"application/vnd.example.config.v1+json"},
+					s.artifactTypes,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := NewArtifactSelector(testCase.sub, nil)
+			testCase.assertions(t, s, err)
+		})
+	}
+}
+
+func Test_artifactSelector_Select(t *testing.T) {
+	const manifestsType = "application/vnd.example.manifests.v1+json"
+	const wasmLayerType = "application/vnd.wasm.content.layer.v1+wasm"
+
+	newDesc := func(manifest, hex string) *remote.Descriptor {
+		return &remote.Descriptor{
+			Descriptor: v1.Descriptor{
+				Digest: v1.Hash{Algorithm: "sha256", Hex: hex},
+			},
+			Manifest: []byte(manifest),
+		}
+	}
+	descs := map[string]*remote.Descriptor{
+		"v1.0.0": newDesc(
+			`{"artifactType":"`+manifestsType+`","annotations":`+
+				`{"org.opencontainers.image.created":"2024-01-01T00:00:00Z"}}`,
+			"a1",
+		),
+		"v1.1.0": newDesc(
+			`{"config":{"mediaType":"`+manifestsType+`"},"annotations":`+
+				`{"org.opencontainers.image.created":"2024-03-01T00:00:00Z"}}`,
+			"a2",
+		),
+		"v2.0.0": newDesc(
+			`{"config":{"mediaType":"application/vnd.oci.image.config.v1+json"},`+
+				`"layers":[{"mediaType":"`+wasmLayerType+`"}],"annotations":`+
+				`{"org.opencontainers.image.created":"2024-02-01T00:00:00Z"}}`,
+			"a3",
+		),
+		"latest": newDesc(`{"artifactType":"`+manifestsType+`"}`, "a4"),
+	}
+
+	newSelector := func(t *testing.T, sub kargoapi.OCISubscription) *artifactSelector {
+		sub.RepoURL = "example/artifact"
+		sel, err := NewArtifactSelector(sub, nil)
+		require.NoError(t, err)
+		s := sel.(*artifactSelector) // nolint: forcetypeassert
+		s.repoClient.remoteListFn = func(name.Repository, ...remote.Option) ([]string, error) {
+			return []string{"v1.0.0", "v1.1.0", "v2.0.0", "latest"}, nil
+		}
+		s.repoClient.remoteGetFn = func(ref name.Reference, _ ...remote.Option) (*remote.Descriptor, error) {
+			desc, ok := descs[ref.Identifier()]
+			if !ok {
+				return nil, &transport.Error{StatusCode: http.StatusNotFound}
+			}
+			return desc, nil
+		}
+		return s
+	}
+
+	testCases := []struct {
+		name       string
+		sub        kargoapi.OCISubscription
+		assertions func(*testing.T, []kargoapi.DiscoveredOCIArtifact, error)
+	}{
+		{
+			name: "SemVer with artifact type constraint",
+			sub: kargoapi.OCISubscription{
+				SelectionStrategy: kargoapi.ImageSelectionStrategySemVer,
+				StrictSemvers:     true,
+				ArtifactTypes:     []string{manifestsType},
+			},
+			assertions: func(t *testing.T, artifacts []kargoapi.DiscoveredOCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Len(t, artifacts, 2)
+				require.Equal(t, "v1.1.0", artifacts[0].Tag)
+				require.Equal(t, "sha256:a2", artifacts[0].Digest)
+				require.Equal(t, manifestsType, artifacts[0].ArtifactType)
+				require.Equal(
+					t,
+					time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+					artifacts[0].CreatedAt.UTC(),
+				)
+				require.Equal(t, "v1.0.0", artifacts[1].Tag)
+			},
+		},
+		{
+			name: "SemVer with media type constraint",
+			sub: kargoapi.OCISubscription{
+				StrictSemvers: true,
+				MediaTypes:    []string{wasmLayerType},
+			},
+			assertions: func(t *testing.T, artifacts []kargoapi.DiscoveredOCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Len(t, artifacts, 1)
+				require.Equal(t, "v2.0.0", artifacts[0].Tag)
+				require.Equal(t, "application/vnd.oci.image.config.v1+json", artifacts[0].ArtifactType)
+			},
+		},
+		{
+			name: "Lexical with discovery limit",
+			sub: kargoapi.OCISubscription{
+				SelectionStrategy: kargoapi.ImageSelectionStrategyLexical,
+				AllowTagsRegexes:  []string{`^v`},
+				DiscoveryLimit:    2,
+			},
+			assertions: func(t *testing.T, artifacts []kargoapi.DiscoveredOCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Len(t, artifacts, 2)
+				require.Equal(t, "v2.0.0", artifacts[0].Tag)
+				require.Equal(t, "v1.1.0", artifacts[1].Tag)
+			},
+		},
+		{
+			name: "NewestBuild",
+			sub: kargoapi.OCISubscription{
+				SelectionStrategy: kargoapi.ImageSelectionStrategyNewestBuild,
+				DiscoveryLimit:    3,
+			},
+			assertions: func(t *testing.T, artifacts []kargoapi.DiscoveredOCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Len(t, artifacts, 3)
+				require.Equal(t, "v1.1.0", artifacts[0].Tag)
+				require.Equal(t, "v2.0.0", artifacts[1].Tag)
+				require.Equal(t, "v1.0.0", artifacts[2].Tag)
+			},
+		},
+		{
+			name: "Digest",
+			sub: kargoapi.OCISubscription{
+				SelectionStrategy: kargoapi.ImageSelectionStrategyDigest,
+				Constraint:        "latest",
+			},
+			assertions: func(t *testing.T, artifacts []kargoapi.DiscoveredOCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.DiscoveredOCIArtifact{{
+					Tag:          "latest",
+					Digest:       "sha256:a4",
+					ArtifactType: manifestsType,
+				}}, artifacts)
+			},
+		},
+		{
+			name: "Digest with tag not found",
+			sub: kargoapi.OCISubscription{
+				SelectionStrategy: kargoapi.ImageSelectionStrategyDigest,
+				Constraint:        "missing",
+			},
+			assertions: func(t *testing.T, artifacts []kargoapi.DiscoveredOCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Empty(t, artifacts)
+			},
+		},
+		{
+			name: "no artifacts match",
+			sub: kargoapi.OCISubscription{
+				StrictSemvers: true,
+				ArtifactTypes: []string{"application/vnd.example.other.v1+json"},
+			},
+			assertions: func(t *testing.T, artifacts []kargoapi.DiscoveredOCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Empty(t, artifacts)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			artifacts, err := newSelector(t, testCase.sub).Select(context.Background())
+			testCase.assertions(t, artifacts, err)
+		})
+	}
+}
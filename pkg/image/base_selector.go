@@ -1,8 +1,13 @@
 package image
 
 import (
+	"context"
 	"fmt"
+	"slices"
+	"strconv"
 
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -13,16 +18,19 @@ import (
 // functionality for all Selector implementations. It is not intended to be used
 // directly.
 type baseSelector struct {
-	platform   *platformConstraint
-	repoClient *repositoryClient
+	platform         *platformConstraint
+	filterExpression *vm.Program
+	verifier         *Verifier
+	repoClient       *repositoryClient
 }
 
 func newBaseSelector(
 	sub kargoapi.ImageSubscription,
 	creds *Credentials,
+	verifier *Verifier,
 ) (*baseSelector, error) {
 	var err error
-	s := &baseSelector{}
+	s := &baseSelector{verifier: verifier}
 	if sub.Platform != "" {
 		if s.platform, err = parsePlatformConstraint(sub.Platform); err != nil {
 			return nil, fmt.Errorf(
@@ -31,6 +39,11 @@ func newBaseSelector(
 			)
 		}
 	}
+	if sub.ExpressionFilter != "" {
+		if s.filterExpression, err = expr.Compile(sub.ExpressionFilter); err != nil {
+			return nil, fmt.Errorf("error compiling filter expression: %w", err)
+		}
+	}
 	repoURL := urls.NormalizeImage(sub.RepoURL)
 	if s.repoClient, err = newRepositoryClient(
 		repoURL,
@@ -53,7 +66,72 @@ func (b *baseSelector) getLoggerContext() []any {
 		"registry", b.repoClient.registry.name,
 		"image", b.repoClient.repoURL,
 		"platformConstrained", b.platform != nil,
+		"expressionFiltered", b.filterExpression != nil,
+		"verified", b.verifier != nil,
+	}
+}
+
+// verify returns a boolean indicating whether the provided image satisfies
+// the selector's verification policy, if any.
+func (b *baseSelector) verify(ctx context.Context, img image) (bool, error) {
+	if b.verifier == nil {
+		return true, nil
+	}
+	return b.verifier.verify(ctx, b.repoClient, img)
+}
+
+// matchesFilterExpression evaluates the selector's filter expression, if any,
+// against the provided image's metadata and returns a boolean indicating
+// whether the image should be considered.
+func (b *baseSelector) matchesFilterExpression(img image) (bool, error) {
+	if b.filterExpression == nil {
+		return true, nil
+	}
+
+	env := map[string]any{
+		"tag":         img.Tag,
+		"digest":      img.Digest,
+		"annotations": img.Annotations,
+		"createdAt":   nil,
+	}
+	if img.CreatedAt != nil {
+		env["createdAt"] = *img.CreatedAt
+	}
+
+	result, err := expr.Run(b.filterExpression, env)
+	if err != nil {
+		return false, fmt.Errorf("error evaluating image filter expression: %w", err)
+	}
+
+	switch result := result.(type) {
+	case bool:
+		return result, nil
+	default:
+		parsedBool, err := strconv.ParseBool(fmt.Sprintf("%v", result))
+		if err != nil {
+			return false, fmt.Errorf("error parsing expression result: %w", err)
+		}
+		return parsedBool, nil
+	}
+}
+
+// filterImagesByExpression returns only those of the provided images that
+// match the selector's filter expression, if any.
+func (b *baseSelector) filterImagesByExpression(images []image) ([]image, error) {
+	if b.filterExpression == nil {
+		return images, nil
+	}
+	filtered := make([]image, 0, len(images))
+	for _, img := range images {
+		matches, err := b.matchesFilterExpression(img)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			filtered = append(filtered, img)
+		}
 	}
+	return slices.Clip(filtered), nil
 }
 
 // imagesToAPIImages converts a slice of internal image to a slice of
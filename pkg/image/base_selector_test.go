@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/expr-lang/expr"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -23,6 +24,13 @@ func TestNewBaseSelector(t *testing.T) {
 				require.ErrorContains(t, err, "error parsing platform constraint")
 			},
 		},
+		{
+			name: "error compiling filter expression",
+			sub:  kargoapi.ImageSubscription{ExpressionFilter: "tag =="},
+			assertions: func(t *testing.T, _ *baseSelector, err error) {
+				require.ErrorContains(t, err, "error compiling filter expression")
+			},
+		},
 		{
 			name: "error creating repository client",
 			sub:  kargoapi.ImageSubscription{}, // No RepoURL
@@ -37,8 +45,9 @@ func TestNewBaseSelector(t *testing.T) {
 		{
 			name: "success",
 			sub: kargoapi.ImageSubscription{
-				RepoURL:  "example/image",
-				Platform: "linux/amd64",
+				RepoURL:          "example/image",
+				Platform:         "linux/amd64",
+				ExpressionFilter: "tag != 'latest'",
 			},
 			assertions: func(t *testing.T, s *baseSelector, err error) {
 				require.NoError(t, err)
@@ -50,13 +59,14 @@ func TestNewBaseSelector(t *testing.T) {
 					},
 					s.platform,
 				)
+				require.NotNil(t, s.filterExpression)
 				require.NotNil(t, s.repoClient)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			s, err := newBaseSelector(testCase.sub, nil)
+			s, err := newBaseSelector(testCase.sub, nil, nil)
 			testCase.assertions(t, s, err)
 		})
 	}
@@ -106,3 +116,116 @@ func Test_baseSelector_imagesToAPIImages(t *testing.T) {
 		apiImages,
 	)
 }
+
+func Test_baseSelector_filterImagesByExpression(t *testing.T) {
+	recent := time.Now().Add(-24 * time.Hour)
+	old := time.Now().Add(-60 * 24 * time.Hour)
+	testImages := []image{
+		{
+			Tag:    "recent-with-source",
+			Digest: "sha256:recent",
+			Annotations: map[string]string{
+				"org.opencontainers.image.source": "https://github.com/example/repo",
+			},
+			CreatedAt: &recent,
+		},
+		{
+			Tag:       "recent-without-source",
+			Digest:    "sha256:recent-without-source",
+			CreatedAt: &recent,
+		},
+		{
+			Tag:    "old-with-source",
+			Digest: "sha256:old",
+			Annotations: map[string]string{
+				"org.opencontainers.image.source": "https://github.com/example/repo",
+			},
+			CreatedAt: &old,
+		},
+		{
+			Tag:    "no-created-at",
+			Digest: "sha256:no-created-at",
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		expression string
+		assertions func(*testing.T, []image, error)
+	}{
+		{
+			name: "no expression",
+			assertions: func(t *testing.T, images []image, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testImages, images)
+			},
+		},
+		{
+			name:       "filter by annotation",
+			expression: `"org.opencontainers.image.source" in annotations`,
+			assertions: func(t *testing.T, images []image, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"recent-with-source", "old-with-source"}, imageTags(images))
+			},
+		},
+		{
+			name:       "filter by creation time",
+			expression: `createdAt != nil && now() - createdAt < duration("720h")`,
+			assertions: func(t *testing.T, images []image, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"recent-with-source", "recent-without-source"}, imageTags(images))
+			},
+		},
+		{
+			name:       "filter by tag and digest",
+			expression: `tag startsWith "old" || digest == "sha256:no-created-at"`,
+			assertions: func(t *testing.T, images []image, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"old-with-source", "no-created-at"}, imageTags(images))
+			},
+		},
+		{
+			name:       "non-boolean result parsed as boolean",
+			expression: `tag == "no-created-at" ? "true" : "false"`,
+			assertions: func(t *testing.T, images []image, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"no-created-at"}, imageTags(images))
+			},
+		},
+		{
+			name:       "result cannot be parsed as boolean",
+			expression: `tag`,
+			assertions: func(t *testing.T, _ []image, err error) {
+				require.ErrorContains(t, err, "error parsing expression result")
+			},
+		},
+		{
+			name:       "error evaluating expression",
+			expression: `annotations.foo.bar()`,
+			assertions: func(t *testing.T, _ []image, err error) {
+				require.ErrorContains(t, err, "error evaluating image filter expression")
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := &baseSelector{}
+			if testCase.expression != "" {
+				var err error
+				s.filterExpression, err = expr.Compile(testCase.expression)
+				require.NoError(t, err)
+			}
+			images, err := s.filterImagesByExpression(testImages)
+			testCase.assertions(t, images, err)
+		})
+	}
+}
+
+func imageTags(images []image) []string {
+	tags := make([]string, len(images))
+	for i, img := range images {
+		tags[i] = img.Tag
+	}
+	return tags
+}
@@ -33,8 +33,9 @@ type digestSelector struct {
 func newDigestSelector(
 	sub kargoapi.ImageSubscription,
 	creds *Credentials,
+	verifier *Verifier,
 ) (Selector, error) {
-	base, err := newBaseSelector(sub, creds)
+	base, err := newBaseSelector(sub, creds, verifier)
 	if err != nil {
 		return nil, fmt.Errorf("error building base selector: %w", err)
 	}
@@ -80,6 +81,24 @@ func (d *digestSelector) Select(
 		return nil, nil
 	}
 
+	matches, err := d.matchesFilterExpression(*img)
+	if err != nil {
+		return nil, fmt.Errorf("error filtering image with tag %q: %w", d.mutableTag, err)
+	}
+	if !matches {
+		logger.Trace("image with tag did not match filter expression")
+		return nil, nil
+	}
+
+	verified, err := d.verify(ctx, *img)
+	if err != nil {
+		return nil, fmt.Errorf("error verifying image with tag %q: %w", d.mutableTag, err)
+	}
+	if !verified {
+		logger.Trace("image with tag did not satisfy verification policy")
+		return nil, nil
+	}
+
 	logger.Trace("found image with tag")
 	return d.imagesToAPIImages([]image{*img}, 0), nil
 }
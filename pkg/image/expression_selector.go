@@ -0,0 +1,116 @@
+package image
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/sortkey"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+func init() {
+	defaultSelectorRegistry.MustRegister(
+		selectorRegistration{
+			Predicate: func(_ context.Context, sub kargoapi.ImageSubscription) (bool, error) {
+				return sub.ImageSelectionStrategy == kargoapi.ImageSelectionStrategyExpression, nil
+			},
+			Value: newExpressionSelector,
+		},
+	)
+}
+
+// expressionSelector implements the Selector interface for
+// kargoapi.ImageSelectionStrategyExpression.
+type expressionSelector struct {
+	*tagBasedSelector
+	sortKey *sortkey.Expression
+}
+
+func newExpressionSelector(
+	sub kargoapi.ImageSubscription,
+	creds *Credentials,
+	verifier *Verifier,
+) (Selector, error) {
+	if sub.SortKeyExpression == "" {
+		return nil, errors.New("sort key expression is required")
+	}
+	tagBased, err := newTagBasedSelector(sub, creds, verifier)
+	if err != nil {
+		return nil, fmt.Errorf("error building tag based selector: %w", err)
+	}
+	s := &expressionSelector{tagBasedSelector: tagBased}
+	if s.sortKey, err = sortkey.Compile(sub.SortKeyExpression); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Select implements the Selector interface.
+func (e *expressionSelector) Select(
+	ctx context.Context,
+) ([]kargoapi.DiscoveredImageReference, error) {
+	loggerCtx := append(
+		e.getLoggerContext(),
+		"selectionStrategy", kargoapi.ImageSelectionStrategyExpression,
+	)
+	logger := logging.LoggerFromContext(ctx).WithValues(loggerCtx...)
+	ctx = logging.ContextWithLogger(ctx, logger)
+
+	logger.Trace("discovering images")
+
+	tags, err := e.repoClient.getTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags: %w", err)
+	}
+	if len(tags) == 0 {
+		logger.Trace("found no tags")
+		return nil, nil
+	}
+	logger.Trace("got all tags")
+
+	tags = e.filterTags(tags)
+	if len(tags) == 0 {
+		logger.Trace("no tags matched criteria")
+		return nil, nil
+	}
+	logger.Trace(
+		"tags matched initial criteria",
+		"count", len(tags),
+	)
+
+	logger.Trace("sorting tags by sort key expression")
+	if tags, err = e.sort(tags); err != nil {
+		return nil, fmt.Errorf("error sorting tags: %w", err)
+	}
+
+	images, err := e.getImagesByTags(ctx, tags)
+	if err != nil {
+		return nil, fmt.Errorf("error getting images by tags: %w", err)
+	}
+
+	if len(images) == 0 {
+		logger.Trace("no images matched criteria")
+		return nil, nil
+	}
+
+	logger.Trace(
+		"discovered images",
+		"count", len(images),
+	)
+
+	return e.imagesToAPIImages(images, e.discoveryLimit), nil
+}
+
+// sort returns the provided tags ordered from greatest to least key, as
+// extracted by the selector's sort key expression. Ties are broken lexically.
+func (e *expressionSelector) sort(tags []string) ([]string, error) {
+	tags = slices.Clone(tags)
+	slices.Sort(tags)
+	slices.Reverse(tags)
+	return sortkey.Sort(e.sortKey, tags, func(tag string) map[string]any {
+		return map[string]any{"tag": tag}
+	})
+}
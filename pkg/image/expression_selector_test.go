@@ -0,0 +1,97 @@
+package image
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewExpressionSelector(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.ImageSubscription
+		assertions func(*testing.T, Selector, error)
+	}{
+		{
+			name: "no sort key expression",
+			sub: kargoapi.ImageSubscription{
+				RepoURL: "example/image",
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "sort key expression is required")
+			},
+		},
+		{
+			name: "error building tag based selector",
+			sub: kargoapi.ImageSubscription{
+				SortKeyExpression: "tag",
+			}, // No RepoURL
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error building tag based selector")
+			},
+		},
+		{
+			name: "error compiling sort key expression",
+			sub: kargoapi.ImageSubscription{
+				RepoURL:           "example/image",
+				SortKeyExpression: "tag +",
+			},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error compiling sort key expression")
+			},
+		},
+		{
+			name: "success",
+			sub: kargoapi.ImageSubscription{
+				RepoURL:           "example/image",
+				SortKeyExpression: "tag",
+			},
+			assertions: func(t *testing.T, s Selector, err error) {
+				require.NoError(t, err)
+				e, ok := s.(*expressionSelector)
+				require.True(t, ok)
+				require.NotNil(t, e.tagBasedSelector)
+				require.NotNil(t, e.sortKey)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := newExpressionSelector(testCase.sub, nil, nil)
+			testCase.assertions(t, s, err)
+		})
+	}
+}
+
+func TestExpressionSelector_sort(t *testing.T) {
+	s, err := newExpressionSelector(
+		kargoapi.ImageSubscription{
+			RepoURL:           "example/image",
+			SortKeyExpression: `[semverParse(split(tag, "-b")[0]), int(split(tag, "-b")[1])]`,
+		},
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+	tags, err := s.(*expressionSelector).sort([]string{
+		"2026.9.30-b1000",
+		"2026.10.3-b99",
+		"2026.10.03-b412",
+		"2026.10.3-b412",
+		"2026.10.1-b500",
+	})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]string{
+			"2026.10.3-b412",
+			"2026.10.03-b412",
+			"2026.10.3-b99",
+			"2026.10.1-b500",
+			"2026.9.30-b1000",
+		},
+		tags,
+	)
+}
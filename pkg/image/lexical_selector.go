@@ -29,8 +29,9 @@ type lexicalSelector struct {
 func newLexicalSelector(
 	sub kargoapi.ImageSubscription,
 	creds *Credentials,
+	verifier *Verifier,
 ) (Selector, error) {
-	tagBased, err := newTagBasedSelector(sub, creds)
+	tagBased, err := newTagBasedSelector(sub, creds, verifier)
 	if err != nil {
 		return nil, fmt.Errorf("error building tag based selector: %w", err)
 	}
@@ -31,8 +31,9 @@ type newestBuildSelector struct {
 func newNewestBuildSelector(
 	sub kargoapi.ImageSubscription,
 	creds *Credentials,
+	verifier *Verifier,
 ) (Selector, error) {
-	tagBased, err := newTagBasedSelector(sub, creds)
+	tagBased, err := newTagBasedSelector(sub, creds, verifier)
 	if err != nil {
 		return nil, fmt.Errorf("error building tag based selector: %w", err)
 	}
@@ -82,9 +83,30 @@ func (n *newestBuildSelector) Select(
 		return nil, nil
 	}
 
+	if images, err = n.filterImagesByExpression(images); err != nil {
+		return nil, fmt.Errorf("error filtering images: %w", err)
+	}
+	if len(images) == 0 {
+		logger.Trace("no images matched filter expression")
+		return nil, nil
+	}
+
 	logger.Trace("sorting images by date")
 	n.sort(images)
 
+	if n.verifier != nil {
+		// Verification is comparatively expensive, so it is only carried out
+		// until enough images satisfying the verification policy have been
+		// found.
+		if images, err = n.verifyImages(ctx, images); err != nil {
+			return nil, fmt.Errorf("error verifying images: %w", err)
+		}
+		if len(images) == 0 {
+			logger.Trace("no images satisfied verification policy")
+			return nil, nil
+		}
+	}
+
 	limit := n.discoveryLimit
 	if limit == 0 || limit > len(images) {
 		limit = len(images)
@@ -181,6 +203,34 @@ func (n *newestBuildSelector) getImagesByTags(
 	return images, nil
 }
 
+// verifyImages verifies the provided, already sorted, images SEQUENTIALLY and
+// discards any that do not satisfy the selector's verification policy. This
+// repeats until the provided images have been exhausted or an amount of
+// verified images equal to the selector's discovery limit has been found.
+func (n *newestBuildSelector) verifyImages(
+	ctx context.Context,
+	images []image,
+) ([]image, error) {
+	limit := n.discoveryLimit
+	if limit == 0 || limit > len(images) {
+		limit = len(images)
+	}
+	verified := make([]image, 0, limit)
+	for _, img := range images {
+		if len(verified) >= limit {
+			break
+		}
+		ok, err := n.verify(ctx, img)
+		if err != nil {
+			return nil, fmt.Errorf("error verifying image with tag %q: %w", img.Tag, err)
+		}
+		if ok {
+			verified = append(verified, img)
+		}
+	}
+	return verified, nil
+}
+
 // sort sorts the provided images in place, in chronologically descending order,
 // breaking ties lexically by tag.
 func (n *newestBuildSelector) sort(images []image) {
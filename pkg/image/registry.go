@@ -2,11 +2,11 @@ package image
 
 import (
 	"sync"
-	"time"
 
 	"github.com/google/go-containerregistry/pkg/name"
-	"github.com/patrickmn/go-cache"
 	"go.uber.org/ratelimit"
+
+	"github.com/akuity/kargo/pkg/controller/discoverycache"
 )
 
 // dockerRegistry is registry configuration for Docker Hub.
@@ -14,11 +14,10 @@ var dockerRegistry = &registry{
 	name:             "Docker Hub",
 	imagePrefix:      name.DefaultRegistry,
 	defaultNamespace: "library",
-	imageCache: cache.New(
-		30*time.Minute, // Default ttl for each entry
-		time.Hour,      // Cleanup interval
-	),
-	rateLimiter: ratelimit.New(10),
+	tagsCache:        discoverycache.New("image_tags"),
+	imageByTagCache:  discoverycache.New("image_manifests_by_tag"),
+	imageCache:       discoverycache.NewImmutable("image_manifests_by_digest"),
+	rateLimiter:      ratelimit.New(10),
 }
 
 var (
@@ -39,19 +38,24 @@ type registry struct {
 	name             string
 	imagePrefix      string
 	defaultNamespace string
-	imageCache       *cache.Cache
-	rateLimiter      ratelimit.Limiter
+	// tagsCache caches the tags of repositories in the registry.
+	tagsCache *discoverycache.Cache
+	// imageByTagCache caches images retrieved by tag. Because tags are
+	// mutable, entries expire relatively quickly.
+	imageByTagCache *discoverycache.Cache
+	// imageCache caches images retrieved by digest.
+	imageCache  *discoverycache.Cache
+	rateLimiter ratelimit.Limiter
 }
 
 // newRegistry initializes and returns a new registry.
 func newRegistry(imagePrefix string) *registry {
 	return &registry{
-		name:        imagePrefix,
-		imagePrefix: imagePrefix,
-		imageCache: cache.New(
-			30*time.Minute, // Default ttl for each entry
-			time.Hour,      // Cleanup interval
-		),
+		name:            imagePrefix,
+		imagePrefix:     imagePrefix,
+		tagsCache:       discoverycache.New("image_tags"),
+		imageByTagCache: discoverycache.New("image_manifests_by_tag"),
+		imageCache:      discoverycache.NewImmutable("image_manifests_by_digest"),
 		// TODO: Make this configurable.
 		rateLimiter: ratelimit.New(20),
 	}
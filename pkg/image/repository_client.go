@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"maps"
 	"net/http"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
@@ -15,10 +17,10 @@ import (
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/types"
 	"github.com/hashicorp/go-cleanhttp"
-	"github.com/patrickmn/go-cache"
 	"go.uber.org/ratelimit"
 	"golang.org/x/sync/semaphore"
 
+	"github.com/akuity/kargo/pkg/controller/discoverycache"
 	"github.com/akuity/kargo/pkg/logging"
 )
 
@@ -51,6 +53,15 @@ type repositoryClient struct {
 	repoRef       name.Reference
 	remoteOptions []remote.Option
 
+	// identity uniquely identifies the combination of repository and options
+	// (including credentials) used to access it. It is used to key cache
+	// entries so that information retrieved by one client is only ever reused
+	// by clients that would have been able to retrieve the same information.
+	identity        string
+	tagsCache       *discoverycache.Cache
+	imageByTagCache *discoverycache.Cache
+	imageCache      *discoverycache.Cache
+
 	// The following behaviors are overridable for testing purposes:
 
 	getImageByTagFn func(
@@ -129,6 +140,15 @@ func newRepositoryClient(
 			}),
 			remote.WithAuth(auth),
 		},
+		identity: discoverycache.Key(
+			repoRef.Context().Name(),
+			creds.Username,
+			creds.Password,
+			strconv.FormatBool(insecureSkipTLSVerify),
+		),
+		tagsCache:       reg.tagsCache,
+		imageByTagCache: reg.imageByTagCache,
+		imageCache:      reg.imageCache,
 	}
 
 	r.getImageByTagFn = r.getImageByTag
@@ -142,21 +162,48 @@ func newRepositoryClient(
 	return r, nil
 }
 
+// getTags lists the tags in the repository. Results are cached briefly, as
+// tags may be added or removed at any time.
 func (r *repositoryClient) getTags(ctx context.Context) ([]string, error) {
-	opts := append(r.remoteOptions, remote.WithContext(ctx))
-	tags, err := r.remoteListFn(r.repoRef.Context(), opts...)
+	tags, err := discoverycache.Get(
+		ctx,
+		r.tagsCache,
+		r.identity,
+		func() ([]string, error) {
+			opts := append(r.remoteOptions, remote.WithContext(ctx))
+			return r.remoteListFn(r.repoRef.Context(), opts...)
+		},
+	)
 	if err != nil {
 		return nil, fmt.Errorf("error listing tags for repo URL %s: %w", r.repoURL, err)
 	}
-	return tags, nil
+	// The cached slice is shared, so return a copy the caller may modify.
+	return slices.Clone(tags), nil
 }
 
-// getImageByTag retrieves an Image by tag. This function uses no cache since
-// tags can be mutable.
+// getImageByTag retrieves an Image by tag. Results are cached only briefly
+// since tags can be mutable.
 func (r *repositoryClient) getImageByTag(
 	ctx context.Context,
 	tag string,
 	platform *platformConstraint,
+) (*image, error) {
+	img, err := discoverycache.Get(
+		ctx,
+		r.imageByTagCache,
+		discoverycache.Key(r.identity, tag, platformCacheKey(platform)),
+		func() (*image, error) {
+			return r.getImageByTagFromRemote(ctx, tag, platform)
+		},
+	)
+	return copyImage(img), err
+}
+
+// getImageByTagFromRemote retrieves an Image by tag from the repository.
+func (r *repositoryClient) getImageByTagFromRemote(
+	ctx context.Context,
+	tag string,
+	platform *platformConstraint,
 ) (*image, error) {
 	repoRef := r.repoRef.Context().Tag(tag)
 	opts := append(r.remoteOptions, remote.WithContext(ctx))
@@ -193,16 +240,28 @@ func (r *repositoryClient) getImageByDigest(
 		"digest", digest,
 	)
 
-	if entry, exists := r.registry.imageCache.Get(digest); exists {
-		img := entry.(image) // nolint: forcetypeassert
-		return &img, nil
-	}
-
-	logger.Trace(
-		"image NOT found in cache",
-		"digest", digest,
+	img, err := discoverycache.Get(
+		ctx,
+		r.imageCache,
+		discoverycache.Key(r.identity, digest, platformCacheKey(platform)),
+		func() (*image, error) {
+			logger.Trace(
+				"image NOT found in cache",
+				"digest", digest,
+			)
+			return r.getImageByDigestFromRemote(ctx, digest, platform)
+		},
 	)
+	return copyImage(img), err
+}
 
+// getImageByDigestFromRemote retrieves an Image for a given digest from the
+// repository.
+func (r *repositoryClient) getImageByDigestFromRemote(
+	ctx context.Context,
+	digest string,
+	platform *platformConstraint,
+) (*image, error) {
 	repoRef := r.repoRef.Context().Digest(digest)
 	opts := append(r.remoteOptions, remote.WithContext(ctx))
 	desc, err := r.remoteGetFn(repoRef, opts...)
@@ -221,16 +280,27 @@ func (r *repositoryClient) getImageByDigest(
 		)
 	}
 
-	if img != nil {
-		// Cache the image
-		r.registry.imageCache.Set(digest, *img, cache.DefaultExpiration)
-		logger.Trace(
-			"cached image",
-			"digest", digest,
-		)
+	return img, nil
+}
+
+// copyImage returns a shallow copy of the provided image, or nil if it is nil.
+// Images may be cached and shared between callers, so callers must only ever
+// be given copies, which they are then free to modify.
+func copyImage(img *image) *image {
+	if img == nil {
+		return nil
 	}
+	cp := *img
+	return &cp
+}
 
-	return img, nil
+// platformCacheKey returns a string representation of the provided platform
+// constraint, or an empty string if it is nil, for use in cache keys.
+func platformCacheKey(platform *platformConstraint) string {
+	if platform == nil {
+		return ""
+	}
+	return platform.String()
 }
 
 // getImageFromRemoteDesc gets an Image from a given remote.Descriptor.
@@ -10,9 +10,10 @@ import (
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/types"
-	"github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/require"
 	"k8s.io/utils/ptr"
+
+	"github.com/akuity/kargo/pkg/controller/discoverycache"
 )
 
 func TestNewRepositoryClient(t *testing.T) {
@@ -32,6 +33,32 @@ func TestNewRepositoryClient(t *testing.T) {
 	require.NotNil(t, client.remoteGetFn)
 }
 
+func Test_repositoryClient_getTags(t *testing.T) {
+	testRepoRef, err := name.ParseReference("fake-url")
+	require.NoError(t, err)
+
+	var calls int
+	client := &repositoryClient{
+		repoRef:   testRepoRef,
+		tagsCache: discoverycache.New("test"),
+		remoteListFn: func(name.Repository, ...remote.Option) ([]string, error) {
+			calls++
+			return []string{"a", "b"}, nil
+		},
+	}
+
+	tags, err := client.getTags(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, tags)
+	// Modifying the returned tags should not affect the cached tags
+	tags[0] = "c"
+
+	tags, err = client.getTags(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, tags)
+	require.Equal(t, 1, calls)
+}
+
 func Test_repositoryClient_getImageByTag(t *testing.T) {
 	const testRepoURL = "fake-url"
 	const testTag = "fake-tag"
@@ -136,14 +163,14 @@ func Test_repositoryClient_getImageByDigest(t *testing.T) {
 		CreatedAt: ptr.To(time.Now().UTC()),
 	}
 
-	testRegistry := &registry{
-		imageCache: cache.New(0, 0),
-	}
-	testRegistry.imageCache.Set(
-		testImage.Digest,
-		testImage,
-		cache.DefaultExpiration,
+	testCache := discoverycache.NewImmutable("test")
+	_, err = discoverycache.Get(
+		context.Background(),
+		testCache,
+		discoverycache.Key("", testDigest, ""),
+		func() (*image, error) { return &testImage, nil },
 	)
+	require.NoError(t, err)
 
 	testCases := []struct {
 		name       string
@@ -153,7 +180,7 @@ func Test_repositoryClient_getImageByDigest(t *testing.T) {
 		{
 			name: "cache hit",
 			client: &repositoryClient{
-				registry: testRegistry,
+				imageCache: testCache,
 			},
 			assertions: func(t *testing.T, img *image, err error) {
 				require.NoError(t, err)
@@ -163,10 +190,8 @@ func Test_repositoryClient_getImageByDigest(t *testing.T) {
 		{
 			name: "error getting descriptor by digest",
 			client: &repositoryClient{
-				repoRef: testRepoRef,
-				registry: &registry{
-					imageCache: cache.New(30*time.Minute, time.Hour),
-				},
+				repoRef:    testRepoRef,
+				imageCache: discoverycache.NewImmutable("test"),
 				remoteGetFn: func(
 					name.Reference, ...remote.Option,
 				) (*remote.Descriptor, error) {
@@ -181,10 +206,8 @@ func Test_repositoryClient_getImageByDigest(t *testing.T) {
 		{
 			name: "error getting image from descriptor",
 			client: &repositoryClient{
-				repoRef: testRepoRef,
-				registry: &registry{
-					imageCache: cache.New(30*time.Minute, time.Hour),
-				},
+				repoRef:    testRepoRef,
+				imageCache: discoverycache.NewImmutable("test"),
 				remoteGetFn: func(
 					name.Reference, ...remote.Option,
 				) (*remote.Descriptor, error) {
@@ -204,10 +227,8 @@ func Test_repositoryClient_getImageByDigest(t *testing.T) {
 		{
 			name: "success",
 			client: &repositoryClient{
-				repoRef: testRepoRef,
-				registry: &registry{
-					imageCache: cache.New(30*time.Minute, time.Hour),
-				},
+				repoRef:    testRepoRef,
+				imageCache: discoverycache.NewImmutable("test"),
 				remoteGetFn: func(
 					name.Reference, ...remote.Option,
 				) (*remote.Descriptor, error) {
@@ -40,6 +40,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -58,6 +59,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -83,6 +85,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -102,6 +105,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -125,6 +129,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -142,6 +147,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -171,6 +177,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit: 1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -190,6 +197,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -213,6 +221,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -231,6 +240,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -255,6 +265,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -274,6 +285,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -297,6 +309,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -315,6 +328,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -343,6 +357,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -362,6 +377,7 @@ func TestSelectImageDockerHub(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			getDockerHubCreds(),
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -36,6 +36,7 @@ func TestSelectImageGHCR(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			nil,
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -61,6 +62,7 @@ func TestSelectImageGHCR(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			nil,
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -83,6 +85,7 @@ func TestSelectImageGHCR(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			nil,
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -105,6 +108,7 @@ func TestSelectImageGHCR(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			nil,
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -127,6 +131,7 @@ func TestSelectImageGHCR(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			nil,
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -151,6 +156,7 @@ func TestSelectImageGHCR(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			nil,
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -173,6 +179,7 @@ func TestSelectImageGHCR(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			nil,
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -200,6 +207,7 @@ func TestSelectImageGHCR(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			nil,
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -231,6 +239,7 @@ func TestSelectImageGHCR(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			nil,
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -255,6 +264,7 @@ func TestSelectImageGHCR(t *testing.T) {
 				DiscoveryLimit:         1,
 			},
 			nil,
+			nil,
 		)
 		require.NoError(t, err)
 
@@ -21,6 +21,7 @@ type (
 	selectorFactory = func(
 		kargoapi.ImageSubscription,
 		*Credentials,
+		*Verifier,
 	) (Selector, error)
 
 	// selectorRegistration associates a selectorPredicate with a selectorFactory.
@@ -39,8 +39,9 @@ func compileRegexes(regexStrs []string) ([]*regexp.Regexp, error) {
 func newTagBasedSelector(
 	sub kargoapi.ImageSubscription,
 	creds *Credentials,
+	verifier *Verifier,
 ) (*tagBasedSelector, error) {
-	base, err := newBaseSelector(sub, creds)
+	base, err := newBaseSelector(sub, creds, verifier)
 	if err != nil {
 		return nil, fmt.Errorf("error building base selector: %w", err)
 	}
@@ -135,7 +136,8 @@ func (t *tagBasedSelector) filterTags(tags []string) []string {
 }
 
 // getImagesByTags retrieves image metadata for the provided tags SEQUENTIALLY.
-// It discards any that does not match the selector's criteria. This repeats
+// It discards any that does not match the selector's criteria, including its
+// filter expression and verification policy, if any. This repeats
 // until the list of provided tags has been exhausted or it has found an amount
 // of image metadata equal to the selector's discovery limit.
 func (t *tagBasedSelector) getImagesByTags(
@@ -165,6 +167,28 @@ func (t *tagBasedSelector) getImagesByTags(
 			)
 			continue
 		}
+		matches, err := t.matchesFilterExpression(*image)
+		if err != nil {
+			return nil, fmt.Errorf("error filtering image with tag %q: %w", tag, err)
+		}
+		if !matches {
+			logger.Trace(
+				"image was found, but did not match filter expression",
+				"tag", tag,
+			)
+			continue
+		}
+		verified, err := t.verify(ctx, *image)
+		if err != nil {
+			return nil, fmt.Errorf("error verifying image with tag %q: %w", tag, err)
+		}
+		if !verified {
+			logger.Trace(
+				"image was found, but did not satisfy verification policy",
+				"tag", tag,
+			)
+			continue
+		}
 
 		logger.Trace(
 			"discovered image",
@@ -0,0 +1,714 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	protobundle "github.com/sigstore/protobuf-specs/gen/pb-go/bundle/v1"
+	protocommon "github.com/sigstore/protobuf-specs/gen/pb-go/common/v1"
+	protodsse "github.com/sigstore/protobuf-specs/gen/pb-go/dsse"
+	protorekor "github.com/sigstore/protobuf-specs/gen/pb-go/rekor/v1"
+	"github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/sigstore/sigstore-go/pkg/root"
+	"github.com/sigstore/sigstore-go/pkg/verify"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+const (
+	// cosignSignatureAnnotation is the annotation cosign uses to store a
+	// base64-encoded signature of a simple signing payload.
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// cosignCertificateAnnotation is the annotation cosign uses to store the
+	// PEM-encoded certificate used to produce a keyless signature.
+	cosignCertificateAnnotation = "dev.sigstore.cosign/certificate"
+	// cosignBundleAnnotation is the annotation cosign uses to store the
+	// transparency log bundle for a signature.
+	cosignBundleAnnotation = "dev.sigstore.cosign/bundle"
+
+	// inTotoPayloadType is the DSSE payload type of an in-toto statement.
+	inTotoPayloadType = "application/vnd.in-toto+json"
+
+	// sigstoreBundleMediaType is the media type of the Sigstore bundles that
+	// keyless signatures stored by cosign are converted to for verification.
+	// This version of the bundle format relies on the transparency log's signed
+	// entry timestamp, which is exactly what cosign stores.
+	sigstoreBundleMediaType = "application/vnd.dev.sigstore.bundle+json;version=0.1"
+
+	// maxRecordedRejections is the maximum number of rejected images a Verifier
+	// will record. This keeps the size of the Warehouse's status in check when
+	// a repository contains many images that do not satisfy a policy.
+	maxRecordedRejections = 20
+)
+
+// VerificationMaterial holds the PEM-encoded key material referenced by a
+// kargoapi.ImageVerificationPolicy, as resolved from Secrets.
+type VerificationMaterial struct {
+	// PublicKeys is a list of PEM-encoded public keys.
+	PublicKeys [][]byte
+	// TrustedRoots contains PEM-encoded certificates of the certificate
+	// authorities trusted to issue keyless signing certificates. Self-signed
+	// certificates are treated as roots and all others as intermediates.
+	TrustedRoots []byte
+	// TransparencyLogKeys contains PEM-encoded public keys of the transparency
+	// logs trusted to attest to the time at which a keyless signature was made.
+	// These are required for keyless verification.
+	TransparencyLogKeys []byte
+}
+
+// Verifier verifies the cosign signatures and attestations of images against
+// a kargoapi.ImageVerificationPolicy. It records any images it rejects so
+// that they can be reported to the user. A Verifier is safe for concurrent
+// use.
+type Verifier struct {
+	publicKeys           []crypto.PublicKey
+	keyless              *verify.Verifier
+	identities           []verify.PolicyOption
+	requiredAttestations []string
+
+	mu       sync.Mutex
+	rejected []kargoapi.RejectedImageReference
+}
+
+// NewVerifier returns a Verifier that enforces the provided policy using the
+// provided key material.
+func NewVerifier(
+	policy kargoapi.ImageVerificationPolicy,
+	material VerificationMaterial,
+) (*Verifier, error) {
+	v := &Verifier{
+		requiredAttestations: policy.RequiredAttestations,
+	}
+	for i, keyPEM := range material.PublicKeys {
+		keys, err := parsePublicKeys(keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing public key %d: %w", i, err)
+		}
+		v.publicKeys = append(v.publicKeys, keys...)
+	}
+	if policy.Keyless != nil {
+		var err error
+		if v.keyless, err = newKeylessVerifier(material); err != nil {
+			return nil, err
+		}
+		for _, id := range policy.Keyless.Identities {
+			certID, err := verify.NewShortCertificateIdentity("", id.IssuerRegex, "", id.SubjectRegex)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"error compiling identity (issuer regex %q, subject regex %q): %w",
+					id.IssuerRegex, id.SubjectRegex, err,
+				)
+			}
+			v.identities = append(v.identities, verify.WithCertificateIdentity(certID))
+		}
+	}
+	if len(v.publicKeys) == 0 && len(v.identities) == 0 {
+		return nil, errors.New("no public keys or keyless identities were specified")
+	}
+	return v, nil
+}
+
+// newKeylessVerifier returns a verify.Verifier that verifies keyless
+// signatures using the provided key material. Short-lived signing certificates
+// can only be validated at the time a signature was made, so every keyless
+// signature must have been recorded in a trusted transparency log, whose
+// signed entry timestamp attests to that time.
+func newKeylessVerifier(material VerificationMaterial) (*verify.Verifier, error) {
+	roots, intermediates, err := parseTrustedRoots(material.TrustedRoots)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing trusted roots: %w", err)
+	}
+	cas := make([]root.CertificateAuthority, len(roots))
+	for i, r := range roots {
+		cas[i] = &root.FulcioCertificateAuthority{
+			Root:          r,
+			Intermediates: intermediates,
+		}
+	}
+	if len(material.TransparencyLogKeys) == 0 {
+		return nil, errors.New(
+			"keyless verification requires at least one transparency log key",
+		)
+	}
+	tlogKeys, err := parsePublicKeys(material.TransparencyLogKeys)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing transparency log keys: %w", err)
+	}
+	tlogs := make(map[string]*root.TransparencyLog, len(tlogKeys))
+	for _, key := range tlogKeys {
+		der, err := x509.MarshalPKIXPublicKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding transparency log key: %w", err)
+		}
+		// A transparency log is identified by the SHA-256 digest of its key.
+		id := sha256.Sum256(der)
+		tlogs[hex.EncodeToString(id[:])] = &root.TransparencyLog{
+			ID:        id[:],
+			PublicKey: key,
+			// The key is trusted for as long as it is configured.
+			ValidityPeriodStart: time.Unix(0, 0),
+			HashFunc:            crypto.SHA256,
+			SignatureHashFunc:   crypto.SHA256,
+		}
+	}
+	trustedRoot, err := root.NewTrustedRoot(root.TrustedRootMediaType01, cas, nil, nil, tlogs)
+	if err != nil {
+		return nil, fmt.Errorf("error building trusted root: %w", err)
+	}
+	return verify.NewVerifier(
+		trustedRoot,
+		verify.WithTransparencyLog(1),
+		verify.WithIntegratedTimestamps(1),
+	)
+}
+
+// Rejected returns the images rejected by the Verifier so far.
+func (v *Verifier) Rejected() []kargoapi.RejectedImageReference {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return slices.Clone(v.rejected)
+}
+
+// verify returns a boolean indicating whether the provided image, retrieved
+// from the repository the provided client interacts with, satisfies the
+// Verifier's policy. Images that do not satisfy the policy are recorded as
+// rejected. An error is returned only if verification could not be carried
+// out at all.
+func (v *Verifier) verify(
+	ctx context.Context,
+	repo *repositoryClient,
+	img image,
+) (bool, error) {
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"tag", img.Tag,
+		"digest", img.Digest,
+	)
+	reason, err := v.check(ctx, repo, img)
+	if err != nil {
+		return false, err
+	}
+	if reason == "" {
+		logger.Trace("image satisfied verification policy")
+		return true, nil
+	}
+	logger.Debug("image did not satisfy verification policy", "reason", reason)
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if len(v.rejected) < maxRecordedRejections {
+		v.rejected = append(v.rejected, kargoapi.RejectedImageReference{
+			Tag:    img.Tag,
+			Digest: img.Digest,
+			Reason: reason,
+		})
+	}
+	return false, nil
+}
+
+// check carries out verification of the provided image and returns the
+// reason it does not satisfy the Verifier's policy, or an empty string if it
+// does.
+func (v *Verifier) check(
+	ctx context.Context,
+	repo *repositoryClient,
+	img image,
+) (string, error) {
+	digest, err := v1.NewHash(img.Digest)
+	if err != nil {
+		return "", fmt.Errorf("error parsing digest %q: %w", img.Digest, err)
+	}
+
+	sigLayers, err := getCosignLayers(ctx, repo, digest, "sig")
+	if err != nil {
+		return "", fmt.Errorf("error retrieving signatures for image %q: %w", img.Digest, err)
+	}
+	if len(sigLayers) == 0 {
+		return "no signatures found", nil
+	}
+	var signed bool
+	var sigErrs []string
+	for _, layer := range sigLayers {
+		if err = v.verifySignatureLayer(layer, digest); err != nil {
+			sigErrs = append(sigErrs, err.Error())
+			continue
+		}
+		signed = true
+		break
+	}
+	if !signed {
+		return fmt.Sprintf("no valid signatures found: %s", strings.Join(sigErrs, "; ")), nil
+	}
+
+	if len(v.requiredAttestations) == 0 {
+		return "", nil
+	}
+	attLayers, err := getCosignLayers(ctx, repo, digest, "att")
+	if err != nil {
+		return "", fmt.Errorf("error retrieving attestations for image %q: %w", img.Digest, err)
+	}
+	attested := map[string]struct{}{}
+	for _, layer := range attLayers {
+		predicateType, err := v.verifyAttestationLayer(layer, digest)
+		if err != nil {
+			logging.LoggerFromContext(ctx).Trace(
+				"attestation could not be verified",
+				"digest", img.Digest,
+				"error", err.Error(),
+			)
+			continue
+		}
+		attested[predicateType] = struct{}{}
+	}
+	var missing []string
+	for _, predicateType := range v.requiredAttestations {
+		if _, ok := attested[predicateType]; !ok {
+			missing = append(missing, predicateType)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Sprintf(
+			"no valid attestations found for predicate types: %s",
+			strings.Join(missing, ", "),
+		), nil
+	}
+	return "", nil
+}
+
+// cosignLayer is a layer of a cosign signature or attestation manifest.
+type cosignLayer struct {
+	annotations map[string]string
+	content     []byte
+}
+
+// getCosignLayers retrieves the layers of the manifest cosign stores at the
+// tag derived from the provided image digest and suffix (e.g. "sig" or
+// "att"). If no such manifest exists, nil is returned.
+func getCosignLayers(
+	ctx context.Context,
+	repo *repositoryClient,
+	digest v1.Hash,
+	suffix string,
+) ([]cosignLayer, error) {
+	ref := repo.repoRef.Context().Tag(
+		fmt.Sprintf("%s-%s.%s", digest.Algorithm, digest.Hex, suffix),
+	)
+	opts := append(repo.remoteOptions, remote.WithContext(ctx))
+	img, err := remote.Image(ref, opts...)
+	if err != nil {
+		var te *transport.Error
+		if errors.As(err, &te) && te.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving manifest %q: %w", ref, err)
+	}
+	layers := make([]cosignLayer, 0, len(manifest.Layers))
+	for _, desc := range manifest.Layers {
+		layer, err := img.LayerByDigest(desc.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving layer %q of %q: %w", desc.Digest, ref, err)
+		}
+		// These layers are not compressed, so their "compressed" form is their
+		// raw content.
+		rc, err := layer.Compressed()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving layer %q of %q: %w", desc.Digest, ref, err)
+		}
+		content, err := io.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error reading layer %q of %q: %w", desc.Digest, ref, err)
+		}
+		layers = append(layers, cosignLayer{
+			annotations: desc.Annotations,
+			content:     content,
+		})
+	}
+	return layers, nil
+}
+
+// simpleSigningPayload is the subset of a cosign simple signing payload that
+// is relevant to verification.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// verifySignatureLayer verifies that the provided layer of a cosign signature
+// manifest is a valid signature of the image with the provided digest.
+func (v *Verifier) verifySignatureLayer(layer cosignLayer, digest v1.Hash) error {
+	payload := simpleSigningPayload{}
+	if err := json.Unmarshal(layer.content, &payload); err != nil {
+		return fmt.Errorf("error unmarshaling signature payload: %w", err)
+	}
+	if payload.Critical.Image.DockerManifestDigest != digest.String() {
+		return fmt.Errorf(
+			"signature is for digest %q",
+			payload.Critical.Image.DockerManifestDigest,
+		)
+	}
+	sig, err := base64.StdEncoding.DecodeString(layer.annotations[cosignSignatureAnnotation])
+	if err != nil {
+		return fmt.Errorf("error decoding signature: %w", err)
+	}
+	if v.isKeyless(layer.annotations) {
+		payloadDigest := sha256.Sum256(layer.content)
+		_, err = v.verifyKeyless(
+			layer.annotations,
+			&protobundle.Bundle{
+				Content: &protobundle.Bundle_MessageSignature{
+					MessageSignature: &protocommon.MessageSignature{
+						MessageDigest: &protocommon.HashOutput{
+							Algorithm: protocommon.HashAlgorithm_SHA2_256,
+							Digest:    payloadDigest[:],
+						},
+						Signature: sig,
+					},
+				},
+			},
+			verify.WithArtifact(bytes.NewReader(layer.content)),
+		)
+		return err
+	}
+	return v.verifyWithPublicKeys(layer.content, sig)
+}
+
+// dsseEnvelope is a DSSE envelope, as used by cosign to store attestations.
+type dsseEnvelope struct {
+	PayloadType string `json:"payloadType"`
+	Payload     []byte `json:"payload"`
+	Signatures  []struct {
+		Sig []byte `json:"sig"`
+	} `json:"signatures"`
+}
+
+// inTotoStatement is the subset of an in-toto statement that is relevant to
+// verification.
+type inTotoStatement struct {
+	PredicateType string `json:"predicateType"`
+	Subject       []struct {
+		Digest map[string]string `json:"digest"`
+	} `json:"subject"`
+}
+
+// verifyAttestationLayer verifies that the provided layer of a cosign
+// attestation manifest is a valid attestation about the image with the
+// provided digest and returns the attestation's predicate type.
+func (v *Verifier) verifyAttestationLayer(layer cosignLayer, digest v1.Hash) (string, error) {
+	envelope := dsseEnvelope{}
+	if err := json.Unmarshal(layer.content, &envelope); err != nil {
+		return "", fmt.Errorf("error unmarshaling attestation envelope: %w", err)
+	}
+	if envelope.PayloadType != inTotoPayloadType {
+		return "", fmt.Errorf("unsupported payload type %q", envelope.PayloadType)
+	}
+	if v.isKeyless(layer.annotations) {
+		return v.verifyKeylessAttestation(layer.annotations, envelope, digest)
+	}
+	pae := dssePAE(envelope.PayloadType, envelope.Payload)
+	var sigErrs []string
+	var verified bool
+	for _, sig := range envelope.Signatures {
+		if err := v.verifyWithPublicKeys(pae, sig.Sig); err != nil {
+			sigErrs = append(sigErrs, err.Error())
+			continue
+		}
+		verified = true
+		break
+	}
+	if !verified {
+		return "", fmt.Errorf("no valid signatures found: %s", strings.Join(sigErrs, "; "))
+	}
+	statement := inTotoStatement{}
+	if err := json.Unmarshal(envelope.Payload, &statement); err != nil {
+		return "", fmt.Errorf("error unmarshaling attestation statement: %w", err)
+	}
+	for _, subject := range statement.Subject {
+		if subject.Digest[digest.Algorithm] == digest.Hex {
+			return statement.PredicateType, nil
+		}
+	}
+	return "", errors.New("attestation is not about the image")
+}
+
+// verifyKeylessAttestation verifies that the provided DSSE envelope, which
+// was signed using the signing certificate recorded in the provided
+// annotations, contains a valid attestation about the image with the provided
+// digest and returns the attestation's predicate type.
+func (v *Verifier) verifyKeylessAttestation(
+	annotations map[string]string,
+	envelope dsseEnvelope,
+	digest v1.Hash,
+) (string, error) {
+	imageDigest, err := hex.DecodeString(digest.Hex)
+	if err != nil {
+		return "", fmt.Errorf("error decoding digest %q: %w", digest, err)
+	}
+	sigs := make([]*protodsse.Signature, len(envelope.Signatures))
+	for i, sig := range envelope.Signatures {
+		sigs[i] = &protodsse.Signature{Sig: sig.Sig}
+	}
+	// The artifact digest is compared to the digests of the statement's
+	// subjects, which ensures the attestation is about the image.
+	res, err := v.verifyKeyless(
+		annotations,
+		&protobundle.Bundle{
+			Content: &protobundle.Bundle_DsseEnvelope{
+				DsseEnvelope: &protodsse.Envelope{
+					Payload:     envelope.Payload,
+					PayloadType: envelope.PayloadType,
+					Signatures:  sigs,
+				},
+			},
+		},
+		verify.WithArtifactDigest(digest.Algorithm, imageDigest),
+	)
+	if err != nil {
+		return "", err
+	}
+	return res.Statement.GetPredicateType(), nil
+}
+
+// dssePAE returns the DSSE pre-authentication encoding of the provided payload
+// type and payload, which is what a DSSE signature is computed over.
+func dssePAE(payloadType string, payload []byte) []byte {
+	return fmt.Appendf(
+		nil,
+		"DSSEv1 %d %s %d %s",
+		len(payloadType), payloadType, len(payload), payload,
+	)
+}
+
+// isKeyless returns a boolean indicating whether the signature with the
+// provided annotations is a keyless signature that the Verifier is expected
+// to verify.
+func (v *Verifier) isKeyless(annotations map[string]string) bool {
+	return v.keyless != nil && annotations[cosignCertificateAnnotation] != ""
+}
+
+// verifyWithPublicKeys verifies the provided signature of the provided
+// message using the Verifier's public keys.
+func (v *Verifier) verifyWithPublicKeys(msg []byte, sig []byte) error {
+	if len(v.publicKeys) == 0 {
+		return errors.New("signature was not made with a trusted identity")
+	}
+	for _, key := range v.publicKeys {
+		if err := verifyWithPublicKey(key, msg, sig); err == nil {
+			return nil
+		}
+	}
+	return errors.New("signature could not be verified with any trusted public key")
+}
+
+// verifyKeyless completes the provided Sigstore bundle, whose content must
+// already be set, using the signing certificate and transparency log bundle
+// recorded by cosign in the provided annotations. It then verifies the bundle
+// against the Verifier's keyless identities and the provided artifact policy.
+// Among other things, this verifies that the transparency log entry pertains
+// to the signature and the signing certificate, and that the certificate was
+// valid at the time the entry was integrated into the log.
+func (v *Verifier) verifyKeyless(
+	annotations map[string]string,
+	b *protobundle.Bundle,
+	artifactPolicy verify.ArtifactPolicyOption,
+) (*verify.VerificationResult, error) {
+	certs, err := parseCertificates([]byte(annotations[cosignCertificateAnnotation]))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing signing certificate: %w", err)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM-encoded signing certificate found")
+	}
+	tlogEntry, err := newTransparencyLogEntry(annotations[cosignBundleAnnotation])
+	if err != nil {
+		return nil, err
+	}
+	b.MediaType = sigstoreBundleMediaType
+	b.VerificationMaterial = &protobundle.VerificationMaterial{
+		Content: &protobundle.VerificationMaterial_X509CertificateChain{
+			X509CertificateChain: &protocommon.X509CertificateChain{
+				Certificates: []*protocommon.X509Certificate{{RawBytes: certs[0].Raw}},
+			},
+		},
+		TlogEntries: []*protorekor.TransparencyLogEntry{tlogEntry},
+	}
+	entity, err := bundle.NewBundle(b)
+	if err != nil {
+		return nil, fmt.Errorf("error building Sigstore bundle: %w", err)
+	}
+	res, err := v.keyless.Verify(entity, verify.NewPolicy(artifactPolicy, v.identities...))
+	if err != nil {
+		return nil, fmt.Errorf("error verifying keyless signature: %w", err)
+	}
+	return res, nil
+}
+
+// rekorBundle is a transparency log bundle, as stored by cosign.
+type rekorBundle struct {
+	SignedEntryTimestamp []byte `json:"SignedEntryTimestamp"`
+	Payload              struct {
+		Body           string `json:"body"`
+		IntegratedTime int64  `json:"integratedTime"`
+		LogIndex       int64  `json:"logIndex"`
+		LogID          string `json:"logID"`
+	} `json:"Payload"`
+}
+
+// newTransparencyLogEntry converts the provided transparency log bundle, as
+// stored by cosign, into a Sigstore transparency log entry.
+func newTransparencyLogEntry(bundleJSON string) (*protorekor.TransparencyLogEntry, error) {
+	if bundleJSON == "" {
+		return nil, errors.New("signature has no transparency log bundle")
+	}
+	rb := rekorBundle{}
+	if err := json.Unmarshal([]byte(bundleJSON), &rb); err != nil {
+		return nil, fmt.Errorf("error unmarshaling transparency log bundle: %w", err)
+	}
+	body, err := base64.StdEncoding.DecodeString(rb.Payload.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding transparency log entry: %w", err)
+	}
+	kindVersion := struct {
+		Kind       string `json:"kind"`
+		APIVersion string `json:"apiVersion"`
+	}{}
+	if err = json.Unmarshal(body, &kindVersion); err != nil {
+		return nil, fmt.Errorf("error unmarshaling transparency log entry: %w", err)
+	}
+	logID, err := hex.DecodeString(rb.Payload.LogID)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding transparency log ID: %w", err)
+	}
+	return &protorekor.TransparencyLogEntry{
+		LogIndex: rb.Payload.LogIndex,
+		LogId:    &protocommon.LogId{KeyId: logID},
+		KindVersion: &protorekor.KindVersion{
+			Kind:    kindVersion.Kind,
+			Version: kindVersion.APIVersion,
+		},
+		IntegratedTime: rb.Payload.IntegratedTime,
+		InclusionPromise: &protorekor.InclusionPromise{
+			SignedEntryTimestamp: rb.SignedEntryTimestamp,
+		},
+		CanonicalizedBody: body,
+	}, nil
+}
+
+// verifyWithPublicKey verifies the provided signature of the provided message
+// using the provided public key. With the exception of Ed25519 keys, the
+// message is hashed using SHA-256, as cosign does by default.
+func verifyWithPublicKey(key crypto.PublicKey, msg []byte, sig []byte) error {
+	digest := sha256.Sum256(msg)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, digest[:], sig) {
+			return errors.New("invalid ECDSA signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig)
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, msg, sig) {
+			return errors.New("invalid Ed25519 signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+// parsePublicKeys parses all PEM-encoded public keys from the provided data.
+func parsePublicKeys(data []byte) ([]crypto.PublicKey, error) {
+	var keys []crypto.PublicKey
+	for {
+		var block *pem.Block
+		if block, data = pem.Decode(data); block == nil {
+			break
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no PEM-encoded public keys found")
+	}
+	return keys, nil
+}
+
+// parseCertificates parses all PEM-encoded certificates from the provided
+// data.
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		if block, data = pem.Decode(data); block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+// parseTrustedRoots parses all PEM-encoded certificates from the provided data
+// into self-signed root certificates and intermediate certificates.
+func parseTrustedRoots(data []byte) ([]*x509.Certificate, []*x509.Certificate, error) {
+	certs, err := parseCertificates(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	var roots, intermediates []*x509.Certificate
+	for _, cert := range certs {
+		if isSelfSigned(cert) {
+			roots = append(roots, cert)
+		} else {
+			intermediates = append(intermediates, cert)
+		}
+	}
+	if len(roots) == 0 {
+		return nil, nil, errors.New("no PEM-encoded root certificates found")
+	}
+	return roots, intermediates, nil
+}
+
+// isSelfSigned returns a boolean indicating whether the provided certificate
+// is self-signed.
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawIssuer, cert.RawSubject) &&
+		cert.CheckSignatureFrom(cert) == nil
+}
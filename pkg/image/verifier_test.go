@@ -0,0 +1,891 @@
+package image
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const testProvenanceType = "https://slsa.dev/provenance/v1"
+
+// testOIDCIssuerOID is the OID of the certificate extension in which Fulcio
+// records the OIDC issuer as a DER-encoded UTF8String.
+var testOIDCIssuerOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+
+func TestNewVerifier(t *testing.T) {
+	key := newTestKey(t)
+	tlogKey := newTestKey(t)
+	ca := newTestCA(t)
+
+	testCases := []struct {
+		name       string
+		policy     kargoapi.ImageVerificationPolicy
+		material   VerificationMaterial
+		assertions func(*testing.T, *Verifier, error)
+	}{
+		{
+			name: "invalid public key",
+			policy: kargoapi.ImageVerificationPolicy{
+				PublicKeys: []kargoapi.ImageVerificationPublicKey{{}},
+			},
+			material: VerificationMaterial{PublicKeys: [][]byte{[]byte("invalid")}},
+			assertions: func(t *testing.T, _ *Verifier, err error) {
+				require.ErrorContains(t, err, "error parsing public key 0")
+			},
+		},
+		{
+			name: "no trusted roots",
+			policy: kargoapi.ImageVerificationPolicy{
+				Keyless: &kargoapi.KeylessVerification{},
+			},
+			assertions: func(t *testing.T, _ *Verifier, err error) {
+				require.ErrorContains(t, err, "error parsing trusted roots")
+			},
+		},
+		{
+			name: "no transparency log keys",
+			policy: kargoapi.ImageVerificationPolicy{
+				Keyless: &kargoapi.KeylessVerification{
+					Identities: []kargoapi.KeylessIdentity{{
+						IssuerRegex:  ".*",
+						SubjectRegex: ".*",
+					}},
+				},
+			},
+			material: VerificationMaterial{TrustedRoots: ca.certPEM},
+			assertions: func(t *testing.T, _ *Verifier, err error) {
+				require.ErrorContains(t, err, "requires at least one transparency log key")
+			},
+		},
+		{
+			name: "invalid identity regex",
+			policy: kargoapi.ImageVerificationPolicy{
+				Keyless: &kargoapi.KeylessVerification{
+					Identities: []kargoapi.KeylessIdentity{{
+						IssuerRegex:  "(",
+						SubjectRegex: ".*",
+					}},
+				},
+			},
+			material: VerificationMaterial{
+				TrustedRoots:        ca.certPEM,
+				TransparencyLogKeys: tlogKey.publicKeyPEM(t),
+			},
+			assertions: func(t *testing.T, _ *Verifier, err error) {
+				require.ErrorContains(t, err, "error compiling identity")
+			},
+		},
+		{
+			name:   "no keys or identities",
+			policy: kargoapi.ImageVerificationPolicy{},
+			assertions: func(t *testing.T, _ *Verifier, err error) {
+				require.ErrorContains(t, err, "no public keys or keyless identities")
+			},
+		},
+		{
+			name: "success",
+			policy: kargoapi.ImageVerificationPolicy{
+				PublicKeys: []kargoapi.ImageVerificationPublicKey{{}},
+				Keyless: &kargoapi.KeylessVerification{
+					Identities: []kargoapi.KeylessIdentity{{
+						IssuerRegex:  ".*",
+						SubjectRegex: ".*",
+					}},
+				},
+				RequiredAttestations: []string{testProvenanceType},
+			},
+			material: VerificationMaterial{
+				PublicKeys:          [][]byte{key.publicKeyPEM(t)},
+				TrustedRoots:        ca.certPEM,
+				TransparencyLogKeys: tlogKey.publicKeyPEM(t),
+			},
+			assertions: func(t *testing.T, v *Verifier, err error) {
+				require.NoError(t, err)
+				require.Len(t, v.publicKeys, 1)
+				require.NotNil(t, v.keyless)
+				require.Len(t, v.identities, 1)
+				require.Equal(t, []string{testProvenanceType}, v.requiredAttestations)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v, err := NewVerifier(testCase.policy, testCase.material)
+			testCase.assertions(t, v, err)
+		})
+	}
+}
+
+func TestVerifier_verify(t *testing.T) {
+	key := newTestKey(t)
+	otherKey := newTestKey(t)
+	tlogKey := newTestKey(t)
+	ca := newTestCA(t)
+
+	// The keyless signing certificate has long since expired, as is typical,
+	// so verification depends on the transparency log bundle.
+	leaf := ca.issue(
+		t,
+		"ci@example.com",
+		"https://issuer.example.com",
+		time.Now().Add(-2*time.Hour),
+		time.Now().Add(-time.Hour),
+	)
+	// Another certificate for the same identity
+	otherLeaf := ca.issue(
+		t,
+		"ci@example.com",
+		"https://issuer.example.com",
+		time.Now().Add(-2*time.Hour),
+		time.Now().Add(-time.Hour),
+	)
+	signedAt := time.Now().Add(-90 * time.Minute)
+
+	repo, server := newTestRepository(t)
+	defer server.Close()
+
+	signed := pushTestImage(t, repo, "signed")
+	signTestImage(t, repo, signed, key, nil)
+
+	unsigned := pushTestImage(t, repo, "unsigned")
+
+	wrongKey := pushTestImage(t, repo, "wrong-key")
+	signTestImage(t, repo, wrongKey, otherKey, nil)
+
+	attested := pushTestImage(t, repo, "attested")
+	signTestImage(t, repo, attested, key, nil)
+	attestTestImage(t, repo, attested, key, testProvenanceType, nil)
+
+	keyless := pushTestImage(t, repo, "keyless")
+	signTestImage(
+		t, repo, keyless, leaf.key,
+		func(payload []byte, sig []byte) map[string]string {
+			return map[string]string{
+				cosignCertificateAnnotation: string(leaf.certPEM),
+				cosignBundleAnnotation: testBundle(
+					t, tlogKey, hashedRekordBody(leaf, payload, sig), signedAt,
+				),
+			}
+		},
+	)
+
+	keylessExpired := pushTestImage(t, repo, "keyless-expired")
+	signTestImage(
+		t, repo, keylessExpired, leaf.key,
+		func(payload []byte, sig []byte) map[string]string {
+			return map[string]string{
+				cosignCertificateAnnotation: string(leaf.certPEM),
+				cosignBundleAnnotation: testBundle(
+					t, tlogKey, hashedRekordBody(leaf, payload, sig), time.Now(),
+				),
+			}
+		},
+	)
+
+	keylessWithoutBundle := pushTestImage(t, repo, "keyless-without-bundle")
+	signTestImage(
+		t, repo, keylessWithoutBundle, leaf.key,
+		func([]byte, []byte) map[string]string {
+			return map[string]string{
+				cosignCertificateAnnotation: string(leaf.certPEM),
+			}
+		},
+	)
+
+	// The transparency log bundle was issued for a different signature
+	keylessOtherSignature := pushTestImage(t, repo, "keyless-other-signature")
+	signTestImage(
+		t, repo, keylessOtherSignature, leaf.key,
+		func([]byte, []byte) map[string]string {
+			otherPayload := []byte("other payload")
+			otherSig := leaf.key.sign(t, otherPayload)
+			return map[string]string{
+				cosignCertificateAnnotation: string(leaf.certPEM),
+				cosignBundleAnnotation: testBundle(
+					t, tlogKey, hashedRekordBody(leaf, otherPayload, otherSig), signedAt,
+				),
+			}
+		},
+	)
+
+	// The transparency log bundle records a different signing certificate
+	keylessOtherCertificate := pushTestImage(t, repo, "keyless-other-certificate")
+	signTestImage(
+		t, repo, keylessOtherCertificate, leaf.key,
+		func(payload []byte, sig []byte) map[string]string {
+			return map[string]string{
+				cosignCertificateAnnotation: string(leaf.certPEM),
+				cosignBundleAnnotation: testBundle(
+					t, tlogKey, hashedRekordBody(otherLeaf, payload, sig), signedAt,
+				),
+			}
+		},
+	)
+
+	keylessAttested := pushTestImage(t, repo, "keyless-attested")
+	keylessAnnotations := func(envelope []byte, statement []byte, sig []byte) map[string]string {
+		return map[string]string{
+			cosignCertificateAnnotation: string(leaf.certPEM),
+			cosignBundleAnnotation: testBundle(
+				t, tlogKey, dsseBody(leaf, envelope, statement, sig), signedAt,
+			),
+		}
+	}
+	signTestImage(
+		t, repo, keylessAttested, leaf.key,
+		func(payload []byte, sig []byte) map[string]string {
+			return map[string]string{
+				cosignCertificateAnnotation: string(leaf.certPEM),
+				cosignBundleAnnotation: testBundle(
+					t, tlogKey, hashedRekordBody(leaf, payload, sig), signedAt,
+				),
+			}
+		},
+	)
+	attestTestImage(t, repo, keylessAttested, leaf.key, testProvenanceType, keylessAnnotations)
+
+	testCases := []struct {
+		name       string
+		policy     kargoapi.ImageVerificationPolicy
+		material   VerificationMaterial
+		img        image
+		assertions func(*testing.T, bool, []kargoapi.RejectedImageReference, error)
+	}{
+		{
+			name: "signed with trusted key",
+			policy: kargoapi.ImageVerificationPolicy{
+				PublicKeys: []kargoapi.ImageVerificationPublicKey{{}},
+			},
+			material: VerificationMaterial{PublicKeys: [][]byte{key.publicKeyPEM(t)}},
+			img:      signed,
+			assertions: func(t *testing.T, ok bool, rejected []kargoapi.RejectedImageReference, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Empty(t, rejected)
+			},
+		},
+		{
+			name: "not signed",
+			policy: kargoapi.ImageVerificationPolicy{
+				PublicKeys: []kargoapi.ImageVerificationPublicKey{{}},
+			},
+			material: VerificationMaterial{PublicKeys: [][]byte{key.publicKeyPEM(t)}},
+			img:      unsigned,
+			assertions: func(t *testing.T, ok bool, rejected []kargoapi.RejectedImageReference, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+				require.Equal(
+					t,
+					[]kargoapi.RejectedImageReference{{
+						Tag:    "unsigned",
+						Digest: unsigned.Digest,
+						Reason: "no signatures found",
+					}},
+					rejected,
+				)
+			},
+		},
+		{
+			name: "signed with untrusted key",
+			policy: kargoapi.ImageVerificationPolicy{
+				PublicKeys: []kargoapi.ImageVerificationPublicKey{{}},
+			},
+			material: VerificationMaterial{PublicKeys: [][]byte{key.publicKeyPEM(t)}},
+			img:      wrongKey,
+			assertions: func(t *testing.T, ok bool, rejected []kargoapi.RejectedImageReference, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+				require.Len(t, rejected, 1)
+				require.Contains(t, rejected[0].Reason, "could not be verified with any trusted public key")
+			},
+		},
+		{
+			name: "missing required attestation",
+			policy: kargoapi.ImageVerificationPolicy{
+				PublicKeys:           []kargoapi.ImageVerificationPublicKey{{}},
+				RequiredAttestations: []string{testProvenanceType},
+			},
+			material: VerificationMaterial{PublicKeys: [][]byte{key.publicKeyPEM(t)}},
+			img:      signed,
+			assertions: func(t *testing.T, ok bool, rejected []kargoapi.RejectedImageReference, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+				require.Len(t, rejected, 1)
+				require.Equal(
+					t,
+					"no valid attestations found for predicate types: "+testProvenanceType,
+					rejected[0].Reason,
+				)
+			},
+		},
+		{
+			name: "attestation of wrong predicate type",
+			policy: kargoapi.ImageVerificationPolicy{
+				PublicKeys:           []kargoapi.ImageVerificationPublicKey{{}},
+				RequiredAttestations: []string{"https://example.com/other/v1"},
+			},
+			material: VerificationMaterial{PublicKeys: [][]byte{key.publicKeyPEM(t)}},
+			img:      attested,
+			assertions: func(t *testing.T, ok bool, rejected []kargoapi.RejectedImageReference, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+				require.Len(t, rejected, 1)
+			},
+		},
+		{
+			name: "signed and attested",
+			policy: kargoapi.ImageVerificationPolicy{
+				PublicKeys:           []kargoapi.ImageVerificationPublicKey{{}},
+				RequiredAttestations: []string{testProvenanceType},
+			},
+			material: VerificationMaterial{PublicKeys: [][]byte{key.publicKeyPEM(t)}},
+			img:      attested,
+			assertions: func(t *testing.T, ok bool, rejected []kargoapi.RejectedImageReference, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Empty(t, rejected)
+			},
+		},
+		{
+			name: "keyless signature by trusted identity",
+			policy: kargoapi.ImageVerificationPolicy{
+				Keyless: &kargoapi.KeylessVerification{
+					Identities: []kargoapi.KeylessIdentity{{
+						IssuerRegex:  `^https://issuer\.example\.com$`,
+						SubjectRegex: `^ci@example\.com$`,
+					}},
+				},
+			},
+			material: VerificationMaterial{
+				TrustedRoots:        ca.certPEM,
+				TransparencyLogKeys: tlogKey.publicKeyPEM(t),
+			},
+			img: keyless,
+			assertions: func(t *testing.T, ok bool, rejected []kargoapi.RejectedImageReference, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Empty(t, rejected)
+			},
+		},
+		{
+			name: "keyless signature by untrusted identity",
+			policy: kargoapi.ImageVerificationPolicy{
+				Keyless: &kargoapi.KeylessVerification{
+					Identities: []kargoapi.KeylessIdentity{{
+						IssuerRegex:  `^https://issuer\.example\.com$`,
+						SubjectRegex: `^release@example\.com$`,
+					}},
+				},
+			},
+			material: VerificationMaterial{
+				TrustedRoots:        ca.certPEM,
+				TransparencyLogKeys: tlogKey.publicKeyPEM(t),
+			},
+			img: keyless,
+			assertions: func(t *testing.T, ok bool, rejected []kargoapi.RejectedImageReference, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+				require.Len(t, rejected, 1)
+				require.Contains(t, rejected[0].Reason, "failed to verify certificate identity")
+			},
+		},
+		{
+			name: "keyless signature from untrusted transparency log",
+			policy: kargoapi.ImageVerificationPolicy{
+				Keyless: &kargoapi.KeylessVerification{
+					Identities: []kargoapi.KeylessIdentity{{
+						IssuerRegex:  ".*",
+						SubjectRegex: ".*",
+					}},
+				},
+			},
+			material: VerificationMaterial{
+				TrustedRoots:        ca.certPEM,
+				TransparencyLogKeys: otherKey.publicKeyPEM(t),
+			},
+			img: keyless,
+			assertions: func(t *testing.T, ok bool, rejected []kargoapi.RejectedImageReference, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+				require.Len(t, rejected, 1)
+				require.Contains(t, rejected[0].Reason, "not enough verified log entries")
+			},
+		},
+		{
+			name: "keyless signature without transparency log bundle",
+			policy: kargoapi.ImageVerificationPolicy{
+				Keyless: &kargoapi.KeylessVerification{
+					Identities: []kargoapi.KeylessIdentity{{
+						IssuerRegex:  ".*",
+						SubjectRegex: ".*",
+					}},
+				},
+			},
+			material: VerificationMaterial{
+				TrustedRoots:        ca.certPEM,
+				TransparencyLogKeys: tlogKey.publicKeyPEM(t),
+			},
+			img: keylessWithoutBundle,
+			assertions: func(t *testing.T, ok bool, rejected []kargoapi.RejectedImageReference, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+				require.Len(t, rejected, 1)
+				require.Contains(t, rejected[0].Reason, "no transparency log bundle")
+			},
+		},
+		{
+			name: "keyless signature with bundle for other signature",
+			policy: kargoapi.ImageVerificationPolicy{
+				Keyless: &kargoapi.KeylessVerification{
+					Identities: []kargoapi.KeylessIdentity{{
+						IssuerRegex:  ".*",
+						SubjectRegex: ".*",
+					}},
+				},
+			},
+			material: VerificationMaterial{
+				TrustedRoots:        ca.certPEM,
+				TransparencyLogKeys: tlogKey.publicKeyPEM(t),
+			},
+			img: keylessOtherSignature,
+			assertions: func(t *testing.T, ok bool, rejected []kargoapi.RejectedImageReference, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+				require.Len(t, rejected, 1)
+				require.Contains(t, rejected[0].Reason, "transparency log signature does not match")
+			},
+		},
+		{
+			name: "keyless signature with bundle for other certificate",
+			policy: kargoapi.ImageVerificationPolicy{
+				Keyless: &kargoapi.KeylessVerification{
+					Identities: []kargoapi.KeylessIdentity{{
+						IssuerRegex:  ".*",
+						SubjectRegex: ".*",
+					}},
+				},
+			},
+			material: VerificationMaterial{
+				TrustedRoots:        ca.certPEM,
+				TransparencyLogKeys: tlogKey.publicKeyPEM(t),
+			},
+			img: keylessOtherCertificate,
+			assertions: func(t *testing.T, ok bool, rejected []kargoapi.RejectedImageReference, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+				require.Len(t, rejected, 1)
+				require.Contains(t, rejected[0].Reason, "error building Sigstore bundle")
+			},
+		},
+		{
+			name: "keyless signature made after certificate expired",
+			policy: kargoapi.ImageVerificationPolicy{
+				Keyless: &kargoapi.KeylessVerification{
+					Identities: []kargoapi.KeylessIdentity{{
+						IssuerRegex:  ".*",
+						SubjectRegex: ".*",
+					}},
+				},
+			},
+			material: VerificationMaterial{
+				TrustedRoots:        ca.certPEM,
+				TransparencyLogKeys: tlogKey.publicKeyPEM(t),
+			},
+			img: keylessExpired,
+			assertions: func(t *testing.T, ok bool, rejected []kargoapi.RejectedImageReference, err error) {
+				require.NoError(t, err)
+				require.False(t, ok)
+				require.Len(t, rejected, 1)
+				require.Contains(t, rejected[0].Reason, "integrated time outside certificate validity")
+			},
+		},
+		{
+			name: "keyless signature and attestation",
+			policy: kargoapi.ImageVerificationPolicy{
+				Keyless: &kargoapi.KeylessVerification{
+					Identities: []kargoapi.KeylessIdentity{{
+						IssuerRegex:  `^https://issuer\.example\.com$`,
+						SubjectRegex: `^ci@example\.com$`,
+					}},
+				},
+				RequiredAttestations: []string{testProvenanceType},
+			},
+			material: VerificationMaterial{
+				TrustedRoots:        ca.certPEM,
+				TransparencyLogKeys: tlogKey.publicKeyPEM(t),
+			},
+			img: keylessAttested,
+			assertions: func(t *testing.T, ok bool, rejected []kargoapi.RejectedImageReference, err error) {
+				require.NoError(t, err)
+				require.True(t, ok)
+				require.Empty(t, rejected)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			v, err := NewVerifier(testCase.policy, testCase.material)
+			require.NoError(t, err)
+			ok, err := v.verify(t.Context(), repo, testCase.img)
+			testCase.assertions(t, ok, v.Rejected(), err)
+		})
+	}
+}
+
+func TestSelectorWithVerifier(t *testing.T) {
+	key := newTestKey(t)
+
+	repo, server := newTestRepository(t)
+	defer server.Close()
+
+	for _, tag := range []string{"v1.0.0", "v1.2.0"} {
+		signTestImage(t, repo, pushTestImage(t, repo, tag), key, nil)
+	}
+	unsigned := pushTestImage(t, repo, "v1.1.0")
+
+	v, err := NewVerifier(
+		kargoapi.ImageVerificationPolicy{
+			PublicKeys: []kargoapi.ImageVerificationPublicKey{{}},
+		},
+		VerificationMaterial{PublicKeys: [][]byte{key.publicKeyPEM(t)}},
+	)
+	require.NoError(t, err)
+
+	s, err := newSemverSelector(
+		kargoapi.ImageSubscription{
+			RepoURL:       repo.repoURL,
+			StrictSemvers: true,
+		},
+		nil,
+		v,
+	)
+	require.NoError(t, err)
+
+	images, err := s.Select(t.Context())
+	require.NoError(t, err)
+	require.Len(t, images, 2)
+	require.Equal(t, "v1.2.0", images[0].Tag)
+	require.Equal(t, "v1.0.0", images[1].Tag)
+	require.Equal(
+		t,
+		[]kargoapi.RejectedImageReference{{
+			Tag:    "v1.1.0",
+			Digest: unsigned.Digest,
+			Reason: "no signatures found",
+		}},
+		v.Rejected(),
+	)
+}
+
+// testKey is an ECDSA key pair used for signing test fixtures.
+type testKey struct {
+	*ecdsa.PrivateKey
+}
+
+func newTestKey(t *testing.T) testKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return testKey{PrivateKey: key}
+}
+
+func (k testKey) publicKeyPEM(t *testing.T) []byte {
+	der, err := x509.MarshalPKIXPublicKey(k.Public())
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func (k testKey) sign(t *testing.T, msg []byte) []byte {
+	digest := sha256.Sum256(msg)
+	sig, err := ecdsa.SignASN1(rand.Reader, k.PrivateKey, digest[:])
+	require.NoError(t, err)
+	return sig
+}
+
+// testCA is a certificate authority used for issuing keyless signing
+// certificates for test fixtures.
+type testCA struct {
+	key     testKey
+	cert    *x509.Certificate
+	certPEM []byte
+}
+
+func newTestCA(t *testing.T) testCA {
+	key := newTestKey(t)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-24 * time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key.PrivateKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return testCA{
+		key:     key,
+		cert:    cert,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// testLeaf is a keyless signing certificate and its key.
+type testLeaf struct {
+	key     testKey
+	certPEM []byte
+}
+
+func (c testCA) issue(
+	t *testing.T,
+	email string,
+	issuer string,
+	notBefore time.Time,
+	notAfter time.Time,
+) testLeaf {
+	key := newTestKey(t)
+	issuerExt, err := asn1.MarshalWithParams(issuer, "utf8")
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       notBefore,
+		NotAfter:        notAfter,
+		EmailAddresses:  []string{email},
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		ExtraExtensions: []pkix.Extension{{Id: testOIDCIssuerOID, Value: issuerExt}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, c.cert, key.Public(), c.key.PrivateKey)
+	require.NoError(t, err)
+	return testLeaf{
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// testBundle returns a transparency log bundle, as stored by cosign, for an
+// entry with the provided body, signed using the provided key.
+func testBundle(t *testing.T, tlogKey testKey, body any, integratedAt time.Time) string {
+	bodyJSON, err := json.Marshal(body)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(tlogKey.Public())
+	require.NoError(t, err)
+	logID := sha256.Sum256(der)
+	bundle := rekorBundle{}
+	bundle.Payload.Body = base64.StdEncoding.EncodeToString(bodyJSON)
+	bundle.Payload.IntegratedTime = integratedAt.Unix()
+	bundle.Payload.LogIndex = 1
+	bundle.Payload.LogID = hex.EncodeToString(logID[:])
+	canonical := fmt.Sprintf(
+		`{"body":%q,"integratedTime":%d,"logID":%q,"logIndex":%d}`,
+		bundle.Payload.Body,
+		bundle.Payload.IntegratedTime,
+		bundle.Payload.LogID,
+		bundle.Payload.LogIndex,
+	)
+	bundle.SignedEntryTimestamp = tlogKey.sign(t, []byte(canonical))
+	bundleJSON, err := json.Marshal(bundle)
+	require.NoError(t, err)
+	return string(bundleJSON)
+}
+
+// hashedRekordBody returns the body of a transparency log entry recording
+// the provided signature of the provided payload, made using the provided
+// certificate.
+func hashedRekordBody(leaf testLeaf, payload []byte, sig []byte) any {
+	digest := sha256.Sum256(payload)
+	return map[string]any{
+		"apiVersion": "0.0.1",
+		"kind":       "hashedrekord",
+		"spec": map[string]any{
+			"data": map[string]any{
+				"hash": map[string]any{
+					"algorithm": "sha256",
+					"value":     hex.EncodeToString(digest[:]),
+				},
+			},
+			"signature": map[string]any{
+				"content": base64.StdEncoding.EncodeToString(sig),
+				"publicKey": map[string]any{
+					"content": base64.StdEncoding.EncodeToString(leaf.certPEM),
+				},
+			},
+		},
+	}
+}
+
+// dsseBody returns the body of a transparency log entry recording the
+// provided DSSE envelope, whose payload and signature are also provided,
+// made using the provided certificate.
+func dsseBody(leaf testLeaf, envelope []byte, payload []byte, sig []byte) any {
+	envelopeDigest := sha256.Sum256(envelope)
+	payloadDigest := sha256.Sum256(payload)
+	return map[string]any{
+		"apiVersion": "0.0.1",
+		"kind":       "dsse",
+		"spec": map[string]any{
+			"envelopeHash": map[string]any{
+				"algorithm": "sha256",
+				"value":     hex.EncodeToString(envelopeDigest[:]),
+			},
+			"payloadHash": map[string]any{
+				"algorithm": "sha256",
+				"value":     hex.EncodeToString(payloadDigest[:]),
+			},
+			"signatures": []map[string]any{{
+				"signature": base64.StdEncoding.EncodeToString(sig),
+				"verifier":  base64.StdEncoding.EncodeToString(leaf.certPEM),
+			}},
+		},
+	}
+}
+
+// newTestRepository starts an in-memory registry and returns a client for a
+// repository within it.
+func newTestRepository(t *testing.T) (*repositoryClient, *httptest.Server) {
+	server := httptest.NewServer(ggcrregistry.New())
+	repoURL := fmt.Sprintf("%s/example/image", strings.TrimPrefix(server.URL, "http://"))
+	repo, err := newRepositoryClient(repoURL, false, nil)
+	require.NoError(t, err)
+	return repo, server
+}
+
+// pushTestImage pushes a random image to the provided repository with the
+// provided tag.
+func pushTestImage(t *testing.T, repo *repositoryClient, tag string) image {
+	img, err := random.Image(64, 1)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(repo.repoRef.Context().Tag(tag), img))
+	digest, err := img.Digest()
+	require.NoError(t, err)
+	return image{Tag: tag, Digest: digest.String()}
+}
+
+// signTestImage signs the provided image using the provided key and pushes
+// the signature to the provided repository in the same manner as cosign.
+// Additional annotations for the signature layer may be derived from the
+// payload and signature using the optional annotate function.
+func signTestImage(
+	t *testing.T,
+	repo *repositoryClient,
+	img image,
+	key testKey,
+	annotate func(payload []byte, sig []byte) map[string]string,
+) {
+	payload := fmt.Appendf(
+		nil,
+		`{"critical":{"identity":{"docker-reference":%q},"image":{"docker-manifest-digest":%q},`+
+			`"type":"cosign container image signature"},"optional":null}`,
+		repo.repoURL, img.Digest,
+	)
+	sig := key.sign(t, payload)
+	annotations := map[string]string{
+		cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(sig),
+	}
+	if annotate != nil {
+		for k, v := range annotate(payload, sig) {
+			annotations[k] = v
+		}
+	}
+	pushCosignLayer(
+		t, repo, img, "sig",
+		static.NewLayer(payload, "application/vnd.dev.cosign.simplesigning.v1+json"),
+		annotations,
+	)
+}
+
+// attestTestImage attests to the provided image using the provided key and
+// pushes the attestation to the provided repository in the same manner as
+// cosign. Additional annotations for the attestation layer may be derived from
+// the envelope, statement, and signature using the optional annotate function.
+func attestTestImage(
+	t *testing.T,
+	repo *repositoryClient,
+	img image,
+	key testKey,
+	predicateType string,
+	annotate func(envelope []byte, statement []byte, sig []byte) map[string]string,
+) {
+	digest, err := v1.NewHash(img.Digest)
+	require.NoError(t, err)
+	statement, err := json.Marshal(map[string]any{
+		"_type":         "https://in-toto.io/Statement/v0.1",
+		"predicateType": predicateType,
+		"subject": []map[string]any{{
+			"name":   repo.repoURL,
+			"digest": map[string]string{digest.Algorithm: digest.Hex},
+		}},
+		"predicate": map[string]any{},
+	})
+	require.NoError(t, err)
+	sig := key.sign(t, dssePAE(inTotoPayloadType, statement))
+	envelope, err := json.Marshal(map[string]any{
+		"payloadType": inTotoPayloadType,
+		"payload":     base64.StdEncoding.EncodeToString(statement),
+		"signatures": []map[string]string{{
+			"sig": base64.StdEncoding.EncodeToString(sig),
+		}},
+	})
+	require.NoError(t, err)
+	annotations := map[string]string{"predicateType": predicateType}
+	if annotate != nil {
+		for k, v := range annotate(envelope, statement, sig) {
+			annotations[k] = v
+		}
+	}
+	pushCosignLayer(
+		t, repo, img, "att",
+		static.NewLayer(envelope, "application/vnd.dsse.envelope.v1+json"),
+		annotations,
+	)
+}
+
+func pushCosignLayer(
+	t *testing.T,
+	repo *repositoryClient,
+	img image,
+	suffix string,
+	layer v1.Layer,
+	annotations map[string]string,
+) {
+	cosignImg, err := mutate.Append(
+		mutate.MediaType(empty.Image, types.OCIManifestSchema1),
+		mutate.Addendum{Layer: layer, Annotations: annotations},
+	)
+	require.NoError(t, err)
+	digest, err := v1.NewHash(img.Digest)
+	require.NoError(t, err)
+	ref, err := name.ParseReference(
+		fmt.Sprintf("%s:%s-%s.%s", repo.repoURL, digest.Algorithm, digest.Hex, suffix),
+	)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, cosignImg))
+}
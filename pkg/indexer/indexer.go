@@ -181,7 +181,7 @@ func RunningPromotionsByArgoCDApplications(
 		// and treating some of them as special cases. We should consider a more
 		// general approach in the future.
 		var res []string
-		for i, step := range promo.Spec.Steps {
+		for i, step := range promo.Spec.GetSteps() {
 			if int64(i) > promo.Status.CurrentStep {
 				// We are only interested in steps that have already been executed or
 				// are about to be.
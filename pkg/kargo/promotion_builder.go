@@ -2,8 +2,12 @@ package kargo
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/oklog/ulid/v2"
@@ -12,6 +16,7 @@ import (
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/expressions"
 	"github.com/akuity/kargo/pkg/server/user"
 )
 
@@ -20,6 +25,22 @@ const (
 	// to separate the task alias from the step alias.
 	PromotionAliasSeparator = "::"
 
+	// OnFailureStepAliasPrefix is the prefix of the default alias of steps in
+	// the onFailure block of a Promotion.
+	OnFailureStepAliasPrefix = "on-failure-"
+
+	// FinallyStepAliasPrefix is the prefix of the default alias of steps in the
+	// finally block of a Promotion.
+	FinallyStepAliasPrefix = "finally-"
+
+	// ForEachItemVar is the name of the variable holding the item of a step
+	// expanded from a step with a forEach expression.
+	ForEachItemVar = "item"
+
+	// ForEachIndexVar is the name of the variable holding the index of the
+	// item of a step expanded from a step with a forEach expression.
+	ForEachIndexVar = "index"
+
 	// nameSeparator is the separator used in the Promotion name.
 	nameSeparator = "."
 
@@ -83,44 +104,273 @@ func (b *PromotionBuilder) Build(
 			Annotations: annotations,
 		},
 		Spec: kargoapi.PromotionSpec{
-			Stage:   stage.Name,
-			Freight: freight,
-			Vars:    vars,
-			Steps:   stage.Spec.PromotionTemplate.Spec.Steps,
+			Stage:     stage.Name,
+			Freight:   freight,
+			Vars:      vars,
+			Steps:     stage.Spec.PromotionTemplate.Spec.Steps,
+			OnFailure: stage.Spec.PromotionTemplate.Spec.OnFailure,
+			Finally:   stage.Spec.PromotionTemplate.Spec.Finally,
 		},
 	}
 	return &promotion, nil
 }
 
 // InflateSteps inflates the Promotion steps by resolving any references to
-// PromotionTasks and expanding them into their individual steps. The inflated
-// steps are then set on the Promotion, replacing the original steps.
+// PromotionTasks and expanding them into their individual steps, and by
+// expanding any steps with a forEach expression into one step per item. The
+// inflated steps are then set on the Promotion, replacing the original steps.
+// This applies to the main sequence of steps as well as to the onFailure and
+// finally blocks.
 func (b *PromotionBuilder) InflateSteps(ctx context.Context, promo *kargoapi.Promotion) error {
-	steps := make([]kargoapi.PromotionStep, 0, len(promo.Spec.Steps))
-	for i, step := range promo.Spec.Steps {
-		switch {
-		case step.Task != nil:
-			alias := step.GetAlias(i)
-			taskSteps, err := b.inflateTaskSteps(
-				ctx,
-				promo.Namespace,
-				alias,
-				promo.Spec.Vars,
-				step,
-			)
-			if err != nil {
-				return fmt.Errorf("inflate tasks steps for task %q (%q): %w", step.Task.Name, alias, err)
+	env, err := b.forEachExprEnv(ctx, promo)
+	if err != nil {
+		return err
+	}
+	if promo.Spec.Steps, err = b.inflateSteps(ctx, promo, env, "", promo.Spec.Steps); err != nil {
+		return err
+	}
+	if promo.Spec.OnFailure, err = b.inflateSteps(
+		ctx, promo, env, OnFailureStepAliasPrefix, promo.Spec.OnFailure,
+	); err != nil {
+		return err
+	}
+	if promo.Spec.Finally, err = b.inflateSteps(
+		ctx, promo, env, FinallyStepAliasPrefix, promo.Spec.Finally,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// inflateSteps inflates the given block of PromotionSteps. The given alias
+// prefix is prepended to the default alias of any step without an explicit
+// alias, ensuring default aliases are unique across blocks.
+func (b *PromotionBuilder) inflateSteps(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	env *forEachEnv,
+	aliasPrefix string,
+	promoSteps []kargoapi.PromotionStep,
+) ([]kargoapi.PromotionStep, error) {
+	if len(promoSteps) == 0 {
+		return promoSteps, nil
+	}
+	steps := make([]kargoapi.PromotionStep, 0, len(promoSteps))
+	for i, promoStep := range promoSteps {
+		alias := promoStep.As
+		if alias == "" {
+			alias = aliasPrefix + promoStep.GetAlias(i)
+		}
+		if promoStep.Parallel != nil && promoStep.ForEach != "" {
+			return nil, fmt.Errorf("step %q with a parallel group cannot set forEach", alias)
+		}
+		iterations, err := expandForEach(env, alias, promoStep)
+		if err != nil {
+			return nil, fmt.Errorf("expand step %q: %w", alias, err)
+		}
+		for _, step := range iterations {
+			switch {
+			case step.Task != nil:
+				taskSteps, err := b.inflateTaskSteps(
+					ctx,
+					promo.Namespace,
+					step.As,
+					promo.Spec.Vars,
+					step,
+				)
+				if err != nil {
+					return nil, fmt.Errorf(
+						"inflate tasks steps for task %q (%q): %w", step.Task.Name, step.As, err,
+					)
+				}
+				steps = append(steps, taskSteps...)
+			case step.Parallel != nil:
+				step.Parallel = step.Parallel.DeepCopy()
+				for j := range step.Parallel.Steps {
+					groupStep := &step.Parallel.Steps[j]
+					groupStep.As = groupStep.GetAlias(step.As, j)
+				}
+				steps = append(steps, step)
+			default:
+				steps = append(steps, step)
 			}
-			steps = append(steps, taskSteps...)
-		default:
-			step.As = step.GetAlias(i)
-			steps = append(steps, step)
 		}
 	}
-	promo.Spec.Steps = steps
+	return steps, nil
+}
+
+// expandForEach expands the given PromotionStep into one PromotionStep for
+// each item in the list its forEach expression evaluates to. Each expanded
+// step is assigned an alias derived from the given alias and the index of the
+// item, and is provided with the item and its index as variables. If the
+// PromotionStep has no forEach expression, it is returned as-is with the given
+// alias.
+func expandForEach(
+	env *forEachEnv,
+	alias string,
+	step kargoapi.PromotionStep,
+) ([]kargoapi.PromotionStep, error) {
+	if step.ForEach == "" {
+		step.As = alias
+		return []kargoapi.PromotionStep{step}, nil
+	}
+
+	if err := env.referencedVarErr(step.ForEach); err != nil {
+		return nil, fmt.Errorf("evaluate forEach expression: %w", err)
+	}
+	res, err := expressions.EvaluateTemplate(step.ForEach, env.env)
+	if err != nil {
+		return nil, fmt.Errorf("evaluate forEach expression: %w", err)
+	}
+	items, ok := res.([]any)
+	if !ok {
+		return nil, fmt.Errorf("forEach expression must evaluate to a list, got %T", res)
+	}
+
+	steps := make([]kargoapi.PromotionStep, 0, len(items))
+	for i, item := range items {
+		itemJSON, err := json.Marshal(item)
+		if err != nil {
+			return nil, fmt.Errorf("marshal forEach item %d: %w", i, err)
+		}
+		iteration := *step.DeepCopy()
+		iteration.ForEach = ""
+		iteration.As = GenerateForEachStepAlias(alias, i)
+		// The item is passed as an expression decoding its base64 encoded JSON
+		// representation, which preserves its type when the variable is
+		// evaluated during the execution of the Promotion. Encoding the item
+		// ensures no part of it can ever be mistaken for expression syntax or
+		// delimiters.
+		iteration.Vars = append(
+			[]kargoapi.ExpressionVariable{
+				{
+					Name: ForEachItemVar,
+					Value: fmt.Sprintf(
+						`${{ fromJSON(fromBase64("%s")) }}`,
+						base64.StdEncoding.EncodeToString(itemJSON),
+					),
+				},
+				{
+					Name:  ForEachIndexVar,
+					Value: fmt.Sprintf("${{ %d }}", i),
+				},
+			},
+			iteration.Vars...,
+		)
+		steps = append(steps, iteration)
+	}
+	return steps, nil
+}
+
+// forEachEnv is the environment forEach expressions are evaluated in.
+type forEachEnv struct {
+	env map[string]any
+	// varErrs holds the errors encountered evaluating those Promotion
+	// variables that could not be made available to forEach expressions,
+	// indexed by variable name.
+	varErrs map[string]error
+}
+
+// forEachExprEnv builds the environment forEach expressions are evaluated in.
+// Because these expressions are evaluated when the Promotion is built, only
+// the context of the Promotion and those Promotion variables that can be
+// evaluated without access to any expression functions are available.
+func (b *PromotionBuilder) forEachExprEnv(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) (*forEachEnv, error) {
+	var origin kargoapi.FreightOrigin
+	if promo.Spec.Freight != "" && hasForEach(promo) {
+		freight := &kargoapi.Freight{}
+		if err := b.client.Get(
+			ctx,
+			client.ObjectKey{Namespace: promo.Namespace, Name: promo.Spec.Freight},
+			freight,
+		); err != nil {
+			return nil, fmt.Errorf("get Freight %q: %w", promo.Spec.Freight, err)
+		}
+		origin = freight.Origin
+	}
+	vars := make(map[string]any, len(promo.Spec.Vars))
+	env := &forEachEnv{
+		env: map[string]any{
+			"ctx": map[string]any{
+				"project":   promo.Namespace,
+				"promotion": promo.Name,
+				"stage":     promo.Spec.Stage,
+				"targetFreight": map[string]any{
+					"name": promo.Spec.Freight,
+					"origin": map[string]any{
+						"name": origin.Name,
+					},
+				},
+				"meta": map[string]any{
+					"promotion": map[string]any{
+						"actor": promo.Annotations[kargoapi.AnnotationKeyCreateActor],
+					},
+				},
+			},
+			"vars": vars,
+		},
+		varErrs: map[string]error{},
+	}
+	for _, v := range promo.Spec.Vars {
+		val, err := expressions.EvaluateTemplate(v.Value, env.env)
+		if err != nil {
+			// The variable may depend on functions which are only available
+			// during the execution of the Promotion. It is left out, but the
+			// error is retained in case a forEach expression references it.
+			env.varErrs[v.Name] = err
+			delete(vars, v.Name)
+			continue
+		}
+		delete(env.varErrs, v.Name)
+		vars[v.Name] = val
+	}
+	return env, nil
+}
+
+// referencedVarErr returns an error if the given forEach expression references
+// any Promotion variable that could not be evaluated when building the
+// Promotion, wrapping the error encountered evaluating that variable.
+func (e *forEachEnv) referencedVarErr(forEach string) error {
+	names := make([]string, 0, len(e.varErrs))
+	for name := range e.varErrs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		quoted := regexp.QuoteMeta(name)
+		ref := regexp.MustCompile(
+			`\bvars\s*(?:\.\s*` + quoted + `\b|\[\s*["'\x60]` + quoted + `["'\x60]\s*\])`,
+		)
+		if ref.MatchString(forEach) {
+			return fmt.Errorf(
+				"variable %q is not available to forEach expressions: %w",
+				name, e.varErrs[name],
+			)
+		}
+	}
 	return nil
 }
 
+// hasForEach returns true if any step of the given Promotion has a forEach
+// expression.
+func hasForEach(promo *kargoapi.Promotion) bool {
+	for _, steps := range [][]kargoapi.PromotionStep{
+		promo.Spec.Steps,
+		promo.Spec.OnFailure,
+		promo.Spec.Finally,
+	} {
+		for _, step := range steps {
+			if step.ForEach != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // inflateTaskSteps inflates the PromotionSteps for the given PromotionStep
 // that references a (Cluster)PromotionTask. The task is retrieved and its
 // steps are inflated with the given task inputs.
@@ -222,6 +472,13 @@ func generatePromotionTaskStepAlias(taskAlias, stepAlias string) string {
 	return fmt.Sprintf("%s%s%s", taskAlias, PromotionAliasSeparator, stepAlias)
 }
 
+// GenerateForEachStepAlias generates an alias for a step expanded from a step
+// with a forEach expression by combining the alias of the original step and
+// the index of the item.
+func GenerateForEachStepAlias(alias string, index int) string {
+	return fmt.Sprintf("%s[%d]", alias, index)
+}
+
 // promotionTaskVarsToStepVars validates the presence of the PromotionTask
 // variables and maps them to variables which can be used by the inflated
 // PromotionStep.
@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/expressions"
 	"github.com/akuity/kargo/pkg/server/user"
 )
 
@@ -96,6 +97,18 @@ func TestPromotionBuilder_Build(t *testing.T) {
 									Uses: "fake-step",
 								},
 							},
+							OnFailure: []kargoapi.PromotionStep{
+								{
+									As:   "revert",
+									Uses: "fake-step",
+								},
+							},
+							Finally: []kargoapi.PromotionStep{
+								{
+									As:   "cleanup",
+									Uses: "fake-step",
+								},
+							},
 						},
 					},
 				},
@@ -126,6 +139,10 @@ func TestPromotionBuilder_Build(t *testing.T) {
 				require.Len(t, promotion.Spec.Steps, 1)
 				assert.Equal(t, "step1", promotion.Spec.Steps[0].As)
 				assert.Equal(t, "fake-step", promotion.Spec.Steps[0].Uses)
+				require.Len(t, promotion.Spec.OnFailure, 1)
+				assert.Equal(t, "revert", promotion.Spec.OnFailure[0].As)
+				require.Len(t, promotion.Spec.Finally, 1)
+				assert.Equal(t, "cleanup", promotion.Spec.Finally[0].As)
 
 				// Check name format
 				assert.Contains(t, promotion.Name, "test-stage")
@@ -301,6 +318,333 @@ func TestPromotionBuilder_InflateSteps(t *testing.T) {
 				}, steps[1].Vars)
 			},
 		},
+		{
+			name: "forEach step",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Stage: "test-stage",
+					Vars: []kargoapi.ExpressionVariable{
+						{Name: "apps", Value: `${{ [{"name": "app-1"}, {"name": "app-2"}] }}`},
+					},
+					Steps: []kargoapi.PromotionStep{
+						{
+							As:      "update",
+							Uses:    "fake-step",
+							ForEach: "${{ vars.apps }}",
+							Vars: []kargoapi.ExpressionVariable{
+								{Name: "app", Value: "${{ vars.item.name }}"},
+							},
+						},
+						{
+							Uses:    "fake-step",
+							ForEach: "${{ [ctx.stage] }}",
+						},
+						{
+							Uses:    "fake-step",
+							ForEach: "${{ [] }}",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep, err error) {
+				require.NoError(t, err)
+				require.Len(t, steps, 3)
+
+				assert.Equal(t, "update[0]", steps[0].As)
+				assert.Empty(t, steps[0].ForEach)
+				assert.Equal(t, []kargoapi.ExpressionVariable{
+					{Name: "item", Value: `${{ fromJSON(fromBase64("eyJuYW1lIjoiYXBwLTEifQ==")) }}`},
+					{Name: "index", Value: "${{ 0 }}"},
+					{Name: "app", Value: "${{ vars.item.name }}"},
+				}, steps[0].Vars)
+
+				assert.Equal(t, "update[1]", steps[1].As)
+				assert.Equal(t, []kargoapi.ExpressionVariable{
+					{Name: "item", Value: `${{ fromJSON(fromBase64("eyJuYW1lIjoiYXBwLTIifQ==")) }}`},
+					{Name: "index", Value: "${{ 1 }}"},
+					{Name: "app", Value: "${{ vars.item.name }}"},
+				}, steps[1].Vars)
+
+				assert.Equal(t, "step-2[0]", steps[2].As)
+				assert.Equal(t, `${{ fromJSON(fromBase64("InRlc3Qtc3RhZ2Ui")) }}`, steps[2].Vars[0].Value)
+			},
+		},
+		{
+			name: "forEach task step",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{
+							Task: &kargoapi.PromotionTaskReference{
+								Name: "test-task",
+							},
+							ForEach: `${{ ["a", "b"] }}`,
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.PromotionTask{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-task",
+						Namespace: "test-project",
+					},
+					Spec: kargoapi.PromotionTaskSpec{
+						Steps: []kargoapi.PromotionStep{
+							{As: "sub-step", Uses: "fake-step"},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep, err error) {
+				require.NoError(t, err)
+				require.Len(t, steps, 2)
+				assert.Equal(t, "task-1[0]::sub-step", steps[0].As)
+				assert.Equal(t, "item", steps[0].Vars[0].Name)
+				assert.Equal(t, "task-1[1]::sub-step", steps[1].As)
+			},
+		},
+		{
+			name: "forEach items containing expression delimiters",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Vars: []kargoapi.ExpressionVariable{
+						{Name: "text", Value: `${{ "}" + "} $" + "{{ vars.x }" + "}" }}`},
+					},
+					Steps: []kargoapi.PromotionStep{
+						{
+							Uses:    "fake-step",
+							ForEach: `${{ [{"text": vars.text, "n": 1}] }}`,
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep, err error) {
+				require.NoError(t, err)
+				require.Len(t, steps, 1)
+				item, err := expressions.EvaluateTemplate(steps[0].Vars[0].Value, nil)
+				require.NoError(t, err)
+				assert.Equal(t, map[string]any{"text": "}} ${{ vars.x }}", "n": float64(1)}, item)
+			},
+		},
+		{
+			name: "forEach step with target Freight origin",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Freight: "test-freight",
+					Steps: []kargoapi.PromotionStep{
+						{
+							As:      "update",
+							Uses:    "fake-step",
+							ForEach: "${{ [ctx.targetFreight.origin.name] }}",
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-freight",
+						Namespace: "test-project",
+					},
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "test-warehouse",
+					},
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep, err error) {
+				require.NoError(t, err)
+				require.Len(t, steps, 1)
+				// "test-warehouse"
+				assert.Equal(
+					t,
+					`${{ fromJSON(fromBase64("InRlc3Qtd2FyZWhvdXNlIg==")) }}`,
+					steps[0].Vars[0].Value,
+				)
+			},
+		},
+		{
+			name: "forEach step with target Freight not found",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Freight: "missing-freight",
+					Steps: []kargoapi.PromotionStep{
+						{
+							Uses:    "fake-step",
+							ForEach: "${{ [1] }}",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.PromotionStep, err error) {
+				assert.ErrorContains(t, err, `get Freight "missing-freight"`)
+			},
+		},
+		{
+			name: "forEach expression references unavailable variable",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Vars: []kargoapi.ExpressionVariable{
+						{Name: "apps", Value: `${{ secret("apps").list }}`},
+					},
+					Steps: []kargoapi.PromotionStep{
+						{
+							Uses:    "fake-step",
+							ForEach: `${{ vars["apps"] }}`,
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.PromotionStep, err error) {
+				assert.ErrorContains(t, err, `variable "apps" is not available to forEach expressions`)
+				assert.ErrorContains(t, err, "secret")
+			},
+		},
+		{
+			name: "forEach expression does not evaluate to a list",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{
+							Uses:    "fake-step",
+							ForEach: "${{ ctx.stage }}",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.PromotionStep, err error) {
+				assert.ErrorContains(t, err, "forEach expression must evaluate to a list")
+			},
+		},
+		{
+			name: "parallel group steps",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{
+							Parallel: &kargoapi.PromotionStepGroup{
+								Steps: []kargoapi.PromotionGroupStep{
+									{Uses: "fake-step"},
+									{Uses: "fake-step", As: "custom"},
+								},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep, err error) {
+				require.NoError(t, err)
+				// The steps of the group directly follow the group
+				require.Len(t, steps, 3)
+				assert.Equal(t, "step-1", steps[0].As)
+				require.NotNil(t, steps[0].Parallel)
+				assert.Equal(t, "step-1-step-1", steps[1].As)
+				assert.Equal(t, "custom", steps[2].As)
+			},
+		},
+		{
+			name: "parallel group step with forEach",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{
+							ForEach: "${{ [1, 2] }}",
+							Parallel: &kargoapi.PromotionStepGroup{
+								Steps: []kargoapi.PromotionGroupStep{{Uses: "fake-step"}},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ []kargoapi.PromotionStep, err error) {
+				assert.ErrorContains(t, err, "with a parallel group cannot set forEach")
+			},
+		},
+		{
+			name: "onFailure and finally steps",
+			promo: kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-promotion",
+					Namespace: "test-project",
+				},
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{
+						{Uses: "fake-step"},
+					},
+					OnFailure: []kargoapi.PromotionStep{
+						{Uses: "fake-step"},
+						{
+							Task: &kargoapi.PromotionTaskReference{
+								Name: "test-task",
+							},
+						},
+					},
+					Finally: []kargoapi.PromotionStep{
+						{Uses: "fake-step"},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.PromotionTask{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-task",
+						Namespace: "test-project",
+					},
+					Spec: kargoapi.PromotionTaskSpec{
+						Steps: []kargoapi.PromotionStep{
+							{Uses: "other-fake-step"},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, steps []kargoapi.PromotionStep, err error) {
+				require.NoError(t, err)
+				require.Len(t, steps, 4)
+
+				assert.Equal(t, "step-1", steps[0].As)
+				assert.Equal(t, "on-failure-step-1", steps[1].As)
+				assert.Equal(t, "on-failure-task-2::step-1", steps[2].As)
+				assert.Equal(t, "other-fake-step", steps[2].Uses)
+				assert.Equal(t, "finally-step-1", steps[3].As)
+			},
+		},
 		{
 			name: "multiple task steps",
 			promo: kargoapi.Promotion{
@@ -396,7 +740,7 @@ func TestPromotionBuilder_InflateSteps(t *testing.T) {
 			b := NewPromotionBuilder(c)
 			p := tt.promo.DeepCopy()
 			err := b.InflateSteps(context.Background(), p)
-			tt.assertions(t, p.Spec.Steps, err)
+			tt.assertions(t, p.Spec.GetSteps(), err)
 		})
 	}
 }
@@ -9,4 +9,9 @@ type Engine interface {
 	// Promote executes the specified sequence of Steps and returns a Result
 	// that aggregates the results of all steps.
 	Promote(context.Context, Context, []Step) (Result, error)
+	// Cleanup releases any resources held for the execution of the steps of
+	// the specified Promotion in the specified Project, stopping the execution
+	// if it is still in progress. It must only be called once the Promotion's
+	// terminal phase has been persisted or the Promotion has been deleted.
+	Cleanup(ctx context.Context, project, promotion string) error
 }
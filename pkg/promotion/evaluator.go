@@ -97,25 +97,37 @@ func ExprEnvWithTaskOutputs(alias string, outputs State) ExprEnvOption {
 // ExprEnvOption functional options. These options can be used to add variables
 // or modify the expression language environment.
 func (p *StepEvaluator) BuildExprEnv(promoCtx Context, opts ...ExprEnvOption) map[string]any {
-	env := map[string]any{
-		"ctx": map[string]any{
-			"project":   promoCtx.Project,
-			"promotion": promoCtx.Promotion,
-			"stage":     promoCtx.Stage,
-			"targetFreight": map[string]any{
-				"name": promoCtx.TargetFreightRef.Name,
-				"origin": map[string]any{
-					"name": promoCtx.TargetFreightRef.Origin.Name,
-				},
+	stepCtx := map[string]any{
+		"project":   promoCtx.Project,
+		"promotion": promoCtx.Promotion,
+		"stage":     promoCtx.Stage,
+		"targetFreight": map[string]any{
+			"name": promoCtx.TargetFreightRef.Name,
+			"origin": map[string]any{
+				"name": promoCtx.TargetFreightRef.Origin.Name,
 			},
-			"meta": map[string]any{
-				"promotion": map[string]any{
-					"actor": promoCtx.Actor,
-				},
+		},
+		"meta": map[string]any{
+			"promotion": map[string]any{
+				"actor": promoCtx.Actor,
 			},
 		},
 	}
 
+	// Make information about the step that caused the main block of steps to
+	// fail available to the steps in the onFailure and finally blocks.
+	if failed := failedStep(promoCtx.StepExecutionMetadata); failed != nil {
+		stepCtx["failedStep"] = map[string]any{
+			"alias":   failed.Alias,
+			"status":  failed.Status,
+			"message": failed.Message,
+		}
+	}
+
+	env := map[string]any{
+		"ctx": stepCtx,
+	}
+
 	// Apply all provided options
 	for _, opt := range opts {
 		opt(env)
@@ -186,14 +198,20 @@ func (p *StepEvaluator) Vars(ctx context.Context, promoCtx Context, step Step) (
 // ShouldSkip determines whether a Step should be skipped based on the "if"
 // condition defined in the Step. If the "if" condition evaluates to false, the
 // Step is skipped. If the "if" condition is not defined, the Step is skipped
-// if any of the previous Steps have failed or errored and are not skipped
-// otherwise.
+// if any of the previous Steps in the same block have failed or errored and
+// are not skipped otherwise. Steps in the onFailure block are always skipped
+// if none of the Steps in the main block have failed or errored.
 func (p *StepEvaluator) ShouldSkip(ctx context.Context, promoCtx Context, step Step) (bool, error) {
+	if step.Block == kargoapi.PromotionStepBlockOnFailure &&
+		!promoCtx.StepExecutionMetadata.InBlock(kargoapi.PromotionStepBlockMain).HasFailures() {
+		return true, nil
+	}
+
 	// If no "if" condition is provided, then this step is automatically skipped
-	// if any of the previous steps have errored or failed and is not skipped
-	// otherwise.
+	// if any of the previous steps in the same block have errored or failed and
+	// is not skipped otherwise.
 	if step.If == "" {
-		return promoCtx.StepExecutionMetadata.HasFailures(), nil
+		return promoCtx.StepExecutionMetadata.InBlock(step.Block).HasFailures(), nil
 	}
 
 	vars, err := p.Vars(ctx, promoCtx, step)
@@ -252,6 +270,21 @@ func (p *StepEvaluator) Config(ctx context.Context, promoCtx Context, step Step)
 		return nil, err
 	}
 
+	return p.config(ctx, promoCtx, step, vars)
+}
+
+// config evaluates the configuration defined in the Step using the provided,
+// already evaluated, variables.
+func (p *StepEvaluator) config(
+	ctx context.Context,
+	promoCtx Context,
+	step Step,
+	vars map[string]any,
+) (Config, error) {
+	if step.Config == nil {
+		return nil, nil
+	}
+
 	env := p.BuildExprEnv(
 		promoCtx,
 		ExprEnvWithStepMetas(promoCtx),
@@ -296,7 +329,12 @@ func (p *StepEvaluator) BuildStepContext(
 	promoCtx Context,
 	step Step,
 ) (*StepContext, error) {
-	stepCfg, err := p.Config(ctx, promoCtx, step)
+	vars, err := p.Vars(ctx, promoCtx, step)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get step vars: %w", err)
+	}
+
+	stepCfg, err := p.config(ctx, promoCtx, step, vars)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get step config: %w", err)
 	}
@@ -315,6 +353,7 @@ func (p *StepEvaluator) BuildStepContext(
 		SharedState:      promoCtx.State.DeepCopy(),
 		Alias:            step.Alias,
 		Config:           stepCfg,
+		Vars:             vars,
 		Project:          promoCtx.Project,
 		Stage:            promoCtx.Stage,
 		Promotion:        promoCtx.Promotion,
@@ -351,3 +390,19 @@ func getAliasNamespace(alias string) string {
 	}
 	return parts[0]
 }
+
+// failedStep returns the StepExecutionMetadata of the first step in the main
+// block that failed or errored, or nil if there is no such step. Steps with
+// ContinueOnError set are not taken into account.
+func failedStep(stepExecMetas kargoapi.StepExecutionMetadataList) *kargoapi.StepExecutionMetadata {
+	for i, stepExecMeta := range stepExecMetas {
+		if stepExecMeta.Block != kargoapi.PromotionStepBlockMain || stepExecMeta.ContinueOnError {
+			continue
+		}
+		switch stepExecMeta.Status {
+		case kargoapi.PromotionStepStatusErrored, kargoapi.PromotionStepStatusFailed:
+			return &stepExecMetas[i]
+		}
+	}
+	return nil
+}
@@ -385,6 +385,51 @@ func TestStepEvaluator_BuildExprEnv(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with failed step",
+			promoCtx: Context{
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{
+					{
+						Alias:           "ignored",
+						Status:          kargoapi.PromotionStepStatusErrored,
+						ContinueOnError: true,
+					},
+					{
+						Alias:   "update",
+						Status:  kargoapi.PromotionStepStatusFailed,
+						Message: "something went wrong",
+					},
+					{
+						Alias:  "revert",
+						Status: kargoapi.PromotionStepStatusErrored,
+						Block:  kargoapi.PromotionStepBlockOnFailure,
+					},
+				},
+			},
+			expected: map[string]any{
+				"ctx": map[string]any{
+					"project":   "",
+					"promotion": "",
+					"stage":     "",
+					"targetFreight": map[string]any{
+						"name": "",
+						"origin": map[string]any{
+							"name": "",
+						},
+					},
+					"meta": map[string]any{
+						"promotion": map[string]any{
+							"actor": "",
+						},
+					},
+					"failedStep": map[string]any{
+						"alias":   "update",
+						"status":  kargoapi.PromotionStepStatusFailed,
+						"message": "something went wrong",
+					},
+				},
+			},
+		},
 		{
 			name: "empty context",
 			promoCtx: Context{
@@ -836,6 +881,68 @@ func TestStepEvaluator_ShouldSkip(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			name: "no if condition with failures in another block",
+			step: Step{
+				Block: kargoapi.PromotionStepBlockFinally,
+			},
+			promoCtx: Context{
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+					Status: kargoapi.PromotionStepStatusFailed,
+				}},
+			},
+			assertions: func(t *testing.T, b bool, err error) {
+				assert.False(t, b)
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "no if condition with failures in same block",
+			step: Step{
+				Block: kargoapi.PromotionStepBlockFinally,
+			},
+			promoCtx: Context{
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+					Status: kargoapi.PromotionStepStatusFailed,
+					Block:  kargoapi.PromotionStepBlockFinally,
+				}},
+			},
+			assertions: func(t *testing.T, b bool, err error) {
+				assert.True(t, b)
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "onFailure step without failures in main block",
+			step: Step{
+				Block: kargoapi.PromotionStepBlockOnFailure,
+				If:    "${{ true }}",
+			},
+			promoCtx: Context{
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+				}},
+			},
+			assertions: func(t *testing.T, b bool, err error) {
+				assert.True(t, b)
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "onFailure step with failures in main block",
+			step: Step{
+				Block: kargoapi.PromotionStepBlockOnFailure,
+			},
+			promoCtx: Context{
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+					Status: kargoapi.PromotionStepStatusErrored,
+				}},
+			},
+			assertions: func(t *testing.T, b bool, err error) {
+				assert.False(t, b)
+				assert.NoError(t, err)
+			},
+		},
 		{
 			name: "if condition uses vars",
 			step: Step{
@@ -1476,7 +1583,11 @@ func TestStepEvaluator_BuildStepContext(t *testing.T) {
 			},
 			step: Step{
 				Alias:  "test-step",
-				Config: []byte(`{"key": "value"}`),
+				Config: []byte(`{"key": "${{ vars.foo }}"}`),
+				Vars: []kargoapi.ExpressionVariable{{
+					Name:  "foo",
+					Value: "value",
+				}},
 			},
 			assertions: func(t *testing.T, stepCtx *StepContext, err error) {
 				require.NoError(t, err)
@@ -1500,6 +1611,9 @@ func TestStepEvaluator_BuildStepContext(t *testing.T) {
 				assert.Equal(t, kargoapi.FreightOriginKindWarehouse, stepCtx.FreightRequests[0].Origin.Kind)
 				assert.Equal(t, "test-warehouse", stepCtx.FreightRequests[0].Origin.Name)
 
+				// Verify vars are evaluated
+				assert.Equal(t, map[string]any{"foo": "value"}, stepCtx.Vars)
+
 				// Verify config is evaluated
 				assert.Equal(t, Config{"key": "value"}, stepCtx.Config)
 			},
@@ -1533,6 +1647,23 @@ func TestStepEvaluator_BuildStepContext(t *testing.T) {
 				assert.Nil(t, stepCtx)
 			},
 		},
+		{
+			name: "handles step vars evaluation error",
+			promoCtx: Context{
+				WorkDir: "/tmp/workdir",
+			},
+			step: Step{
+				Alias: "test-step",
+				Vars: []kargoapi.ExpressionVariable{{
+					Name:  "foo",
+					Value: "${{ invalid.expression }}",
+				}},
+			},
+			assertions: func(t *testing.T, stepCtx *StepContext, err error) {
+				require.ErrorContains(t, err, "failed to get step vars")
+				assert.Nil(t, stepCtx)
+			},
+		},
 		{
 			name: "handles nil step config",
 			promoCtx: Context{
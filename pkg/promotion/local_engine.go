@@ -15,7 +15,7 @@ import (
 
 // ReservedStepAliasRegex is a regular expression that matches step aliases that
 // are reserved for internal use.
-var ReservedStepAliasRegex = regexp.MustCompile(`^(step|task)-\d+$`)
+var ReservedStepAliasRegex = regexp.MustCompile(`^(((on-failure|finally)-)?(step|task)-\d+|.*\[\d+\])$`)
 
 // ExprDataCacheFn is a function that returns a new cache to use in expression
 // functions that consult the Kubernetes API.
@@ -62,6 +62,30 @@ func NewLocalEngine(
 	}
 }
 
+// NewDryRunEngine returns an implementation of the Engine interface that uses
+// built-in StepRunners locally, except where a simulator has been registered
+// in DryRunStepRunnerRegistry for a StepRunner with side effects. In that case,
+// the simulator is used instead.
+func NewDryRunEngine(
+	kargoClient client.Client,
+	argocdClient client.Client,
+	credsDB credentials.Database,
+	cacheFunc ExprDataCacheFn,
+) *LocalEngine {
+	return &LocalEngine{
+		orchestator: NewLocalOrchestrator(
+			&dryRunStepRunnerRegistry{
+				StepRunnerRegistry: DefaultStepRunnerRegistry,
+				simulators:         DryRunStepRunnerRegistry,
+			},
+			kargoClient,
+			argocdClient,
+			credsDB,
+			cacheFunc,
+		),
+	}
+}
+
 // Promote implements the Engine interface.
 func (e *LocalEngine) Promote(
 	ctx context.Context,
@@ -79,6 +103,11 @@ func (e *LocalEngine) Promote(
 	return e.orchestator.ExecuteSteps(ctx, promoCtx, steps)
 }
 
+// Cleanup implements the Engine interface.
+func (e *LocalEngine) Cleanup(ctx context.Context, project, promotion string) error {
+	return e.orchestator.Cleanup(ctx, project, promotion)
+}
+
 // setupWorkDir creates a temporary working directory if one is not provided.
 func (e *LocalEngine) setupWorkDir(existingDir string) (string, error) {
 	if existingDir != "" {
@@ -2,11 +2,21 @@ package promotion
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"regexp"
 	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	gocache "github.com/patrickmn/go-cache"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -14,6 +24,11 @@ import (
 	"github.com/akuity/kargo/pkg/health"
 )
 
+// forEachAliasRegex matches the aliases of steps expanded from a step with a
+// forEach expression, capturing the alias of the original step and the index
+// of the item.
+var forEachAliasRegex = regexp.MustCompile(`^(.+)\[(\d+)\]$`)
+
 // LocalOrchestrator is an implementation of the Orchestrator interface that
 // executes steps locally using the provided StepExecutor and StepRunner
 // registry.
@@ -40,6 +55,13 @@ func NewLocalOrchestrator(
 	}
 }
 
+// Cleanup implements the Orchestrator interface. Steps are executed
+// synchronously by ExecuteSteps, so no resources outlive a call to it and
+// there is nothing to clean up.
+func (o *LocalOrchestrator) Cleanup(context.Context, string, string) error {
+	return nil
+}
+
 // ExecuteSteps executes the provided steps in the context of the given
 // Promotion context. It iterates through the steps, evaluates their "if"
 // conditions, and executes them if they are not skipped. It also handles
@@ -76,6 +98,17 @@ func (o *LocalOrchestrator) ExecuteSteps(
 					step.Alias, ctx.Err(),
 				).Finished()
 			}
+			if step.Parallel != nil {
+				for _, groupStep := range steps[i+1 : i+1+int64(step.Parallel.Steps)] {
+					groupMeta := (*StepMetadata)(promoCtx.GetStepExecutionMetadata(groupStep))
+					if groupMeta.StartedAt != nil && groupMeta.FinishedAt == nil {
+						groupMeta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
+							"step %q was canceled due to context cancellation: %s",
+							groupStep.Alias, ctx.Err(),
+						).Finished()
+					}
+				}
+			}
 			return Result{
 				Status:                kargoapi.PromotionPhaseErrored,
 				Message:               fmt.Sprintf("execution canceled: %s", ctx.Err()),
@@ -88,6 +121,29 @@ func (o *LocalOrchestrator) ExecuteSteps(
 			// Continue execution if the context is still active.
 		}
 
+		if step.Parallel != nil {
+			groupSteps := steps[i+1 : i+1+int64(step.Parallel.Steps)]
+			complete, groupHealthChecks, retryAfter, err := o.executeParallelGroup(
+				ctx, &promoCtx, step, groupSteps,
+			)
+			healthChecks = append(healthChecks, groupHealthChecks...)
+			if !complete {
+				// Group incomplete; return errors (if any) for progressive
+				// backoff.
+				return Result{
+					Status:                kargoapi.PromotionPhaseRunning,
+					CurrentStep:           i,
+					StepExecutionMetadata: promoCtx.StepExecutionMetadata,
+					State:                 promoCtx.State,
+					HealthChecks:          healthChecks,
+					RetryAfter:            retryAfter,
+				}, err
+			}
+			// Continue with the first step after the group.
+			i += int64(len(groupSteps))
+			continue
+		}
+
 		processor := NewStepEvaluator(o.client, o.newCache())
 
 		// Only evaluate the "if" conditio when the step has not yet started.
@@ -134,6 +190,20 @@ func (o *LocalOrchestrator) ExecuteSteps(
 			continue
 		}
 
+		// If a previous attempt to execute the step errored or failed, do not
+		// make another attempt before the time determined by the backoff policy
+		// of the step.
+		if wait := untilNextAttempt(meta); wait > 0 {
+			return Result{
+				Status:                kargoapi.PromotionPhaseRunning,
+				CurrentStep:           i,
+				StepExecutionMetadata: promoCtx.StepExecutionMetadata,
+				State:                 promoCtx.State,
+				HealthChecks:          healthChecks,
+				RetryAfter:            &wait,
+			}, nil
+		}
+
 		// Mark the step as started.
 		meta.Started()
 
@@ -147,10 +217,8 @@ func (o *LocalOrchestrator) ExecuteSteps(
 		}
 
 		// Execute the step.
-		result, err := o.executor.ExecuteStep(ctx, StepExecutionRequest{
-			Context: *stepCtx,
-			Step:    step,
-		})
+		meta.Attempted()
+		result, err := o.executeAttempt(ctx, step, *stepCtx)
 
 		// Propagate the step output to the state.
 		o.propagateStepOutput(promoCtx, step, reg.Metadata, result)
@@ -168,6 +236,19 @@ func (o *LocalOrchestrator) ExecuteSteps(
 
 		// Determine the completion of the step based on the metadata.
 		if !o.determineStepCompletion(promoCtx, step, reg.Metadata, err) {
+			if meta.NextAttemptAt != nil {
+				// The step is backing off after an errored or failed attempt.
+				// The error has been recorded in the step's metadata, and the
+				// Promotion should be requeued for the next attempt.
+				return Result{
+					Status:                kargoapi.PromotionPhaseRunning,
+					CurrentStep:           i,
+					StepExecutionMetadata: promoCtx.StepExecutionMetadata,
+					State:                 promoCtx.State,
+					HealthChecks:          healthChecks,
+					RetryAfter:            ptr.To(untilNextAttempt(meta)),
+				}, nil
+			}
 			// Step incomplete; return error (if any) for progressive backoff.
 			return Result{
 				Status:                kargoapi.PromotionPhaseRunning,
@@ -200,6 +281,292 @@ func (o *LocalOrchestrator) ExecuteSteps(
 	}, nil
 }
 
+// parallelStepExecution holds the state of the execution of a single step in
+// a parallel group.
+type parallelStepExecution struct {
+	step     Step
+	stepMeta StepRunnerMetadata
+	stepCtx  *StepContext
+	result   StepResult
+	err      error
+}
+
+// executeParallelGroup executes the steps in the parallel group defined by the
+// provided group step. Steps in the group that have not yet completed are
+// executed concurrently, each in a subdirectory of the Promotion's working
+// directory, with no more than the group's maximum concurrency executing at
+// any given time. It returns true if all steps in the group have completed,
+// along with the health checks of the steps that succeeded during this
+// invocation. If the group has not completed, the shortest retry interval
+// suggested by any incomplete step and the errors (if any) of those steps are
+// also returned.
+func (o *LocalOrchestrator) executeParallelGroup(
+	ctx context.Context,
+	promoCtx *Context,
+	group Step,
+	steps []Step,
+) (bool, []health.Criteria, *time.Duration, error) {
+	// Ensure execution metadata for all steps in the group exists before
+	// obtaining references to it, as appending to the metadata may invalidate
+	// previously obtained references.
+	for _, step := range steps {
+		promoCtx.GetStepExecutionMetadata(step)
+	}
+	groupMeta := promoCtx.SetCurrentStep(group)
+	metas := make([]*StepMetadata, len(steps))
+	for j, step := range steps {
+		metas[j] = (*StepMetadata)(promoCtx.GetStepExecutionMetadata(step))
+	}
+
+	processor := NewStepEvaluator(o.client, o.newCache())
+
+	// Only evaluate the "if" condition of the group when it has not yet
+	// started. If the group is skipped, so are all steps in it.
+	if groupMeta.StartedAt == nil {
+		skip, err := processor.ShouldSkip(ctx, *promoCtx, group)
+		if err != nil || skip {
+			if err != nil {
+				groupMeta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
+					"error checking if step %q should be skipped: %s", group.Alias, err,
+				)
+			} else {
+				groupMeta.WithStatus(kargoapi.PromotionStepStatusSkipped)
+			}
+			for _, meta := range metas {
+				meta.WithStatus(kargoapi.PromotionStepStatusSkipped)
+			}
+			return true, nil, nil, nil
+		}
+		groupMeta.Started()
+	}
+
+	// Prepare the execution of all steps that have not yet completed. This is
+	// done sequentially, as it involves evaluating expressions against the
+	// shared Context.
+	var retryAfter *time.Duration
+	executions := make([]*parallelStepExecution, 0, len(steps))
+	for j, step := range steps {
+		meta := metas[j]
+		if isStepComplete(meta) {
+			continue
+		}
+
+		if meta.StartedAt == nil {
+			skip, err := processor.ShouldSkip(ctx, *promoCtx, step)
+			switch {
+			case err != nil:
+				meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
+					"error checking if step %q should be skipped: %s", step.Alias, err,
+				)
+				continue
+			case skip:
+				meta.WithStatus(kargoapi.PromotionStepStatusSkipped)
+				continue
+			}
+		}
+
+		reg, err := o.registry.Get(step.Kind)
+		if err != nil {
+			meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
+				"error getting runner for step kind %q", step.Kind,
+			)
+			continue
+		}
+
+		if wait := untilNextAttempt(meta); wait > 0 {
+			retryAfter = shorterDuration(retryAfter, &wait)
+			continue
+		}
+
+		meta.Started()
+
+		stepCtx, err := processor.BuildStepContext(ctx, *promoCtx, step)
+		if err != nil {
+			meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
+				"failed to build step context: %s", err,
+			).Finished()
+			continue
+		}
+
+		// Isolate the working directory of the step from those of the other
+		// steps in the group.
+		stepCtx.WorkDir = filepath.Join(promoCtx.WorkDir, parallelStepWorkDir(step.Alias))
+		if err = os.MkdirAll(stepCtx.WorkDir, 0o700); err != nil {
+			meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
+				"failed to create working directory for step %q: %s", step.Alias, err,
+			).Finished()
+			continue
+		}
+
+		meta.Attempted()
+		executions = append(executions, &parallelStepExecution{
+			step:     step,
+			stepMeta: reg.Metadata,
+			stepCtx:  stepCtx,
+		})
+	}
+
+	// Execute the steps concurrently.
+	sem := make(chan struct{}, max(group.Parallel.MaxConcurrency, 1))
+	var wg sync.WaitGroup
+	for _, e := range executions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			e.result, e.err = o.executeAttempt(ctx, e.step, *e.stepCtx)
+		}()
+	}
+	wg.Wait()
+
+	// Process the results of the steps sequentially, as this involves
+	// updating the shared Context.
+	var healthChecks []health.Criteria
+	var errs []error
+	for _, e := range executions {
+		meta := promoCtx.SetCurrentStep(e.step)
+
+		o.propagateStepOutput(*promoCtx, e.step, e.stepMeta, e.result)
+
+		if !e.result.Status.Valid() {
+			meta.WithStatus(kargoapi.PromotionStepStatusErrored).WithMessagef(
+				"step %q returned an invalid status: %s", e.step.Alias, e.result.Status,
+			).Finished()
+			continue
+		}
+
+		err := o.reconcileResultWithMetadata(*promoCtx, e.step, e.result, e.err)
+		if !o.determineStepCompletion(*promoCtx, e.step, e.stepMeta, err) {
+			switch {
+			case meta.NextAttemptAt != nil:
+				retryAfter = shorterDuration(retryAfter, ptr.To(untilNextAttempt(meta)))
+			case err != nil:
+				errs = append(errs, err)
+			default:
+				retryAfter = shorterDuration(retryAfter, e.result.RetryAfter)
+			}
+			continue
+		}
+
+		if meta.Status == kargoapi.PromotionStepStatusSucceeded && e.result.HealthCheck != nil {
+			healthChecks = append(healthChecks, *e.result.HealthCheck)
+		}
+	}
+
+	groupMeta = promoCtx.SetCurrentStep(group)
+	if !slices.ContainsFunc(metas, func(meta *StepMetadata) bool { return !isStepComplete(meta) }) {
+		// Determine the status of the group from those of its steps. Unless
+		// the group itself may fail without affecting the Promotion, steps
+		// which may fail without affecting the Promotion do not affect the
+		// status of the group either.
+		status := kargoapi.PromotionStepStatusSucceeded
+		var msg string
+		for j, meta := range metas {
+			if steps[j].ContinueOnError && !group.ContinueOnError {
+				continue
+			}
+			if meta.Status.Compare(status) > 0 {
+				status = meta.Status
+				msg = fmt.Sprintf("step %q: %s", steps[j].Alias, meta.Message)
+			}
+		}
+		if status == kargoapi.PromotionStepStatusSkipped {
+			status = kargoapi.PromotionStepStatusSucceeded
+			msg = ""
+		}
+		groupMeta.WithStatus(status).WithMessage(msg).Finished()
+		return true, healthChecks, nil, nil
+	}
+
+	groupMeta.WithStatus(kargoapi.PromotionStepStatusRunning)
+	return false, healthChecks, retryAfter, errors.Join(errs...)
+}
+
+// executeAttempt executes a single attempt of the provided step. If the step
+// has an attempt timeout, the attempt is canceled once it has elapsed.
+func (o *LocalOrchestrator) executeAttempt(
+	ctx context.Context,
+	step Step,
+	stepCtx StepContext,
+) (StepResult, error) {
+	timeout := step.Retry.GetAttemptTimeout(0)
+	if timeout <= 0 {
+		return o.executor.ExecuteStep(ctx, StepExecutionRequest{
+			Context: stepCtx,
+			Step:    step,
+		})
+	}
+
+	attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	result, err := o.executor.ExecuteStep(attemptCtx, StepExecutionRequest{
+		Context: stepCtx,
+		Step:    step,
+	})
+	if err != nil && ctx.Err() == nil && errors.Is(attemptCtx.Err(), context.DeadlineExceeded) {
+		return StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("attempt timed out after %s: %w", timeout, err)
+	}
+	return result, err
+}
+
+// untilNextAttempt returns the interval until the next attempt to execute the
+// step the provided StepMetadata belongs to may be made. It returns zero if no
+// such restriction applies.
+func untilNextAttempt(meta *StepMetadata) time.Duration {
+	if meta.NextAttemptAt == nil {
+		return 0
+	}
+	return max(time.Until(meta.NextAttemptAt.Time), 0)
+}
+
+// backoffInterval returns the delay before the next attempt to execute a step
+// with the provided backoff policy after the given number of consecutive
+// errored or failed attempts, including a random jitter.
+func backoffInterval(
+	backoff *kargoapi.PromotionStepRetryBackoff,
+	failedAttempts uint32,
+) time.Duration {
+	interval := backoff.GetInterval(failedAttempts)
+	if backoff.Jitter > 0 && interval > 0 {
+		interval += time.Duration(rand.Int64N(int64(interval)*int64(backoff.Jitter)/100 + 1))
+	}
+	return interval
+}
+
+// shorterDuration returns the shorter of the two provided durations, treating
+// nil as the absence of a duration.
+func shorterDuration(a, b *time.Duration) *time.Duration {
+	if a == nil || (b != nil && *b < *a) {
+		return b
+	}
+	return a
+}
+
+// isStepComplete returns true if the step the provided StepMetadata belongs to
+// has completed. This is the case if the step finished, or if it did not
+// start but was still assigned a status (e.g. because it was skipped).
+func isStepComplete(meta *StepMetadata) bool {
+	return meta.FinishedAt != nil || (meta.StartedAt == nil && meta.Status != "")
+}
+
+// parallelStepWorkDir returns the name of the subdirectory of the Promotion's
+// working directory that the step with the provided alias uses as its working
+// directory when executed as part of a parallel group. Characters which may
+// have a special meaning in paths are replaced.
+func parallelStepWorkDir(alias string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9',
+			r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, alias)
+}
+
 func (o *LocalOrchestrator) propagateStepOutput(
 	promoCtx Context,
 	step Step,
@@ -208,6 +575,7 @@ func (o *LocalOrchestrator) propagateStepOutput(
 ) {
 	// Update the state with the output of the step.
 	promoCtx.State[step.Alias] = result.Output
+	aggregateForEachOutput(promoCtx.State, step.Alias)
 
 	// If the step instructs that the output should be propagated to the
 	// task namespace, do so.
@@ -219,10 +587,32 @@ func (o *LocalOrchestrator) propagateStepOutput(
 			for k, v := range result.Output {
 				promoCtx.State[aliasNamespace].(map[string]any)[k] = v // nolint: forcetypeassert
 			}
+			aggregateForEachOutput(promoCtx.State, aliasNamespace)
 		}
 	}
 }
 
+// aggregateForEachOutput adds the output stored in the State under the given
+// alias to the list of outputs of the step it was expanded from, if the alias
+// is that of a step expanded from a step with a forEach expression. The
+// position of the output in the list corresponds to the index of the item.
+func aggregateForEachOutput(state State, alias string) {
+	matches := forEachAliasRegex.FindStringSubmatch(alias)
+	if matches == nil {
+		return
+	}
+	index, err := strconv.Atoi(matches[2])
+	if err != nil {
+		return
+	}
+	outputs, _ := state[matches[1]].([]any)
+	if len(outputs) <= index {
+		outputs = append(outputs, make([]any, index+1-len(outputs))...)
+	}
+	outputs[index] = state[alias]
+	state[matches[1]] = outputs
+}
+
 func (o *LocalOrchestrator) reconcileResultWithMetadata(
 	promoCtx Context,
 	step Step,
@@ -316,7 +706,15 @@ func (o *LocalOrchestrator) determineStepCompletion(
 	if err != nil {
 		// Treat Errored/Failed as if the step is still running so that the
 		// Promotion will be requeued. The step will be retried on the next
-		// reconciliation.
+		// reconciliation, or once the delay determined by its backoff policy
+		// has elapsed.
+		if backoff := step.Retry.GetBackoff(); backoff != nil {
+			interval := backoffInterval(backoff, meta.ErrorCount)
+			meta.BackOff(interval).WithMessagef(
+				"%s; step will be retried in %s", meta.Message, interval.Round(time.Second),
+			)
+			return false
+		}
 		meta.WithMessagef("%s; step will be retried", meta.Message)
 		return false
 	}
@@ -3,6 +3,8 @@ package promotion
 import (
 	"context"
 	"errors"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -16,6 +18,11 @@ import (
 )
 
 func TestLocalOrchestrator_ExecuteSteps(t *testing.T) {
+	// barrier is used by steps which can only succeed when executed
+	// concurrently.
+	barrier := &sync.WaitGroup{}
+	barrier.Add(2)
+
 	tests := []struct {
 		name          string
 		registrations []StepRunnerRegistration
@@ -288,6 +295,138 @@ func TestLocalOrchestrator_ExecuteSteps(t *testing.T) {
 				assert.Contains(t, result.StepExecutionMetadata[0].Message, "will be retried")
 			},
 		},
+		{
+			name: "non-terminal error on step execution; backing off",
+			steps: []Step{
+				{
+					Kind:  "error-step",
+					Alias: "step1",
+					Retry: &kargoapi.PromotionStepRetry{
+						ErrorThreshold: 3,
+						Backoff: &kargoapi.PromotionStepRetryBackoff{
+							InitialInterval: &metav1.Duration{Duration: time.Minute},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				// The error is recorded, but not returned, so the Promotion is
+				// requeued according to the backoff policy.
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseRunning, result.Status)
+				require.NotNil(t, result.RetryAfter)
+				assert.Greater(t, *result.RetryAfter, 55*time.Second)
+				assert.LessOrEqual(t, *result.RetryAfter, time.Minute)
+
+				require.Len(t, result.StepExecutionMetadata, 1)
+
+				stepExecMeta := result.StepExecutionMetadata[0]
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, stepExecMeta.Status)
+				assert.Equal(t, uint32(1), stepExecMeta.ErrorCount)
+				assert.Equal(t, uint32(1), stepExecMeta.Attempts)
+				assert.NotNil(t, stepExecMeta.NextAttemptAt)
+				assert.Contains(t, stepExecMeta.Message, "will be retried in 1m0s")
+			},
+		},
+		{
+			name: "step is backing off; no attempt is made",
+			promoCtx: Context{
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+					Alias:         "step1",
+					StartedAt:     ptr.To(metav1.Now()),
+					Status:        kargoapi.PromotionStepStatusErrored,
+					ErrorCount:    1,
+					Attempts:      1,
+					NextAttemptAt: ptr.To(metav1.NewTime(time.Now().Add(time.Minute))),
+				}},
+			},
+			steps: []Step{
+				{
+					Kind:  "success-step",
+					Alias: "step1",
+					Retry: &kargoapi.PromotionStepRetry{
+						ErrorThreshold: 3,
+						Backoff:        &kargoapi.PromotionStepRetryBackoff{},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseRunning, result.Status)
+				require.NotNil(t, result.RetryAfter)
+				assert.Greater(t, *result.RetryAfter, time.Duration(0))
+
+				require.Len(t, result.StepExecutionMetadata, 1)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.StepExecutionMetadata[0].Status)
+				assert.Equal(t, uint32(1), result.StepExecutionMetadata[0].Attempts)
+				assert.NotNil(t, result.StepExecutionMetadata[0].NextAttemptAt)
+			},
+		},
+		{
+			name: "step is no longer backing off; attempt is made",
+			promoCtx: Context{
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+					Alias:         "step1",
+					StartedAt:     ptr.To(metav1.Now()),
+					Status:        kargoapi.PromotionStepStatusErrored,
+					ErrorCount:    1,
+					Attempts:      1,
+					NextAttemptAt: ptr.To(metav1.NewTime(time.Now().Add(-time.Second))),
+				}},
+			},
+			steps: []Step{
+				{
+					Kind:  "success-step",
+					Alias: "step1",
+					Retry: &kargoapi.PromotionStepRetry{
+						ErrorThreshold: 3,
+						Backoff:        &kargoapi.PromotionStepRetryBackoff{},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Status)
+
+				require.Len(t, result.StepExecutionMetadata, 1)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[0].Status)
+				assert.Equal(t, uint32(2), result.StepExecutionMetadata[0].Attempts)
+				assert.Nil(t, result.StepExecutionMetadata[0].NextAttemptAt)
+			},
+		},
+		{
+			name: "attempt timeout elapsed",
+			registrations: []StepRunnerRegistration{{
+				Name: "slow-step",
+				Value: func(_ StepRunnerCapabilities) StepRunner {
+					return &MockStepRunner{
+						RunFunc: func(ctx context.Context, _ *StepContext) (StepResult, error) {
+							<-ctx.Done()
+							return StepResult{Status: kargoapi.PromotionStepStatusErrored}, ctx.Err()
+						},
+					}
+				},
+			}},
+			steps: []Step{
+				{
+					Kind:  "slow-step",
+					Alias: "step1",
+					Retry: &kargoapi.PromotionStepRetry{
+						AttemptTimeout: &metav1.Duration{Duration: 10 * time.Millisecond},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseErrored, result.Status)
+
+				require.Len(t, result.StepExecutionMetadata, 1)
+				stepExecMeta := result.StepExecutionMetadata[0]
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, stepExecMeta.Status)
+				assert.Contains(t, stepExecMeta.Message, "attempt timed out after 10ms")
+				assert.NotNil(t, stepExecMeta.FinishedAt)
+			},
+		},
 		{
 			name: "non-terminal error on step execution; timeout elapsed",
 			promoCtx: Context{
@@ -499,6 +638,272 @@ func TestLocalOrchestrator_ExecuteSteps(t *testing.T) {
 				assert.NotNil(t, result.StepExecutionMetadata[0].FinishedAt)
 			},
 		},
+		{
+			name: "onFailure and finally steps after failure",
+			registrations: []StepRunnerRegistration{{
+				Name: "config-output-step",
+				Value: func(_ StepRunnerCapabilities) StepRunner {
+					return &MockStepRunner{
+						RunFunc: func(_ context.Context, stepCtx *StepContext) (StepResult, error) {
+							return StepResult{
+								Status: kargoapi.PromotionStepStatusSucceeded,
+								Output: stepCtx.Config,
+							}, nil
+						},
+					}
+				},
+			}},
+			steps: []Step{
+				{Kind: "success-step", Alias: "step1"},
+				{Kind: "terminal-error-step", Alias: "step2"},
+				{Kind: "success-step", Alias: "step3"},
+				{
+					Kind:   "config-output-step",
+					Alias:  "revert",
+					Block:  kargoapi.PromotionStepBlockOnFailure,
+					Config: []byte(`{"alias": "${{ ctx.failedStep.alias }}", "message": "${{ ctx.failedStep.message }}"}`),
+				},
+				{Kind: "success-step", Alias: "cleanup", Block: kargoapi.PromotionStepBlockFinally},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseErrored, result.Status)
+				assert.Contains(t, result.Message, "something went wrong")
+				assert.Equal(t, int64(4), result.CurrentStep)
+
+				require.Len(t, result.StepExecutionMetadata, 5)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[0].Status)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.StepExecutionMetadata[1].Status)
+				assert.Equal(t, kargoapi.PromotionStepStatusSkipped, result.StepExecutionMetadata[2].Status)
+
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[3].Status)
+				assert.Equal(t, kargoapi.PromotionStepBlockOnFailure, result.StepExecutionMetadata[3].Block)
+				assert.Equal(t, "step2", result.State["revert"].(map[string]any)["alias"]) // nolint: forcetypeassert
+				assert.Contains(
+					t,
+					result.State["revert"].(map[string]any)["message"], // nolint: forcetypeassert
+					"something went wrong",
+				)
+
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[4].Status)
+				assert.Equal(t, kargoapi.PromotionStepBlockFinally, result.StepExecutionMetadata[4].Block)
+			},
+		},
+		{
+			name: "onFailure steps skipped after success",
+			steps: []Step{
+				{Kind: "success-step", Alias: "step1"},
+				{Kind: "error-step", Alias: "revert", Block: kargoapi.PromotionStepBlockOnFailure},
+				{Kind: "success-step", Alias: "cleanup", Block: kargoapi.PromotionStepBlockFinally},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Status)
+				assert.Equal(t, int64(2), result.CurrentStep)
+
+				require.Len(t, result.StepExecutionMetadata, 3)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[0].Status)
+				assert.Equal(t, kargoapi.PromotionStepStatusSkipped, result.StepExecutionMetadata[1].Status)
+				assert.Nil(t, result.StepExecutionMetadata[1].StartedAt)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[2].Status)
+			},
+		},
+		{
+			name: "failed finally step fails the promotion",
+			steps: []Step{
+				{Kind: "success-step", Alias: "step1"},
+				{Kind: "terminal-error-step", Alias: "cleanup", Block: kargoapi.PromotionStepBlockFinally},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseErrored, result.Status)
+				require.Len(t, result.StepExecutionMetadata, 2)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.StepExecutionMetadata[1].Status)
+			},
+		},
+		{
+			name: "outputs of forEach steps are aggregated",
+			registrations: []StepRunnerRegistration{{
+				Name: "config-output-step",
+				Value: func(_ StepRunnerCapabilities) StepRunner {
+					return &MockStepRunner{
+						RunFunc: func(_ context.Context, stepCtx *StepContext) (StepResult, error) {
+							return StepResult{
+								Status: kargoapi.PromotionStepStatusSucceeded,
+								Output: stepCtx.Config,
+							}, nil
+						},
+					}
+				},
+			}},
+			steps: []Step{
+				{
+					Kind:  "config-output-step",
+					Alias: "update[0]",
+					Vars: []kargoapi.ExpressionVariable{
+						{Name: "item", Value: `${{ fromJSON(fromBase64("eyJuYW1lIjoiYXBwLTEifQ==")) }}`},
+						{Name: "index", Value: "${{ 0 }}"},
+					},
+					Config: []byte(`{"app": "${{ vars.item.name }}", "index": "${{ quote(vars.index) }}"}`),
+				},
+				{
+					Kind:  "config-output-step",
+					Alias: "update[1]",
+					Vars: []kargoapi.ExpressionVariable{
+						{Name: "item", Value: `${{ fromJSON(fromBase64("eyJuYW1lIjoiYXBwLTIifQ==")) }}`},
+						{Name: "index", Value: "${{ 1 }}"},
+					},
+					Config: []byte(`{"app": "${{ vars.item.name }}", "index": "${{ quote(vars.index) }}"}`),
+				},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Status)
+				require.Len(t, result.StepExecutionMetadata, 2)
+				assert.Equal(t, "update[0]", result.StepExecutionMetadata[0].Alias)
+				assert.Equal(t, "update[1]", result.StepExecutionMetadata[1].Alias)
+				assert.Equal(t, []any{
+					map[string]any{"app": "app-1", "index": "0"},
+					map[string]any{"app": "app-2", "index": "1"},
+				}, result.State["update"])
+			},
+		},
+		{
+			name: "steps in parallel group are executed concurrently",
+			registrations: []StepRunnerRegistration{{
+				Name: "barrier-step",
+				Value: func(_ StepRunnerCapabilities) StepRunner {
+					return &MockStepRunner{
+						RunFunc: func(_ context.Context, stepCtx *StepContext) (StepResult, error) {
+							barrier.Done()
+							done := make(chan struct{})
+							go func() {
+								barrier.Wait()
+								close(done)
+							}()
+							select {
+							case <-done:
+							case <-time.After(5 * time.Second):
+								return StepResult{Status: kargoapi.PromotionStepStatusErrored},
+									errors.New("steps were not executed concurrently")
+							}
+							return StepResult{
+								Status: kargoapi.PromotionStepStatusSucceeded,
+								Output: map[string]any{"workDir": stepCtx.WorkDir},
+							}, nil
+						},
+					}
+				},
+			}},
+			steps: []Step{
+				{Alias: "group", Parallel: &StepGroup{Steps: 2, MaxConcurrency: 2}},
+				{Kind: "barrier-step", Alias: "a", Group: "group"},
+				{Kind: "barrier-step", Alias: "b", Group: "group"},
+				{Kind: "success-step", Alias: "after"},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Status)
+				assert.Equal(t, int64(3), result.CurrentStep)
+
+				require.Len(t, result.StepExecutionMetadata, 4)
+				for i, alias := range []string{"group", "a", "b", "after"} {
+					assert.Equal(t, alias, result.StepExecutionMetadata[i].Alias)
+					assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[i].Status)
+					assert.NotNil(t, result.StepExecutionMetadata[i].FinishedAt)
+				}
+
+				// Each step in the group has its own working directory
+				for _, alias := range []string{"a", "b"} {
+					workDir, ok := result.State[alias].(map[string]any)["workDir"].(string)
+					require.True(t, ok)
+					assert.Equal(t, alias, filepath.Base(workDir))
+					assert.DirExists(t, workDir)
+				}
+			},
+		},
+		{
+			name: "failed step in parallel group fails the group",
+			steps: []Step{
+				{Alias: "group", Parallel: &StepGroup{Steps: 3, MaxConcurrency: 1}},
+				{Kind: "success-step", Alias: "a", Group: "group"},
+				{Kind: "terminal-error-step", Alias: "b", Group: "group"},
+				{Kind: "error-step", Alias: "c", Group: "group", ContinueOnError: true, Retry: &kargoapi.PromotionStepRetry{
+					ErrorThreshold: 1,
+				}},
+				{Kind: "success-step", Alias: "after"},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseErrored, result.Status)
+				assert.Contains(t, result.Message, `step "b"`)
+				assert.Equal(t, int64(4), result.CurrentStep)
+
+				require.Len(t, result.StepExecutionMetadata, 5)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.StepExecutionMetadata[0].Status)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[1].Status)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.StepExecutionMetadata[2].Status)
+				assert.Equal(t, kargoapi.PromotionStepStatusErrored, result.StepExecutionMetadata[3].Status)
+				assert.Equal(t, kargoapi.PromotionStepStatusSkipped, result.StepExecutionMetadata[4].Status)
+			},
+		},
+		{
+			name: "skipped parallel group skips its steps",
+			steps: []Step{
+				{Alias: "group", If: "${{ false }}", Parallel: &StepGroup{Steps: 2, MaxConcurrency: 2}},
+				{Kind: "success-step", Alias: "a", Group: "group"},
+				{Kind: "success-step", Alias: "b", Group: "group"},
+				{Kind: "success-step", Alias: "after"},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseSucceeded, result.Status)
+
+				require.Len(t, result.StepExecutionMetadata, 4)
+				for i := range 3 {
+					assert.Equal(t, kargoapi.PromotionStepStatusSkipped, result.StepExecutionMetadata[i].Status)
+					assert.Nil(t, result.StepExecutionMetadata[i].StartedAt)
+				}
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[3].Status)
+			},
+		},
+		{
+			name: "parallel group with running step",
+			promoCtx: Context{
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{
+					{Alias: "group", StartedAt: ptr.To(metav1.Now()), Status: kargoapi.PromotionStepStatusRunning},
+					{
+						Alias:      "a",
+						StartedAt:  ptr.To(metav1.Now()),
+						FinishedAt: ptr.To(metav1.Now()),
+						Status:     kargoapi.PromotionStepStatusSucceeded,
+					},
+					{Alias: "b", StartedAt: ptr.To(metav1.Now()), Status: kargoapi.PromotionStepStatusRunning},
+				},
+			},
+			steps: []Step{
+				{Alias: "group", Parallel: &StepGroup{Steps: 3, MaxConcurrency: 3}},
+				// This step has completed on a previous reconciliation and
+				// would fail if it was executed again.
+				{Kind: "terminal-error-step", Alias: "a", Group: "group"},
+				{Kind: "running-step", Alias: "b", Group: "group"},
+				{Kind: "success-step", Alias: "c", Group: "group"},
+				{Kind: "success-step", Alias: "after"},
+			},
+			assertions: func(t *testing.T, result Result, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, kargoapi.PromotionPhaseRunning, result.Status)
+				assert.Equal(t, int64(0), result.CurrentStep)
+
+				require.Len(t, result.StepExecutionMetadata, 4)
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, result.StepExecutionMetadata[0].Status)
+				assert.Nil(t, result.StepExecutionMetadata[0].FinishedAt)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[1].Status)
+				assert.Equal(t, kargoapi.PromotionStepStatusRunning, result.StepExecutionMetadata[2].Status)
+				assert.Equal(t, kargoapi.PromotionStepStatusSucceeded, result.StepExecutionMetadata[3].Status)
+				assert.NotNil(t, result.StepExecutionMetadata[3].FinishedAt)
+			},
+		},
 		{
 			name: "panic during step execution",
 			registrations: []StepRunnerRegistration{
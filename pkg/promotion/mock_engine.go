@@ -10,6 +10,7 @@ import (
 // to facilitate unit testing.
 type MockEngine struct {
 	PromoteFn func(context.Context, Context, []Step) (Result, error)
+	CleanupFn func(ctx context.Context, project, promotion string) error
 }
 
 // Promote implements the Engine interface.
@@ -23,3 +24,11 @@ func (m *MockEngine) Promote(
 	}
 	return m.PromoteFn(ctx, promoCtx, steps)
 }
+
+// Cleanup implements the Engine interface.
+func (m *MockEngine) Cleanup(ctx context.Context, project, promotion string) error {
+	if m.CleanupFn == nil {
+		return nil
+	}
+	return m.CleanupFn(ctx, project, promotion)
+}
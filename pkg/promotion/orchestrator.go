@@ -16,6 +16,10 @@ type Orchestrator interface {
 	// Promotion after executing the steps, including any health checks that
 	// were performed during the execution.
 	ExecuteSteps(ctx context.Context, promoCtx Context, steps []Step) (Result, error)
+	// Cleanup releases any resources held for the execution of the steps of
+	// the specified Promotion in the specified Project, stopping the execution
+	// if it is still in progress.
+	Cleanup(ctx context.Context, project, promotion string) error
 }
 
 // DetermineFinalPhase determines the final PromotionPhase based on the
@@ -0,0 +1,376 @@
+package promotion
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+const (
+	// WorkerRequestKey is the key of the Secret data entry holding the
+	// WorkerRequest for a worker Pod.
+	WorkerRequestKey = "request"
+	// WorkerResultKey is the key of the Secret data entry holding the most
+	// recent WorkerResult written by a worker Pod.
+	WorkerResultKey = "result"
+
+	// workerContainerName is the name of the container executing the steps of
+	// a Promotion within a worker Pod.
+	workerContainerName = "worker"
+
+	// workerLabelKey is the key of a label applied to all Jobs and Secrets
+	// belonging to worker Pods.
+	workerLabelKey = "kargo.akuity.io/promotion-worker"
+
+	// workerPollInterval is the interval at which the progress of a Promotion
+	// that is executed by a worker Pod is reported.
+	workerPollInterval = 10 * time.Second
+)
+
+// PodOrchestratorConfig represents configuration for a PodOrchestrator.
+type PodOrchestratorConfig struct {
+	// Enabled indicates whether the steps of Promotions should be executed in
+	// worker Pods instead of the controller process.
+	Enabled bool `envconfig:"PROMOTION_WORKER_ENABLED" default:"false"`
+	// Namespace is the namespace in which worker Pods are created.
+	Namespace string `envconfig:"PROMOTION_WORKER_NAMESPACE"`
+	// PodTemplatePath is the path to a YAML file containing the
+	// corev1.PodTemplateSpec from which worker Pods are created. It must
+	// define a container named "worker" that runs the Kargo image.
+	PodTemplatePath string `envconfig:"PROMOTION_WORKER_POD_TEMPLATE_PATH"`
+}
+
+// PodOrchestratorConfigFromEnv returns a PodOrchestratorConfig populated from
+// environment variables.
+func PodOrchestratorConfigFromEnv() PodOrchestratorConfig {
+	cfg := PodOrchestratorConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// WorkerRequest describes the steps of a Promotion to be executed by a worker
+// Pod and the context to execute them in.
+type WorkerRequest struct {
+	// Context is the context of the Promotion.
+	Context Context `json:"context"`
+	// Steps are the steps of the Promotion.
+	Steps []Step `json:"steps"`
+}
+
+// WorkerResult is the outcome of the execution of the steps of a Promotion by
+// a worker Pod. Worker Pods update it after every pass over the steps, which
+// allows the progress of the Promotion to be observed.
+type WorkerResult struct {
+	// Result is the Result of the most recent pass over the steps.
+	Result Result `json:"result"`
+	// Error is the message of the error returned by the most recent pass over
+	// the steps, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// PodOrchestrator is an implementation of the Orchestrator interface that
+// executes the steps of each Promotion in a dedicated, short-lived worker Pod
+// (managed by a Job) instead of the current process. This isolates the
+// filesystem and compute resources used by the steps of a Promotion from
+// those of all other Promotions.
+//
+// A worker Pod receives its WorkerRequest, and reports its WorkerResult,
+// through a Secret of the same name as its Job.
+type PodOrchestrator struct {
+	// workerClient is a client for the cluster in which worker Pods are
+	// created.
+	workerClient client.Client
+	// kargoClient is a client for the Kargo control plane.
+	kargoClient client.Client
+	namespace   string
+	podTemplate corev1.PodTemplateSpec
+}
+
+// NewPodOrchestrator returns a new PodOrchestrator that uses the provided
+// clients and configuration.
+func NewPodOrchestrator(
+	workerClient client.Client,
+	kargoClient client.Client,
+	cfg PodOrchestratorConfig,
+) (*PodOrchestrator, error) {
+	if cfg.Namespace == "" {
+		return nil, errors.New("worker namespace is required")
+	}
+	data, err := os.ReadFile(cfg.PodTemplatePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading worker Pod template: %w", err)
+	}
+	podTemplate := corev1.PodTemplateSpec{}
+	if err = yaml.Unmarshal(data, &podTemplate); err != nil {
+		return nil, fmt.Errorf("error parsing worker Pod template: %w", err)
+	}
+	if workerContainer(&podTemplate.Spec) == nil {
+		return nil, fmt.Errorf(
+			"worker Pod template does not define a container named %q",
+			workerContainerName,
+		)
+	}
+	return &PodOrchestrator{
+		workerClient: workerClient,
+		kargoClient:  kargoClient,
+		namespace:    cfg.Namespace,
+		podTemplate:  podTemplate,
+	}, nil
+}
+
+// NewWorkerPodEngine returns an implementation of the Engine interface that
+// executes the steps of each Promotion in a worker Pod using the provided
+// PodOrchestrator.
+func NewWorkerPodEngine(orchestrator *PodOrchestrator) *LocalEngine {
+	return &LocalEngine{orchestator: orchestrator}
+}
+
+// ExecuteSteps implements the Orchestrator interface. If no worker Pod exists
+// for the Promotion yet, one is created. Otherwise, the progress reported by
+// the existing worker Pod is returned. The worker is left in place even once
+// it has reported a terminal result, so that the result can be retrieved again
+// should it fail to be persisted. It is deleted by Cleanup.
+func (o *PodOrchestrator) ExecuteSteps(
+	ctx context.Context,
+	promoCtx Context,
+	steps []Step,
+) (Result, error) {
+	logger := logging.LoggerFromContext(ctx)
+	name := WorkerName(promoCtx.Project, promoCtx.Promotion)
+
+	job := &batchv1.Job{}
+	err := o.workerClient.Get(ctx, client.ObjectKey{Namespace: o.namespace, Name: name}, job)
+	if apierrors.IsNotFound(err) {
+		if err = o.createWorker(ctx, name, promoCtx, steps); err != nil {
+			return o.progress(promoCtx), fmt.Errorf("error creating worker: %w", err)
+		}
+		logger.Debug("created worker", "job", name)
+		return o.progress(promoCtx), nil
+	}
+	if err != nil {
+		return o.progress(promoCtx), fmt.Errorf("error getting worker Job %q: %w", name, err)
+	}
+
+	res := o.progress(promoCtx)
+	var resErr error
+	secret := &corev1.Secret{}
+	if err = o.workerClient.Get(
+		ctx, client.ObjectKey{Namespace: o.namespace, Name: name}, secret,
+	); err != nil {
+		return res, fmt.Errorf("error getting worker Secret %q: %w", name, err)
+	}
+	if data := secret.Data[WorkerResultKey]; len(data) > 0 {
+		workerRes := WorkerResult{}
+		if err = json.Unmarshal(data, &workerRes); err != nil {
+			return res, fmt.Errorf("error unmarshaling worker result: %w", err)
+		}
+		res = workerRes.Result
+		if workerRes.Error != "" {
+			resErr = errors.New(workerRes.Error)
+		}
+	}
+
+	if finished, msg := jobFinished(job); finished && !res.Status.IsTerminal() {
+		// The worker Pod exited before reporting a terminal result; e.g.
+		// because it exceeded its memory limit.
+		res.Status = kargoapi.PromotionPhaseErrored
+		res.Message = fmt.Sprintf("worker Pod exited before the Promotion finished: %s", msg)
+		resErr = nil
+	}
+
+	if res.Status.IsTerminal() {
+		return res, resErr
+	}
+
+	res.RetryAfter = ptr.To(workerPollInterval)
+	return res, resErr
+}
+
+// Cleanup implements the Orchestrator interface. It deletes the Job and Secret
+// of the worker Pod for the specified Promotion, if they exist. Deleting the
+// Job terminates the worker Pod if it is still running.
+func (o *PodOrchestrator) Cleanup(ctx context.Context, project, promotion string) error {
+	name := WorkerName(project, promotion)
+	key := client.ObjectKey{Namespace: o.namespace, Name: name}
+	if err := o.workerClient.Delete(
+		ctx,
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}},
+		client.PropagationPolicy(metav1.DeletePropagationBackground),
+	); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("error deleting worker Job %q: %w", name, err)
+	}
+	// The Secret is owned by the Job and would normally be garbage collected
+	// along with it, but it may not have been if creation of the worker was
+	// interrupted before its owner was set.
+	if err := o.workerClient.Delete(
+		ctx,
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}},
+	); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("error deleting worker Secret %q: %w", name, err)
+	}
+	return nil
+}
+
+// progress returns a Result reflecting the progress recorded in the provided
+// Context. It is used until a worker Pod has reported any progress itself.
+func (o *PodOrchestrator) progress(promoCtx Context) Result {
+	return Result{
+		Status:                kargoapi.PromotionPhaseRunning,
+		CurrentStep:           promoCtx.StartFromStep,
+		StepExecutionMetadata: promoCtx.StepExecutionMetadata,
+		State:                 promoCtx.State,
+		RetryAfter:            ptr.To(workerPollInterval),
+	}
+}
+
+// createWorker creates the Secret holding the WorkerRequest for the provided
+// Promotion context and steps, and the Job that runs the worker Pod.
+func (o *PodOrchestrator) createWorker(
+	ctx context.Context,
+	name string,
+	promoCtx Context,
+	steps []Step,
+) error {
+	// The working directory of the worker Pod is not shared with this process.
+	promoCtx.WorkDir = ""
+	reqData, err := json.Marshal(WorkerRequest{Context: promoCtx, Steps: steps})
+	if err != nil {
+		return fmt.Errorf("error marshaling worker request: %w", err)
+	}
+
+	labels := map[string]string{workerLabelKey: "true"}
+	annotations := map[string]string{
+		kargoapi.LabelKeyProject:        promoCtx.Project,
+		kargoapi.AnnotationKeyPromotion: promoCtx.Promotion,
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   o.namespace,
+			Name:        name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Data: map[string][]byte{WorkerRequestKey: reqData},
+	}
+	if err = o.workerClient.Create(ctx, secret); apierrors.IsAlreadyExists(err) {
+		// A previous attempt to create the worker was interrupted. Replace the
+		// request it left behind.
+		if err = o.workerClient.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+			return fmt.Errorf("error getting worker Secret %q: %w", name, err)
+		}
+		secret.Data = map[string][]byte{WorkerRequestKey: reqData}
+		err = o.workerClient.Update(ctx, secret)
+	}
+	if err != nil {
+		return fmt.Errorf("error creating worker Secret %q: %w", name, err)
+	}
+
+	podTemplate := o.podTemplate.DeepCopy()
+	if podTemplate.Labels == nil {
+		podTemplate.Labels = map[string]string{}
+	}
+	podTemplate.Labels[workerLabelKey] = "true"
+	podTemplate.Spec.RestartPolicy = corev1.RestartPolicyNever
+	container := workerContainer(&podTemplate.Spec)
+	container.Args = []string{"promotion-worker", "--namespace", o.namespace, "--name", name}
+	projectCfg, err := api.GetProjectConfig(ctx, o.kargoClient, promoCtx.Project)
+	if err != nil {
+		return err
+	}
+	if projectCfg != nil && projectCfg.Spec.PromotionWorker != nil &&
+		projectCfg.Spec.PromotionWorker.Resources != nil {
+		container.Resources = *projectCfg.Spec.PromotionWorker.Resources.DeepCopy()
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   o.namespace,
+			Name:        name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](0),
+			// No TTL is set. A finished worker, along with its result, must
+			// remain until its outcome has been persisted, however long that
+			// takes. Otherwise, it would be recreated and would execute steps
+			// that have already been executed once more. Workers are deleted by
+			// Cleanup instead.
+			Template: *podTemplate,
+		},
+	}
+	if err = o.workerClient.Create(ctx, job); err != nil {
+		return fmt.Errorf("error creating worker Job %q: %w", name, err)
+	}
+
+	// Make the Job the owner of the Secret, so the Secret is garbage collected
+	// along with the Job.
+	patch := client.MergeFrom(secret.DeepCopy())
+	secret.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: batchv1.SchemeGroupVersion.String(),
+		Kind:       "Job",
+		Name:       job.Name,
+		UID:        job.UID,
+	}}
+	if err = o.workerClient.Patch(ctx, secret, patch); err != nil {
+		return fmt.Errorf("error setting owner of worker Secret %q: %w", name, err)
+	}
+	return nil
+}
+
+// WorkerName returns the name of the Job and Secret of the worker Pod for the
+// specified Promotion.
+func WorkerName(project, promotion string) string {
+	return fmt.Sprintf(
+		"promotion-worker-%x",
+		sha256.Sum256([]byte(project+"/"+promotion)),
+	)[:len("promotion-worker-")+16]
+}
+
+// workerContainer returns the container executing the steps of a Promotion
+// within the provided PodSpec, or nil if there is none.
+func workerContainer(spec *corev1.PodSpec) *corev1.Container {
+	for i := range spec.Containers {
+		if spec.Containers[i].Name == workerContainerName {
+			return &spec.Containers[i]
+		}
+	}
+	return nil
+}
+
+// jobFinished returns true and a message describing the outcome if the
+// provided Job has finished, and false otherwise.
+func jobFinished(job *batchv1.Job) (bool, string) {
+	for _, cond := range job.Status.Conditions {
+		if (cond.Type != batchv1.JobFailed && cond.Type != batchv1.JobComplete) ||
+			cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		if cond.Message != "" {
+			return true, cond.Message
+		}
+		if cond.Reason != "" {
+			return true, cond.Reason
+		}
+		return true, string(cond.Type)
+	}
+	return false, ""
+}
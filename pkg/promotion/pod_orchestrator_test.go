@@ -0,0 +1,330 @@
+package promotion
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewPodOrchestrator(t *testing.T) {
+	writeTemplate := func(t *testing.T, template string) string {
+		path := filepath.Join(t.TempDir(), "pod-template.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(template), 0600))
+		return path
+	}
+
+	t.Run("namespace is required", func(t *testing.T) {
+		_, err := NewPodOrchestrator(nil, nil, PodOrchestratorConfig{})
+		require.ErrorContains(t, err, "worker namespace is required")
+	})
+
+	t.Run("template without worker container", func(t *testing.T) {
+		_, err := NewPodOrchestrator(nil, nil, PodOrchestratorConfig{
+			Namespace: "kargo",
+			PodTemplatePath: writeTemplate(t, `spec:
+  containers:
+  - name: other
+    image: kargo
+`),
+		})
+		require.ErrorContains(t, err, `does not define a container named "worker"`)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		o, err := NewPodOrchestrator(nil, nil, PodOrchestratorConfig{
+			Namespace: "kargo",
+			PodTemplatePath: writeTemplate(t, `spec:
+  serviceAccountName: kargo-controller
+  containers:
+  - name: worker
+    image: kargo
+`),
+		})
+		require.NoError(t, err)
+		require.Equal(t, "kargo-controller", o.podTemplate.Spec.ServiceAccountName)
+	})
+}
+
+func TestPodOrchestrator_ExecuteSteps(t *testing.T) {
+	const testNamespace = "kargo"
+	testPromoCtx := Context{
+		Project:       "fake-project",
+		Promotion:     "fake-promotion",
+		WorkDir:       "/tmp/promotion-abc",
+		StartFromStep: 1,
+		State:         State{"foo": "bar"},
+	}
+	testSteps := []Step{{Kind: "fake-step", Alias: "step-1"}}
+	workerName := WorkerName(testPromoCtx.Project, testPromoCtx.Promotion)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	newWorkerObjects := func(workerRes *WorkerResult, conditions ...batchv1.JobCondition) []client.Object {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: workerName},
+			Data:       map[string][]byte{},
+		}
+		if workerRes != nil {
+			data, err := json.Marshal(workerRes)
+			require.NoError(t, err)
+			secret.Data[WorkerResultKey] = data
+		}
+		return []client.Object{
+			&batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: workerName},
+				Status:     batchv1.JobStatus{Conditions: conditions},
+			},
+			secret,
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		kargoObjects []client.Object
+		objects      []client.Object
+		assertions   func(*testing.T, client.Client, Result, error)
+	}{
+		{
+			name: "creates worker",
+			kargoObjects: []client.Object{
+				&kargoapi.ProjectConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testPromoCtx.Project,
+						Name:      testPromoCtx.Project,
+					},
+					Spec: kargoapi.ProjectConfigSpec{
+						PromotionWorker: &kargoapi.PromotionWorkerConfig{
+							Resources: &corev1.ResourceRequirements{
+								Limits: corev1.ResourceList{
+									corev1.ResourceMemory: resource.MustParse("512Mi"),
+								},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+				require.Equal(t, testPromoCtx.StartFromStep, res.CurrentStep)
+				require.Equal(t, testPromoCtx.State, res.State)
+				require.NotNil(t, res.RetryAfter)
+
+				job := &batchv1.Job{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testNamespace, Name: workerName},
+					job,
+				))
+				require.Equal(t, corev1.RestartPolicyNever, job.Spec.Template.Spec.RestartPolicy)
+				// The worker must outlive its result until Cleanup deletes it
+				require.Nil(t, job.Spec.TTLSecondsAfterFinished)
+				container := job.Spec.Template.Spec.Containers[0]
+				require.Equal(
+					t,
+					[]string{"promotion-worker", "--namespace", testNamespace, "--name", workerName},
+					container.Args,
+				)
+				require.Equal(
+					t,
+					resource.MustParse("512Mi"),
+					container.Resources.Limits[corev1.ResourceMemory],
+				)
+
+				secret := &corev1.Secret{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testNamespace, Name: workerName},
+					secret,
+				))
+				require.Len(t, secret.OwnerReferences, 1)
+				req := WorkerRequest{}
+				require.NoError(t, json.Unmarshal(secret.Data[WorkerRequestKey], &req))
+				require.Equal(t, testPromoCtx.Promotion, req.Context.Promotion)
+				require.Empty(t, req.Context.WorkDir)
+				require.Equal(t, testSteps, req.Steps)
+			},
+		},
+		{
+			name:    "worker has not reported progress yet",
+			objects: newWorkerObjects(nil),
+			assertions: func(t *testing.T, _ client.Client, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+				require.Equal(t, testPromoCtx.StartFromStep, res.CurrentStep)
+			},
+		},
+		{
+			name: "worker reports progress",
+			objects: newWorkerObjects(&WorkerResult{
+				Result: Result{
+					Status:      kargoapi.PromotionPhaseRunning,
+					CurrentStep: 3,
+					State:       State{"step-1": map[string]any{"foo": "bar"}},
+				},
+			}),
+			assertions: func(t *testing.T, c client.Client, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+				require.Equal(t, int64(3), res.CurrentStep)
+				require.Equal(t, State{"step-1": map[string]any{"foo": "bar"}}, res.State)
+				require.NotNil(t, res.RetryAfter)
+
+				// The worker must not have been deleted
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testNamespace, Name: workerName},
+					&batchv1.Job{},
+				))
+			},
+		},
+		{
+			name: "worker reports terminal result",
+			objects: newWorkerObjects(
+				&WorkerResult{
+					Result: Result{
+						Status:  kargoapi.PromotionPhaseFailed,
+						Message: "something went wrong",
+					},
+					Error: "step failed",
+				},
+				batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			),
+			assertions: func(t *testing.T, c client.Client, res Result, err error) {
+				require.ErrorContains(t, err, "step failed")
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+				require.Equal(t, "something went wrong", res.Message)
+
+				// The worker must not be deleted until the result has been
+				// persisted
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testNamespace, Name: workerName},
+					&batchv1.Job{},
+				))
+			},
+		},
+		{
+			name: "worker Pod exits without terminal result",
+			objects: newWorkerObjects(
+				&WorkerResult{Result: Result{Status: kargoapi.PromotionPhaseRunning}},
+				batchv1.JobCondition{
+					Type:    batchv1.JobFailed,
+					Status:  corev1.ConditionTrue,
+					Message: "Job has reached the specified backoff limit",
+				},
+			),
+			assertions: func(t *testing.T, _ client.Client, res Result, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+				require.Contains(t, res.Message, "Job has reached the specified backoff limit")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			workerClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				WithStatusSubresource(&batchv1.Job{}).
+				Build()
+			o := &PodOrchestrator{
+				workerClient: workerClient,
+				kargoClient: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.kargoObjects...).
+					Build(),
+				namespace: testNamespace,
+				podTemplate: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: workerContainerName, Image: "kargo"}},
+					},
+				},
+			}
+			res, err := o.ExecuteSteps(context.Background(), testPromoCtx, testSteps)
+			testCase.assertions(t, workerClient, res, err)
+		})
+	}
+}
+
+func TestPodOrchestrator_Cleanup(t *testing.T) {
+	const testNamespace = "kargo"
+	workerName := WorkerName("fake-project", "fake-promotion")
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
+
+	testCases := []struct {
+		name    string
+		objects []client.Object
+	}{
+		{
+			name: "worker exists",
+			objects: []client.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: workerName},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: workerName},
+				},
+			},
+		},
+		{
+			name: "only Secret exists",
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: workerName},
+				},
+			},
+		},
+		{
+			name: "worker does not exist",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			workerClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				Build()
+			o := &PodOrchestrator{
+				workerClient: workerClient,
+				namespace:    testNamespace,
+			}
+			require.NoError(
+				t,
+				o.Cleanup(context.Background(), "fake-project", "fake-promotion"),
+			)
+			key := client.ObjectKey{Namespace: testNamespace, Name: workerName}
+			err := workerClient.Get(context.Background(), key, &batchv1.Job{})
+			require.True(t, apierrors.IsNotFound(err))
+			err = workerClient.Get(context.Background(), key, &corev1.Secret{})
+			require.True(t, apierrors.IsNotFound(err))
+		})
+	}
+}
+
+func TestWorkerName(t *testing.T) {
+	name := WorkerName("fake-project", "fake-promotion")
+	require.Len(t, name, len("promotion-worker-")+16)
+	require.Equal(t, name, WorkerName("fake-project", "fake-promotion"))
+	require.NotEqual(t, name, WorkerName("fake-project", "other-promotion"))
+}
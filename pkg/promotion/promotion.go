@@ -209,6 +209,7 @@ func (c *Context) GetStepExecutionMetadata(step Step) *kargoapi.StepExecutionMet
 		kargoapi.StepExecutionMetadata{
 			Alias:           step.Alias,
 			ContinueOnError: step.ContinueOnError,
+			Block:           step.Block,
 		},
 	)
 	return &c.StepExecutionMetadata[len(c.StepExecutionMetadata)-1]
@@ -271,30 +272,86 @@ type Step struct {
 	// Config is an opaque JSON to be passed to the StepRunner executing this
 	// step.
 	Config []byte
+	// Block is the block of steps this step belongs to. Steps in the onFailure
+	// block are only executed if a step in the main block failed, while steps
+	// in the finally block are always executed.
+	Block kargoapi.PromotionStepBlock
+	// Parallel is non-nil if this step is a group of steps which are executed
+	// concurrently. The steps in the group directly follow this step.
+	Parallel *StepGroup
+	// Group is the alias of the step defining the parallel group this step
+	// belongs to, if any.
+	Group string
+}
+
+// StepGroup describes a group of steps which are executed concurrently.
+type StepGroup struct {
+	// Steps is the number of steps in the group.
+	Steps int
+	// MaxConcurrency is the maximum number of steps in the group that are
+	// executed concurrently.
+	MaxConcurrency int
 }
 
 // NewSteps creates a slice of Steps from the provided Promotion. Each Step in
-// the slice corresponds to a step defined in the Promotion's spec.
+// the slice corresponds to a step defined in the Promotion's spec. Steps from
+// the main sequence come first, followed by those from the onFailure and
+// finally blocks. Steps defining a parallel group are directly followed by
+// the steps in the group.
 func NewSteps(promo *kargoapi.Promotion) []Step {
-	result := make([]Step, len(promo.Spec.Steps))
-	for i, step := range promo.Spec.Steps {
-		var rawConfig []byte
-		if step.Config != nil {
-			rawConfig = step.Config.Raw
-		}
-		result[i] = Step{
-			Kind:            step.Uses,
-			Alias:           step.As,
-			If:              step.If,
-			ContinueOnError: step.ContinueOnError,
-			Retry:           step.Retry,
-			Vars:            step.Vars,
-			Config:          rawConfig,
+	result := make([]Step, 0, len(promo.Spec.GetSteps()))
+	for _, block := range []struct {
+		block kargoapi.PromotionStepBlock
+		steps []kargoapi.PromotionStep
+	}{
+		{block: kargoapi.PromotionStepBlockMain, steps: promo.Spec.Steps},
+		{block: kargoapi.PromotionStepBlockOnFailure, steps: promo.Spec.OnFailure},
+		{block: kargoapi.PromotionStepBlockFinally, steps: promo.Spec.Finally},
+	} {
+		for _, step := range block.steps {
+			if step.Parallel == nil {
+				result = append(result, newStep(step, block.block))
+				continue
+			}
+			group := newStep(step, block.block)
+			group.Parallel = &StepGroup{
+				Steps:          len(step.Parallel.Steps),
+				MaxConcurrency: step.Parallel.GetMaxConcurrency(),
+			}
+			result = append(result, group)
+			for _, groupStep := range step.Parallel.Steps {
+				s := newStep(groupStep.PromotionStep(), block.block)
+				s.Group = group.Alias
+				// Variables of the group are available to all steps in the group.
+				s.Vars = slices.Concat(group.Vars, s.Vars)
+				// If the group may fail without affecting the Promotion, so may
+				// the steps in the group.
+				s.ContinueOnError = s.ContinueOnError || group.ContinueOnError
+				result = append(result, s)
+			}
 		}
 	}
 	return result
 }
 
+// newStep creates a Step from the provided PromotionStep.
+func newStep(step kargoapi.PromotionStep, block kargoapi.PromotionStepBlock) Step {
+	var rawConfig []byte
+	if step.Config != nil {
+		rawConfig = step.Config.Raw
+	}
+	return Step{
+		Kind:            step.Uses,
+		Alias:           step.As,
+		If:              step.If,
+		ContinueOnError: step.ContinueOnError,
+		Retry:           step.Retry,
+		Vars:            step.Vars,
+		Config:          rawConfig,
+		Block:           block,
+	}
+}
+
 // StepMetadata is a type that represents metadata about the execution of a
 // single step in a user-defined promotion process. It is used to track the
 // status, start and finish times, error counts, and other relevant information
@@ -339,6 +396,24 @@ func (m *StepMetadata) Error() *StepMetadata {
 	return m
 }
 
+// Attempted increments the attempt count of the StepMetadata and clears the
+// time of the next attempt. It returns the updated StepMetadata. This method
+// is used to track the number of attempts made to execute the step, and is
+// called each time the step is about to be executed.
+func (m *StepMetadata) Attempted() *StepMetadata {
+	m.Attempts++
+	m.NextAttemptAt = nil
+	return m
+}
+
+// BackOff sets the time of the next attempt to execute the step to the
+// current time plus the provided interval. It returns the updated
+// StepMetadata.
+func (m *StepMetadata) BackOff(interval time.Duration) *StepMetadata {
+	m.NextAttemptAt = ptr.To(metav1.NewTime(time.Now().Add(interval)))
+	return m
+}
+
 // Started sets the StartedAt timestamp to the current time if it is not already
 // set, and resets the error count to zero. It returns the updated StepMetadata.
 // This method is used to mark the start of the step's execution, indicating
@@ -377,6 +452,10 @@ type StepContext struct {
 	// Config is the configuration of the step that is currently being
 	// executed.
 	Config Config
+	// Vars are the evaluated variables available to the step that is currently
+	// being executed. These include variables defined at the Promotion level as
+	// well as any defined by the step itself.
+	Vars map[string]any
 	// Project is the Project that the Promotion is associated with.
 	Project string
 	// Stage is the Stage that the Promotion is targeting.
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
@@ -842,3 +843,75 @@ func TestContext_DeepCopy(t *testing.T) {
 		})
 	}
 }
+
+func TestNewSteps(t *testing.T) {
+	steps := NewSteps(&kargoapi.Promotion{
+		Spec: kargoapi.PromotionSpec{
+			Steps: []kargoapi.PromotionStep{{
+				Uses:   "fake-step",
+				As:     "step-1",
+				Config: &apiextensionsv1.JSON{Raw: []byte(`{}`)},
+			}, {
+				As:              "step-2",
+				ContinueOnError: true,
+				Vars:            []kargoapi.ExpressionVariable{{Name: "foo", Value: "bar"}},
+				Parallel: &kargoapi.PromotionStepGroup{
+					Steps: []kargoapi.PromotionGroupStep{
+						{Uses: "fake-step", As: "step-2-step-1"},
+						{
+							Uses: "fake-step",
+							As:   "step-2-step-2",
+							Vars: []kargoapi.ExpressionVariable{{Name: "bar", Value: "baz"}},
+						},
+					},
+				},
+			}},
+			OnFailure: []kargoapi.PromotionStep{{Uses: "fake-step", As: "on-failure-step-1"}},
+			Finally:   []kargoapi.PromotionStep{{Uses: "fake-step", As: "finally-step-1"}},
+		},
+	})
+	assert.Equal(t, []Step{
+		{
+			Kind:   "fake-step",
+			Alias:  "step-1",
+			Config: []byte(`{}`),
+			Block:  kargoapi.PromotionStepBlockMain,
+		},
+		{
+			Alias:           "step-2",
+			ContinueOnError: true,
+			Vars:            []kargoapi.ExpressionVariable{{Name: "foo", Value: "bar"}},
+			Block:           kargoapi.PromotionStepBlockMain,
+			Parallel:        &StepGroup{Steps: 2, MaxConcurrency: 2},
+		},
+		{
+			Kind:            "fake-step",
+			Alias:           "step-2-step-1",
+			ContinueOnError: true,
+			Vars:            []kargoapi.ExpressionVariable{{Name: "foo", Value: "bar"}},
+			Block:           kargoapi.PromotionStepBlockMain,
+			Group:           "step-2",
+		},
+		{
+			Kind:            "fake-step",
+			Alias:           "step-2-step-2",
+			ContinueOnError: true,
+			Vars: []kargoapi.ExpressionVariable{
+				{Name: "foo", Value: "bar"},
+				{Name: "bar", Value: "baz"},
+			},
+			Block: kargoapi.PromotionStepBlockMain,
+			Group: "step-2",
+		},
+		{
+			Kind:  "fake-step",
+			Alias: "on-failure-step-1",
+			Block: kargoapi.PromotionStepBlockOnFailure,
+		},
+		{
+			Kind:  "fake-step",
+			Alias: "finally-step-1",
+			Block: kargoapi.PromotionStepBlockFinally,
+		},
+	}, steps)
+}
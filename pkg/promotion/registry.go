@@ -58,6 +58,29 @@ func MustNewStepRunnerRegistry(
 
 var DefaultStepRunnerRegistry = MustNewStepRunnerRegistry()
 
+// DryRunStepRunnerRegistry is a registry of StepRunners that simulate the
+// StepRunners of the same name in DefaultStepRunnerRegistry when the steps of a
+// Promotion are executed as a dry run. Only StepRunners with side effects
+// beyond the working directory of the Promotion require a simulator.
+var DryRunStepRunnerRegistry = MustNewStepRunnerRegistry()
+
+// dryRunStepRunnerRegistry is a StepRunnerRegistry that prefers the
+// StepRunners registered in a registry of simulators over those registered in
+// the underlying registry.
+type dryRunStepRunnerRegistry struct {
+	StepRunnerRegistry
+	simulators StepRunnerRegistry
+}
+
+// Get returns the registration of the simulator for the provided step kind, if
+// one exists, or the registration from the underlying registry otherwise.
+func (d *dryRunStepRunnerRegistry) Get(name string) (StepRunnerRegistration, error) {
+	if reg, err := d.simulators.Get(name); err == nil {
+		return reg, nil
+	}
+	return d.StepRunnerRegistry.Get(name)
+}
+
 // StepRunnerMetadata contains metadata about a StepRunner.
 type StepRunnerMetadata struct {
 	// DefaultTimeout is the default soft maximum interval in which a StepRunner
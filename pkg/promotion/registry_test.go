@@ -52,3 +52,35 @@ func Test_stepRunnerRegistry(t *testing.T) {
 		})
 	}
 }
+
+func Test_dryRunStepRunnerRegistry(t *testing.T) {
+	r := &dryRunStepRunnerRegistry{
+		StepRunnerRegistry: MustNewStepRunnerRegistry(
+			StepRunnerRegistration{
+				Name:     "simulated",
+				Metadata: StepRunnerMetadata{DefaultErrorThreshold: 1},
+			},
+			StepRunnerRegistration{
+				Name:     "not-simulated",
+				Metadata: StepRunnerMetadata{DefaultErrorThreshold: 2},
+			},
+		),
+		simulators: MustNewStepRunnerRegistry(
+			StepRunnerRegistration{
+				Name:     "simulated",
+				Metadata: StepRunnerMetadata{DefaultErrorThreshold: 3},
+			},
+		),
+	}
+
+	reg, err := r.Get("simulated")
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), reg.Metadata.DefaultErrorThreshold)
+
+	reg, err = r.Get("not-simulated")
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), reg.Metadata.DefaultErrorThreshold)
+
+	_, err = r.Get("unknown")
+	require.Error(t, err)
+}
@@ -0,0 +1,137 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/expr-lang/expr"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/dotenv"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const stepKindDotenvParse = "dotenv-parse"
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindDotenvParse,
+			Value: newDotenvParser,
+		},
+	)
+}
+
+// dotenvParser is an implementation of the promotion.StepRunner interface that
+// parses a .env file and extracts specified outputs.
+type dotenvParser struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newDotenvParser returns a new instance of dotenvParser.
+func newDotenvParser(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &dotenvParser{schemaLoader: getConfigSchemaLoader(stepKindDotenvParse)}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (d *dotenvParser) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := d.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return d.run(ctx, stepCtx, cfg)
+}
+
+// convert validates dotenvParser configuration against a JSON schema and
+// converts it into a builtin.DotenvParseConfig struct.
+func (d *dotenvParser) convert(cfg promotion.Config) (builtin.DotenvParseConfig, error) {
+	return validateAndConvert[builtin.DotenvParseConfig](d.schemaLoader, cfg, stepKindDotenvParse)
+}
+
+func (d *dotenvParser) run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.DotenvParseConfig,
+) (promotion.StepResult, error) {
+	failure := promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}
+
+	if cfg.Path == "" {
+		return failure, fmt.Errorf(".env file path cannot be empty")
+	}
+
+	if len(cfg.Outputs) == 0 {
+		return failure, fmt.Errorf("invalid %s config: outputs is required", stepKindDotenvParse)
+	}
+
+	data, err := d.readAndParseDotenv(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return failure, err
+	}
+
+	extractedValues, err := d.extractValues(data, cfg.Outputs)
+	if err != nil {
+		return failure, fmt.Errorf("failed to extract outputs: %w", err)
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: extractedValues,
+	}, nil
+}
+
+// readAndParseDotenv reads and parses a .env file and returns the result.
+func (d *dotenvParser) readAndParseDotenv(
+	workDir string,
+	path string,
+) (map[string]string, error) {
+	absFilePath, err := securejoin.SecureJoin(workDir, path)
+	if err != nil {
+		return nil, fmt.Errorf("error joining path %q: %w", path, err)
+	}
+	fileData, err := os.ReadFile(absFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading .env file %q: %w", absFilePath, err)
+	}
+	data, err := dotenv.Parse(fileData)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse .env file: %w", err)
+	}
+	return data, nil
+}
+
+// extractValues returns select data extracted from the provided data by
+// evaluating it against expressions contained within the provided
+// []builtin.DotenvParse.
+func (d *dotenvParser) extractValues(
+	data map[string]string,
+	outputs []builtin.DotenvParse,
+) (map[string]any, error) {
+	results := make(map[string]any, len(outputs))
+	for _, output := range outputs {
+		program, err := expr.Compile(output.FromExpression)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error compiling expression %q: %w",
+				output.FromExpression, err,
+			)
+		}
+		value, err := expr.Run(program, data)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error evaluating expression %q: %w",
+				output.FromExpression, err,
+			)
+		}
+		results[output.Name] = value
+	}
+	return results, nil
+}
@@ -0,0 +1,117 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/dotenv"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const stepKindDotenvUpdate = "dotenv-update"
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindDotenvUpdate,
+			Value: newDotenvUpdater,
+		},
+	)
+}
+
+// dotenvUpdater is an implementation of the promotion.StepRunner interface that
+// updates the values of specified variables in a .env file.
+type dotenvUpdater struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newDotenvUpdater returns an implementation of the promotion.StepRunner interface
+// that updates the values of specified variables in a .env file.
+func newDotenvUpdater(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &dotenvUpdater{schemaLoader: getConfigSchemaLoader(stepKindDotenvUpdate)}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (d *dotenvUpdater) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := d.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return d.run(ctx, stepCtx, cfg)
+}
+
+// convert validates dotenvUpdater configuration against a JSON schema and
+// converts it into a builtin.DotenvUpdateConfig struct.
+func (d *dotenvUpdater) convert(cfg promotion.Config) (builtin.DotenvUpdateConfig, error) {
+	return validateAndConvert[builtin.DotenvUpdateConfig](d.schemaLoader, cfg, stepKindDotenvUpdate)
+}
+
+func (d *dotenvUpdater) run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.DotenvUpdateConfig,
+) (promotion.StepResult, error) {
+	updates := make([]dotenv.Update, len(cfg.Updates))
+	for i, update := range cfg.Updates {
+		updates[i] = dotenv.Update{
+			Key:   update.Key,
+			Value: update.Value,
+		}
+	}
+
+	result := promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}
+	if len(updates) > 0 {
+		if err := d.updateFile(stepCtx.WorkDir, cfg.Path, updates); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf(".env file update failed: %w", err)
+		}
+
+		if commitMsg := d.generateCommitMessage(cfg.Path, cfg.Updates); commitMsg != "" {
+			result.Output = map[string]any{
+				"commitMessage": commitMsg,
+			}
+		}
+	}
+	return result, nil
+}
+
+func (d *dotenvUpdater) updateFile(workDir string, path string, updates []dotenv.Update) error {
+	absFilePath, err := securejoin.SecureJoin(workDir, path)
+	if err != nil {
+		return fmt.Errorf("error joining path %q: %w", path, err)
+	}
+	if err := dotenv.SetValuesInFile(absFilePath, updates); err != nil {
+		return fmt.Errorf("error updating .env file %q: %w", path, err)
+	}
+	return nil
+}
+
+func (d *dotenvUpdater) generateCommitMessage(path string, updates []builtin.DotenvUpdate) string {
+	if len(updates) == 0 {
+		return ""
+	}
+
+	var commitMsg strings.Builder
+	_, _ = commitMsg.WriteString(fmt.Sprintf("Updated %s\n", path))
+	for _, update := range updates {
+		switch v := update.Value.(type) {
+		case string:
+			_, _ = commitMsg.WriteString(fmt.Sprintf("\n- %s: %q", update.Key, v))
+		default:
+			_, _ = commitMsg.WriteString(fmt.Sprintf("\n- %s: %v", update.Key, v))
+		}
+	}
+
+	return commitMsg.String()
+}
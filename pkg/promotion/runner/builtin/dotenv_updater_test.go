@@ -0,0 +1,175 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_dotenvUpdater_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name: "path is empty string",
+			config: promotion.Config{
+				"path": "",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name:   "updates not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): updates is required",
+			},
+		},
+		{
+			name: "updates is empty array",
+			config: promotion.Config{
+				"updates": []promotion.Config{},
+			},
+			expectedProblems: []string{
+				"updates: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "key not specified",
+			config: promotion.Config{
+				"updates": []promotion.Config{{}},
+			},
+			expectedProblems: []string{
+				"updates.0: key is required",
+			},
+		},
+		{
+			name: "value is not a scalar",
+			config: promotion.Config{
+				"updates": []promotion.Config{{
+					"key":   "IMAGE_TAG",
+					"value": []string{"foo"},
+				}},
+			},
+			expectedProblems: []string{
+				"updates.0.value: Invalid type",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path": ".env",
+				"updates": []promotion.Config{
+					{
+						"key":   "IMAGE_TAG",
+						"value": "foo",
+					},
+					{
+						"key":   "IMAGE_TAG",
+						"value": 42,
+					},
+					{
+						"key":   "IMAGE_TAG",
+						"value": true,
+					},
+				},
+			},
+		},
+	}
+
+	r := newDotenvUpdater(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*dotenvUpdater)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_dotenvUpdater_run(t *testing.T) {
+	tests := []struct {
+		name       string
+		files      map[string]string
+		cfg        builtin.DotenvUpdateConfig
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name: "successful run",
+			files: map[string]string{
+				".env": `# Image settings
+IMAGE_TAG=1.0.0 # The tag
+export REPLICAS=2
+`,
+			},
+			cfg: builtin.DotenvUpdateConfig{
+				Path: ".env",
+				Updates: []builtin.DotenvUpdate{
+					{Key: "IMAGE_TAG", Value: "1.1.0"},
+					{Key: "REPLICAS", Value: float64(3)},
+					{Key: "DEBUG", Value: true},
+				},
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"commitMessage": "Updated .env\n\n- IMAGE_TAG: \"1.1.0\"\n- REPLICAS: 3\n- DEBUG: true",
+					},
+				}, result)
+				content, err := os.ReadFile(filepath.Join(workDir, ".env"))
+				require.NoError(t, err)
+				assert.Equal(t, `# Image settings
+IMAGE_TAG=1.1.0 # The tag
+export REPLICAS=3
+DEBUG=true
+`, string(content))
+			},
+		},
+		{
+			name:  "file does not exist",
+			files: map[string]string{},
+			cfg: builtin.DotenvUpdateConfig{
+				Path: ".env",
+				Updates: []builtin.DotenvUpdate{
+					{Key: "IMAGE_TAG", Value: "foo"},
+				},
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "no such file or directory")
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+			},
+		},
+	}
+
+	runner := &dotenvUpdater{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for p, c := range tt.files {
+				require.NoError(t, os.MkdirAll(filepath.Join(workDir, filepath.Dir(p)), 0o700))
+				require.NoError(t, os.WriteFile(filepath.Join(workDir, p), []byte(c), 0o600))
+			}
+
+			stepCtx := &promotion.StepContext{
+				Project: "test-project",
+				WorkDir: workDir,
+			}
+			result, err := runner.run(context.Background(), stepCtx, tt.cfg)
+			tt.assertions(t, workDir, result, err)
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/git"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	stepKindGitTag = "git-tag"
+
+	// stateKeyTag is the key used to store the name of the tag that was pushed
+	// in the shared State.
+	stateKeyTag = "tag"
+)
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindGitTag,
+			Metadata: promotion.StepRunnerMetadata{
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessCredentials,
+				},
+			},
+			Value: newGitTagger,
+		},
+	)
+}
+
+// gitTagger is an implementation of the promotion.StepRunner interface that
+// creates an annotated tag in a local Git repository and pushes it to the
+// remote Git repository.
+type gitTagger struct {
+	schemaLoader gojsonschema.JSONLoader
+	credsDB      credentials.Database
+}
+
+// newGitTagger returns an implementation of the promotion.StepRunner interface
+// that creates an annotated tag in a local Git repository and pushes it to the
+// remote Git repository.
+func newGitTagger(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &gitTagger{
+		credsDB:      caps.CredsDB,
+		schemaLoader: getConfigSchemaLoader(stepKindGitTag),
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (g *gitTagger) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := g.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return g.run(ctx, stepCtx, cfg)
+}
+
+// convert validates the configuration against a JSON schema and converts it
+// into a builtin.GitTagConfig struct.
+func (g *gitTagger) convert(cfg promotion.Config) (builtin.GitTagConfig, error) {
+	return validateAndConvert[builtin.GitTagConfig](g.schemaLoader, cfg, stepKindGitTag)
+}
+
+func (g *gitTagger) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.GitTagConfig,
+) (promotion.StepResult, error) {
+	logger := logging.LoggerFromContext(ctx)
+
+	// Just as in the git-push step, we need to load the working tree once to
+	// learn the URL of the repository before we can look for applicable
+	// credentials and reload the working tree with them.
+	path, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, fmt.Errorf(
+			"error joining path %s with work dir %s: %w",
+			cfg.Path, stepCtx.WorkDir, err,
+		)
+	}
+	loadOpts := &git.LoadWorkTreeOptions{}
+	workTree, err := git.LoadWorkTree(path, loadOpts)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error loading working tree from %s: %w", cfg.Path, err)
+	}
+	creds, err := g.credsDB.Get(
+		ctx,
+		stepCtx.Project,
+		credentials.TypeGit,
+		workTree.URL(),
+	)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error getting credentials for %s: %w", workTree.URL(), err)
+	}
+	if creds != nil {
+		loadOpts.Credentials = &git.RepoCredentials{
+			Username:      creds.Username,
+			Password:      creds.Password,
+			SSHPrivateKey: creds.SSHPrivateKey,
+		}
+	}
+	if workTree, err = git.LoadWorkTree(path, loadOpts); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error loading working tree from %s: %w", cfg.Path, err)
+	}
+
+	var commitID string
+	if cfg.Commit == "" {
+		if commitID, err = workTree.LastCommitID(); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error getting last commit ID: %w", err)
+		}
+	} else if commitID, err = workTree.ResolveCommitID(cfg.Commit); err != nil {
+		// The commit may be specified as an abbreviated ID or as a ref, but must
+		// be compared to the full ID the remote tag points to.
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error resolving commit %q: %w", cfg.Commit, err)
+	}
+
+	output := map[string]any{
+		stateKeyTag:    cfg.Tag,
+		stateKeyCommit: commitID,
+	}
+
+	remoteCommitID, err := workTree.RemoteTagCommitID(cfg.Tag)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error checking for existing tag %q: %w", cfg.Tag, err)
+	}
+	switch {
+	case remoteCommitID == commitID:
+		// The tag already exists and points to the expected commit. This most
+		// likely means this step already executed successfully on a prior
+		// attempt, so there is nothing left to do.
+		logger.Debug(
+			"tag already exists in remote repository and points to the expected commit",
+			"tag", cfg.Tag, "commit", commitID,
+		)
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusSucceeded,
+			Output: output,
+		}, nil
+	case remoteCommitID != "" && !cfg.Force:
+		// Tags are meant to be immutable, so no amount of retries will fix this.
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{
+				Err: fmt.Errorf(
+					"tag %q already exists in remote repository and points to commit %q "+
+						"instead of %q",
+					cfg.Tag, remoteCommitID, commitID,
+				),
+			}
+	}
+
+	tagOpts := &git.CreateTagOptions{
+		Commit:  commitID,
+		Message: cfg.Message,
+		// The local tag may already exist, either because it was fetched when the
+		// repository was cloned or because a prior attempt at this step created
+		// it but failed to push it. The check above has already established that
+		// overwriting the remote tag (if any) is acceptable, so it is safe to
+		// replace the local one.
+		Force: true,
+	}
+	if cfg.Tagger != nil {
+		tagOpts.Tagger = &git.User{
+			Name:       cfg.Tagger.Name,
+			Email:      cfg.Tagger.Email,
+			SigningKey: cfg.Tagger.SigningKey,
+		}
+	}
+	if err = workTree.CreateTag(cfg.Tag, tagOpts); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error creating tag %q: %w", cfg.Tag, err)
+	}
+
+	if err = workTree.PushTag(cfg.Tag, &git.PushTagOptions{Force: cfg.Force}); err != nil {
+		if git.IsTagAlreadyExists(err) {
+			// Someone else pushed a tag by the same name in the time since we
+			// checked. Treat this the same as if we had found it above.
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+				&promotion.TerminalError{Err: err}
+		}
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error pushing tag %q to remote: %w", cfg.Tag, err)
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: output,
+	}, nil
+}
@@ -0,0 +1,315 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sosedoff/gitkit"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/git"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_gitTagger_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name: "path not specified",
+			config: promotion.Config{
+				"tag": "v1.0.0",
+			},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name: "path is empty string",
+			config: promotion.Config{
+				"path": "",
+				"tag":  "v1.0.0",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "tag not specified",
+			config: promotion.Config{
+				"path": "/fake/path",
+			},
+			expectedProblems: []string{
+				"(root): tag is required",
+			},
+		},
+		{
+			name: "tag is empty string",
+			config: promotion.Config{
+				"path": "/fake/path",
+				"tag":  "",
+			},
+			expectedProblems: []string{
+				"tag: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "tagger email is not specified",
+			config: promotion.Config{
+				"path": "/fake/path",
+				"tag":  "v1.0.0",
+				"tagger": promotion.Config{
+					"name": "Tony Stark",
+				},
+			},
+			expectedProblems: []string{
+				"tagger: email is required",
+			},
+		},
+		{
+			name: "tagger name is not specified",
+			config: promotion.Config{
+				"path": "/fake/path",
+				"tag":  "v1.0.0",
+				"tagger": promotion.Config{
+					"email": "tony@starkindustries.com",
+				},
+			},
+			expectedProblems: []string{
+				"tagger: name is required",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"commit":  "fake-commit",
+				"force":   true,
+				"message": "fake message",
+				"path":    "/fake/path",
+				"tag":     "v1.0.0",
+				"tagger": promotion.Config{
+					"email":      "tony@starkindustries.com",
+					"name":       "Tony Stark",
+					"signingKey": "fake-key",
+				},
+			},
+		},
+	}
+
+	r := newGitTagger(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*gitTagger)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_gitTagger_run(t *testing.T) {
+	// Set up a test Git server in-process
+	service := gitkit.New(
+		gitkit.Config{
+			Dir:        t.TempDir(),
+			AutoCreate: true,
+		},
+	)
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	// This is the URL of the "remote" repository
+	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
+
+	// Seed the "remote" repository with an initial commit.
+	setupRepo, err := git.Clone(testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer setupRepo.Close()
+	err = os.WriteFile(filepath.Join(setupRepo.Dir(), "test.txt"), []byte("foo"), 0600)
+	require.NoError(t, err)
+	err = setupRepo.AddAllAndCommit("Initial commit", nil)
+	require.NoError(t, err)
+	err = setupRepo.Push(nil)
+	require.NoError(t, err)
+
+	workDir := t.TempDir()
+
+	// Finagle a local bare repo and working tree into place the way that
+	// gitCloner might have so we can verify gitTagger's ability to reload the
+	// working tree from the file system.
+	repo, err := git.CloneBare(
+		testRepoURL,
+		nil,
+		&git.BareCloneOptions{
+			BaseDir: workDir,
+		},
+	)
+	require.NoError(t, err)
+	defer repo.Close()
+	// "master" is still the default branch name for a new repository
+	// unless you configure it otherwise.
+	workTree, err := repo.AddWorkTree(
+		filepath.Join(workDir, "master"),
+		&git.AddWorkTreeOptions{Ref: "master"},
+	)
+	require.NoError(t, err)
+	firstCommit, err := workTree.LastCommitID()
+	require.NoError(t, err)
+
+	r := newGitTagger(promotion.StepRunnerCapabilities{
+		CredsDB: &credentials.FakeDB{},
+	})
+	runner, ok := r.(*gitTagger)
+	require.True(t, ok)
+
+	stepCtx := &promotion.StepContext{
+		Project:   "fake-project",
+		Stage:     "fake-stage",
+		Promotion: "fake-promotion",
+		WorkDir:   workDir,
+	}
+
+	t.Run("creates and pushes tag", func(t *testing.T) {
+		res, err := runner.run(
+			context.Background(),
+			stepCtx,
+			builtin.GitTagConfig{
+				Path:    "master",
+				Tag:     "v1.0.0",
+				Message: "Release v1.0.0",
+				Tagger: &builtin.GitTagConfigTagger{
+					Name:  "Tony Stark",
+					Email: "tony@starkindustries.com",
+				},
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+		require.Equal(t, "v1.0.0", res.Output[stateKeyTag])
+		require.Equal(t, firstCommit, res.Output[stateKeyCommit])
+
+		remoteCommit, err := workTree.RemoteTagCommitID("v1.0.0")
+		require.NoError(t, err)
+		require.Equal(t, firstCommit, remoteCommit)
+	})
+
+	t.Run("is idempotent", func(t *testing.T) {
+		res, err := runner.run(
+			context.Background(),
+			stepCtx,
+			builtin.GitTagConfig{
+				Path: "master",
+				Tag:  "v1.0.0",
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+		require.Equal(t, firstCommit, res.Output[stateKeyCommit])
+	})
+
+	// Make a second commit so that the existing tag no longer points to the
+	// head of the branch.
+	err = os.WriteFile(filepath.Join(workTree.Dir(), "test.txt"), []byte("bar"), 0600)
+	require.NoError(t, err)
+	err = workTree.AddAllAndCommit("Second commit", nil)
+	require.NoError(t, err)
+	secondCommit, err := workTree.LastCommitID()
+	require.NoError(t, err)
+
+	t.Run("fails if tag exists and points to another commit", func(t *testing.T) {
+		res, err := runner.run(
+			context.Background(),
+			stepCtx,
+			builtin.GitTagConfig{
+				Path: "master",
+				Tag:  "v1.0.0",
+			},
+		)
+		require.ErrorContains(t, err, "already exists in remote repository")
+		require.True(t, promotion.IsTerminal(err))
+		require.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+	})
+
+	t.Run("overwrites tag if forced", func(t *testing.T) {
+		res, err := runner.run(
+			context.Background(),
+			stepCtx,
+			builtin.GitTagConfig{
+				Path:  "master",
+				Tag:   "v1.0.0",
+				Force: true,
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+		require.Equal(t, secondCommit, res.Output[stateKeyCommit])
+
+		remoteCommit, err := workTree.RemoteTagCommitID("v1.0.0")
+		require.NoError(t, err)
+		require.Equal(t, secondCommit, remoteCommit)
+	})
+
+	t.Run("tags a specific commit", func(t *testing.T) {
+		res, err := runner.run(
+			context.Background(),
+			stepCtx,
+			builtin.GitTagConfig{
+				Path:   "master",
+				Tag:    "v0.9.0",
+				Commit: firstCommit,
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+
+		remoteCommit, err := workTree.RemoteTagCommitID("v0.9.0")
+		require.NoError(t, err)
+		require.Equal(t, firstCommit, remoteCommit)
+	})
+
+	t.Run("is idempotent when commit is abbreviated", func(t *testing.T) {
+		res, err := runner.run(
+			context.Background(),
+			stepCtx,
+			builtin.GitTagConfig{
+				Path:   "master",
+				Tag:    "v0.9.0",
+				Commit: firstCommit[:7],
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+		require.Equal(t, firstCommit, res.Output[stateKeyCommit])
+	})
+
+	t.Run("is idempotent when commit is a ref", func(t *testing.T) {
+		res, err := runner.run(
+			context.Background(),
+			stepCtx,
+			builtin.GitTagConfig{
+				Path:   "master",
+				Tag:    "v1.0.0",
+				Commit: "HEAD",
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+		require.Equal(t, secondCommit, res.Output[stateKeyCommit])
+	})
+
+	t.Run("fails if commit cannot be resolved", func(t *testing.T) {
+		res, err := runner.run(
+			context.Background(),
+			stepCtx,
+			builtin.GitTagConfig{
+				Path:   "master",
+				Tag:    "v0.8.0",
+				Commit: "no-such-ref",
+			},
+		)
+		require.ErrorContains(t, err, "error resolving commit")
+		require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+	})
+}
@@ -0,0 +1,122 @@
+package builtin
+
+import (
+	"context"
+
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	checkers "github.com/akuity/kargo/pkg/health/checker/builtin"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const stepKindHTTPHealth = checkers.HTTPCheckerName
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindHTTPHealth,
+			Value: newHTTPHealthRegistrar,
+		},
+	)
+}
+
+// httpHealthRegistrar is an implementation of the promotion.StepRunner
+// interface that does not send any request itself, but registers an HTTP
+// request to be sent whenever the health of the Stage is assessed. The
+// response is evaluated using the same criteria as the http step.
+type httpHealthRegistrar struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newHTTPHealthRegistrar returns an implementation of the
+// promotion.StepRunner interface that registers HTTP health checks.
+func newHTTPHealthRegistrar(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &httpHealthRegistrar{
+		schemaLoader: getConfigSchemaLoader(stepKindHTTPHealth),
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (h *httpHealthRegistrar) Run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := h.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return h.run(cfg), nil
+}
+
+// convert validates httpHealthRegistrar configuration against a JSON schema
+// and converts it into a builtin.HTTPHealthConfig struct.
+func (h *httpHealthRegistrar) convert(cfg promotion.Config) (builtin.HTTPHealthConfig, error) {
+	return validateAndConvert[builtin.HTTPHealthConfig](h.schemaLoader, cfg, stepKindHTTPHealth)
+}
+
+func (h *httpHealthRegistrar) run(cfg builtin.HTTPHealthConfig) promotion.StepResult {
+	input := checkers.HTTPHealthInput{
+		Method:                cfg.Method,
+		URL:                   cfg.URL,
+		Body:                  cfg.Body,
+		InsecureSkipTLSVerify: cfg.InsecureSkipTLSVerify,
+		CACertFrom:            toHTTPHealthValueSource(cfg.CACertFrom),
+		Timeout:               cfg.Timeout,
+		ResponseContentType:   cfg.ResponseContentType,
+		SuccessExpression:     cfg.SuccessExpression,
+		FailureExpression:     cfg.FailureExpression,
+	}
+	for _, header := range cfg.Headers {
+		input.Headers = append(input.Headers, checkers.HTTPHealthHeader{
+			Name:      header.Name,
+			Value:     header.Value,
+			ValueFrom: toHTTPHealthValueSource(header.ValueFrom),
+		})
+	}
+	for _, queryParam := range cfg.QueryParams {
+		input.QueryParams = append(input.QueryParams, checkers.HTTPHealthQueryParam{
+			Name:  queryParam.Name,
+			Value: queryParam.Value,
+		})
+	}
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		HealthCheck: &health.Criteria{
+			Kind: checkers.HTTPCheckerName,
+			Input: health.Input{
+				"method":                input.Method,
+				"url":                   input.URL,
+				"headers":               input.Headers,
+				"queryParams":           input.QueryParams,
+				"body":                  input.Body,
+				"insecureSkipTLSVerify": input.InsecureSkipTLSVerify,
+				"caCertFrom":            input.CACertFrom,
+				"timeout":               input.Timeout,
+				"responseContentType":   input.ResponseContentType,
+				"successExpression":     input.SuccessExpression,
+				"failureExpression":     input.FailureExpression,
+			},
+		},
+	}
+}
+
+// toHTTPHealthValueSource converts a builtin.HTTPHealthValueSource into a
+// checkers.HTTPHealthValueSource.
+func toHTTPHealthValueSource(
+	source *builtin.HTTPHealthValueSource,
+) *checkers.HTTPHealthValueSource {
+	if source == nil {
+		return nil
+	}
+	return &checkers.HTTPHealthValueSource{
+		SecretKeyRef: &checkers.HTTPHealthSecretKeySelector{
+			Name: source.SecretKeyRef.Name,
+			Key:  source.SecretKeyRef.Key,
+		},
+	}
+}
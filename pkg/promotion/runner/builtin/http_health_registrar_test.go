@@ -0,0 +1,131 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	checkers "github.com/akuity/kargo/pkg/health/checker/builtin"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_httpHealthRegistrar_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "url not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): url is required",
+			},
+		},
+		{
+			name: "method not supported",
+			config: promotion.Config{
+				"url":    "https://example.com/healthz",
+				"method": "DELETE",
+			},
+			expectedProblems: []string{
+				"method: Does not match pattern",
+			},
+		},
+		{
+			name: "header with both value and valueFrom",
+			config: promotion.Config{
+				"url": "https://example.com/healthz",
+				"headers": []promotion.Config{{
+					"name":  "Authorization",
+					"value": "Bearer foo",
+					"valueFrom": promotion.Config{
+						"secretKeyRef": promotion.Config{"name": "token", "key": "token"},
+					},
+				}},
+			},
+			expectedProblems: []string{
+				"headers.0: Must validate one and only one schema (oneOf)",
+			},
+		},
+		{
+			name: "secretKeyRef without key",
+			config: promotion.Config{
+				"url": "https://example.com/healthz",
+				"caCertFrom": promotion.Config{
+					"secretKeyRef": promotion.Config{"name": "ca"},
+				},
+			},
+			expectedProblems: []string{
+				"caCertFrom.secretKeyRef: key is required",
+			},
+		},
+		{
+			name: "valid configuration",
+			config: promotion.Config{
+				"url": "https://example.com/healthz",
+				"headers": []promotion.Config{
+					{"name": "Accept", "value": "application/json"},
+					{
+						"name": "Authorization",
+						"valueFrom": promotion.Config{
+							"secretKeyRef": promotion.Config{"name": "token", "key": "token"},
+						},
+					},
+				},
+				"caCertFrom": promotion.Config{
+					"secretKeyRef": promotion.Config{"name": "ca", "key": "ca.crt"},
+				},
+				"timeout":           "5s",
+				"successExpression": `response.body.version == "v1.2.3"`,
+				"failureExpression": "response.status >= 500",
+			},
+		},
+	}
+
+	r := newHTTPHealthRegistrar(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*httpHealthRegistrar)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_httpHealthRegistrar_run(t *testing.T) {
+	r := newHTTPHealthRegistrar(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*httpHealthRegistrar)
+	require.True(t, ok)
+
+	res := runner.run(builtin.HTTPHealthConfig{
+		URL: "https://example.com/healthz",
+		Headers: []builtin.HTTPHealthHeader{{
+			Name: "Authorization",
+			ValueFrom: &builtin.HTTPHealthValueSource{
+				SecretKeyRef: builtin.SecretKeyRef{Name: "token", Key: "token"},
+			},
+		}},
+		SuccessExpression: `response.body.version == "v1.2.3"`,
+	})
+	require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+	require.NotNil(t, res.HealthCheck)
+	require.Equal(t, checkers.HTTPCheckerName, res.HealthCheck.Kind)
+
+	// The input must be understood by the corresponding Checker
+	input, err := health.InputToStruct[checkers.HTTPHealthInput](res.HealthCheck.Input)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		checkers.HTTPHealthInput{
+			URL: "https://example.com/healthz",
+			Headers: []checkers.HTTPHealthHeader{{
+				Name: "Authorization",
+				ValueFrom: &checkers.HTTPHealthValueSource{
+					SecretKeyRef: &checkers.HTTPHealthSecretKeySelector{
+						Name: "token",
+						Key:  "token",
+					},
+				},
+			}},
+			SuccessExpression: `response.body.version == "v1.2.3"`,
+		},
+		input,
+	)
+}
@@ -0,0 +1,84 @@
+package builtin
+
+import (
+	"context"
+
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/health"
+	checkers "github.com/akuity/kargo/pkg/health/checker/builtin"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const stepKindKubernetesHealth = checkers.KubernetesCheckerName
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindKubernetesHealth,
+			Value: newKubernetesHealthRegistrar,
+		},
+	)
+}
+
+// kubernetesHealthRegistrar is an implementation of the promotion.StepRunner
+// interface that does not modify anything, but registers arbitrary Kubernetes
+// resources whose health should subsequently be factored into the health of
+// the Stage. This is useful for Stages that deploy by means other than Argo CD;
+// e.g. plain manifests, Flux, or a custom operator.
+type kubernetesHealthRegistrar struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newKubernetesHealthRegistrar returns an implementation of the
+// promotion.StepRunner interface that registers Kubernetes resources for
+// health checks.
+func newKubernetesHealthRegistrar(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &kubernetesHealthRegistrar{
+		schemaLoader: getConfigSchemaLoader(stepKindKubernetesHealth),
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (k *kubernetesHealthRegistrar) Run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := k.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return k.run(cfg), nil
+}
+
+// convert validates kubernetesHealthRegistrar configuration against a JSON
+// schema and converts it into a builtin.KubernetesHealthConfig struct.
+func (k *kubernetesHealthRegistrar) convert(cfg promotion.Config) (builtin.KubernetesHealthConfig, error) {
+	return validateAndConvert[builtin.KubernetesHealthConfig](k.schemaLoader, cfg, stepKindKubernetesHealth)
+}
+
+func (k *kubernetesHealthRegistrar) run(cfg builtin.KubernetesHealthConfig) promotion.StepResult {
+	resources := make([]checkers.KubernetesResourceHealthCheck, len(cfg.Resources))
+	for i, r := range cfg.Resources {
+		resources[i] = checkers.KubernetesResourceHealthCheck{
+			APIVersion:    r.APIVersion,
+			Kind:          r.Kind,
+			Namespace:     r.Namespace,
+			Name:          r.Name,
+			LabelSelector: r.LabelSelector,
+		}
+	}
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		HealthCheck: &health.Criteria{
+			Kind: checkers.KubernetesCheckerName,
+			Input: health.Input{
+				"resources": resources,
+			},
+		},
+	}
+}
@@ -0,0 +1,122 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	checkers "github.com/akuity/kargo/pkg/health/checker/builtin"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_kubernetesHealthRegistrar_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "resources not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): resources is required",
+			},
+		},
+		{
+			name: "resources is empty array",
+			config: promotion.Config{
+				"resources": []promotion.Config{},
+			},
+			expectedProblems: []string{
+				"resources: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "apiVersion and kind not specified",
+			config: promotion.Config{
+				"resources": []promotion.Config{{"name": "guestbook"}},
+			},
+			expectedProblems: []string{
+				"resources.0: apiVersion is required",
+				"resources.0: kind is required",
+			},
+		},
+		{
+			name: "neither name nor labelSelector specified",
+			config: promotion.Config{
+				"resources": []promotion.Config{{
+					"apiVersion": "apps/v1",
+					"kind":       "Deployment",
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0: Must validate one and only one schema (oneOf)",
+			},
+		},
+		{
+			name: "both name and labelSelector specified",
+			config: promotion.Config{
+				"resources": []promotion.Config{{
+					"apiVersion":    "apps/v1",
+					"kind":          "Deployment",
+					"name":          "guestbook",
+					"labelSelector": "app=guestbook",
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0: Must validate one and only one schema (oneOf)",
+			},
+		},
+		{
+			name: "valid configuration",
+			config: promotion.Config{
+				"resources": []promotion.Config{
+					{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"namespace":  "guestbook",
+						"name":       "guestbook",
+					},
+					{
+						"apiVersion":    "helm.toolkit.fluxcd.io/v2",
+						"kind":          "HelmRelease",
+						"namespace":     "guestbook",
+						"labelSelector": "app=guestbook",
+					},
+				},
+			},
+		},
+	}
+
+	r := newKubernetesHealthRegistrar(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*kubernetesHealthRegistrar)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_kubernetesHealthRegistrar_run(t *testing.T) {
+	r := newKubernetesHealthRegistrar(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*kubernetesHealthRegistrar)
+	require.True(t, ok)
+
+	res := runner.run(builtin.KubernetesHealthConfig{
+		Resources: []builtin.KubernetesHealthResource{{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Namespace:  "guestbook",
+			Name:       "guestbook",
+		}},
+	})
+	require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+	require.NotNil(t, res.HealthCheck)
+	require.Equal(t, checkers.KubernetesCheckerName, res.HealthCheck.Kind)
+	require.Equal(
+		t,
+		[]checkers.KubernetesResourceHealthCheck{{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Namespace:  "guestbook",
+			Name:       "guestbook",
+		}},
+		res.HealthCheck.Input["resources"],
+	)
+}
@@ -0,0 +1,396 @@
+package builtin
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	stepKindOCIPush = "oci-push"
+
+	// defaultOCIPushFileMediaType is the media type used for the layer of an
+	// artifact pushed from a single file when no media type is specified.
+	defaultOCIPushFileMediaType = "application/octet-stream"
+
+	// stateKeyDigest is the key used to store the digest of a pushed artifact
+	// in the shared State.
+	stateKeyDigest = "digest"
+	// stateKeyImageRef is the key used to store the digest-qualified reference
+	// of a pushed artifact in the shared State.
+	stateKeyImageRef = "imageRef"
+)
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindOCIPush,
+			Metadata: promotion.StepRunnerMetadata{
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessCredentials,
+				},
+			},
+			Value: newOCIPusher,
+		},
+	)
+}
+
+// ociPusher is an implementation of the promotion.StepRunner interface that
+// packages a file or directory as an OCI artifact and pushes it to a registry.
+type ociPusher struct {
+	schemaLoader gojsonschema.JSONLoader
+	credsDB      credentials.Database
+}
+
+// newOCIPusher returns an implementation of the promotion.StepRunner interface
+// that packages a file or directory as an OCI artifact and pushes it to a
+// registry. It uses the provided credentials database to authenticate with the
+// registry.
+func newOCIPusher(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &ociPusher{
+		credsDB:      caps.CredsDB,
+		schemaLoader: getConfigSchemaLoader(stepKindOCIPush),
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (p *ociPusher) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := p.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return p.run(ctx, stepCtx, cfg)
+}
+
+// convert validates the ociPusher configuration against a JSON schema and
+// converts it into a builtin.OCIPushConfig struct.
+func (p *ociPusher) convert(cfg promotion.Config) (builtin.OCIPushConfig, error) {
+	return validateAndConvert[builtin.OCIPushConfig](p.schemaLoader, cfg, stepKindOCIPush)
+}
+
+// run executes the ociPusher step with the provided configuration.
+func (p *ociPusher) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.OCIPushConfig,
+) (promotion.StepResult, error) {
+	ref, credType, err := p.parseImageReference(cfg.ImageRef)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{Err: err}
+	}
+
+	layer, err := p.buildLayer(stepCtx.WorkDir, cfg)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+
+	img, err := p.buildImage(stepCtx, cfg, layer)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+
+	remoteOpts, err := p.buildRemoteOptions(ctx, stepCtx, cfg, ref, credType)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+
+	if err = remote.Write(ref, img, remoteOpts...); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("failed to push artifact to %q: %w", cfg.ImageRef, err)
+	}
+
+	digest, err := img.Digest()
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("failed to get digest of artifact: %w", err)
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: map[string]any{
+			stateKeyDigest:   digest.String(),
+			stateKeyImageRef: ref.Context().Digest(digest.String()).String(),
+			stateKeyTag:      ref.Identifier(),
+		},
+	}, nil
+}
+
+// parseImageReference parses the image reference and determines credential
+// type. Only tag references are accepted, as the digest of the artifact is not
+// known until it has been built.
+func (p *ociPusher) parseImageReference(imageRef string) (name.Tag, credentials.Type, error) {
+	credType := credentials.TypeImage
+
+	// Just as in the oci-download step, an "oci://" prefix indicates that the
+	// destination is a Helm OCI repository.
+	if strings.HasPrefix(imageRef, "oci://") {
+		imageRef = strings.TrimPrefix(imageRef, "oci://")
+		credType = credentials.TypeHelm
+	}
+
+	ref, err := name.NewTag(imageRef)
+	if err != nil {
+		return name.Tag{}, "", fmt.Errorf("invalid image reference %q: %w", imageRef, err)
+	}
+
+	return ref, credType, nil
+}
+
+// buildLayer packages the file or directory at the configured path as a single
+// layer. A directory is packaged as a gzipped tarball, while a file is used
+// as-is.
+func (p *ociPusher) buildLayer(workDir string, cfg builtin.OCIPushConfig) (v1.Layer, error) {
+	absPath, err := securejoin.SecureJoin(workDir, cfg.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join path %q: %w", cfg.Path, err)
+	}
+
+	fi, err := os.Stat(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat %q: %w", cfg.Path, err)
+	}
+
+	var content []byte
+	mediaType := types.MediaType(cfg.MediaType)
+	if fi.IsDir() {
+		if content, err = p.tarDirectory(absPath); err != nil {
+			return nil, fmt.Errorf("failed to package directory %q: %w", cfg.Path, err)
+		}
+		if mediaType == "" {
+			mediaType = types.OCILayer
+		}
+	} else {
+		if content, err = os.ReadFile(absPath); err != nil {
+			return nil, fmt.Errorf("failed to read file %q: %w", cfg.Path, err)
+		}
+		if mediaType == "" {
+			mediaType = defaultOCIPushFileMediaType
+		}
+	}
+
+	if len(content) > maxOCIArtifactSize {
+		return nil, &promotion.TerminalError{
+			Err: fmt.Errorf(
+				"artifact size %d exceeds maximum allowed size of %d bytes",
+				len(content), maxOCIArtifactSize,
+			),
+		}
+	}
+
+	return static.NewLayer(content, mediaType), nil
+}
+
+// tarDirectory writes the contents of the specified directory to a gzipped
+// tarball. Paths in the tarball are relative to the directory. Modification
+// times and ownership are omitted so that packaging the same content always
+// yields the same digest.
+func (p *ociPusher) tarDirectory(dir string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == dir {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		fi, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		var link string
+		if fi.Mode()&fs.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		} else if !fi.Mode().IsRegular() && !fi.IsDir() {
+			// Skip anything that is not a regular file, directory, or symlink
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(fi, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relPath)
+		if fi.IsDir() {
+			header.Name += "/"
+		}
+		header.Mode = int64(fi.Mode().Perm())
+		header.Uid, header.Gid = 0, 0
+		header.Uname, header.Gname = "", ""
+		header.ModTime = time.Unix(0, 0)
+		header.AccessTime, header.ChangeTime = time.Time{}, time.Time{}
+
+		if err = tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tw.Close(); err != nil {
+		return nil, err
+	}
+	if err = gzw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// buildImage assembles an OCI artifact from the provided layer, applying the
+// configured config media type and annotations.
+func (p *ociPusher) buildImage(
+	stepCtx *promotion.StepContext,
+	cfg builtin.OCIPushConfig,
+	layer v1.Layer,
+) (v1.Image, error) {
+	configMediaType := types.MediaType(cfg.ConfigMediaType)
+	if configMediaType == "" {
+		configMediaType = types.OCIConfigJSON
+	}
+
+	img := mutate.MediaType(empty.Image, types.OCIManifestSchema1)
+	img = mutate.ConfigMediaType(img, configMediaType)
+	img, err := mutate.Append(img, mutate.Addendum{Layer: layer})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add layer to artifact: %w", err)
+	}
+
+	annotations := map[string]string{}
+	if stepCtx.TargetFreightRef.Name != "" {
+		annotations[kargoapi.AnnotationKeyFreight] = stepCtx.TargetFreightRef.Name
+	}
+	if stepCtx.Stage != "" {
+		annotations[kargoapi.AnnotationKeyStage] = stepCtx.Stage
+	}
+	if stepCtx.Promotion != "" {
+		annotations[kargoapi.AnnotationKeyPromotion] = stepCtx.Promotion
+	}
+	maps.Copy(annotations, cfg.Annotations)
+
+	img, ok := mutate.Annotations(img, annotations).(v1.Image)
+	if !ok {
+		return nil, fmt.Errorf("failed to add annotations to artifact")
+	}
+	return img, nil
+}
+
+// buildRemoteOptions constructs the remote options for the registry.
+func (p *ociPusher) buildRemoteOptions(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.OCIPushConfig,
+	ref name.Reference,
+	credType credentials.Type,
+) ([]remote.Option, error) {
+	remoteOpts := []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithTransport(p.buildHTTPTransport(cfg)),
+	}
+
+	if authOpt, err := p.getAuthOption(ctx, stepCtx, ref, credType); err != nil {
+		return nil, err
+	} else if authOpt != nil {
+		remoteOpts = append(remoteOpts, authOpt)
+	}
+
+	return remoteOpts, nil
+}
+
+// buildHTTPTransport creates a new HTTP transport with TLS settings based on
+// the configuration.
+func (p *ociPusher) buildHTTPTransport(cfg builtin.OCIPushConfig) *http.Transport {
+	httpTransport := cleanhttp.DefaultTransport()
+	if cfg.InsecureSkipTLSVerify {
+		httpTransport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+	}
+	return httpTransport
+}
+
+// getAuthOption retrieves and configures authentication for the registry.
+func (p *ociPusher) getAuthOption(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	ref name.Reference,
+	credType credentials.Type,
+) (remote.Option, error) {
+	repoURL := ref.Context().String()
+
+	// NB: Some credential database implementations expect the URL to be
+	// prefixed with "oci://".
+	if credType == credentials.TypeHelm {
+		repoURL = "oci://" + repoURL
+	}
+
+	creds, err := p.credsDB.Get(ctx, stepCtx.Project, credType, repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("error obtaining credentials for image repo %q: %w", repoURL, err)
+	}
+
+	if creds != nil && (creds.Username != "" || creds.Password != "") {
+		return remote.WithAuth(&authn.Basic{
+			Username: creds.Username,
+			Password: creds.Password,
+		}), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,290 @@
+package builtin
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_ociPusher_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path is not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name:   "imageRef is not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): imageRef is required",
+			},
+		},
+		{
+			name: "imageRef is a digest",
+			config: promotion.Config{
+				"path":     "out",
+				"imageRef": "registry.example.com/image@sha256:abc",
+			},
+			expectedProblems: []string{
+				"imageRef: Does not match pattern",
+			},
+		},
+		{
+			name: "valid config",
+			config: promotion.Config{
+				"path":     "out",
+				"imageRef": "registry.example.com/image:tag",
+			},
+		},
+		{
+			name: "valid config with OCI protocol and port",
+			config: promotion.Config{
+				"path":     "out",
+				"imageRef": "oci://localhost:5000/charts/image:tag",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path":            "out",
+				"imageRef":        "registry.example.com/image:tag",
+				"mediaType":       "application/vnd.cncf.flux.content.v1.tar+gzip",
+				"configMediaType": "application/vnd.cncf.flux.config.v1+json",
+				"annotations": map[string]any{
+					"org.opencontainers.image.source": "https://github.com/example/repo",
+				},
+				"insecureSkipTLSVerify": true,
+			},
+		},
+	}
+
+	r := newOCIPusher(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*ociPusher)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_ociPusher_parseImageReference(t *testing.T) {
+	tests := []struct {
+		name       string
+		imageRef   string
+		assertions func(*testing.T, name.Tag, credentials.Type, error)
+	}{
+		{
+			name:     "image reference",
+			imageRef: "registry.example.com/image:tag",
+			assertions: func(t *testing.T, ref name.Tag, credType credentials.Type, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "registry.example.com/image:tag", ref.String())
+				assert.Equal(t, credentials.TypeImage, credType)
+			},
+		},
+		{
+			name:     "Helm OCI reference",
+			imageRef: "oci://registry.example.com/chart:1.0.0",
+			assertions: func(t *testing.T, ref name.Tag, credType credentials.Type, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "registry.example.com/chart:1.0.0", ref.String())
+				assert.Equal(t, credentials.TypeHelm, credType)
+			},
+		},
+		{
+			name:     "digest reference",
+			imageRef: "registry.example.com/image@sha256:" + strings.Repeat("a", 64),
+			assertions: func(t *testing.T, _ name.Tag, _ credentials.Type, err error) {
+				require.ErrorContains(t, err, "invalid image reference")
+			},
+		},
+	}
+
+	p := &ociPusher{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, credType, err := p.parseImageReference(tt.imageRef)
+			tt.assertions(t, ref, credType, err)
+		})
+	}
+}
+
+func Test_ociPusher_run(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+	registryHost := strings.TrimPrefix(server.URL, "http://")
+
+	workDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(workDir, "out", "nested"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "out", "a.yaml"), []byte("a: 1"), 0o600))
+	require.NoError(
+		t,
+		os.WriteFile(filepath.Join(workDir, "out", "nested", "b.yaml"), []byte("b: 2"), 0o600),
+	)
+	require.NoError(t, os.WriteFile(filepath.Join(workDir, "single.txt"), []byte("hello"), 0o600))
+
+	stepCtx := &promotion.StepContext{
+		Project:          "fake-project",
+		Stage:            "fake-stage",
+		Promotion:        "fake-promotion",
+		TargetFreightRef: kargoapi.FreightReference{Name: "fake-freight"},
+		WorkDir:          workDir,
+	}
+
+	r := newOCIPusher(promotion.StepRunnerCapabilities{
+		CredsDB: &credentials.FakeDB{},
+	})
+	runner, ok := r.(*ociPusher)
+	require.True(t, ok)
+
+	t.Run("pushes directory as gzipped tarball", func(t *testing.T) {
+		res, err := runner.run(
+			context.Background(),
+			stepCtx,
+			builtin.OCIPushConfig{
+				Path:     "out",
+				ImageRef: registryHost + "/manifests:v1",
+				Annotations: map[string]string{
+					"custom":                        "value",
+					kargoapi.AnnotationKeyPromotion: "overridden",
+				},
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+		require.Equal(t, "v1", res.Output[stateKeyTag])
+
+		digest, ok := res.Output[stateKeyDigest].(string)
+		require.True(t, ok)
+		require.Equal(t, registryHost+"/manifests@"+digest, res.Output[stateKeyImageRef])
+
+		ref, err := name.ParseReference(registryHost + "/manifests:v1")
+		require.NoError(t, err)
+		img, err := remote.Image(ref)
+		require.NoError(t, err)
+
+		manifest, err := img.Manifest()
+		require.NoError(t, err)
+		require.Equal(t, types.OCIManifestSchema1, manifest.MediaType)
+		require.Equal(t, types.OCIConfigJSON, manifest.Config.MediaType)
+		require.Equal(t, map[string]string{
+			kargoapi.AnnotationKeyFreight:   "fake-freight",
+			kargoapi.AnnotationKeyStage:     "fake-stage",
+			kargoapi.AnnotationKeyPromotion: "overridden",
+			"custom":                        "value",
+		}, manifest.Annotations)
+		require.Len(t, manifest.Layers, 1)
+		require.Equal(t, types.OCILayer, manifest.Layers[0].MediaType)
+
+		layers, err := img.Layers()
+		require.NoError(t, err)
+		rc, err := layers[0].Compressed()
+		require.NoError(t, err)
+		defer rc.Close()
+		gzr, err := gzip.NewReader(rc)
+		require.NoError(t, err)
+		tr := tar.NewReader(gzr)
+		files := map[string]string{}
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			if header.Typeflag != tar.TypeReg {
+				continue
+			}
+			content, err := io.ReadAll(tr)
+			require.NoError(t, err)
+			files[header.Name] = string(content)
+		}
+		require.Equal(t, map[string]string{
+			"a.yaml":        "a: 1",
+			"nested/b.yaml": "b: 2",
+		}, files)
+	})
+
+	t.Run("produces reproducible digests", func(t *testing.T) {
+		cfg := builtin.OCIPushConfig{
+			Path:     "out",
+			ImageRef: registryHost + "/manifests:v2",
+		}
+		res1, err := runner.run(context.Background(), stepCtx, cfg)
+		require.NoError(t, err)
+
+		// Touch a file to change its modification time without changing its
+		// content.
+		require.NoError(t, os.WriteFile(filepath.Join(workDir, "out", "a.yaml"), []byte("a: 1"), 0o600))
+
+		res2, err := runner.run(context.Background(), stepCtx, cfg)
+		require.NoError(t, err)
+		require.Equal(t, res1.Output[stateKeyDigest], res2.Output[stateKeyDigest])
+	})
+
+	t.Run("pushes single file as-is", func(t *testing.T) {
+		res, err := runner.run(
+			context.Background(),
+			stepCtx,
+			builtin.OCIPushConfig{
+				Path:            "single.txt",
+				ImageRef:        registryHost + "/single:v1",
+				MediaType:       "text/plain",
+				ConfigMediaType: "application/vnd.example.config.v1+json",
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+
+		ref, err := name.ParseReference(registryHost + "/single:v1")
+		require.NoError(t, err)
+		img, err := remote.Image(ref)
+		require.NoError(t, err)
+
+		manifest, err := img.Manifest()
+		require.NoError(t, err)
+		require.Equal(t, types.MediaType("application/vnd.example.config.v1+json"), manifest.Config.MediaType)
+		require.Len(t, manifest.Layers, 1)
+		require.Equal(t, types.MediaType("text/plain"), manifest.Layers[0].MediaType)
+
+		layers, err := img.Layers()
+		require.NoError(t, err)
+		rc, err := layers[0].Compressed()
+		require.NoError(t, err)
+		defer rc.Close()
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.Equal(t, "hello", string(content))
+	})
+
+	t.Run("path does not exist", func(t *testing.T) {
+		res, err := runner.run(
+			context.Background(),
+			stepCtx,
+			builtin.OCIPushConfig{
+				Path:     "missing",
+				ImageRef: registryHost + "/missing:v1",
+			},
+		)
+		require.ErrorContains(t, err, "failed to stat")
+		require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+	})
+}
@@ -0,0 +1,137 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/expr-lang/expr"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/properties"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const stepKindPropertiesParse = "properties-parse"
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindPropertiesParse,
+			Value: newPropertiesParser,
+		},
+	)
+}
+
+// propertiesParser is an implementation of the promotion.StepRunner interface that
+// parses a properties file and extracts specified outputs.
+type propertiesParser struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newPropertiesParser returns a new instance of propertiesParser.
+func newPropertiesParser(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &propertiesParser{schemaLoader: getConfigSchemaLoader(stepKindPropertiesParse)}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (p *propertiesParser) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := p.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return p.run(ctx, stepCtx, cfg)
+}
+
+// convert validates propertiesParser configuration against a JSON schema and
+// converts it into a builtin.PropertiesParseConfig struct.
+func (p *propertiesParser) convert(cfg promotion.Config) (builtin.PropertiesParseConfig, error) {
+	return validateAndConvert[builtin.PropertiesParseConfig](p.schemaLoader, cfg, stepKindPropertiesParse)
+}
+
+func (p *propertiesParser) run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.PropertiesParseConfig,
+) (promotion.StepResult, error) {
+	failure := promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}
+
+	if cfg.Path == "" {
+		return failure, fmt.Errorf("properties file path cannot be empty")
+	}
+
+	if len(cfg.Outputs) == 0 {
+		return failure, fmt.Errorf("invalid %s config: outputs is required", stepKindPropertiesParse)
+	}
+
+	data, err := p.readAndParseProperties(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return failure, err
+	}
+
+	extractedValues, err := p.extractValues(data, cfg.Outputs)
+	if err != nil {
+		return failure, fmt.Errorf("failed to extract outputs: %w", err)
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Output: extractedValues,
+	}, nil
+}
+
+// readAndParseProperties reads and parses a properties file and returns the result.
+func (p *propertiesParser) readAndParseProperties(
+	workDir string,
+	path string,
+) (map[string]string, error) {
+	absFilePath, err := securejoin.SecureJoin(workDir, path)
+	if err != nil {
+		return nil, fmt.Errorf("error joining path %q: %w", path, err)
+	}
+	fileData, err := os.ReadFile(absFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading properties file %q: %w", absFilePath, err)
+	}
+	data, err := properties.Parse(fileData)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse properties file: %w", err)
+	}
+	return data, nil
+}
+
+// extractValues returns select data extracted from the provided data by
+// evaluating it against expressions contained within the provided
+// []builtin.PropertiesParse.
+func (p *propertiesParser) extractValues(
+	data map[string]string,
+	outputs []builtin.PropertiesParse,
+) (map[string]any, error) {
+	results := make(map[string]any, len(outputs))
+	for _, output := range outputs {
+		program, err := expr.Compile(output.FromExpression)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error compiling expression %q: %w",
+				output.FromExpression, err,
+			)
+		}
+		value, err := expr.Run(program, data)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error evaluating expression %q: %w",
+				output.FromExpression, err,
+			)
+		}
+		results[output.Name] = value
+	}
+	return results, nil
+}
@@ -0,0 +1,180 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_propertiesParser_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name: "path not specified",
+			config: promotion.Config{
+				"outputs": []promotion.Config{
+					{"name": "output1", "fromExpression": "app.version"},
+				},
+			},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name: "outputs not specified",
+			config: promotion.Config{
+				"path": "application.properties",
+			},
+			expectedProblems: []string{
+				"(root): outputs is required",
+			},
+		},
+		{
+			name: "outputs is empty array",
+			config: promotion.Config{
+				"path":    "application.properties",
+				"outputs": []promotion.Config{},
+			},
+			expectedProblems: []string{
+				"outputs: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "name and fromExpression not specified",
+			config: promotion.Config{
+				"path":    "application.properties",
+				"outputs": []promotion.Config{{}},
+			},
+			expectedProblems: []string{
+				"outputs.0: name is required",
+				"outputs.0: fromExpression is required",
+			},
+		},
+		{
+			name: "valid configuration",
+			config: promotion.Config{
+				"path": "application.properties",
+				"outputs": []promotion.Config{
+					{"name": "output1", "fromExpression": "app.version"},
+				},
+			},
+		},
+	}
+
+	r := newPropertiesParser(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*propertiesParser)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_propertiesParser_run(t *testing.T) {
+	tests := []struct {
+		name       string
+		files      map[string]string
+		cfg        builtin.PropertiesParseConfig
+		assertions func(*testing.T, promotion.StepResult, error)
+	}{
+		{
+			name: "successful run with outputs",
+			files: map[string]string{
+				"application.properties": `# Application settings
+app.version=1.0.0
+app.image.tag : 1.0.0
+`,
+			},
+			cfg: builtin.PropertiesParseConfig{
+				Path: "application.properties",
+				Outputs: []builtin.PropertiesParse{
+					{Name: "version", FromExpression: "$env['app.version']"},
+					{Name: "tag", FromExpression: "$env['app.image.tag']"},
+				},
+			},
+			assertions: func(t *testing.T, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"version": "1.0.0",
+						"tag":     "1.0.0",
+					},
+				}, result)
+			},
+		},
+		{
+			name: "invalid file",
+			files: map[string]string{
+				"application.properties": "key=\\u12\n",
+			},
+			cfg: builtin.PropertiesParseConfig{
+				Path: "application.properties",
+				Outputs: []builtin.PropertiesParse{
+					{Name: "output", FromExpression: "app.version"},
+				},
+			},
+			assertions: func(t *testing.T, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "could not parse properties file")
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+			},
+		},
+		{
+			name: "failed to extract outputs",
+			files: map[string]string{
+				"application.properties": `# Application settings
+app.version=1.0.0
+app.image.tag : 1.0.0
+`,
+			},
+			cfg: builtin.PropertiesParseConfig{
+				Path: "application.properties",
+				Outputs: []builtin.PropertiesParse{
+					{Name: "output", FromExpression: "invalid expression ("},
+				},
+			},
+			assertions: func(t *testing.T, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "failed to extract outputs")
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+			},
+		},
+		{
+			name:  "file does not exist",
+			files: map[string]string{},
+			cfg: builtin.PropertiesParseConfig{
+				Path: "application.properties",
+				Outputs: []builtin.PropertiesParse{
+					{Name: "output", FromExpression: "app.version"},
+				},
+			},
+			assertions: func(t *testing.T, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "no such file or directory")
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+			},
+		},
+	}
+
+	runner := &propertiesParser{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for p, c := range tt.files {
+				require.NoError(t, os.MkdirAll(filepath.Join(workDir, filepath.Dir(p)), 0o700))
+				require.NoError(t, os.WriteFile(filepath.Join(workDir, p), []byte(c), 0o600))
+			}
+
+			stepCtx := &promotion.StepContext{
+				Project: "test-project",
+				WorkDir: workDir,
+			}
+			result, err := runner.run(context.Background(), stepCtx, tt.cfg)
+			tt.assertions(t, result, err)
+		})
+	}
+}
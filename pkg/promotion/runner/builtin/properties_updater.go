@@ -0,0 +1,117 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/properties"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const stepKindPropertiesUpdate = "properties-update"
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindPropertiesUpdate,
+			Value: newPropertiesUpdater,
+		},
+	)
+}
+
+// propertiesUpdater is an implementation of the promotion.StepRunner interface that
+// updates the values of specified properties in a properties file.
+type propertiesUpdater struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newPropertiesUpdater returns an implementation of the promotion.StepRunner interface
+// that updates the values of specified properties in a properties file.
+func newPropertiesUpdater(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &propertiesUpdater{schemaLoader: getConfigSchemaLoader(stepKindPropertiesUpdate)}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (p *propertiesUpdater) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := p.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return p.run(ctx, stepCtx, cfg)
+}
+
+// convert validates propertiesUpdater configuration against a JSON schema and
+// converts it into a builtin.PropertiesUpdateConfig struct.
+func (p *propertiesUpdater) convert(cfg promotion.Config) (builtin.PropertiesUpdateConfig, error) {
+	return validateAndConvert[builtin.PropertiesUpdateConfig](p.schemaLoader, cfg, stepKindPropertiesUpdate)
+}
+
+func (p *propertiesUpdater) run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.PropertiesUpdateConfig,
+) (promotion.StepResult, error) {
+	updates := make([]properties.Update, len(cfg.Updates))
+	for i, update := range cfg.Updates {
+		updates[i] = properties.Update{
+			Key:   update.Key,
+			Value: update.Value,
+		}
+	}
+
+	result := promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}
+	if len(updates) > 0 {
+		if err := p.updateFile(stepCtx.WorkDir, cfg.Path, updates); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("properties file update failed: %w", err)
+		}
+
+		if commitMsg := p.generateCommitMessage(cfg.Path, cfg.Updates); commitMsg != "" {
+			result.Output = map[string]any{
+				"commitMessage": commitMsg,
+			}
+		}
+	}
+	return result, nil
+}
+
+func (p *propertiesUpdater) updateFile(workDir string, path string, updates []properties.Update) error {
+	absFilePath, err := securejoin.SecureJoin(workDir, path)
+	if err != nil {
+		return fmt.Errorf("error joining path %q: %w", path, err)
+	}
+	if err := properties.SetValuesInFile(absFilePath, updates); err != nil {
+		return fmt.Errorf("error updating properties file %q: %w", path, err)
+	}
+	return nil
+}
+
+func (p *propertiesUpdater) generateCommitMessage(path string, updates []builtin.PropertiesUpdate) string {
+	if len(updates) == 0 {
+		return ""
+	}
+
+	var commitMsg strings.Builder
+	_, _ = commitMsg.WriteString(fmt.Sprintf("Updated %s\n", path))
+	for _, update := range updates {
+		switch v := update.Value.(type) {
+		case string:
+			_, _ = commitMsg.WriteString(fmt.Sprintf("\n- %s: %q", update.Key, v))
+		default:
+			_, _ = commitMsg.WriteString(fmt.Sprintf("\n- %s: %v", update.Key, v))
+		}
+	}
+
+	return commitMsg.String()
+}
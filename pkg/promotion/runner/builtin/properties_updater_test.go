@@ -0,0 +1,175 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_propertiesUpdater_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name: "path is empty string",
+			config: promotion.Config{
+				"path": "",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name:   "updates not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): updates is required",
+			},
+		},
+		{
+			name: "updates is empty array",
+			config: promotion.Config{
+				"updates": []promotion.Config{},
+			},
+			expectedProblems: []string{
+				"updates: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "key not specified",
+			config: promotion.Config{
+				"updates": []promotion.Config{{}},
+			},
+			expectedProblems: []string{
+				"updates.0: key is required",
+			},
+		},
+		{
+			name: "value is not a scalar",
+			config: promotion.Config{
+				"updates": []promotion.Config{{
+					"key":   "app.version",
+					"value": []string{"foo"},
+				}},
+			},
+			expectedProblems: []string{
+				"updates.0.value: Invalid type",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path": "application.properties",
+				"updates": []promotion.Config{
+					{
+						"key":   "app.version",
+						"value": "foo",
+					},
+					{
+						"key":   "app.version",
+						"value": 42,
+					},
+					{
+						"key":   "app.version",
+						"value": true,
+					},
+				},
+			},
+		},
+	}
+
+	r := newPropertiesUpdater(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*propertiesUpdater)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_propertiesUpdater_run(t *testing.T) {
+	tests := []struct {
+		name       string
+		files      map[string]string
+		cfg        builtin.PropertiesUpdateConfig
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name: "successful run",
+			files: map[string]string{
+				"application.properties": `# Application settings
+app.version=1.0.0
+app.image.tag : 1.0.0
+`,
+			},
+			cfg: builtin.PropertiesUpdateConfig{
+				Path: "application.properties",
+				Updates: []builtin.PropertiesUpdate{
+					{Key: "app.version", Value: "1.1.0"},
+					{Key: "app.image.tag", Value: "1.1.0"},
+					{Key: "app.replicas", Value: float64(3)},
+				},
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"commitMessage": "Updated application.properties\n\n- app.version: \"1.1.0\"\n- app.image.tag: \"1.1.0\"\n- app.replicas: 3",
+					},
+				}, result)
+				content, err := os.ReadFile(filepath.Join(workDir, "application.properties"))
+				require.NoError(t, err)
+				assert.Equal(t, `# Application settings
+app.version=1.1.0
+app.image.tag : 1.1.0
+app.replicas=3
+`, string(content))
+			},
+		},
+		{
+			name:  "file does not exist",
+			files: map[string]string{},
+			cfg: builtin.PropertiesUpdateConfig{
+				Path: "application.properties",
+				Updates: []builtin.PropertiesUpdate{
+					{Key: "app.version", Value: "foo"},
+				},
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "no such file or directory")
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+			},
+		},
+	}
+
+	runner := &propertiesUpdater{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for p, c := range tt.files {
+				require.NoError(t, os.MkdirAll(filepath.Join(workDir, filepath.Dir(p)), 0o700))
+				require.NoError(t, os.WriteFile(filepath.Join(workDir, p), []byte(c), 0o600))
+			}
+
+			stepCtx := &promotion.StepContext{
+				Project: "test-project",
+				WorkDir: workDir,
+			}
+			result, err := runner.run(context.Background(), stepCtx, tt.cfg)
+			tt.assertions(t, workDir, result, err)
+		})
+	}
+}
@@ -0,0 +1,602 @@
+package builtin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libargocd "github.com/akuity/kargo/pkg/argocd"
+	"github.com/akuity/kargo/pkg/controller/git"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+// stateKeyDiff is the key used to store the diff of the changes a simulated
+// push would have pushed to a remote repository in the shared State.
+const stateKeyDiff = "diff"
+
+// simulatedMessagePrefix prefixes the messages of all simulated steps, to
+// make them easily distinguishable from steps that were actually executed.
+const simulatedMessagePrefix = "Simulated: "
+
+func init() {
+	// Simulators stand in for the step runners that have side effects beyond the
+	// working directory of a Promotion when the Promotion is executed as a dry
+	// run.
+	promotion.DryRunStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindGitPush,
+			Metadata: promotion.StepRunnerMetadata{
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessCredentials,
+				},
+			},
+			Value: newGitPushSimulator,
+		},
+	)
+	promotion.DryRunStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindGitTag,
+			Value: newGitTagSimulator,
+		},
+	)
+	promotion.DryRunStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindGitOpenPR,
+			Value: newGitOpenPRSimulator,
+		},
+	)
+	promotion.DryRunStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindGitWaitForPR,
+			Value: newGitWaitForPRSimulator,
+		},
+	)
+	promotion.DryRunStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindGitMergePR,
+			Value: newGitMergePRSimulator,
+		},
+	)
+	promotion.DryRunStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindArgoCDUpdate,
+			Metadata: promotion.StepRunnerMetadata{
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessArgoCD,
+				},
+			},
+			Value: newArgoCDUpdateSimulator,
+		},
+	)
+	promotion.DryRunStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindHTTP,
+			Value: newHTTPSimulator,
+		},
+	)
+	promotion.DryRunStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name:  stepKindOCIPush,
+			Value: newOCIPushSimulator,
+		},
+	)
+	promotion.DryRunStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindSetMetadata,
+			Metadata: promotion.StepRunnerMetadata{
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessControlPlane,
+				},
+			},
+			Value: newSetMetadataSimulator,
+		},
+	)
+}
+
+// gitPushSimulator is an implementation of the promotion.StepRunner interface
+// that simulates pushing commits from a local Git repository to a remote Git
+// repository. Instead of pushing, it outputs a diff of the changes that would
+// have been pushed.
+type gitPushSimulator struct {
+	schemaLoader gojsonschema.JSONLoader
+	credsDB      credentials.Database
+}
+
+// newGitPushSimulator returns an implementation of the promotion.StepRunner
+// interface that simulates pushing commits to a remote Git repository.
+func newGitPushSimulator(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &gitPushSimulator{
+		schemaLoader: getConfigSchemaLoader(stepKindGitPush),
+		credsDB:      caps.CredsDB,
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (g *gitPushSimulator) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := validateAndConvert[builtin.GitPushConfig](g.schemaLoader, stepCtx.Config, stepKindGitPush)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+
+	path, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, fmt.Errorf(
+			"error joining path %s with work dir %s: %w",
+			cfg.Path, stepCtx.WorkDir, err,
+		)
+	}
+	// Credentials are required for determining what the remote branch looks
+	// like.
+	loadOpts := &git.LoadWorkTreeOptions{}
+	workTree, err := git.LoadWorkTree(path, loadOpts)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error loading working tree from %s: %w", cfg.Path, err)
+	}
+	creds, err := g.credsDB.Get(ctx, stepCtx.Project, credentials.TypeGit, workTree.URL())
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error getting credentials for %s: %w", workTree.URL(), err)
+	}
+	if creds != nil {
+		loadOpts.Credentials = &git.RepoCredentials{
+			Username:      creds.Username,
+			Password:      creds.Password,
+			SSHPrivateKey: creds.SSHPrivateKey,
+		}
+		if workTree, err = git.LoadWorkTree(path, loadOpts); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error loading working tree from %s: %w", cfg.Path, err)
+		}
+	}
+
+	targetBranch := cfg.TargetBranch
+	if cfg.GenerateTargetBranch {
+		targetBranch = fmt.Sprintf("kargo/promotion/%s", stepCtx.Promotion)
+	}
+	if targetBranch == "" {
+		if targetBranch, err = workTree.CurrentBranch(); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error getting current branch: %w", err)
+		}
+	}
+
+	diff, err := workTree.DiffFromRemoteBranch(targetBranch)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error getting diff from remote branch %q: %w", targetBranch, err)
+	}
+	commitID, err := workTree.LastCommitID()
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error getting last commit ID: %w", err)
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Message: fmt.Sprintf(
+			"%swould push commit %s to branch %q of %s",
+			simulatedMessagePrefix, commitID, targetBranch, workTree.URL(),
+		),
+		Output: map[string]any{
+			stateKeyBranch:    targetBranch,
+			stateKeyCommit:    commitID,
+			stateKeyCommitURL: "",
+			stateKeyDiff:      diff,
+		},
+	}, nil
+}
+
+// gitTagSimulator is an implementation of the promotion.StepRunner interface
+// that simulates creating a tag and pushing it to a remote Git repository.
+type gitTagSimulator struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newGitTagSimulator returns an implementation of the promotion.StepRunner
+// interface that simulates creating and pushing a tag.
+func newGitTagSimulator(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &gitTagSimulator{schemaLoader: getConfigSchemaLoader(stepKindGitTag)}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (g *gitTagSimulator) Run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := validateAndConvert[builtin.GitTagConfig](g.schemaLoader, stepCtx.Config, stepKindGitTag)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+
+	commitID := cfg.Commit
+	if commitID == "" {
+		path, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+		if err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, fmt.Errorf(
+				"error joining path %s with work dir %s: %w",
+				cfg.Path, stepCtx.WorkDir, err,
+			)
+		}
+		workTree, err := git.LoadWorkTree(path, nil)
+		if err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error loading working tree from %s: %w", cfg.Path, err)
+		}
+		if commitID, err = workTree.LastCommitID(); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error getting last commit ID: %w", err)
+		}
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Message: fmt.Sprintf(
+			"%swould tag commit %s as %q", simulatedMessagePrefix, commitID, cfg.Tag,
+		),
+		Output: map[string]any{
+			stateKeyTag:    cfg.Tag,
+			stateKeyCommit: commitID,
+		},
+	}, nil
+}
+
+// gitOpenPRSimulator is an implementation of the promotion.StepRunner
+// interface that simulates opening a pull request.
+type gitOpenPRSimulator struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newGitOpenPRSimulator returns an implementation of the promotion.StepRunner
+// interface that simulates opening a pull request.
+func newGitOpenPRSimulator(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &gitOpenPRSimulator{schemaLoader: getConfigSchemaLoader(stepKindGitOpenPR)}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (g *gitOpenPRSimulator) Run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := validateAndConvert[builtin.GitOpenPRConfig](g.schemaLoader, stepCtx.Config, stepKindGitOpenPR)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Message: fmt.Sprintf(
+			"%swould open a pull request from branch %q to branch %q of %s",
+			simulatedMessagePrefix, cfg.SourceBranch, cfg.TargetBranch, cfg.RepoURL,
+		),
+		Output: map[string]any{
+			"pr": map[string]any{
+				"id":  int64(0),
+				"url": "",
+			},
+		},
+	}, nil
+}
+
+// gitWaitForPRSimulator is an implementation of the promotion.StepRunner
+// interface that simulates waiting for a pull request to be merged.
+type gitWaitForPRSimulator struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newGitWaitForPRSimulator returns an implementation of the
+// promotion.StepRunner interface that simulates waiting for a pull request to
+// be merged.
+func newGitWaitForPRSimulator(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &gitWaitForPRSimulator{schemaLoader: getConfigSchemaLoader(stepKindGitWaitForPR)}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (g *gitWaitForPRSimulator) Run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := validateAndConvert[builtin.GitWaitForPRConfig](
+		g.schemaLoader, stepCtx.Config, stepKindGitWaitForPR,
+	)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Message: fmt.Sprintf(
+			"%swould wait for pull request %d of %s to be merged",
+			simulatedMessagePrefix, cfg.PRNumber, cfg.RepoURL,
+		),
+		Output: map[string]any{stateKeyCommit: ""},
+	}, nil
+}
+
+// gitMergePRSimulator is an implementation of the promotion.StepRunner
+// interface that simulates merging a pull request.
+type gitMergePRSimulator struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newGitMergePRSimulator returns an implementation of the promotion.StepRunner
+// interface that simulates merging a pull request.
+func newGitMergePRSimulator(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &gitMergePRSimulator{schemaLoader: getConfigSchemaLoader(stepKindGitMergePR)}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (g *gitMergePRSimulator) Run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := validateAndConvert[builtin.GitMergePRConfig](g.schemaLoader, stepCtx.Config, stepKindGitMergePR)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Message: fmt.Sprintf(
+			"%swould merge pull request %d of %s",
+			simulatedMessagePrefix, cfg.PRNumber, cfg.RepoURL,
+		),
+		Output: map[string]any{stateKeyCommit: ""},
+	}, nil
+}
+
+// argoCDUpdateSimulator is an implementation of the promotion.StepRunner
+// interface that simulates updating Argo CD Application resources. It outputs
+// the Applications that would have been updated.
+type argoCDUpdateSimulator struct {
+	updater *argocdUpdater
+}
+
+// newArgoCDUpdateSimulator returns an implementation of the
+// promotion.StepRunner interface that simulates updating Argo CD Application
+// resources.
+func newArgoCDUpdateSimulator(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &argoCDUpdateSimulator{
+		updater: newArgocdUpdater(caps).(*argocdUpdater), // nolint: forcetypeassert
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (a *argoCDUpdateSimulator) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := a.updater.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+
+	var apps []string
+	for i := range cfg.Apps {
+		update := &cfg.Apps[i]
+		if a.updater.argocdClient == nil {
+			// Without access to Argo CD, the best we can do is to report the
+			// Applications referenced by name. Applications selected by label
+			// cannot be resolved at all.
+			if update.Name == "" {
+				// nolint:staticcheck
+				return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, errors.New(
+					"Argo CD integration is disabled; cannot resolve Argo CD " +
+						"Application resources selected by label",
+				)
+			}
+			namespace := update.Namespace
+			if namespace == "" {
+				namespace = libargocd.Namespace()
+			}
+			apps = append(apps, fmt.Sprintf("%s/%s", namespace, update.Name))
+			continue
+		}
+		matched, err := a.updater.getAuthorizedApplicationsFn(ctx, stepCtx, update)
+		if err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+		}
+		for _, app := range matched {
+			apps = append(apps, fmt.Sprintf("%s/%s", app.Namespace, app.Name))
+		}
+	}
+
+	appsOutput := make([]any, len(apps))
+	for i, app := range apps {
+		appsOutput[i] = app
+	}
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Message: fmt.Sprintf(
+			"%swould update Argo CD Application(s) %s",
+			simulatedMessagePrefix, strings.Join(apps, ", "),
+		),
+		Output: map[string]any{"applications": appsOutput},
+	}, nil
+}
+
+// httpSimulator is an implementation of the promotion.StepRunner interface
+// that sends GET requests like the http step does, but only simulates sending
+// requests with any other method.
+type httpSimulator struct {
+	requester *httpRequester
+}
+
+// newHTTPSimulator returns an implementation of the promotion.StepRunner
+// interface that simulates sending HTTP requests with side effects.
+func newHTTPSimulator(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &httpSimulator{
+		requester: newHTTPRequester(caps).(*httpRequester), // nolint: forcetypeassert
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (h *httpSimulator) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := h.requester.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	method := strings.ToUpper(cfg.Method)
+	if method == "" || method == http.MethodGet {
+		return h.requester.run(ctx, stepCtx, cfg)
+	}
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Message: fmt.Sprintf(
+			"%swould send %s request to %s", simulatedMessagePrefix, method, cfg.URL,
+		),
+	}, nil
+}
+
+// ociPushSimulator is an implementation of the promotion.StepRunner interface
+// that simulates pushing an artifact to an OCI registry.
+type ociPushSimulator struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newOCIPushSimulator returns an implementation of the promotion.StepRunner
+// interface that simulates pushing an artifact to an OCI registry.
+func newOCIPushSimulator(promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &ociPushSimulator{schemaLoader: getConfigSchemaLoader(stepKindOCIPush)}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (o *ociPushSimulator) Run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := validateAndConvert[builtin.OCIPushConfig](o.schemaLoader, stepCtx.Config, stepKindOCIPush)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Message: fmt.Sprintf(
+			"%swould push %s to %s", simulatedMessagePrefix, cfg.Path, cfg.ImageRef,
+		),
+		Output: map[string]any{
+			stateKeyDigest:   "",
+			stateKeyImageRef: "",
+			stateKeyTag:      "",
+		},
+	}, nil
+}
+
+// setMetadataSimulator is an implementation of the promotion.StepRunner
+// interface that simulates updating metadata on Stage or Freight resources.
+// It verifies that the resources exist and that the metadata can be set on
+// them, but never patches them.
+type setMetadataSimulator struct {
+	setter *metadataSetter
+}
+
+// newSetMetadataSimulator returns an implementation of the
+// promotion.StepRunner interface that simulates updating metadata on Stage or
+// Freight resources.
+func newSetMetadataSimulator(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &setMetadataSimulator{
+		setter: newMetadataSetter(caps).(*metadataSetter), // nolint: forcetypeassert
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (s *setMetadataSimulator) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := s.setter.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+
+	updates := make([]string, 0, len(cfg.Updates))
+	for _, update := range cfg.Updates {
+		var obj client.Object
+		switch update.Kind {
+		case "Stage":
+			obj = &kargoapi.Stage{}
+		case "Freight":
+			obj = &kargoapi.Freight{}
+		default:
+			return promotion.StepResult{
+				Status: kargoapi.PromotionStepStatusFailed,
+			}, &promotion.TerminalError{
+				Err: fmt.Errorf("unsupported kind %q", update.Kind),
+			}
+		}
+		if err = s.setter.kargoClient.Get(
+			ctx,
+			types.NamespacedName{
+				Name:      update.Name,
+				Namespace: stepCtx.Project,
+			},
+			obj,
+		); err != nil {
+			return promotion.StepResult{
+				Status: kargoapi.PromotionStepStatusErrored,
+			}, fmt.Errorf("error getting %s: %w", update.Kind, err)
+		}
+		// Metadata is only ever set on a copy of the status, which is discarded
+		var status metadataUpsertable
+		switch o := obj.(type) {
+		case *kargoapi.Stage:
+			status = o.Status.DeepCopy()
+		case *kargoapi.Freight:
+			status = o.Status.DeepCopy()
+		}
+		if err = s.setter.upsertMetadata(status, update.Values); err != nil {
+			return promotion.StepResult{
+				Status: kargoapi.PromotionStepStatusErrored,
+			}, fmt.Errorf(
+				"error updating metadata for %s %q in namespace %q: %w",
+				update.Kind, update.Name, stepCtx.Project, err,
+			)
+		}
+		updates = append(updates, fmt.Sprintf(
+			"%s %q (%s)",
+			update.Kind, update.Name,
+			strings.Join(slices.Sorted(maps.Keys(update.Values)), ", "),
+		))
+	}
+
+	return promotion.StepResult{
+		Status: kargoapi.PromotionStepStatusSucceeded,
+		Message: fmt.Sprintf(
+			"%swould set metadata on %s",
+			simulatedMessagePrefix, strings.Join(updates, ", "),
+		),
+	}, nil
+}
@@ -0,0 +1,335 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sosedoff/gitkit"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/git"
+	"github.com/akuity/kargo/pkg/credentials"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+func Test_simulatorRegistrations(t *testing.T) {
+	for _, stepKind := range []string{
+		stepKindGitPush,
+		stepKindGitTag,
+		stepKindGitOpenPR,
+		stepKindGitWaitForPR,
+		stepKindGitMergePR,
+		stepKindArgoCDUpdate,
+		stepKindHTTP,
+		stepKindOCIPush,
+		stepKindSetMetadata,
+	} {
+		t.Run(stepKind, func(t *testing.T) {
+			simulator, err := promotion.DryRunStepRunnerRegistry.Get(stepKind)
+			require.NoError(t, err)
+			runner, err := promotion.DefaultStepRunnerRegistry.Get(stepKind)
+			require.NoError(t, err)
+			// A simulator must never require capabilities the step runner it
+			// stands in for does not require.
+			for _, capability := range simulator.Metadata.RequiredCapabilities {
+				require.Contains(t, runner.Metadata.RequiredCapabilities, capability)
+			}
+		})
+	}
+}
+
+func Test_gitPushSimulator_Run(t *testing.T) {
+	// Set up a test Git server in-process
+	service := gitkit.New(
+		gitkit.Config{
+			Dir:        t.TempDir(),
+			AutoCreate: true,
+		},
+	)
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	// This is the URL of the "remote" repository
+	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
+
+	// Seed the remote repository with an initial commit.
+	setupRepo, err := git.Clone(testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer setupRepo.Close()
+	err = os.WriteFile(filepath.Join(setupRepo.Dir(), "test.txt"), []byte("foo\n"), 0600)
+	require.NoError(t, err)
+	require.NoError(t, setupRepo.AddAllAndCommit("Initial commit", nil))
+	require.NoError(t, setupRepo.Push(nil))
+
+	// Finagle a local bare repo and working tree into place the way that
+	// gitCloner might have.
+	workDir := t.TempDir()
+	repo, err := git.CloneBare(testRepoURL, nil, &git.BareCloneOptions{BaseDir: workDir})
+	require.NoError(t, err)
+	defer repo.Close()
+	// "master" is still the default branch name for a new repository
+	// unless you configure it otherwise.
+	workTree, err := repo.AddWorkTree(
+		filepath.Join(workDir, "master"),
+		&git.AddWorkTreeOptions{Ref: "master"},
+	)
+	require.NoError(t, err)
+
+	// Commit a change similarly to how gitCommitter would have.
+	err = os.WriteFile(filepath.Join(workTree.Dir(), "test.txt"), []byte("bar\n"), 0600)
+	require.NoError(t, err)
+	require.NoError(t, workTree.AddAllAndCommit("Update test.txt", nil))
+	expectedCommit, err := workTree.LastCommitID()
+	require.NoError(t, err)
+
+	runner := newGitPushSimulator(promotion.StepRunnerCapabilities{
+		CredsDB: &credentials.FakeDB{},
+	})
+	res, err := runner.Run(
+		context.Background(),
+		&promotion.StepContext{
+			Project:   "fake-project",
+			Stage:     "fake-stage",
+			Promotion: "fake-promotion",
+			WorkDir:   workDir,
+			Config:    promotion.Config{"path": "master"},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+	require.Contains(t, res.Message, simulatedMessagePrefix)
+	require.Equal(t, "master", res.Output[stateKeyBranch])
+	require.Equal(t, expectedCommit, res.Output[stateKeyCommit])
+	require.Contains(t, res.Output[stateKeyDiff], "-foo\n+bar\n")
+
+	// Nothing should have been pushed to the remote repository.
+	checkRepo, err := git.Clone(testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer checkRepo.Close()
+	remoteCommit, err := checkRepo.LastCommitID()
+	require.NoError(t, err)
+	require.NotEqual(t, expectedCommit, remoteCommit)
+}
+
+func Test_httpSimulator_Run(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	runner := newHTTPSimulator(promotion.StepRunnerCapabilities{})
+
+	t.Run("GET request is sent", func(t *testing.T) {
+		res, err := runner.Run(
+			context.Background(),
+			&promotion.StepContext{
+				Config: promotion.Config{"url": srv.URL},
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+		require.NotContains(t, res.Message, simulatedMessagePrefix)
+		require.Equal(t, 1, requests)
+	})
+
+	t.Run("POST request is simulated", func(t *testing.T) {
+		res, err := runner.Run(
+			context.Background(),
+			&promotion.StepContext{
+				Config: promotion.Config{
+					"method": http.MethodPost,
+					"url":    srv.URL,
+				},
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+		require.Equal(t, simulatedMessagePrefix+"would send POST request to "+srv.URL, res.Message)
+		require.Equal(t, 1, requests)
+	})
+}
+
+func Test_argoCDUpdateSimulator_Run(t *testing.T) {
+	runner := newArgoCDUpdateSimulator(promotion.StepRunnerCapabilities{})
+
+	t.Run("applications referenced by name", func(t *testing.T) {
+		res, err := runner.Run(
+			context.Background(),
+			&promotion.StepContext{
+				Config: promotion.Config{
+					"apps": []any{
+						map[string]any{"name": "app-1"},
+						map[string]any{"name": "app-2", "namespace": "other"},
+					},
+				},
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+		require.Equal(
+			t,
+			map[string]any{"applications": []any{"argocd/app-1", "other/app-2"}},
+			res.Output,
+		)
+	})
+
+	t.Run("applications selected by label without Argo CD", func(t *testing.T) {
+		res, err := runner.Run(
+			context.Background(),
+			&promotion.StepContext{
+				Config: promotion.Config{
+					"apps": []any{
+						map[string]any{
+							"selector": map[string]any{
+								"matchLabels": map[string]any{"app": "fake-app"},
+							},
+						},
+					},
+				},
+			},
+		)
+		require.ErrorContains(t, err, "cannot resolve Argo CD Application resources selected by label")
+		require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+	})
+}
+
+func Test_gitOpenPRSimulator_Run(t *testing.T) {
+	runner := newGitOpenPRSimulator(promotion.StepRunnerCapabilities{})
+	res, err := runner.Run(
+		context.Background(),
+		&promotion.StepContext{
+			Config: promotion.Config{
+				"repoURL":      "https://github.com/example/repo.git",
+				"sourceBranch": "feature",
+				"targetBranch": "main",
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+	require.Equal(
+		t,
+		map[string]any{"pr": map[string]any{"id": int64(0), "url": ""}},
+		res.Output,
+	)
+}
+
+func Test_setMetadataSimulator_Run(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	const (
+		testObjName = "test-obj"
+		testProject = "test-project"
+	)
+
+	testMetadata := map[string]apiextensionsv1.JSON{
+		"existing": {Raw: []byte(`"value"`)},
+	}
+
+	newClient := func() client.Client {
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testObjName,
+					Namespace: testProject,
+				},
+				Status: kargoapi.StageStatus{Metadata: testMetadata},
+			},
+			&kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testObjName,
+					Namespace: testProject,
+				},
+				Status: kargoapi.FreightStatus{Metadata: testMetadata},
+			},
+		).WithStatusSubresource(&kargoapi.Stage{}, &kargoapi.Freight{}).Build()
+	}
+
+	t.Run("resource not found", func(t *testing.T) {
+		runner := newSetMetadataSimulator(
+			promotion.StepRunnerCapabilities{KargoClient: newClient()},
+		)
+		res, err := runner.Run(
+			context.Background(),
+			&promotion.StepContext{
+				Project: testProject,
+				Config: promotion.Config{
+					"updates": []any{
+						map[string]any{
+							"kind":   "Stage",
+							"name":   "nonexistent",
+							"values": map[string]any{"key": "value"},
+						},
+					},
+				},
+			},
+		)
+		require.ErrorContains(t, err, "error getting Stage")
+		require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+	})
+
+	t.Run("dry run leaves status unchanged", func(t *testing.T) {
+		kargoClient := newClient()
+		res, err := promotion.NewDryRunEngine(
+			kargoClient,
+			nil,
+			nil,
+			promotion.DefaultExprDataCacheFn,
+		).Promote(
+			context.Background(),
+			promotion.Context{
+				Project: testProject,
+				Stage:   testObjName,
+				State:   promotion.State{},
+			},
+			[]promotion.Step{{
+				Kind: stepKindSetMetadata,
+				Config: []byte(`{"updates":[` +
+					`{"kind":"Stage","name":"test-obj","values":{"new":"value","existing":"updated"}},` +
+					`{"kind":"Freight","name":"test-obj","values":{"new":"value"}}` +
+					`]}`),
+			}},
+		)
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionPhaseSucceeded, res.Status)
+		require.Len(t, res.StepExecutionMetadata, 1)
+		require.Equal(
+			t,
+			`Simulated: would set metadata on Stage "test-obj" (existing, new), `+
+				`Freight "test-obj" (new)`,
+			res.StepExecutionMetadata[0].Message,
+		)
+
+		stage := &kargoapi.Stage{}
+		require.NoError(t, kargoClient.Get(
+			context.Background(),
+			types.NamespacedName{Namespace: testProject, Name: testObjName},
+			stage,
+		))
+		require.Equal(t, testMetadata, stage.Status.Metadata)
+
+		freight := &kargoapi.Freight{}
+		require.NoError(t, kargoClient.Get(
+			context.Background(),
+			types.NamespacedName{Namespace: testProject, Name: testObjName},
+			freight,
+		))
+		require.Equal(t, testMetadata, freight.Status.Metadata)
+	})
+}
@@ -0,0 +1,156 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	libExec "github.com/akuity/kargo/pkg/exec"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	// sopsSecretKeyAge is the key in a SOPS key Secret's data that holds one or
+	// more age identities (private keys).
+	sopsSecretKeyAge = "age"
+	// sopsSecretKeyPGP is the key in a SOPS key Secret's data that holds one or
+	// more ASCII-armored PGP keys.
+	sopsSecretKeyPGP = "pgp"
+)
+
+// sopsEnv is a minimal environment in which the sops binary is executed. It is
+// deliberately isolated from the environment of the controller so that sops
+// cannot pick up any ambient credentials (e.g. for a cloud KMS) and so that
+// key material never outlives the execution of a single step.
+type sopsEnv struct {
+	// homeDir is a temporary directory used as the home directory for sops and
+	// gpg.
+	homeDir string
+	// vars are additional environment variables to set when executing sops.
+	vars []string
+}
+
+// newSOPSEnv creates a new sopsEnv. If secretName is non-empty, key material
+// is loaded from the Secret with that name in the specified namespace. The
+// caller is responsible for calling cleanup() on the returned sopsEnv.
+func newSOPSEnv(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	secretName string,
+) (*sopsEnv, error) {
+	homeDir, err := os.MkdirTemp("", "sops-")
+	if err != nil {
+		return nil, fmt.Errorf("error creating temporary home directory: %w", err)
+	}
+	env := &sopsEnv{homeDir: homeDir}
+	if secretName == "" {
+		return env, nil
+	}
+	if err = env.loadKeys(ctx, c, namespace, secretName); err != nil {
+		env.cleanup()
+		return nil, err
+	}
+	return env, nil
+}
+
+// loadKeys retrieves the key material from the specified Secret and makes it
+// available to sops.
+func (e *sopsEnv) loadKeys(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	secretName string,
+) error {
+	if c == nil {
+		return fmt.Errorf("no Kubernetes client available to retrieve Secret %q", secretName)
+	}
+	secret := &corev1.Secret{}
+	if err := c.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: namespace,
+			Name:      secretName,
+		},
+		secret,
+	); err != nil {
+		return fmt.Errorf(
+			"error getting Secret %q in namespace %q: %w",
+			secretName, namespace, err,
+		)
+	}
+
+	ageKeys, hasAge := secret.Data[sopsSecretKeyAge]
+	pgpKeys, hasPGP := secret.Data[sopsSecretKeyPGP]
+	if !hasAge && !hasPGP {
+		return fmt.Errorf(
+			"no %q or %q key found in Secret %q in namespace %q",
+			sopsSecretKeyAge, sopsSecretKeyPGP, secretName, namespace,
+		)
+	}
+
+	if hasAge {
+		ageKeyPath := filepath.Join(e.homeDir, "age-keys.txt")
+		if err := os.WriteFile(ageKeyPath, ageKeys, 0600); err != nil {
+			return fmt.Errorf("error writing age keys: %w", err)
+		}
+		e.vars = append(e.vars, "SOPS_AGE_KEY_FILE="+ageKeyPath)
+	}
+
+	if hasPGP {
+		gnupgHome := filepath.Join(e.homeDir, ".gnupg")
+		if err := os.Mkdir(gnupgHome, 0700); err != nil {
+			return fmt.Errorf("error creating GnuPG home directory: %w", err)
+		}
+		e.vars = append(e.vars, "GNUPGHOME="+gnupgHome)
+		pgpKeyPath := filepath.Join(e.homeDir, "pgp-keys.asc")
+		if err := os.WriteFile(pgpKeyPath, pgpKeys, 0600); err != nil {
+			return fmt.Errorf("error writing PGP keys: %w", err)
+		}
+		if _, err := libExec.Exec(e.command("gpg", "--batch", "--import", pgpKeyPath)); err != nil {
+			return fmt.Errorf("error importing PGP keys: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// command returns an *exec.Cmd for the specified command that will be
+// executed within the sopsEnv.
+func (e *sopsEnv) command(name string, args ...string) *exec.Cmd {
+	cmd := exec.Command(name, args...) // nolint: gosec
+	cmd.Dir = e.homeDir
+	cmd.Env = append(
+		[]string{
+			"PATH=" + os.Getenv("PATH"),
+			"HOME=" + e.homeDir,
+		},
+		e.vars...,
+	)
+	return cmd
+}
+
+// cleanup removes all key material and other temporary files created by the
+// sopsEnv.
+func (e *sopsEnv) cleanup() {
+	_ = os.RemoveAll(e.homeDir)
+}
+
+// sopsTypeArgs returns the sops command line arguments for the specified input
+// and output types.
+func sopsTypeArgs(inputType, outputType *builtin.SopsFileType) []string {
+	var args []string
+	if inputType != nil {
+		args = append(args, "--input-type", string(*inputType))
+	}
+	if outputType != nil {
+		args = append(args, "--output-type", string(*outputType))
+	}
+	return args
+}
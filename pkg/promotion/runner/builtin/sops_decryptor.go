@@ -0,0 +1,116 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libExec "github.com/akuity/kargo/pkg/exec"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const stepKindSOPSDecrypt = "sops-decrypt"
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindSOPSDecrypt,
+			Metadata: promotion.StepRunnerMetadata{
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessControlPlane,
+				},
+			},
+			Value: newSOPSDecryptor,
+		},
+	)
+}
+
+// sopsDecryptor is an implementation of the promotion.StepRunner interface
+// that decrypts a SOPS-encrypted file using key material stored in a Secret
+// in the Project's namespace.
+type sopsDecryptor struct {
+	kargoClient  client.Client
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newSOPSDecryptor returns an implementation of the promotion.StepRunner
+// interface that decrypts a SOPS-encrypted file.
+func newSOPSDecryptor(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &sopsDecryptor{
+		kargoClient:  caps.KargoClient,
+		schemaLoader: getConfigSchemaLoader(stepKindSOPSDecrypt),
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (s *sopsDecryptor) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := s.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return s.run(ctx, stepCtx, cfg)
+}
+
+// convert validates sopsDecryptor configuration against a JSON schema and
+// converts it into a builtin.SOPSDecryptConfig struct.
+func (s *sopsDecryptor) convert(cfg promotion.Config) (builtin.SOPSDecryptConfig, error) {
+	return validateAndConvert[builtin.SOPSDecryptConfig](s.schemaLoader, cfg, stepKindSOPSDecrypt)
+}
+
+func (s *sopsDecryptor) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.SOPSDecryptConfig,
+) (promotion.StepResult, error) {
+	absInPath, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error joining path %q: %w", cfg.Path, err)
+	}
+	if _, err = os.Stat(absInPath); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error reading file %q: %w", cfg.Path, err)
+	}
+
+	args := append([]string{"--decrypt"}, sopsTypeArgs(cfg.InputType, cfg.OutputType)...)
+	if cfg.OutPath == "" {
+		args = append(args, "--in-place")
+	} else {
+		var absOutPath string
+		if absOutPath, err = securejoin.SecureJoin(stepCtx.WorkDir, cfg.OutPath); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error joining path %q: %w", cfg.OutPath, err)
+		}
+		if err = os.MkdirAll(filepath.Dir(absOutPath), 0o700); err != nil {
+			return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+				fmt.Errorf("error creating directory for %q: %w", cfg.OutPath, err)
+		}
+		args = append(args, "--output", absOutPath)
+	}
+	args = append(args, absInPath)
+
+	env, err := newSOPSEnv(ctx, s.kargoClient, stepCtx.Project, cfg.KeySecret)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error preparing SOPS keys: %w", err)
+	}
+	defer env.cleanup()
+
+	if _, err = libExec.Exec(env.command("sops", args...)); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error decrypting file %q: %w", cfg.Path, err)
+	}
+	return promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+}
@@ -0,0 +1,190 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_sopsDecryptor_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name:   "keySecret not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): keySecret is required",
+			},
+		},
+		{
+			name: "keySecret is empty string",
+			config: promotion.Config{
+				"keySecret": "",
+			},
+			expectedProblems: []string{
+				"keySecret: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "invalid inputType",
+			config: promotion.Config{
+				"inputType": "xml",
+			},
+			expectedProblems: []string{
+				"inputType: inputType must be one of the following",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path":       "secrets.enc.yaml",
+				"outPath":    "secrets.yaml",
+				"keySecret":  "sops-keys",
+				"inputType":  "yaml",
+				"outputType": "json",
+			},
+		},
+	}
+
+	r := newSOPSDecryptor(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*sopsDecryptor)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_sopsDecryptor_run(t *testing.T) {
+	const testProject = "test-project"
+
+	keySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sops-keys",
+			Namespace: testProject,
+		},
+		Data: map[string][]byte{"age": []byte("AGE-SECRET-KEY-1FAKE")},
+	}
+
+	tests := []struct {
+		name       string
+		files      map[string]string
+		cfg        builtin.SOPSDecryptConfig
+		assertions func(*testing.T, string, string, promotion.StepResult, error)
+	}{
+		{
+			name: "file does not exist",
+			cfg: builtin.SOPSDecryptConfig{
+				Path:      "missing.yaml",
+				KeySecret: keySecret.Name,
+			},
+			assertions: func(t *testing.T, _, _ string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error reading file")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name:  "key Secret does not exist",
+			files: map[string]string{"secrets.yaml": "encrypted"},
+			cfg: builtin.SOPSDecryptConfig{
+				Path:      "secrets.yaml",
+				KeySecret: "missing",
+			},
+			assertions: func(t *testing.T, _, _ string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error preparing SOPS keys")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name:  "decrypts in place",
+			files: map[string]string{"secrets.yaml": "encrypted"},
+			cfg: builtin.SOPSDecryptConfig{
+				Path:      "secrets.yaml",
+				KeySecret: keySecret.Name,
+			},
+			assertions: func(t *testing.T, workDir, recordDir string, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				require.Equal(
+					t,
+					[]string{"--decrypt", "--in-place", filepath.Join(workDir, "secrets.yaml")},
+					readFakeSOPSRecord(t, recordDir, "args"),
+				)
+				require.Equal(
+					t,
+					[]string{"AGE-SECRET-KEY-1FAKE"},
+					readFakeSOPSRecord(t, recordDir, "age-keys"),
+				)
+				// Ensure sops did not inherit the environment of the controller
+				for _, v := range readFakeSOPSRecord(t, recordDir, "env") {
+					require.False(t, strings.HasPrefix(v, "KARGO_SOPS_TEST="))
+				}
+			},
+		},
+		{
+			name:  "decrypts to another path",
+			files: map[string]string{"secrets.enc": "encrypted"},
+			cfg: builtin.SOPSDecryptConfig{
+				Path:       "secrets.enc",
+				OutPath:    "out/secrets.json",
+				KeySecret:  keySecret.Name,
+				InputType:  ptr.To(builtin.YAML),
+				OutputType: ptr.To(builtin.JSON),
+			},
+			assertions: func(t *testing.T, workDir, recordDir string, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				require.Equal(
+					t,
+					[]string{
+						"--decrypt",
+						"--input-type", "yaml",
+						"--output-type", "json",
+						"--output", filepath.Join(workDir, "out", "secrets.json"),
+						filepath.Join(workDir, "secrets.enc"),
+					},
+					readFakeSOPSRecord(t, recordDir, "args"),
+				)
+				require.DirExists(t, filepath.Join(workDir, "out"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("KARGO_SOPS_TEST", "leaked")
+			recordDir := installFakeSOPS(t)
+			workDir := t.TempDir()
+			for name, content := range tt.files {
+				require.NoError(t, os.WriteFile(filepath.Join(workDir, name), []byte(content), 0o600))
+			}
+			runner := &sopsDecryptor{
+				kargoClient: fake.NewClientBuilder().WithObjects(keySecret).Build(),
+			}
+			res, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{
+					Project: testProject,
+					WorkDir: workDir,
+				},
+				tt.cfg,
+			)
+			tt.assertions(t, workDir, recordDir, res, err)
+		})
+	}
+}
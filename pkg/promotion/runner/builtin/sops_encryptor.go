@@ -0,0 +1,172 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	libExec "github.com/akuity/kargo/pkg/exec"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const stepKindSOPSEncrypt = "sops-encrypt"
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindSOPSEncrypt,
+			Metadata: promotion.StepRunnerMetadata{
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessControlPlane,
+				},
+			},
+			Value: newSOPSEncryptor,
+		},
+	)
+}
+
+// sopsEncryptor is an implementation of the promotion.StepRunner interface
+// that encrypts a file using SOPS.
+type sopsEncryptor struct {
+	kargoClient  client.Client
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newSOPSEncryptor returns an implementation of the promotion.StepRunner
+// interface that encrypts a file using SOPS.
+func newSOPSEncryptor(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &sopsEncryptor{
+		kargoClient:  caps.KargoClient,
+		schemaLoader: getConfigSchemaLoader(stepKindSOPSEncrypt),
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (s *sopsEncryptor) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := s.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return s.run(ctx, stepCtx, cfg)
+}
+
+// convert validates sopsEncryptor configuration against a JSON schema and
+// converts it into a builtin.SOPSEncryptConfig struct.
+func (s *sopsEncryptor) convert(cfg promotion.Config) (builtin.SOPSEncryptConfig, error) {
+	return validateAndConvert[builtin.SOPSEncryptConfig](s.schemaLoader, cfg, stepKindSOPSEncrypt)
+}
+
+func (s *sopsEncryptor) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.SOPSEncryptConfig,
+) (promotion.StepResult, error) {
+	if cfg.ConfigPath != "" && (len(cfg.AgeRecipients) > 0 || len(cfg.PGPFingerprints) > 0) {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusFailed},
+			&promotion.TerminalError{
+				Err: fmt.Errorf(
+					"configPath is mutually exclusive with ageRecipients and pgpFingerprints",
+				),
+			}
+	}
+
+	absInPath, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error joining path %q: %w", cfg.Path, err)
+	}
+	if _, err = os.Stat(absInPath); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error reading file %q: %w", cfg.Path, err)
+	}
+
+	args, dir, err := s.buildArgs(stepCtx.WorkDir, absInPath, cfg)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, err
+	}
+
+	env, err := newSOPSEnv(ctx, s.kargoClient, stepCtx.Project, cfg.KeySecret)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error preparing SOPS keys: %w", err)
+	}
+	defer env.cleanup()
+
+	cmd := env.command("sops", args...)
+	if dir != "" {
+		cmd.Dir = dir
+	}
+	if _, err = libExec.Exec(cmd); err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error encrypting file %q: %w", cfg.Path, err)
+	}
+	return promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+}
+
+// buildArgs returns the arguments with which to invoke sops, along with the
+// directory in which it should be invoked, if that matters. When a SOPS
+// configuration file is used, sops is invoked from the directory containing
+// that file and the file to encrypt is referenced relative to that directory
+// so that the path_regex of its creation rules match as they would for a user
+// running sops from the same directory.
+func (s *sopsEncryptor) buildArgs(
+	workDir string,
+	absInPath string,
+	cfg builtin.SOPSEncryptConfig,
+) ([]string, string, error) {
+	args := append([]string{"--encrypt"}, sopsTypeArgs(cfg.InputType, cfg.OutputType)...)
+
+	var dir string
+	inPath := absInPath
+	if cfg.ConfigPath != "" {
+		absConfigPath, err := securejoin.SecureJoin(workDir, cfg.ConfigPath)
+		if err != nil {
+			return nil, "", fmt.Errorf("error joining path %q: %w", cfg.ConfigPath, err)
+		}
+		dir = filepath.Dir(absConfigPath)
+		if inPath, err = filepath.Rel(dir, absInPath); err != nil {
+			return nil, "", fmt.Errorf(
+				"error determining path of %q relative to %q: %w",
+				cfg.Path, cfg.ConfigPath, err,
+			)
+		}
+		args = append(args, "--config", absConfigPath)
+	} else {
+		if len(cfg.AgeRecipients) > 0 {
+			args = append(args, "--age", strings.Join(cfg.AgeRecipients, ","))
+		}
+		if len(cfg.PGPFingerprints) > 0 {
+			args = append(args, "--pgp", strings.Join(cfg.PGPFingerprints, ","))
+		}
+		if cfg.EncryptedRegex != "" {
+			args = append(args, "--encrypted-regex", cfg.EncryptedRegex)
+		}
+	}
+
+	if cfg.OutPath == "" {
+		args = append(args, "--in-place")
+	} else {
+		absOutPath, err := securejoin.SecureJoin(workDir, cfg.OutPath)
+		if err != nil {
+			return nil, "", fmt.Errorf("error joining path %q: %w", cfg.OutPath, err)
+		}
+		if err = os.MkdirAll(filepath.Dir(absOutPath), 0o700); err != nil {
+			return nil, "", fmt.Errorf("error creating directory for %q: %w", cfg.OutPath, err)
+		}
+		args = append(args, "--output", absOutPath)
+	}
+	return append(args, inPath), dir, nil
+}
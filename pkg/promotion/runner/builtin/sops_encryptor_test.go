@@ -0,0 +1,186 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_sopsEncryptor_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name: "path not specified",
+			config: promotion.Config{
+				"ageRecipients": []string{"age1fake"},
+			},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name: "no recipients or config specified",
+			config: promotion.Config{
+				"path": "secrets.yaml",
+			},
+			expectedProblems: []string{
+				"(root): Must validate at least one schema (anyOf)",
+			},
+		},
+		{
+			name: "ageRecipients contains empty string",
+			config: promotion.Config{
+				"path":          "secrets.yaml",
+				"ageRecipients": []string{""},
+			},
+			expectedProblems: []string{
+				"ageRecipients.0: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "valid with recipients",
+			config: promotion.Config{
+				"path":            "secrets.yaml",
+				"outPath":         "secrets.enc.yaml",
+				"ageRecipients":   []string{"age1fake"},
+				"pgpFingerprints": []string{"FBC7B9E2A4F9289AC0C1D4843D16CEE4A27381B4"},
+				"keySecret":       "sops-keys",
+				"encryptedRegex":  "^(data|stringData)$",
+				"inputType":       "yaml",
+				"outputType":      "yaml",
+			},
+		},
+		{
+			name: "valid with config",
+			config: promotion.Config{
+				"path":       "secrets.yaml",
+				"configPath": ".sops.yaml",
+			},
+		},
+	}
+
+	r := newSOPSEncryptor(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*sopsEncryptor)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_sopsEncryptor_run(t *testing.T) {
+	tests := []struct {
+		name       string
+		files      map[string]string
+		cfg        builtin.SOPSEncryptConfig
+		assertions func(*testing.T, string, string, promotion.StepResult, error)
+	}{
+		{
+			name: "configPath combined with recipients",
+			cfg: builtin.SOPSEncryptConfig{
+				Path:          "secrets.yaml",
+				ConfigPath:    ".sops.yaml",
+				AgeRecipients: []string{"age1fake"},
+			},
+			assertions: func(t *testing.T, _, _ string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "mutually exclusive")
+				require.True(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+		{
+			name: "file does not exist",
+			cfg: builtin.SOPSEncryptConfig{
+				Path:          "missing.yaml",
+				AgeRecipients: []string{"age1fake"},
+			},
+			assertions: func(t *testing.T, _, _ string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error reading file")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name:  "encrypts in place for recipients",
+			files: map[string]string{"secrets.yaml": "plaintext"},
+			cfg: builtin.SOPSEncryptConfig{
+				Path:            "secrets.yaml",
+				AgeRecipients:   []string{"age1foo", "age1bar"},
+				PGPFingerprints: []string{"FBC7B9E2A4F9289AC0C1D4843D16CEE4A27381B4"},
+				EncryptedRegex:  "^data$",
+			},
+			assertions: func(t *testing.T, workDir, recordDir string, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				require.Equal(
+					t,
+					[]string{
+						"--encrypt",
+						"--age", "age1foo,age1bar",
+						"--pgp", "FBC7B9E2A4F9289AC0C1D4843D16CEE4A27381B4",
+						"--encrypted-regex", "^data$",
+						"--in-place",
+						filepath.Join(workDir, "secrets.yaml"),
+					},
+					readFakeSOPSRecord(t, recordDir, "args"),
+				)
+			},
+		},
+		{
+			name: "encrypts using config",
+			files: map[string]string{
+				"env/.sops.yaml":        "creation_rules: []",
+				"env/prod/secrets.yaml": "plaintext",
+			},
+			cfg: builtin.SOPSEncryptConfig{
+				Path:       "env/prod/secrets.yaml",
+				OutPath:    "env/prod/secrets.enc.yaml",
+				ConfigPath: "env/.sops.yaml",
+			},
+			assertions: func(t *testing.T, workDir, recordDir string, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				require.Equal(
+					t,
+					[]string{
+						"--encrypt",
+						"--config", filepath.Join(workDir, "env", ".sops.yaml"),
+						"--output", filepath.Join(workDir, "env", "prod", "secrets.enc.yaml"),
+						filepath.Join("prod", "secrets.yaml"),
+					},
+					readFakeSOPSRecord(t, recordDir, "args"),
+				)
+				pwd := readFakeSOPSRecord(t, recordDir, "pwd")
+				expected, err := filepath.EvalSymlinks(filepath.Join(workDir, "env"))
+				require.NoError(t, err)
+				actual, err := filepath.EvalSymlinks(pwd[0])
+				require.NoError(t, err)
+				require.Equal(t, expected, actual)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recordDir := installFakeSOPS(t)
+			workDir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(workDir, name)
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+				require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+			}
+			runner := &sopsEncryptor{}
+			res, err := runner.run(
+				context.Background(),
+				&promotion.StepContext{
+					Project: "test-project",
+					WorkDir: workDir,
+				},
+				tt.cfg,
+			)
+			tt.assertions(t, workDir, recordDir, res, err)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// installFakeSOPS places a fake sops binary at the front of the PATH. Each
+// time it is executed, the fake records its arguments, working directory, and
+// environment in files within the returned directory.
+func installFakeSOPS(t *testing.T) string {
+	t.Helper()
+	binDir := t.TempDir()
+	recordDir := t.TempDir()
+	script := fmt.Sprintf(`#!/bin/sh
+printf '%%s\n' "$@" > %[1]s/args
+pwd > %[1]s/pwd
+env > %[1]s/env
+if [ -n "$SOPS_AGE_KEY_FILE" ]; then cp "$SOPS_AGE_KEY_FILE" %[1]s/age-keys; fi
+`, recordDir)
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "sops"), []byte(script), 0o700)) // nolint: gosec
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return recordDir
+}
+
+// readFakeSOPSRecord returns the lines of the specified file recorded by the
+// fake sops binary installed by installFakeSOPS.
+func readFakeSOPSRecord(t *testing.T, recordDir, name string) []string {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join(recordDir, name))
+	require.NoError(t, err)
+	return strings.Split(strings.TrimSuffix(string(b), "\n"), "\n")
+}
+
+func Test_newSOPSEnv(t *testing.T) {
+	const testProject = "test-project"
+
+	tests := []struct {
+		name       string
+		client     client.Client
+		secretName string
+		assertions func(*testing.T, *sopsEnv, error)
+	}{
+		{
+			name: "no Secret specified",
+			assertions: func(t *testing.T, env *sopsEnv, err error) {
+				require.NoError(t, err)
+				require.Empty(t, env.vars)
+				require.DirExists(t, env.homeDir)
+			},
+		},
+		{
+			name:       "no client available",
+			secretName: "sops-keys",
+			assertions: func(t *testing.T, _ *sopsEnv, err error) {
+				require.ErrorContains(t, err, "no Kubernetes client available")
+			},
+		},
+		{
+			name:       "Secret not found",
+			client:     fake.NewClientBuilder().Build(),
+			secretName: "sops-keys",
+			assertions: func(t *testing.T, _ *sopsEnv, err error) {
+				require.ErrorContains(t, err, "error getting Secret")
+			},
+		},
+		{
+			name: "Secret has no key material",
+			client: fake.NewClientBuilder().WithObjects(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "sops-keys",
+						Namespace: testProject,
+					},
+					Data: map[string][]byte{"foo": []byte("bar")},
+				},
+			).Build(),
+			secretName: "sops-keys",
+			assertions: func(t *testing.T, _ *sopsEnv, err error) {
+				require.ErrorContains(t, err, `no "age" or "pgp" key found`)
+			},
+		},
+		{
+			name: "age keys",
+			client: fake.NewClientBuilder().WithObjects(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "sops-keys",
+						Namespace: testProject,
+					},
+					Data: map[string][]byte{"age": []byte("AGE-SECRET-KEY-1FAKE")},
+				},
+			).Build(),
+			secretName: "sops-keys",
+			assertions: func(t *testing.T, env *sopsEnv, err error) {
+				require.NoError(t, err)
+				keyPath := filepath.Join(env.homeDir, "age-keys.txt")
+				require.Equal(t, []string{"SOPS_AGE_KEY_FILE=" + keyPath}, env.vars)
+				b, err := os.ReadFile(keyPath)
+				require.NoError(t, err)
+				require.Equal(t, "AGE-SECRET-KEY-1FAKE", string(b))
+
+				cmd := env.command("sops", "--version")
+				require.Equal(t, env.homeDir, cmd.Dir)
+				require.Contains(t, cmd.Env, "HOME="+env.homeDir)
+				require.Contains(t, cmd.Env, "SOPS_AGE_KEY_FILE="+keyPath)
+
+				env.cleanup()
+				require.NoDirExists(t, env.homeDir)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, err := newSOPSEnv(context.Background(), tt.client, testProject, tt.secretName)
+			if env != nil {
+				t.Cleanup(env.cleanup)
+			}
+			tt.assertions(t, env, err)
+		})
+	}
+}
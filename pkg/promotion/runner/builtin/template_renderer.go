@@ -0,0 +1,409 @@
+package builtin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	securejoin "github.com/cyphar/filepath-securejoin"
+	"github.com/xeipuuv/gojsonschema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/controller/freight"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+const (
+	stepKindTemplateRender = "template-render"
+
+	// templateFileExt is the extension that is stripped from the names of
+	// template files when rendering a directory of templates.
+	templateFileExt = ".tmpl"
+	// templatePartialPrefix is the prefix of the names of template files that
+	// are not rendered themselves when rendering a directory of templates, but
+	// may define templates for use by others.
+	templatePartialPrefix = "_"
+)
+
+func init() {
+	promotion.DefaultStepRunnerRegistry.MustRegister(
+		promotion.StepRunnerRegistration{
+			Name: stepKindTemplateRender,
+			Metadata: promotion.StepRunnerMetadata{
+				RequiredCapabilities: []promotion.StepRunnerCapability{
+					promotion.StepCapabilityAccessControlPlane,
+				},
+			},
+			Value: newTemplateRenderer,
+		},
+	)
+}
+
+// templateRenderer is an implementation of the promotion.StepRunner interface
+// that renders Go templates to files.
+type templateRenderer struct {
+	kargoClient  client.Client
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newTemplateRenderer returns an implementation of the promotion.StepRunner
+// interface that renders Go templates to files.
+func newTemplateRenderer(caps promotion.StepRunnerCapabilities) promotion.StepRunner {
+	return &templateRenderer{
+		kargoClient:  caps.KargoClient,
+		schemaLoader: getConfigSchemaLoader(stepKindTemplateRender),
+	}
+}
+
+// Run implements the promotion.StepRunner interface.
+func (t *templateRenderer) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	cfg, err := t.convert(stepCtx.Config)
+	if err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return t.run(ctx, stepCtx, cfg)
+}
+
+// convert validates templateRenderer configuration against a JSON schema and
+// converts it into a builtin.TemplateRenderConfig struct.
+func (t *templateRenderer) convert(cfg promotion.Config) (builtin.TemplateRenderConfig, error) {
+	return validateAndConvert[builtin.TemplateRenderConfig](t.schemaLoader, cfg, stepKindTemplateRender)
+}
+
+func (t *templateRenderer) run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+	cfg builtin.TemplateRenderConfig,
+) (promotion.StepResult, error) {
+	absPath, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.Path)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error joining path %q: %w", cfg.Path, err)
+	}
+	absOutPath, err := securejoin.SecureJoin(stepCtx.WorkDir, cfg.OutPath)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error joining path %q: %w", cfg.OutPath, err)
+	}
+
+	fi, err := os.Stat(absPath)
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error getting info for path %q: %w", cfg.Path, err)
+	}
+
+	tmpl := template.New(stepKindTemplateRender).Funcs(t.funcMap(ctx, stepCtx))
+	if cfg.Strict {
+		tmpl = tmpl.Option("missingkey=error")
+	}
+	r := &templateRendering{
+		tmpl:   tmpl,
+		data:   t.buildData(stepCtx),
+		strict: cfg.Strict,
+	}
+
+	if fi.IsDir() {
+		err = r.renderDir(absPath, absOutPath)
+	} else {
+		err = r.renderFile(absPath, absOutPath)
+	}
+	if err != nil {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error rendering templates from %q: %w", cfg.Path, err)
+	}
+	return promotion.StepResult{Status: kargoapi.PromotionStepStatusSucceeded}, nil
+}
+
+// templateRendering holds the state of a single execution of the
+// template-render step.
+type templateRendering struct {
+	tmpl   *template.Template
+	data   map[string]any
+	strict bool
+}
+
+// renderFile renders the single template file at the specified path to the
+// specified output path.
+func (r *templateRendering) renderFile(path string, outPath string) error {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("error getting info for file %q: %w", path, err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading template file %q: %w", path, err)
+	}
+	name := filepath.Base(path)
+	if _, err = r.tmpl.New(name).Parse(string(content)); err != nil {
+		return fmt.Errorf("error parsing template %q: %w", name, err)
+	}
+	return r.execute(name, outPath, fi.Mode().Perm())
+}
+
+// renderDir renders all template files in the specified directory (and its
+// subdirectories) to the specified output directory, preserving the directory
+// structure. All templates share a single namespace, so templates defined in
+// one file may be used by others. Files with names beginning with an
+// underscore are treated as partials; they are parsed, but not rendered
+// themselves. The .tmpl extension, if present, is stripped from the names of
+// rendered files.
+func (r *templateRendering) renderDir(dir string, outDir string) error {
+	type file struct {
+		name string
+		mode fs.FileMode
+	}
+	var files []file
+	if err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		// Symlinks are deliberately not followed, as they may point outside of
+		// the work directory.
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("error reading template file %q: %w", name, err)
+		}
+		if _, err = r.tmpl.New(name).Parse(string(content)); err != nil {
+			return fmt.Errorf("error parsing template %q: %w", name, err)
+		}
+		if !strings.HasPrefix(d.Name(), templatePartialPrefix) {
+			fi, err := d.Info()
+			if err != nil {
+				return fmt.Errorf("error getting info for file %q: %w", name, err)
+			}
+			files = append(files, file{name: name, mode: fi.Mode().Perm()})
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		outPath := filepath.Join(outDir, strings.TrimSuffix(filepath.FromSlash(f.name), templateFileExt))
+		if err := r.execute(f.name, outPath, f.mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// execute renders the named template to the specified output path.
+func (r *templateRendering) execute(name string, outPath string, mode fs.FileMode) error {
+	buf := &bytes.Buffer{}
+	if err := r.tmpl.ExecuteTemplate(buf, name, r.data); err != nil {
+		return fmt.Errorf("error executing template %q: %w", name, err)
+	}
+	out := buf.Bytes()
+	if !r.strict {
+		// References to missing map keys render as "<no value>" by default.
+		out = bytes.ReplaceAll(out, []byte("<no value>"), nil)
+	}
+	if err := os.MkdirAll(filepath.Dir(outPath), 0o700); err != nil {
+		return fmt.Errorf("error creating directory for %q: %w", name, err)
+	}
+	if err := os.WriteFile(outPath, out, mode); err != nil {
+		return fmt.Errorf("error writing rendered template %q: %w", name, err)
+	}
+	return nil
+}
+
+// buildData returns the data templates are rendered with. It mirrors the
+// environment available to expressions in step configuration.
+func (t *templateRenderer) buildData(stepCtx *promotion.StepContext) map[string]any {
+	vars := stepCtx.Vars
+	if vars == nil {
+		vars = map[string]any{}
+	}
+	outputs := stepCtx.SharedState
+	if outputs == nil {
+		outputs = promotion.State{}
+	}
+	return map[string]any{
+		"ctx": map[string]any{
+			"project":   stepCtx.Project,
+			"promotion": stepCtx.Promotion,
+			"stage":     stepCtx.Stage,
+			"targetFreight": map[string]any{
+				"name": stepCtx.TargetFreightRef.Name,
+				"origin": map[string]any{
+					"name": stepCtx.TargetFreightRef.Origin.Name,
+				},
+			},
+			"meta": map[string]any{
+				"promotion": map[string]any{
+					"actor": stepCtx.PromotionActor,
+				},
+			},
+		},
+		"vars":    vars,
+		"outputs": outputs,
+	}
+}
+
+// funcMap returns the functions available to templates. These include the
+// Sprig function library, minus any functions that would expose information
+// about the environment Kargo is running in, and the same Freight functions
+// that are available to expressions in step configuration.
+func (t *templateRenderer) funcMap(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) template.FuncMap {
+	funcs := sprig.TxtFuncMap()
+	for _, name := range []string{"env", "expandenv", "getHostByName"} {
+		delete(funcs, name)
+	}
+
+	freightRefs := stepCtx.Freight.References()
+	funcs["toYaml"] = func(v any) (string, error) {
+		b, err := yaml.Marshal(v)
+		return strings.TrimSuffix(string(b), "\n"), err
+	}
+	funcs["warehouse"] = func(name string) (kargoapi.FreightOrigin, error) {
+		if name == "" {
+			return kargoapi.FreightOrigin{}, fmt.Errorf("name must not be empty")
+		}
+		return kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: name,
+		}, nil
+	}
+	funcs["commitFrom"] = func(
+		repoURL string,
+		origin ...kargoapi.FreightOrigin,
+	) (*kargoapi.GitCommit, error) {
+		desiredOrigin, err := templateFreightOrigin(origin)
+		if err != nil {
+			return nil, err
+		}
+		return freight.FindCommit(
+			ctx,
+			t.kargoClient,
+			stepCtx.Project,
+			stepCtx.FreightRequests,
+			desiredOrigin,
+			freightRefs,
+			repoURL,
+		)
+	}
+	funcs["imageFrom"] = func(
+		repoURL string,
+		origin ...kargoapi.FreightOrigin,
+	) (*kargoapi.Image, error) {
+		desiredOrigin, err := templateFreightOrigin(origin)
+		if err != nil {
+			return nil, err
+		}
+		return freight.FindImage(
+			ctx,
+			t.kargoClient,
+			stepCtx.Project,
+			stepCtx.FreightRequests,
+			desiredOrigin,
+			freightRefs,
+			repoURL,
+		)
+	}
+	funcs["chartFrom"] = func(repoURL string, args ...any) (*kargoapi.Chart, error) {
+		var chartName string
+		var origins []kargoapi.FreightOrigin
+		for i, arg := range args {
+			switch a := arg.(type) {
+			case string:
+				if i != 0 {
+					return nil, fmt.Errorf("chart name must be the second argument")
+				}
+				chartName = a
+			case kargoapi.FreightOrigin:
+				origins = append(origins, a)
+			default:
+				return nil, fmt.Errorf("argument must be string or FreightOrigin, got %T", arg)
+			}
+		}
+		desiredOrigin, err := templateFreightOrigin(origins)
+		if err != nil {
+			return nil, err
+		}
+		return freight.FindChart(
+			ctx,
+			t.kargoClient,
+			stepCtx.Project,
+			stepCtx.FreightRequests,
+			desiredOrigin,
+			freightRefs,
+			repoURL,
+			chartName,
+		)
+	}
+	funcs["ociArtifactFrom"] = func(
+		repoURL string,
+		origin ...kargoapi.FreightOrigin,
+	) (*kargoapi.OCIArtifact, error) {
+		desiredOrigin, err := templateFreightOrigin(origin)
+		if err != nil {
+			return nil, err
+		}
+		return freight.FindOCIArtifact(
+			ctx,
+			t.kargoClient,
+			stepCtx.Project,
+			stepCtx.FreightRequests,
+			desiredOrigin,
+			freightRefs,
+			repoURL,
+		)
+	}
+	funcs["releaseFrom"] = func(
+		repoURL string,
+		origin ...kargoapi.FreightOrigin,
+	) (*kargoapi.Release, error) {
+		desiredOrigin, err := templateFreightOrigin(origin)
+		if err != nil {
+			return nil, err
+		}
+		return freight.FindRelease(
+			ctx,
+			t.kargoClient,
+			stepCtx.Project,
+			stepCtx.FreightRequests,
+			desiredOrigin,
+			freightRefs,
+			repoURL,
+		)
+	}
+	return funcs
+}
+
+// templateFreightOrigin returns the single, optional, FreightOrigin passed to
+// a template function.
+func templateFreightOrigin(origins []kargoapi.FreightOrigin) (*kargoapi.FreightOrigin, error) {
+	switch len(origins) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &origins[0], nil
+	default:
+		return nil, fmt.Errorf("expected at most one FreightOrigin, got %d", len(origins))
+	}
+}
@@ -0,0 +1,233 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_templateRenderer_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name: "path is empty string",
+			config: promotion.Config{
+				"path": "",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name:   "outPath not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): outPath is required",
+			},
+		},
+		{
+			name: "outPath is empty string",
+			config: promotion.Config{
+				"outPath": "",
+			},
+			expectedProblems: []string{
+				"outPath: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path":    "templates",
+				"outPath": "out",
+				"strict":  true,
+			},
+		},
+	}
+
+	r := newTemplateRenderer(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*templateRenderer)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_templateRenderer_run(t *testing.T) {
+	stepCtx := &promotion.StepContext{
+		Project:        "test-project",
+		Stage:          "test-stage",
+		Promotion:      "test-promotion",
+		PromotionActor: "test-actor",
+		Vars: map[string]any{
+			"appName": "my-app",
+		},
+		SharedState: promotion.State{
+			"build": map[string]any{"number": 42},
+		},
+		TargetFreightRef: kargoapi.FreightReference{
+			Name:   "test-freight",
+			Origin: kargoapi.FreightOrigin{Kind: kargoapi.FreightOriginKindWarehouse, Name: "test-warehouse"},
+		},
+		Freight: kargoapi.FreightCollection{
+			Freight: map[string]kargoapi.FreightReference{
+				"Warehouse/test-warehouse": {
+					Name:   "test-freight",
+					Origin: kargoapi.FreightOrigin{Kind: kargoapi.FreightOriginKindWarehouse, Name: "test-warehouse"},
+					Images: []kargoapi.Image{{
+						RepoURL: "example.com/my-app",
+						Tag:     "v1.2.3",
+						Digest:  "sha256:abc",
+					}},
+					Commits: []kargoapi.GitCommit{{
+						RepoURL: "https://github.com/example/repo.git",
+						ID:      "deadbeef",
+					}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		files      map[string]string
+		cfg        builtin.TemplateRenderConfig
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name: "path does not exist",
+			cfg: builtin.TemplateRenderConfig{
+				Path:    "missing.tmpl",
+				OutPath: "out.txt",
+			},
+			assertions: func(t *testing.T, _ string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error getting info for path")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "invalid template",
+			files: map[string]string{
+				"version.json.tmpl": `{{ .vars.appName `,
+			},
+			cfg: builtin.TemplateRenderConfig{
+				Path:    "version.json.tmpl",
+				OutPath: "version.json",
+			},
+			assertions: func(t *testing.T, _ string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "error parsing template")
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "env function is not available",
+			files: map[string]string{
+				"secret.tmpl": `{{ env "HOME" }}`,
+			},
+			cfg: builtin.TemplateRenderConfig{
+				Path:    "secret.tmpl",
+				OutPath: "secret.txt",
+			},
+			assertions: func(t *testing.T, _ string, _ promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `function "env" not defined`)
+			},
+		},
+		{
+			name: "renders single file",
+			files: map[string]string{
+				"version.json.tmpl": `{"app": "{{ .vars.appName }}", ` +
+					`"stage": "{{ .ctx.stage }}", ` +
+					`"freight": "{{ .ctx.targetFreight.name }}", ` +
+					`"build": {{ .outputs.build.number }}, ` +
+					`"tag": "{{ (imageFrom "example.com/my-app" (warehouse "test-warehouse")).Tag }}", ` +
+					`"commit": "{{ (commitFrom "https://github.com/example/repo.git" (warehouse "test-warehouse")).ID | upper }}", ` +
+					`"missing": "{{ .vars.missing }}"}`,
+			},
+			cfg: builtin.TemplateRenderConfig{
+				Path:    "version.json.tmpl",
+				OutPath: "out/version.json",
+			},
+			assertions: func(t *testing.T, workDir string, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				b, err := os.ReadFile(filepath.Join(workDir, "out", "version.json"))
+				require.NoError(t, err)
+				require.JSONEq(
+					t,
+					`{"app": "my-app", "stage": "test-stage", "freight": "test-freight", `+
+						`"build": 42, "tag": "v1.2.3", "commit": "DEADBEEF", "missing": ""}`,
+					string(b),
+				)
+			},
+		},
+		{
+			name: "strict mode fails on missing key",
+			files: map[string]string{
+				"out.tmpl": `{{ .vars.missing }}`,
+			},
+			cfg: builtin.TemplateRenderConfig{
+				Path:    "out.tmpl",
+				OutPath: "out.txt",
+				Strict:  true,
+			},
+			assertions: func(t *testing.T, _ string, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `map has no entry for key "missing"`)
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "renders directory",
+			files: map[string]string{
+				"templates/_helpers.tpl":          `{{ define "name" }}{{ .vars.appName }}-{{ .ctx.stage }}{{ end }}`,
+				"templates/values.yaml.tmpl":      "name: {{ template \"name\" . }}\nimage:\n  {{- toYaml (dict \"tag\" \"v1\") | nindent 2 }}\n",
+				"templates/nested/NOTES.txt.tmpl": `Promoted by {{ .ctx.meta.promotion.actor }}`,
+				"templates/static.txt":            `static`,
+			},
+			cfg: builtin.TemplateRenderConfig{
+				Path:    "templates",
+				OutPath: "rendered",
+			},
+			assertions: func(t *testing.T, workDir string, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				outDir := filepath.Join(workDir, "rendered")
+				require.NoFileExists(t, filepath.Join(outDir, "_helpers.tpl"))
+				b, err := os.ReadFile(filepath.Join(outDir, "values.yaml"))
+				require.NoError(t, err)
+				require.Equal(t, "name: my-app-test-stage\nimage:\n  tag: v1\n", string(b))
+				b, err = os.ReadFile(filepath.Join(outDir, "nested", "NOTES.txt"))
+				require.NoError(t, err)
+				require.Equal(t, "Promoted by test-actor", string(b))
+				b, err = os.ReadFile(filepath.Join(outDir, "static.txt"))
+				require.NoError(t, err)
+				require.Equal(t, "static", string(b))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(workDir, name)
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+				require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+			}
+			runner := &templateRenderer{}
+			stepCtx := *stepCtx
+			stepCtx.WorkDir = workDir
+			res, err := runner.run(context.Background(), &stepCtx, tt.cfg)
+			tt.assertions(t, workDir, res, err)
+		})
+	}
+}
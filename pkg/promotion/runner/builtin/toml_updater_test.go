@@ -0,0 +1,202 @@
+package builtin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+	"github.com/akuity/kargo/pkg/x/promotion/runner/builtin"
+)
+
+func Test_tomlUpdater_convert(t *testing.T) {
+	tests := []validationTestCase{
+		{
+			name:   "path not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): path is required",
+			},
+		},
+		{
+			name: "path is empty string",
+			config: promotion.Config{
+				"path": "",
+			},
+			expectedProblems: []string{
+				"path: String length must be greater than or equal to 1",
+			},
+		},
+		{
+			name:   "updates not specified",
+			config: promotion.Config{},
+			expectedProblems: []string{
+				"(root): updates is required",
+			},
+		},
+		{
+			name: "updates is empty array",
+			config: promotion.Config{
+				"updates": []promotion.Config{},
+			},
+			expectedProblems: []string{
+				"updates: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "key not specified",
+			config: promotion.Config{
+				"updates": []promotion.Config{{}},
+			},
+			expectedProblems: []string{
+				"updates.0: key is required",
+			},
+		},
+		{
+			name: "value is not a scalar",
+			config: promotion.Config{
+				"updates": []promotion.Config{{
+					"key":   "package.version",
+					"value": []string{"foo"},
+				}},
+			},
+			expectedProblems: []string{
+				"updates.0.value: Invalid type",
+			},
+		},
+		{
+			name: "valid kitchen sink",
+			config: promotion.Config{
+				"path": "Cargo.toml",
+				"updates": []promotion.Config{
+					{
+						"key":   "package.version",
+						"value": "foo",
+					},
+					{
+						"key":   "package.version",
+						"value": 42,
+					},
+					{
+						"key":   "package.version",
+						"value": true,
+					},
+				},
+			},
+		},
+	}
+
+	r := newTOMLUpdater(promotion.StepRunnerCapabilities{})
+	runner, ok := r.(*tomlUpdater)
+	require.True(t, ok)
+
+	runValidationTests(t, runner.convert, tests)
+}
+
+func Test_tomlUpdater_run(t *testing.T) {
+	tests := []struct {
+		name       string
+		files      map[string]string
+		cfg        builtin.TOMLUpdateConfig
+		assertions func(*testing.T, string, promotion.StepResult, error)
+	}{
+		{
+			name: "successful run",
+			files: map[string]string{
+				"Cargo.toml": `# Package metadata
+[package]
+name = "my-app" # The name
+version = "1.0.0"
+
+[image]
+tag = '1.0.0'
+replicas = 2
+enabled = true
+`,
+			},
+			cfg: builtin.TOMLUpdateConfig{
+				Path: "Cargo.toml",
+				Updates: []builtin.TOMLUpdate{
+					{Key: "package.version", Value: "1.1.0"},
+					{Key: "image.tag", Value: "1.1.0"},
+					{Key: "image.replicas", Value: float64(3)},
+				},
+			},
+			assertions: func(t *testing.T, workDir string, result promotion.StepResult, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, promotion.StepResult{
+					Status: kargoapi.PromotionStepStatusSucceeded,
+					Output: map[string]any{
+						"commitMessage": "Updated Cargo.toml\n\n- package.version: \"1.1.0\"\n- image.tag: \"1.1.0\"\n- image.replicas: 3",
+					},
+				}, result)
+				content, err := os.ReadFile(filepath.Join(workDir, "Cargo.toml"))
+				require.NoError(t, err)
+				assert.Equal(t, `# Package metadata
+[package]
+name = "my-app" # The name
+version = "1.1.0"
+
+[image]
+tag = '1.1.0'
+replicas = 3
+enabled = true
+`, string(content))
+			},
+		},
+		{
+			name:  "file does not exist",
+			files: map[string]string{},
+			cfg: builtin.TOMLUpdateConfig{
+				Path: "Cargo.toml",
+				Updates: []builtin.TOMLUpdate{
+					{Key: "package.version", Value: "foo"},
+				},
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "no such file or directory")
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+			},
+		},
+		{
+			name: "key does not exist",
+			files: map[string]string{
+				"Cargo.toml": "[package]\nname = \"my-app\"\n",
+			},
+			cfg: builtin.TOMLUpdateConfig{
+				Path: "Cargo.toml",
+				Updates: []builtin.TOMLUpdate{
+					{Key: "package.version", Value: "1.1.0"},
+				},
+			},
+			assertions: func(t *testing.T, _ string, result promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `key "package.version" not found`)
+				assert.Equal(t, promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored}, result)
+			},
+		},
+	}
+
+	runner := &tomlUpdater{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			for p, c := range tt.files {
+				require.NoError(t, os.MkdirAll(filepath.Join(workDir, filepath.Dir(p)), 0o700))
+				require.NoError(t, os.WriteFile(filepath.Join(workDir, p), []byte(c), 0o600))
+			}
+
+			stepCtx := &promotion.StepContext{
+				Project: "test-project",
+				WorkDir: workDir,
+			}
+			result, err := runner.run(context.Background(), stepCtx, tt.cfg)
+			tt.assertions(t, workDir, result, err)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// defaultRequestTimeout is the maximum duration of a single request to a
+// plugin if no timeout is configured for it.
+const defaultRequestTimeout = 5 * time.Minute
+
+// Config describes a set of step plugins.
+type Config struct {
+	// Plugins is a list of step plugins.
+	Plugins []StepPluginConfig `json:"plugins,omitempty"`
+}
+
+// StepPluginConfig describes a single step plugin; i.e. a StepRunner that is
+// implemented by an external process or by a server (typically running as a
+// sidecar of the controller, unless promotion workers are enabled) speaking
+// the step plugin protocol.
+type StepPluginConfig struct {
+	// Name is the step kind implemented by the plugin. Steps of a promotion
+	// process reference it by means of their "uses" field.
+	Name string `json:"name"`
+	// Endpoint is the URL of a server implementing the step plugin protocol.
+	// Requests are sent to it as HTTP POST requests. Mutually exclusive with
+	// Command.
+	Endpoint string `json:"endpoint,omitempty"`
+	// Command is the command (and its arguments) of an executable implementing
+	// the step plugin protocol. A request is written to the standard input of
+	// a new process, which is expected to write a response to its standard
+	// output. Mutually exclusive with Endpoint.
+	Command []string `json:"command,omitempty"`
+	// ConfigSchema is an optional JSON schema the configuration of steps of
+	// this kind is validated against before a request is sent to the plugin.
+	ConfigSchema json.RawMessage `json:"configSchema,omitempty"`
+	// RequestTimeout is the maximum duration of a single request to the
+	// plugin. Defaults to 5 minutes.
+	RequestTimeout *metav1.Duration `json:"requestTimeout,omitempty"`
+	// DefaultTimeout is the default soft maximum interval in which a step of
+	// this kind that reports a Running status may be retried. It can be
+	// overridden by step-level retry configuration. A value of 0 means no
+	// timeout.
+	DefaultTimeout *metav1.Duration `json:"defaultTimeout,omitempty"`
+	// DefaultErrorThreshold is the default number of consecutive times a step
+	// of this kind must fail before retries are abandoned. It can be
+	// overridden by step-level retry configuration. Defaults to 1.
+	DefaultErrorThreshold uint32 `json:"defaultErrorThreshold,omitempty"`
+}
+
+// GetRequestTimeout returns the maximum duration of a single request to the
+// plugin.
+func (s StepPluginConfig) GetRequestTimeout() time.Duration {
+	if s.RequestTimeout == nil || s.RequestTimeout.Duration <= 0 {
+		return defaultRequestTimeout
+	}
+	return s.RequestTimeout.Duration
+}
+
+// Validate returns an error if the StepPluginConfig is invalid.
+func (s StepPluginConfig) Validate() error {
+	if s.Name == "" {
+		return errors.New("name is required")
+	}
+	switch {
+	case s.Endpoint == "" && len(s.Command) == 0:
+		return fmt.Errorf("plugin %q: one of endpoint or command is required", s.Name)
+	case s.Endpoint != "" && len(s.Command) > 0:
+		return fmt.Errorf("plugin %q: endpoint and command are mutually exclusive", s.Name)
+	case s.Endpoint != "":
+		u, err := url.Parse(s.Endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("plugin %q: endpoint must be an absolute HTTP(S) URL", s.Name)
+		}
+	}
+	if len(s.ConfigSchema) > 0 && !json.Valid(s.ConfigSchema) {
+		return fmt.Errorf("plugin %q: configSchema is not valid JSON", s.Name)
+	}
+	return nil
+}
+
+// IsLoopback returns true if the plugin is a server reachable at a loopback
+// endpoint, as is the case for a sidecar of the controller. Such a server is
+// only reachable from within the same Pod.
+func (s StepPluginConfig) IsLoopback() bool {
+	if s.Endpoint == "" {
+		return false
+	}
+	u, err := url.Parse(s.Endpoint)
+	if err != nil {
+		return false
+	}
+	host := u.Hostname()
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// ValidateForWorkers returns an error if any of the plugins is unreachable
+// from promotion worker Pods because its endpoint is a loopback endpoint.
+func (c Config) ValidateForWorkers() error {
+	for _, p := range c.Plugins {
+		if p.IsLoopback() {
+			return fmt.Errorf(
+				"plugin %q: loopback endpoint %q is not reachable from promotion worker Pods",
+				p.Name, p.Endpoint,
+			)
+		}
+	}
+	return nil
+}
+
+// LoadConfig reads a Config from the YAML or JSON file at the provided path
+// and validates it.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("error reading step plugin config %q: %w", path, err)
+	}
+	cfg := Config{}
+	if err = yaml.UnmarshalStrict(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("error parsing step plugin config %q: %w", path, err)
+	}
+	names := make(map[string]struct{}, len(cfg.Plugins))
+	for _, p := range cfg.Plugins {
+		if err = p.Validate(); err != nil {
+			return Config{}, fmt.Errorf("invalid step plugin config %q: %w", path, err)
+		}
+		if _, ok := names[p.Name]; ok {
+			return Config{}, fmt.Errorf(
+				"invalid step plugin config %q: plugin %q is defined more than once",
+				path, p.Name,
+			)
+		}
+		names[p.Name] = struct{}{}
+	}
+	return cfg, nil
+}
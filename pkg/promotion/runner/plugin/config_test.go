@@ -0,0 +1,159 @@
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	testCases := []struct {
+		name       string
+		config     string
+		assertions func(*testing.T, Config, error)
+	}{
+		{
+			name:   "invalid YAML",
+			config: "plugins: {",
+			assertions: func(t *testing.T, _ Config, err error) {
+				require.ErrorContains(t, err, "error parsing step plugin config")
+			},
+		},
+		{
+			name: "unknown field",
+			config: `plugins:
+- name: foo
+  endpoint: http://localhost:8080
+  bogus: true
+`,
+			assertions: func(t *testing.T, _ Config, err error) {
+				require.ErrorContains(t, err, "error parsing step plugin config")
+			},
+		},
+		{
+			name: "missing endpoint and command",
+			config: `plugins:
+- name: foo
+`,
+			assertions: func(t *testing.T, _ Config, err error) {
+				require.ErrorContains(t, err, "one of endpoint or command is required")
+			},
+		},
+		{
+			name: "endpoint and command",
+			config: `plugins:
+- name: foo
+  endpoint: http://localhost:8080
+  command: ["foo"]
+`,
+			assertions: func(t *testing.T, _ Config, err error) {
+				require.ErrorContains(t, err, "mutually exclusive")
+			},
+		},
+		{
+			name: "relative endpoint",
+			config: `plugins:
+- name: foo
+  endpoint: /run
+`,
+			assertions: func(t *testing.T, _ Config, err error) {
+				require.ErrorContains(t, err, "absolute HTTP(S) URL")
+			},
+		},
+		{
+			name: "duplicate plugin",
+			config: `plugins:
+- name: foo
+  endpoint: http://localhost:8080
+- name: foo
+  command: ["foo"]
+`,
+			assertions: func(t *testing.T, _ Config, err error) {
+				require.ErrorContains(t, err, "defined more than once")
+			},
+		},
+		{
+			name: "success",
+			config: `plugins:
+- name: update-cmdb
+  endpoint: http://localhost:8080/run
+  requestTimeout: 30s
+  defaultTimeout: 10m
+  defaultErrorThreshold: 3
+  configSchema:
+    type: object
+    required: ["ci"]
+- name: notify
+  command: ["/usr/local/bin/notify", "--json"]
+`,
+			assertions: func(t *testing.T, cfg Config, err error) {
+				require.NoError(t, err)
+				require.Len(t, cfg.Plugins, 2)
+
+				cmdb := cfg.Plugins[0]
+				require.Equal(t, "update-cmdb", cmdb.Name)
+				require.Equal(t, 30*time.Second, cmdb.GetRequestTimeout())
+				require.Equal(t, 10*time.Minute, cmdb.DefaultTimeout.Duration)
+				require.Equal(t, uint32(3), cmdb.DefaultErrorThreshold)
+				require.JSONEq(t, `{"type":"object","required":["ci"]}`, string(cmdb.ConfigSchema))
+
+				notify := cfg.Plugins[1]
+				require.Equal(t, []string{"/usr/local/bin/notify", "--json"}, notify.Command)
+				require.Equal(t, defaultRequestTimeout, notify.GetRequestTimeout())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "plugins.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(testCase.config), 0600))
+			cfg, err := LoadConfig(path)
+			testCase.assertions(t, cfg, err)
+		})
+	}
+}
+
+func TestConfig_ValidateForWorkers(t *testing.T) {
+	testCases := []struct {
+		name        string
+		plugin      StepPluginConfig
+		expectedErr bool
+	}{
+		{
+			name:   "command",
+			plugin: StepPluginConfig{Name: "foo", Command: []string{"foo"}},
+		},
+		{
+			name:   "in-cluster endpoint",
+			plugin: StepPluginConfig{Name: "foo", Endpoint: "http://cmdb.plugins.svc:8080/run"},
+		},
+		{
+			name:        "localhost endpoint",
+			plugin:      StepPluginConfig{Name: "foo", Endpoint: "http://LOCALHOST:8080/run"},
+			expectedErr: true,
+		},
+		{
+			name:        "IPv4 loopback endpoint",
+			plugin:      StepPluginConfig{Name: "foo", Endpoint: "http://127.0.0.1:8080/run"},
+			expectedErr: true,
+		},
+		{
+			name:        "IPv6 loopback endpoint",
+			plugin:      StepPluginConfig{Name: "foo", Endpoint: "https://[::1]:8443/run"},
+			expectedErr: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := Config{Plugins: []StepPluginConfig{testCase.plugin}}.ValidateForWorkers()
+			if testCase.expectedErr {
+				require.ErrorContains(t, err, "not reachable from promotion worker Pods")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package plugin
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// ProtocolVersion is the version of the step plugin protocol implemented by
+// this package. It is sent with every request and plugins must echo it in
+// their responses.
+const ProtocolVersion = "step-plugin.kargo.akuity.io/v1"
+
+// RunRequest is a request to a plugin to execute a single step of a
+// promotion process.
+type RunRequest struct {
+	// APIVersion is the version of the step plugin protocol.
+	APIVersion string `json:"apiVersion"`
+	// Step identifies the step to be executed.
+	Step StepInfo `json:"step"`
+	// Config is the configuration of the step, with all expressions already
+	// evaluated.
+	Config map[string]any `json:"config,omitempty"`
+	// Context is the context in which the step is executed.
+	Context RunContext `json:"context"`
+}
+
+// StepInfo identifies a step of a promotion process.
+type StepInfo struct {
+	// Kind is the kind of the step; i.e. the name of the plugin.
+	Kind string `json:"kind"`
+	// Alias is the alias of the step.
+	Alias string `json:"alias"`
+}
+
+// RunContext is the context in which a step is executed.
+type RunContext struct {
+	// UIBaseURL may be used to construct deeper URLs for interacting with the
+	// Kargo UI.
+	UIBaseURL string `json:"uiBaseURL,omitempty"`
+	// WorkDir is the working directory of the Promotion. It is only usable by
+	// plugins that share a filesystem with the controller.
+	WorkDir string `json:"workDir,omitempty"`
+	// Project is the Project that the Promotion is associated with.
+	Project string `json:"project"`
+	// Stage is the Stage that the Promotion is targeting.
+	Stage string `json:"stage"`
+	// Promotion is the name of the Promotion.
+	Promotion string `json:"promotion"`
+	// PromotionActor is the name of the actor triggering the Promotion.
+	PromotionActor string `json:"promotionActor,omitempty"`
+	// Vars are the evaluated variables available to the step.
+	Vars map[string]any `json:"vars,omitempty"`
+	// SharedState is the state shared between steps, which contains the
+	// outputs of previous steps keyed by their aliases.
+	SharedState map[string]any `json:"sharedState,omitempty"`
+	// FreightRequests is the list of Freight from various origins that is
+	// requested by the Stage targeted by the Promotion.
+	FreightRequests []kargoapi.FreightRequest `json:"freightRequests,omitempty"`
+	// Freight is the collection of all Freight referenced by the Promotion.
+	Freight kargoapi.FreightCollection `json:"freight"`
+	// TargetFreightRef is the Freight that triggered the Promotion.
+	TargetFreightRef kargoapi.FreightReference `json:"targetFreightRef"`
+}
+
+// RunResponse is a plugin's response to a RunRequest.
+type RunResponse struct {
+	// APIVersion is the version of the step plugin protocol. It must match the
+	// version of the request.
+	APIVersion string `json:"apiVersion"`
+	// Status is the outcome of the step. Plugins reporting a Running status
+	// are called again later, subject to the same timeout as builtin steps.
+	Status kargoapi.PromotionStepStatus `json:"status"`
+	// Message is an optional message that provides additional context about
+	// the outcome of the step.
+	Message string `json:"message,omitempty"`
+	// Output is the output of the step, which is made available to subsequent
+	// steps.
+	Output map[string]any `json:"output,omitempty"`
+	// RetryAfter is an optional, suggested duration after which a step
+	// reporting a Running status should be called again.
+	RetryAfter *metav1.Duration `json:"retryAfter,omitempty"`
+	// Terminal indicates that an Errored or Failed step must not be retried,
+	// regardless of the error threshold of the step.
+	Terminal bool `json:"terminal,omitempty"`
+}
@@ -0,0 +1,237 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/xeipuuv/gojsonschema"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// Register registers a StepRunner for each of the plugins in the provided
+// Config with the provided registry. Plugins may not replace StepRunners that
+// are already registered.
+func Register(registry promotion.StepRunnerRegistry, cfg Config) error {
+	for _, p := range cfg.Plugins {
+		if _, err := registry.Get(p.Name); err == nil {
+			return fmt.Errorf(
+				"plugin %q conflicts with a step runner of the same name", p.Name,
+			)
+		}
+		runner, err := newStepRunner(p)
+		if err != nil {
+			return err
+		}
+		if err = registry.Register(promotion.StepRunnerRegistration{
+			Name:     p.Name,
+			Metadata: runner.metadata(),
+			Value: func(promotion.StepRunnerCapabilities) promotion.StepRunner {
+				return runner
+			},
+		}); err != nil {
+			return fmt.Errorf("error registering plugin %q: %w", p.Name, err)
+		}
+	}
+	return nil
+}
+
+// RegisterSimulators registers a simulator for each of the plugins in the
+// provided Config with the provided registry, which is expected to be
+// promotion.DryRunStepRunnerRegistry. Nothing is known about the side effects
+// of a plugin, so a simulator never calls the plugin. It only validates the
+// step's configuration against the plugin's configuration schema.
+func RegisterSimulators(registry promotion.StepRunnerRegistry, cfg Config) error {
+	for _, p := range cfg.Plugins {
+		runner, err := newStepRunner(p)
+		if err != nil {
+			return err
+		}
+		sim := &simulator{runner: runner}
+		if err = registry.Register(promotion.StepRunnerRegistration{
+			Name:     p.Name,
+			Metadata: runner.metadata(),
+			Value: func(promotion.StepRunnerCapabilities) promotion.StepRunner {
+				return sim
+			},
+		}); err != nil {
+			return fmt.Errorf("error registering simulator for plugin %q: %w", p.Name, err)
+		}
+	}
+	return nil
+}
+
+// newStepRunner validates the provided plugin configuration and returns a
+// stepRunner for the plugin.
+func newStepRunner(p StepPluginConfig) (*stepRunner, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	var schemaLoader gojsonschema.JSONLoader
+	if len(p.ConfigSchema) > 0 {
+		schemaLoader = gojsonschema.NewBytesLoader(p.ConfigSchema)
+		if _, err := gojsonschema.NewSchema(schemaLoader); err != nil {
+			return nil, fmt.Errorf("plugin %q: invalid configSchema: %w", p.Name, err)
+		}
+	}
+	return &stepRunner{
+		cfg:          p,
+		schemaLoader: schemaLoader,
+		transport:    newTransport(p),
+	}, nil
+}
+
+// transport sends a RunRequest to a plugin and returns its RunResponse.
+type transport interface {
+	roundTrip(context.Context, *RunRequest) (*RunResponse, error)
+}
+
+// stepRunner is an implementation of the promotion.StepRunner interface that
+// delegates the execution of a step to a plugin.
+type stepRunner struct {
+	cfg          StepPluginConfig
+	schemaLoader gojsonschema.JSONLoader
+	transport    transport
+}
+
+// Run implements the promotion.StepRunner interface.
+func (s *stepRunner) Run(
+	ctx context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	if err := s.validate(stepCtx.Config); err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.GetRequestTimeout())
+	defer cancel()
+
+	res, err := s.transport.roundTrip(ctx, s.newRequest(stepCtx))
+	if err != nil {
+		// The plugin may be temporarily unavailable, so this is subject to the
+		// step's error threshold.
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			fmt.Errorf("error calling plugin %q: %w", s.cfg.Name, err)
+	}
+	if res.APIVersion != ProtocolVersion {
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			&promotion.TerminalError{Err: fmt.Errorf(
+				"plugin %q responded with unsupported protocol version %q; expected %q",
+				s.cfg.Name, res.APIVersion, ProtocolVersion,
+			)}
+	}
+
+	result := promotion.StepResult{
+		Status:  res.Status,
+		Message: res.Message,
+		Output:  res.Output,
+	}
+	if res.RetryAfter != nil {
+		result.RetryAfter = &res.RetryAfter.Duration
+	}
+
+	switch res.Status {
+	case kargoapi.PromotionStepStatusSucceeded,
+		kargoapi.PromotionStepStatusSkipped,
+		kargoapi.PromotionStepStatusRunning:
+		return result, nil
+	case kargoapi.PromotionStepStatusErrored, kargoapi.PromotionStepStatusFailed:
+		msg := res.Message
+		if msg == "" {
+			msg = "no details provided"
+		}
+		err = fmt.Errorf("plugin %q reported status %s: %s", s.cfg.Name, res.Status, msg)
+		if res.Terminal {
+			err = &promotion.TerminalError{Err: err}
+		}
+		return result, err
+	default:
+		return promotion.StepResult{Status: kargoapi.PromotionStepStatusErrored},
+			&promotion.TerminalError{Err: fmt.Errorf(
+				"plugin %q reported unknown status %q", s.cfg.Name, res.Status,
+			)}
+	}
+}
+
+// metadata returns the promotion.StepRunnerMetadata for the plugin.
+func (s *stepRunner) metadata() promotion.StepRunnerMetadata {
+	metadata := promotion.StepRunnerMetadata{
+		DefaultErrorThreshold: s.cfg.DefaultErrorThreshold,
+	}
+	if s.cfg.DefaultTimeout != nil {
+		metadata.DefaultTimeout = s.cfg.DefaultTimeout.Duration
+	}
+	return metadata
+}
+
+// validate validates the provided step configuration against the plugin's
+// configuration schema, if it has one.
+func (s *stepRunner) validate(cfg promotion.Config) error {
+	if s.schemaLoader == nil {
+		return nil
+	}
+	result, err := gojsonschema.Validate(s.schemaLoader, gojsonschema.NewGoLoader(cfg))
+	if err != nil {
+		return fmt.Errorf("could not validate %s config: %w", s.cfg.Name, err)
+	}
+	if !result.Valid() {
+		errs := make([]error, len(result.Errors()))
+		for i, err := range result.Errors() {
+			errs[i] = errors.New(err.String())
+		}
+		return fmt.Errorf("invalid %s config: %w", s.cfg.Name, errors.Join(errs...))
+	}
+	return nil
+}
+
+// newRequest builds a RunRequest from the provided StepContext.
+func (s *stepRunner) newRequest(stepCtx *promotion.StepContext) *RunRequest {
+	return &RunRequest{
+		APIVersion: ProtocolVersion,
+		Step: StepInfo{
+			Kind:  s.cfg.Name,
+			Alias: stepCtx.Alias,
+		},
+		Config: stepCtx.Config,
+		Context: RunContext{
+			UIBaseURL:        stepCtx.UIBaseURL,
+			WorkDir:          stepCtx.WorkDir,
+			Project:          stepCtx.Project,
+			Stage:            stepCtx.Stage,
+			Promotion:        stepCtx.Promotion,
+			PromotionActor:   stepCtx.PromotionActor,
+			Vars:             stepCtx.Vars,
+			SharedState:      stepCtx.SharedState,
+			FreightRequests:  stepCtx.FreightRequests,
+			Freight:          stepCtx.Freight,
+			TargetFreightRef: stepCtx.TargetFreightRef,
+		},
+	}
+}
+
+// simulator is an implementation of the promotion.StepRunner interface that
+// stands in for a plugin when the steps of a Promotion are executed as a dry
+// run.
+type simulator struct {
+	runner *stepRunner
+}
+
+// Run implements the promotion.StepRunner interface.
+func (s *simulator) Run(
+	_ context.Context,
+	stepCtx *promotion.StepContext,
+) (promotion.StepResult, error) {
+	if err := s.runner.validate(stepCtx.Config); err != nil {
+		return promotion.StepResult{
+			Status: kargoapi.PromotionStepStatusFailed,
+		}, &promotion.TerminalError{Err: err}
+	}
+	return promotion.StepResult{
+		Status:  kargoapi.PromotionStepStatusSucceeded,
+		Message: fmt.Sprintf("Simulated: would call step plugin %q", s.runner.cfg.Name),
+	}, nil
+}
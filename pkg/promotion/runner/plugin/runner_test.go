@@ -0,0 +1,322 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+func TestRegister(t *testing.T) {
+	t.Run("conflict with existing step runner", func(t *testing.T) {
+		registry := promotion.MustNewStepRunnerRegistry(
+			promotion.StepRunnerRegistration{Name: "foo"},
+		)
+		err := Register(registry, Config{Plugins: []StepPluginConfig{{
+			Name:     "foo",
+			Endpoint: "http://localhost:8080",
+		}}})
+		require.ErrorContains(t, err, "conflicts with a step runner of the same name")
+	})
+
+	t.Run("invalid config schema", func(t *testing.T) {
+		err := Register(promotion.MustNewStepRunnerRegistry(), Config{Plugins: []StepPluginConfig{{
+			Name:         "foo",
+			Endpoint:     "http://localhost:8080",
+			ConfigSchema: json.RawMessage(`{"type":"bogus"}`),
+		}}})
+		require.ErrorContains(t, err, "invalid configSchema")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		registry := promotion.MustNewStepRunnerRegistry()
+		err := Register(registry, Config{Plugins: []StepPluginConfig{{
+			Name:                  "foo",
+			Endpoint:              "http://localhost:8080",
+			DefaultTimeout:        &metav1.Duration{Duration: time.Minute},
+			DefaultErrorThreshold: 3,
+		}}})
+		require.NoError(t, err)
+		reg, err := registry.Get("foo")
+		require.NoError(t, err)
+		require.Equal(t, time.Minute, reg.Metadata.DefaultTimeout)
+		require.Equal(t, uint32(3), reg.Metadata.DefaultErrorThreshold)
+		require.NotNil(t, reg.Value(promotion.StepRunnerCapabilities{}))
+	})
+}
+
+func TestRegisterSimulators(t *testing.T) {
+	t.Run("invalid config schema", func(t *testing.T) {
+		err := RegisterSimulators(promotion.MustNewStepRunnerRegistry(), Config{Plugins: []StepPluginConfig{{
+			Name:         "foo",
+			Endpoint:     "http://localhost:8080",
+			ConfigSchema: json.RawMessage(`{"type":"bogus"}`),
+		}}})
+		require.ErrorContains(t, err, "invalid configSchema")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		registry := promotion.MustNewStepRunnerRegistry()
+		err := RegisterSimulators(registry, Config{Plugins: []StepPluginConfig{{
+			Name:           "foo",
+			Endpoint:       "http://localhost:8080",
+			DefaultTimeout: &metav1.Duration{Duration: time.Minute},
+		}}})
+		require.NoError(t, err)
+		reg, err := registry.Get("foo")
+		require.NoError(t, err)
+		require.Equal(t, time.Minute, reg.Metadata.DefaultTimeout)
+		require.IsType(t, &simulator{}, reg.Value(promotion.StepRunnerCapabilities{}))
+	})
+}
+
+func Test_simulator_Run(t *testing.T) {
+	testCases := []struct {
+		name       string
+		config     promotion.Config
+		assertions func(*testing.T, promotion.StepResult, error)
+	}{
+		{
+			name:   "config does not match schema",
+			config: promotion.Config{},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "invalid test-plugin config")
+				require.True(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+		{
+			name:   "success",
+			config: promotion.Config{"ci": "fake-ci"},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				require.Equal(t, `Simulated: would call step plugin "test-plugin"`, res.Message)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			handler := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+				t.Fatal("plugin should not have been called")
+			})
+			srv := httptest.NewServer(handler)
+			t.Cleanup(srv.Close)
+			runner, err := newStepRunner(StepPluginConfig{
+				Name:         "test-plugin",
+				Endpoint:     srv.URL,
+				ConfigSchema: json.RawMessage(`{"type":"object","required":["ci"]}`),
+			})
+			require.NoError(t, err)
+			res, err := (&simulator{runner: runner}).Run(
+				context.Background(),
+				&promotion.StepContext{Config: testCase.config},
+			)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
+
+func Test_stepRunner_Run(t *testing.T) {
+	testCases := []struct {
+		name       string
+		schema     string
+		config     promotion.Config
+		handler    http.HandlerFunc
+		assertions func(*testing.T, promotion.StepResult, error)
+	}{
+		{
+			name:   "config does not match schema",
+			schema: `{"type":"object","required":["ci"]}`,
+			config: promotion.Config{},
+			handler: func(http.ResponseWriter, *http.Request) {
+				t.Fatal("plugin should not have been called")
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "invalid test-plugin config")
+				require.True(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+		{
+			name: "unexpected HTTP status",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "unexpected HTTP 503 response")
+				require.False(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "unsupported protocol version",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"apiVersion":"v0","status":"Succeeded"}`))
+			},
+			assertions: func(t *testing.T, _ promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "unsupported protocol version")
+				require.True(t, promotion.IsTerminal(err))
+			},
+		},
+		{
+			name: "unknown status",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_ = json.NewEncoder(w).Encode(RunResponse{
+					APIVersion: ProtocolVersion,
+					Status:     "Bogus",
+				})
+			},
+			assertions: func(t *testing.T, _ promotion.StepResult, err error) {
+				require.ErrorContains(t, err, `unknown status "Bogus"`)
+				require.True(t, promotion.IsTerminal(err))
+			},
+		},
+		{
+			name: "plugin reports retryable failure",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_ = json.NewEncoder(w).Encode(RunResponse{
+					APIVersion: ProtocolVersion,
+					Status:     kargoapi.PromotionStepStatusErrored,
+					Message:    "CMDB unavailable",
+				})
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "CMDB unavailable")
+				require.False(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusErrored, res.Status)
+			},
+		},
+		{
+			name: "plugin reports terminal failure",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_ = json.NewEncoder(w).Encode(RunResponse{
+					APIVersion: ProtocolVersion,
+					Status:     kargoapi.PromotionStepStatusFailed,
+					Message:    "change rejected",
+					Terminal:   true,
+				})
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.ErrorContains(t, err, "change rejected")
+				require.True(t, promotion.IsTerminal(err))
+				require.Equal(t, kargoapi.PromotionStepStatusFailed, res.Status)
+			},
+		},
+		{
+			name: "plugin reports running",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_ = json.NewEncoder(w).Encode(RunResponse{
+					APIVersion: ProtocolVersion,
+					Status:     kargoapi.PromotionStepStatusRunning,
+					RetryAfter: &metav1.Duration{Duration: 30 * time.Second},
+				})
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusRunning, res.Status)
+				require.NotNil(t, res.RetryAfter)
+				require.Equal(t, 30*time.Second, *res.RetryAfter)
+			},
+		},
+		{
+			name:   "success",
+			schema: `{"type":"object","required":["ci"]}`,
+			config: promotion.Config{"ci": "my-app"},
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, http.MethodPost, r.Method)
+				require.Equal(t, contentTypeJSON, r.Header.Get(contentTypeHeader))
+				req := RunRequest{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				require.Equal(t, ProtocolVersion, req.APIVersion)
+				require.Equal(t, StepInfo{Kind: "test-plugin", Alias: "update"}, req.Step)
+				require.Equal(t, "my-app", req.Config["ci"])
+				require.Equal(t, "fake-project", req.Context.Project)
+				require.Equal(t, "fake-stage", req.Context.Stage)
+				_ = json.NewEncoder(w).Encode(RunResponse{
+					APIVersion: ProtocolVersion,
+					Status:     kargoapi.PromotionStepStatusSucceeded,
+					Output:     map[string]any{"changeID": "CHG-1"},
+				})
+			},
+			assertions: func(t *testing.T, res promotion.StepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+				require.Equal(t, map[string]any{"changeID": "CHG-1"}, res.Output)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			srv := httptest.NewServer(testCase.handler)
+			t.Cleanup(srv.Close)
+
+			registry := promotion.MustNewStepRunnerRegistry()
+			cfg := StepPluginConfig{
+				Name:     "test-plugin",
+				Endpoint: srv.URL,
+			}
+			if testCase.schema != "" {
+				cfg.ConfigSchema = json.RawMessage(testCase.schema)
+			}
+			require.NoError(t, Register(registry, Config{Plugins: []StepPluginConfig{cfg}}))
+			reg, err := registry.Get("test-plugin")
+			require.NoError(t, err)
+
+			res, err := reg.Value(promotion.StepRunnerCapabilities{}).Run(
+				context.Background(),
+				&promotion.StepContext{
+					Project: "fake-project",
+					Stage:   "fake-stage",
+					Alias:   "update",
+					Config:  testCase.config,
+				},
+			)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
+
+func Test_execTransport_roundTrip(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		transport := &execTransport{command: []string{
+			"sh", "-c",
+			`grep -q '"alias":"notify"' && ` +
+				`echo '{"apiVersion":"` + ProtocolVersion + `","status":"Succeeded"}'`,
+		}}
+		res, err := transport.roundTrip(context.Background(), &RunRequest{
+			APIVersion: ProtocolVersion,
+			Step:       StepInfo{Kind: "notify", Alias: "notify"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, kargoapi.PromotionStepStatusSucceeded, res.Status)
+	})
+
+	t.Run("process fails", func(t *testing.T) {
+		transport := &execTransport{command: []string{"sh", "-c", "echo oops >&2; exit 1"}}
+		_, err := transport.roundTrip(context.Background(), &RunRequest{})
+		require.ErrorContains(t, err, "oops")
+	})
+
+	t.Run("response too large", func(t *testing.T) {
+		transport := &execTransport{command: []string{"sh", "-c", "yes"}}
+		_, err := transport.roundTrip(context.Background(), &RunRequest{})
+		require.ErrorContains(t, err, "content exceeds limit")
+	})
+
+	t.Run("process times out", func(t *testing.T) {
+		transport := &execTransport{command: []string{"sleep", "10"}}
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_, err := transport.roundTrip(ctx, &RunRequest{})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,147 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+
+	"github.com/akuity/kargo/pkg/io"
+)
+
+const (
+	// maxResponseBytes is the maximum size of a plugin's response.
+	maxResponseBytes = 2 << 20
+	// maxStderrBytes is the maximum amount of a plugin process's standard
+	// error that is retained for inclusion in error messages.
+	maxStderrBytes = 64 << 10
+
+	contentTypeHeader = "Content-Type"
+	contentTypeJSON   = "application/json"
+)
+
+// newTransport returns a transport appropriate for the provided
+// StepPluginConfig.
+func newTransport(cfg StepPluginConfig) transport {
+	if cfg.Endpoint != "" {
+		return &httpTransport{
+			endpoint: cfg.Endpoint,
+			client:   cleanhttp.DefaultPooledClient(),
+		}
+	}
+	return &execTransport{command: cfg.Command}
+}
+
+// httpTransport is a transport that sends requests to a server as HTTP POST
+// requests.
+type httpTransport struct {
+	endpoint string
+	client   *http.Client
+}
+
+// roundTrip implements the transport interface.
+func (h *httpTransport) roundTrip(ctx context.Context, req *RunRequest) (*RunResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling request: %w", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, h.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error building request: %w", err)
+	}
+	httpReq.Header.Set(contentTypeHeader, contentTypeJSON)
+	httpRes, err := h.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+	resBody, err := io.LimitRead(httpRes.Body, maxResponseBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %w", err)
+	}
+	if httpRes.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(
+			"received unexpected HTTP %d response: %s",
+			httpRes.StatusCode, strings.TrimSpace(string(resBody)),
+		)
+	}
+	res := &RunResponse{}
+	if err = json.Unmarshal(resBody, res); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+	return res, nil
+}
+
+// execTransport is a transport that executes a new process for every request.
+// The request is written to the process's standard input and the response is
+// read from its standard output.
+type execTransport struct {
+	command []string
+}
+
+// roundTrip implements the transport interface.
+func (e *execTransport) roundTrip(ctx context.Context, req *RunRequest) (*RunResponse, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling request: %w", err)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, e.command[0], e.command[1:]...) // nolint: gosec
+	cmd.Dir = req.Context.WorkDir
+	cmd.Stdin = bytes.NewReader(body)
+	stderr := &truncatingBuffer{limit: maxStderrBytes}
+	cmd.Stderr = stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("error creating pipe for standard output: %w", err)
+	}
+	if err = cmd.Start(); err != nil {
+		return nil, fmt.Errorf("error executing %q: %w", e.command[0], err)
+	}
+	// As with responses from servers, never read more of the response into
+	// memory than is permitted.
+	resBody, readErr := io.LimitRead(stdout, maxResponseBytes)
+	if readErr != nil {
+		// Don't wait for the process to produce output that won't be read
+		cancel()
+	}
+	if err = cmd.Wait(); err != nil && readErr == nil {
+		return nil, fmt.Errorf(
+			"error executing %q: %w: %s",
+			e.command[0], err, strings.TrimSpace(stderr.String()),
+		)
+	}
+	if readErr != nil {
+		return nil, fmt.Errorf("error reading response: %w", readErr)
+	}
+	res := &RunResponse{}
+	if err = json.Unmarshal(resBody, res); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+	return res, nil
+}
+
+// truncatingBuffer is an io.Writer that retains only the first limit bytes
+// written to it and silently discards the rest.
+type truncatingBuffer struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+// Write implements io.Writer.
+func (t *truncatingBuffer) Write(p []byte) (int, error) {
+	if remaining := t.limit - t.buf.Len(); remaining > 0 {
+		t.buf.Write(p[:min(len(p), remaining)])
+	}
+	return len(p), nil
+}
+
+// String returns the retained bytes as a string.
+func (t *truncatingBuffer) String() string {
+	return t.buf.String()
+}
@@ -0,0 +1,117 @@
+package promotion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+// defaultWorkerRetryInterval is the interval after which a worker retries
+// steps that reported themselves to be Running if they did not suggest an
+// interval themselves.
+var defaultWorkerRetryInterval = 30 * time.Second
+
+// Worker executes the steps of a single Promotion as described by the
+// WorkerRequest stored in a Secret created by a PodOrchestrator. It is
+// intended to run in a worker Pod.
+type Worker struct {
+	client       client.Client
+	orchestrator Orchestrator
+	secret       client.ObjectKey
+}
+
+// NewWorker returns a new Worker that reads its WorkerRequest from, and
+// writes its WorkerResults to, the specified Secret using the provided client,
+// and executes steps using the provided Orchestrator.
+func NewWorker(c client.Client, orchestrator Orchestrator, secret client.ObjectKey) *Worker {
+	return &Worker{
+		client:       c,
+		orchestrator: orchestrator,
+		secret:       secret,
+	}
+}
+
+// Run executes the steps of the Promotion until the Promotion reaches a
+// terminal phase or the provided context is canceled. The WorkerResult is
+// written to the Secret after every pass over the steps.
+func (w *Worker) Run(ctx context.Context) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	secret := &corev1.Secret{}
+	if err := w.client.Get(ctx, w.secret, secret); err != nil {
+		return fmt.Errorf("error getting worker Secret %q: %w", w.secret, err)
+	}
+	req := WorkerRequest{}
+	if err := json.Unmarshal(secret.Data[WorkerRequestKey], &req); err != nil {
+		return fmt.Errorf("error unmarshaling worker request: %w", err)
+	}
+
+	promoCtx := req.Context
+	workDir, err := os.MkdirTemp("", "promotion-")
+	if err != nil {
+		return fmt.Errorf("error creating working directory: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+	promoCtx.WorkDir = workDir
+
+	for {
+		res, err := w.orchestrator.ExecuteSteps(ctx, promoCtx, req.Steps)
+		workerRes := WorkerResult{Result: res}
+		if err != nil {
+			workerRes.Error = err.Error()
+		}
+		if err = w.writeResult(ctx, workerRes); err != nil {
+			return err
+		}
+		if res.Status != kargoapi.PromotionPhaseRunning {
+			logger.Info("promotion finished", "phase", res.Status)
+			return nil
+		}
+
+		promoCtx.StartFromStep = res.CurrentStep
+		promoCtx.StepExecutionMetadata = res.StepExecutionMetadata
+		promoCtx.State = res.State
+
+		retryAfter := defaultWorkerRetryInterval
+		if res.RetryAfter != nil {
+			retryAfter = *res.RetryAfter
+		}
+		logger.Debug("promotion is running; retrying", "after", retryAfter)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryAfter):
+		}
+	}
+}
+
+// writeResult writes the provided WorkerResult to the Secret.
+func (w *Worker) writeResult(ctx context.Context, res WorkerResult) error {
+	data, err := json.Marshal(res)
+	if err != nil {
+		return fmt.Errorf("error marshaling worker result: %w", err)
+	}
+	if err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret := &corev1.Secret{}
+		if err := w.client.Get(ctx, w.secret, secret); err != nil {
+			return err
+		}
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[WorkerResultKey] = data
+		return w.client.Update(ctx, secret)
+	}); err != nil {
+		return fmt.Errorf("error writing worker result to Secret %q: %w", w.secret, err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package promotion
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// orchestratorFunc is an implementation of the Orchestrator interface that
+// delegates to a function.
+type orchestratorFunc func(context.Context, Context, []Step) (Result, error)
+
+func (f orchestratorFunc) ExecuteSteps(ctx context.Context, promoCtx Context, steps []Step) (Result, error) {
+	return f(ctx, promoCtx, steps)
+}
+
+func (f orchestratorFunc) Cleanup(context.Context, string, string) error {
+	return nil
+}
+
+func TestWorker_Run(t *testing.T) {
+	secretKey := client.ObjectKey{Namespace: "kargo", Name: "promotion-worker-abc"}
+	req, err := json.Marshal(WorkerRequest{
+		Context: Context{Project: "fake-project", Promotion: "fake-promotion"},
+		Steps:   []Step{{Kind: "fake-step", Alias: "step-1"}},
+	})
+	require.NoError(t, err)
+	c := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: secretKey.Namespace, Name: secretKey.Name},
+		Data:       map[string][]byte{WorkerRequestKey: req},
+	}).Build()
+
+	var passes int
+	worker := NewWorker(c, orchestratorFunc(
+		func(_ context.Context, promoCtx Context, steps []Step) (Result, error) {
+			passes++
+			require.Equal(t, "fake-promotion", promoCtx.Promotion)
+			require.NotEmpty(t, promoCtx.WorkDir)
+			require.Len(t, steps, 1)
+			if passes == 1 {
+				return Result{
+					Status:     kargoapi.PromotionPhaseRunning,
+					State:      State{"step-1": "waiting"},
+					RetryAfter: ptr.To(time.Millisecond),
+				}, nil
+			}
+			// The state from the previous pass must be carried over.
+			require.Equal(t, State{"step-1": "waiting"}, promoCtx.State)
+			return Result{
+				Status:  kargoapi.PromotionPhaseErrored,
+				Message: "step errored",
+			}, errors.New("something went wrong")
+		},
+	), secretKey)
+
+	require.NoError(t, worker.Run(context.Background()))
+	require.Equal(t, 2, passes)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(context.Background(), secretKey, secret))
+	res := WorkerResult{}
+	require.NoError(t, json.Unmarshal(secret.Data[WorkerResultKey], &res))
+	require.Equal(t, kargoapi.PromotionPhaseErrored, res.Result.Status)
+	require.Equal(t, "step errored", res.Result.Message)
+	require.Equal(t, "something went wrong", res.Error)
+}
@@ -0,0 +1,326 @@
+package properties
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Update represents a discrete update to be made to a .properties file.
+type Update struct {
+	// Key is the key of the property to update.
+	Key string
+	// Value is the new value to set for the property. It must be a string, a
+	// boolean, or a number.
+	Value any
+}
+
+// Parse parses the provided .properties file contents and returns the
+// properties it defines. If a property is defined more than once, the last
+// definition wins.
+func Parse(inBytes []byte) (map[string]string, error) {
+	props := map[string]string{}
+	for _, e := range scan(string(inBytes)) {
+		key, err := unescape(e.rawKey)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing key %q: %w", e.rawKey, err)
+		}
+		value, err := unescape(e.rawValue)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing value of key %q: %w", key, err)
+		}
+		props[key] = value
+	}
+	return props, nil
+}
+
+// SetValuesInFile overwrites the specified file with the changes specified by
+// the list of Updates. Properties that are not already defined in the file are
+// appended to it. Importantly, all comments, ordering, and formatting in the
+// file are preserved.
+func SetValuesInFile(file string, updates []Update) error {
+	inBytes, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("error reading file %q: %w", file, err)
+	}
+	outBytes, err := SetValuesInBytes(inBytes, updates)
+	if err != nil {
+		return fmt.Errorf("error mutating bytes: %w", err)
+	}
+	// This file should always exist already, so the permissions we choose here
+	// don't really matter. We went with 0600 just to appease the gosec linter.
+	if err = os.WriteFile(file, outBytes, 0600); err != nil {
+		return fmt.Errorf("error writing mutated bytes to file %q: %w", file, err)
+	}
+	return nil
+}
+
+// SetValuesInBytes returns a copy of the provided bytes with the changes
+// specified by Updates applied. Properties that are not already defined are
+// appended. If a property is defined more than once, only its last definition
+// (i.e. the effective one) is updated. Importantly, all comments, ordering,
+// and formatting in the input bytes are preserved in the output.
+func SetValuesInBytes(inBytes []byte, updates []Update) ([]byte, error) {
+	doc := string(inBytes)
+	lastEntries := map[string]entry{}
+	for _, e := range scan(doc) {
+		key, err := unescape(e.rawKey)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing key %q: %w", e.rawKey, err)
+		}
+		lastEntries[key] = e
+	}
+
+	type change struct {
+		start, end int
+		newValue   string
+	}
+	var changes []change
+	var appended []string
+	appendedIdx := map[string]int{}
+	for _, update := range updates {
+		val, err := stringify(update.Value)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding value for key %q: %w", update.Key, err)
+		}
+		e, ok := lastEntries[update.Key]
+		if !ok {
+			line := escape(update.Key, true) + "=" + escape(val, false)
+			if i, ok := appendedIdx[update.Key]; ok {
+				appended[i] = line
+			} else {
+				appendedIdx[update.Key] = len(appended)
+				appended = append(appended, line)
+			}
+			continue
+		}
+		changes = slices.DeleteFunc(changes, func(c change) bool {
+			return c.start == e.valueStart
+		})
+		changes = append(changes, change{
+			start:    e.valueStart,
+			end:      e.valueEnd,
+			newValue: escape(val, false),
+		})
+	}
+
+	// Apply changes from the end of the document to the beginning so that the
+	// offsets of yet-to-be-applied changes remain valid.
+	slices.SortFunc(changes, func(a, b change) int {
+		return b.start - a.start
+	})
+	for _, c := range changes {
+		doc = doc[:c.start] + c.newValue + doc[c.end:]
+	}
+
+	if len(appended) > 0 {
+		if doc != "" && !strings.HasSuffix(doc, "\n") {
+			doc += "\n"
+		}
+		doc += strings.Join(appended, "\n") + "\n"
+	}
+	return []byte(doc), nil
+}
+
+// entry describes a property definition within a .properties file.
+type entry struct {
+	// rawKey is the key as it appears in the file, with escape sequences
+	// intact.
+	rawKey string
+	// rawValue is the value as it appears in the file, with escape sequences
+	// intact and line continuations removed.
+	rawValue string
+	// valueStart is the offset of the first byte of the value.
+	valueStart int
+	// valueEnd is the offset of the byte following the last byte of the value,
+	// including any continuation lines.
+	valueEnd int
+}
+
+// scan returns all property definitions in the provided .properties file
+// contents in the order in which they appear.
+func scan(doc string) []entry {
+	var entries []entry
+	pos := 0
+	for pos < len(doc) {
+		// Skip leading whitespace
+		for pos < len(doc) && isWhitespace(doc[pos]) {
+			pos++
+		}
+		if pos >= len(doc) {
+			break
+		}
+		if c := doc[pos]; c == '\n' || c == '\r' {
+			pos++
+			continue
+		}
+		if c := doc[pos]; c == '#' || c == '!' {
+			pos = lineEnd(doc, pos)
+			continue
+		}
+
+		// The key ends at the first unescaped separator or whitespace
+		keyStart := pos
+		for pos < len(doc) && !isLineTerminator(doc[pos]) {
+			if doc[pos] == '\\' && pos+1 < len(doc) && !isLineTerminator(doc[pos+1]) {
+				pos += 2
+				continue
+			}
+			if doc[pos] == '=' || doc[pos] == ':' || isWhitespace(doc[pos]) {
+				break
+			}
+			pos++
+		}
+		e := entry{rawKey: doc[keyStart:pos]}
+
+		// Skip the separator and any whitespace surrounding it
+		for pos < len(doc) && isWhitespace(doc[pos]) {
+			pos++
+		}
+		if pos < len(doc) && (doc[pos] == '=' || doc[pos] == ':') {
+			pos++
+			for pos < len(doc) && isWhitespace(doc[pos]) {
+				pos++
+			}
+		}
+
+		// The value runs to the end of the logical line, which may span
+		// several physical lines by way of a trailing backslash.
+		e.valueStart = pos
+		var value strings.Builder
+		for {
+			end := lineEnd(doc, pos)
+			line := strings.TrimRight(doc[pos:end], "\r\n")
+			trailing := len(line) - len(strings.TrimRight(line, "\\"))
+			if trailing%2 == 0 {
+				value.WriteString(line)
+				e.valueEnd = pos + len(line)
+				pos = end
+				break
+			}
+			value.WriteString(line[:len(line)-1])
+			pos = end
+			for pos < len(doc) && isWhitespace(doc[pos]) {
+				pos++
+			}
+			if pos >= len(doc) {
+				e.valueEnd = pos
+				break
+			}
+		}
+		e.rawValue = value.String()
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// lineEnd returns the offset of the byte following the line terminator of the
+// line containing the specified offset.
+func lineEnd(doc string, pos int) int {
+	i := strings.IndexByte(doc[pos:], '\n')
+	if i < 0 {
+		return len(doc)
+	}
+	return pos + i + 1
+}
+
+func isWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\f'
+}
+
+func isLineTerminator(c byte) bool {
+	return c == '\n' || c == '\r'
+}
+
+// unescape processes the escape sequences in the provided raw key or value.
+func unescape(raw string) (string, error) {
+	if !strings.Contains(raw, `\`) {
+		return raw, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(raw); i++ {
+		c := raw[i]
+		if c != '\\' || i+1 >= len(raw) {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		switch raw[i] {
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			if i+4 >= len(raw) {
+				return "", fmt.Errorf("malformed \\uXXXX encoding")
+			}
+			r, err := strconv.ParseUint(raw[i+1:i+5], 16, 16)
+			if err != nil {
+				return "", fmt.Errorf("malformed \\uXXXX encoding: %w", err)
+			}
+			b.WriteRune(rune(r))
+			i += 4
+		default:
+			b.WriteByte(raw[i])
+		}
+	}
+	return b.String(), nil
+}
+
+// escape returns the provided key or value with special characters escaped.
+func escape(s string, isKey bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			b.WriteString(`\\`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\f':
+			b.WriteString(`\f`)
+		case ' ':
+			// Spaces are significant in keys and leading spaces in values
+			// would otherwise be discarded.
+			if isKey || i == 0 {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(c)
+		case '=', ':', '#', '!':
+			if isKey {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// stringify returns the string representation of the provided scalar value.
+func stringify(val any) (string, error) {
+	switch v := val.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", val)
+	}
+}
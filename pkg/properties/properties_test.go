@@ -0,0 +1,139 @@
+package properties
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name       string
+		inBytes    []byte
+		assertions func(*testing.T, map[string]string, error)
+	}{
+		{
+			name:    "empty",
+			inBytes: []byte(""),
+			assertions: func(t *testing.T, props map[string]string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, props)
+			},
+		},
+		{
+			name:    "malformed unicode escape",
+			inBytes: []byte(`key=\u12`),
+			assertions: func(t *testing.T, _ map[string]string, err error) {
+				require.ErrorContains(t, err, "malformed")
+			},
+		},
+		{
+			name: "various forms",
+			inBytes: []byte(`# A comment
+! Another comment
+app.version=1.0.0
+app.name : kargo
+app.description   The description
+  indented.key = indented value
+escaped\ key\:with\=separators=value
+unicode=café
+tabbed=a\tb
+multi.line=first, \
+           second, \
+           third
+empty=
+flag
+app.version=1.0.1
+`),
+			assertions: func(t *testing.T, props map[string]string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, map[string]string{
+					"app.version":                 "1.0.1",
+					"app.name":                    "kargo",
+					"app.description":             "The description",
+					"indented.key":                "indented value",
+					"escaped key:with=separators": "value",
+					"unicode":                     "café",
+					"tabbed":                      "a\tb",
+					"multi.line":                  "first, second, third",
+					"empty":                       "",
+					"flag":                        "",
+				}, props)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			props, err := Parse(testCase.inBytes)
+			testCase.assertions(t, props, err)
+		})
+	}
+}
+
+func TestSetValuesInBytes(t *testing.T) {
+	testCases := []struct {
+		name       string
+		inBytes    []byte
+		updates    []Update
+		assertions func(*testing.T, []byte, error)
+	}{
+		{
+			name:    "unsupported value type",
+			inBytes: []byte("key=value\n"),
+			updates: []Update{{Key: "key", Value: []any{}}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "unsupported value type")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name: "preserves comments, ordering, and formatting",
+			inBytes: []byte(`# Application settings
+app.version = 1.0.0
+app.name:kargo
+escaped\ key=old
+multi.line=first, \
+           second
+replicas=2
+app.version = 1.0.1
+`),
+			updates: []Update{
+				{Key: "app.version", Value: "2.0.0"},
+				{Key: "app.name", Value: " leading space"},
+				{Key: "escaped key", Value: "new"},
+				{Key: "multi.line", Value: "single\nline"},
+				{Key: "replicas", Value: float64(3)},
+			},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, `# Application settings
+app.version = 1.0.0
+app.name:\ leading space
+escaped\ key=new
+multi.line=single\nline
+replicas=3
+app.version = 2.0.0
+`, string(bytes))
+			},
+		},
+		{
+			name:    "appends undefined properties",
+			inBytes: []byte("key=value"),
+			updates: []Update{
+				{Key: "new key", Value: true},
+				{Key: "other=key", Value: "a:b"},
+				{Key: "new key", Value: false},
+			},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "key=value\nnew\\ key=false\nother\\=key=a:b\n", string(bytes))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			bytes, err := SetValuesInBytes(testCase.inBytes, testCase.updates)
+			testCase.assertions(t, bytes, err)
+		})
+	}
+}
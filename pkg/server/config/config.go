@@ -26,6 +26,7 @@ type ServerConfig struct {
 	AdminConfig                 *AdminConfig
 	DexProxyConfig              *dex.ProxyConfig
 	ArgoCDConfig                ArgoCDConfig
+	PromotionDryRunConfig       PromotionDryRunConfig
 	PermissiveCORSPolicyEnabled bool
 	RolloutsIntegrationEnabled  bool
 	AnalysisRunLogURLTemplate   string
@@ -55,6 +56,7 @@ func ServerConfigFromEnv() ServerConfig {
 		cfg.DexProxyConfig = &dexProxyCfg
 	}
 	envconfig.MustProcess("", &cfg.ArgoCDConfig)
+	envconfig.MustProcess("", &cfg.PromotionDryRunConfig)
 	cfg.PermissiveCORSPolicyEnabled =
 		types.MustParseBool(os.GetEnv("PERMISSIVE_CORS_POLICY_ENABLED", "false"))
 	cfg.RolloutsIntegrationEnabled =
@@ -143,3 +145,15 @@ type ArgoCDConfig struct {
 	// URLs is a mapping from shard name to Argo CD URL
 	URLs ArgoCDURLMap `envconfig:"ARGOCD_URLS"`
 }
+
+// PromotionDryRunConfig represents configuration for the dry runs of
+// Promotions, which are executed synchronously by the API server.
+type PromotionDryRunConfig struct {
+	// Timeout is the maximum amount of time a single dry run may take. A
+	// non-positive value means dry runs are not subject to a timeout.
+	Timeout time.Duration `envconfig:"PROMOTION_DRY_RUN_TIMEOUT" default:"2m"`
+	// MaxConcurrent is the maximum number of dry runs the API server executes
+	// concurrently. Requests for additional dry runs are rejected. A
+	// non-positive value means the number of dry runs is not limited.
+	MaxConcurrent int64 `envconfig:"PROMOTION_DRY_RUN_MAX_CONCURRENT" default:"4"`
+}
@@ -122,6 +122,16 @@ func (s *server) PromoteToStage(
 	if err != nil {
 		return nil, fmt.Errorf("build promotion: %w", err)
 	}
+	if req.Msg.GetDryRun() {
+		report, err := s.dryRunPromotionFn(ctx, stage, promotion, freight)
+		if err != nil {
+			return nil, fmt.Errorf("dry run promotion: %w", err)
+		}
+		return connect.NewResponse(&svcv1alpha1.PromoteToStageResponse{
+			Promotion:    promotion,
+			DryRunReport: report,
+		}), nil
+	}
 	if err := s.createPromotionFn(ctx, promotion); err != nil {
 		return nil, fmt.Errorf("create promotion: %w", err)
 	}
@@ -509,6 +509,90 @@ func TestPromoteToStage(t *testing.T) {
 				require.Equal(t, string(kargoapi.EventTypePromotionCreated), event.Reason)
 			},
 		},
+		{
+			name: "dry run",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+				DryRun:  true,
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-stage",
+						},
+						Spec: testStageSpec,
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-freight",
+						},
+					}, nil
+				},
+				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
+					return true
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				dryRunPromotionFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					*kargoapi.Promotion,
+					*kargoapi.Freight,
+				) (*svcv1alpha1.PromotionDryRunReport, error) {
+					return &svcv1alpha1.PromotionDryRunReport{
+						Phase: string(kargoapi.PromotionPhaseSucceeded),
+					}, nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("promotion should not be created")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.NotNil(t, res.Msg.GetPromotion())
+				require.Equal(
+					t,
+					string(kargoapi.PromotionPhaseSucceeded),
+					res.Msg.GetDryRunReport().GetPhase(),
+				)
+				require.Empty(t, recorder.Events)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/api"
+	"github.com/akuity/kargo/pkg/kargo"
+	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
+)
+
+// dryRunPromotion executes the steps of the provided Promotion, which is not
+// persisted, with any steps that have side effects replaced by simulators. The
+// Promotion's status is updated to reflect the outcome and a report of the
+// outcome is returned. Dry runs are executed synchronously by the API server,
+// so their number and duration are bounded by the server's configuration.
+func (s *server) dryRunPromotion(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	promo *kargoapi.Promotion,
+	freight *kargoapi.Freight,
+) (*svcv1alpha1.PromotionDryRunReport, error) {
+	if s.dryRunSem != nil {
+		if !s.dryRunSem.TryAcquire(1) {
+			return nil, connect.NewError(
+				connect.CodeResourceExhausted,
+				errors.New("too many concurrent promotion dry runs; try again later"),
+			)
+		}
+		defer s.dryRunSem.Release(1)
+	}
+	if timeout := s.cfg.PromotionDryRunConfig.Timeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	if err := kargo.NewPromotionBuilder(s.client).InflateSteps(ctx, promo); err != nil {
+		return nil, fmt.Errorf("inflate promotion steps: %w", err)
+	}
+
+	targetFreightRef := kargoapi.FreightReference{
+		Name:         freight.Name,
+		Commits:      freight.Commits,
+		Images:       freight.Images,
+		Charts:       freight.Charts,
+		OCIArtifacts: freight.OCIArtifacts,
+		Releases:     freight.Releases,
+		Origin:       freight.Origin,
+	}
+	promo.Status.Freight = &targetFreightRef
+	promo.Status.FreightCollection = buildDryRunFreightCollection(targetFreightRef, stage)
+
+	workDir, err := os.MkdirTemp("", "promotion-dry-run-")
+	if err != nil {
+		return nil, fmt.Errorf("create working directory: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(workDir); err != nil {
+			logging.LoggerFromContext(ctx).Error(err, "could not remove working directory")
+		}
+	}()
+
+	steps := promotion.NewSteps(promo)
+	promoCtx := promotion.NewContext(
+		promo,
+		stage,
+		promotion.WithActor(api.CreateActorAnnotationValue(promo)),
+		promotion.WithWorkDir(workDir),
+	)
+	// Steps are executed only once. A step that is still running after that
+	// (e.g. because it is waiting for something to happen) is reported as such
+	// instead of being retried.
+	res, err := s.dryRunEngine.Promote(ctx, promoCtx, steps)
+	if err != nil && res.Message == "" {
+		res.Message = err.Error()
+	}
+	promo.Status.Phase = res.Status
+	promo.Status.Message = res.Message
+	promo.Status.CurrentStep = res.CurrentStep
+	promo.Status.StepExecutionMetadata = res.StepExecutionMetadata
+	promo.Status.State = &apiextensionsv1.JSON{Raw: res.State.ToJSON()}
+
+	report := &svcv1alpha1.PromotionDryRunReport{
+		Phase:   string(res.Status),
+		Message: res.Message,
+		Steps:   make([]*svcv1alpha1.PromotionDryRunStep, len(res.StepExecutionMetadata)),
+	}
+	var diff strings.Builder
+	for i, meta := range res.StepExecutionMetadata {
+		step := &svcv1alpha1.PromotionDryRunStep{
+			Alias:   meta.Alias,
+			Status:  string(meta.Status),
+			Message: meta.Message,
+		}
+		if i < len(steps) {
+			step.Uses = steps[i].Kind
+		}
+		if output, ok := res.State[meta.Alias]; ok && output != nil {
+			outputJSON, err := json.Marshal(output)
+			if err != nil {
+				return nil, fmt.Errorf("marshal output of step %q: %w", meta.Alias, err)
+			}
+			step.Output = string(outputJSON)
+			// The simulated git-push step outputs a diff of the changes it would
+			// have pushed.
+			if out, ok := output.(map[string]any); ok && step.Uses == "git-push" {
+				if stepDiff, ok := out["diff"].(string); ok {
+					diff.WriteString(stepDiff)
+				}
+			}
+		}
+		report.Steps[i] = step
+	}
+	report.Diff = diff.String()
+	return report, nil
+}
+
+// buildDryRunFreightCollection constructs a FreightCollection that contains
+// all FreightReferences from the Stage's last Promotion that are still
+// requested by the Stage, plus the provided target Freight. This mirrors what
+// the Promotion reconciler does for a Promotion that is actually executed.
+func buildDryRunFreightCollection(
+	targetFreight kargoapi.FreightReference,
+	stage *kargoapi.Stage,
+) *kargoapi.FreightCollection {
+	freightCol := &kargoapi.FreightCollection{}
+	if lastPromo := stage.Status.LastPromotion; len(stage.Spec.RequestedFreight) > 1 &&
+		lastPromo != nil && lastPromo.Status != nil && lastPromo.Status.FreightCollection != nil {
+		for _, req := range stage.Spec.RequestedFreight {
+			if f, ok := lastPromo.Status.FreightCollection.Freight[req.Origin.String()]; ok {
+				freightCol.UpdateOrPush(f)
+			}
+		}
+	}
+	freightCol.UpdateOrPush(targetFreight)
+	return freightCol
+}
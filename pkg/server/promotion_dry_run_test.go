@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_server_dryRunPromotion_concurrencyLimit(t *testing.T) {
+	s := &server{dryRunSem: semaphore.NewWeighted(1)}
+	// Simulate a dry run that is already in progress
+	require.True(t, s.dryRunSem.TryAcquire(1))
+	_, err := s.dryRunPromotion(
+		context.Background(),
+		&kargoapi.Stage{},
+		&kargoapi.Promotion{},
+		&kargoapi.Freight{},
+	)
+	require.Error(t, err)
+	require.Equal(t, connect.CodeResourceExhausted, connect.CodeOf(err))
+}
+
+func Test_buildDryRunFreightCollection(t *testing.T) {
+	warehouseA := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "warehouse-a",
+	}
+	warehouseB := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "warehouse-b",
+	}
+	warehouseC := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "warehouse-c",
+	}
+	targetFreight := kargoapi.FreightReference{
+		Name:   "new-a",
+		Origin: warehouseA,
+	}
+
+	t.Run("single requested Freight", func(t *testing.T) {
+		col := buildDryRunFreightCollection(targetFreight, &kargoapi.Stage{
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{Origin: warehouseA}},
+			},
+		})
+		require.Len(t, col.Freight, 1)
+		require.Equal(t, targetFreight, col.Freight[warehouseA.String()])
+	})
+
+	t.Run("inherits Freight still requested from last Promotion", func(t *testing.T) {
+		col := buildDryRunFreightCollection(targetFreight, &kargoapi.Stage{
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{
+					{Origin: warehouseA},
+					{Origin: warehouseB},
+				},
+			},
+			Status: kargoapi.StageStatus{
+				LastPromotion: &kargoapi.PromotionReference{
+					Status: &kargoapi.PromotionStatus{
+						FreightCollection: &kargoapi.FreightCollection{
+							Freight: map[string]kargoapi.FreightReference{
+								warehouseA.String(): {Name: "old-a", Origin: warehouseA},
+								warehouseB.String(): {Name: "old-b", Origin: warehouseB},
+								warehouseC.String(): {Name: "old-c", Origin: warehouseC},
+							},
+						},
+					},
+				},
+			},
+		})
+		require.Len(t, col.Freight, 2)
+		require.Equal(t, "new-a", col.Freight[warehouseA.String()].Name)
+		require.Equal(t, "old-b", col.Freight[warehouseB.String()].Name)
+	})
+}
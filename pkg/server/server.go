@@ -18,24 +18,33 @@ import (
 	"github.com/rs/cors"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	"golang.org/x/sync/semaphore"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	svcv1alpha1 "github.com/akuity/kargo/api/service/v1alpha1"
 	"github.com/akuity/kargo/api/service/v1alpha1/svcv1alpha1connect"
 	rolloutsapi "github.com/akuity/kargo/api/stubs/rollouts/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/pkg/api"
 	rollouts "github.com/akuity/kargo/pkg/api/stubs/rollouts"
+	libCreds "github.com/akuity/kargo/pkg/credentials"
+	credsdb "github.com/akuity/kargo/pkg/credentials/kubernetes"
 	"github.com/akuity/kargo/pkg/event"
 	httputil "github.com/akuity/kargo/pkg/http"
 	"github.com/akuity/kargo/pkg/logging"
+	"github.com/akuity/kargo/pkg/promotion"
 	"github.com/akuity/kargo/pkg/server/config"
 	"github.com/akuity/kargo/pkg/server/dex"
 	"github.com/akuity/kargo/pkg/server/kubernetes"
 	"github.com/akuity/kargo/pkg/server/option"
 	"github.com/akuity/kargo/pkg/server/rbac"
 	"github.com/akuity/kargo/pkg/server/validation"
+
+	// Step runners (and the simulators that stand in for them during dry runs)
+	// register themselves with the promotion package.
+	_ "github.com/akuity/kargo/pkg/promotion/runner/builtin"
 )
 
 var (
@@ -51,6 +60,8 @@ type server struct {
 	rolesDB           rbac.RolesDatabase
 	serviceAccountsDB rbac.ServiceAccountsDatabase
 	sender            event.Sender
+	dryRunEngine      promotion.Engine
+	dryRunSem         *semaphore.Weighted
 
 	// The following behaviors are overridable for testing purposes:
 
@@ -88,6 +99,14 @@ type server struct {
 		...client.CreateOption,
 	) error
 
+	// Promotion dry runs:
+	dryRunPromotionFn func(
+		context.Context,
+		*kargoapi.Stage,
+		*kargoapi.Promotion,
+		*kargoapi.Freight,
+	) (*svcv1alpha1.PromotionDryRunReport, error)
+
 	// Promote downstream:
 	findDownstreamStagesFn func(
 		context.Context,
@@ -166,6 +185,7 @@ type Server interface {
 func NewServer(
 	cfg config.ServerConfig,
 	kubeClient kubernetes.Client,
+	argocdClient client.Client,
 	rolesDB rbac.RolesDatabase,
 	serviceAccountsDB rbac.ServiceAccountsDatabase,
 	sender event.Sender,
@@ -176,6 +196,20 @@ func NewServer(
 		rolesDB:           rolesDB,
 		serviceAccountsDB: serviceAccountsDB,
 		sender:            sender,
+		dryRunEngine: promotion.NewDryRunEngine(
+			kubeClient.InternalClient(),
+			argocdClient,
+			credsdb.NewDatabase(
+				kubeClient.InternalClient(),
+				nil,
+				libCreds.DefaultProviderRegistry,
+				credsdb.DatabaseConfigFromEnv(),
+			),
+			promotion.DefaultExprDataCacheFn,
+		),
+	}
+	if maxConcurrent := cfg.PromotionDryRunConfig.MaxConcurrent; maxConcurrent > 0 {
+		s.dryRunSem = semaphore.NewWeighted(maxConcurrent)
 	}
 
 	s.validateProjectExistsFn = s.validateProjectExists
@@ -184,6 +218,7 @@ func NewServer(
 	s.getFreightByNameOrAliasFn = api.GetFreightByNameOrAlias
 	s.isFreightAvailableFn = s.isFreightAvailable
 	s.createPromotionFn = kubeClient.Create
+	s.dryRunPromotionFn = s.dryRunPromotion
 	s.findDownstreamStagesFn = s.findDownstreamStages
 	s.listFreightFn = kubeClient.List
 	s.getAvailableFreightForStageFn = s.getAvailableFreightForStage
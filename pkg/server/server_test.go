@@ -38,6 +38,7 @@ func TestNewServer(t *testing.T) {
 	s, ok := NewServer(
 		testServerConfig,
 		testClient,
+		nil,
 		rbac.NewKubernetesRolesDatabase(testClient),
 		rbac.NewKubernetesServiceAccountsDatabase(
 			testClient,
@@ -58,6 +59,7 @@ func TestNewServer(t *testing.T) {
 	require.NotNil(t, s.getFreightByNameOrAliasFn)
 	require.NotNil(t, s.isFreightAvailableFn)
 	require.NotNil(t, s.createPromotionFn)
+	require.NotNil(t, s.dryRunPromotionFn)
 	require.NotNil(t, s.findDownstreamStagesFn)
 	require.NotNil(t, s.listFreightFn)
 	require.NotNil(t, s.getAvailableFreightForStageFn)
@@ -0,0 +1,443 @@
+package toml
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Update represents a discrete update to be made to a TOML document.
+type Update struct {
+	// Key is the dot-separated path to the key to update.
+	Key string
+	// Value is the new value to set for the key. It must be a string, a
+	// boolean, or a number.
+	Value any
+}
+
+// Decode unmarshals the provided TOML bytes into a map.
+func Decode(inBytes []byte) (map[string]any, error) {
+	data := map[string]any{}
+	if err := toml.Unmarshal(inBytes, &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// SetValuesInFile overwrites the specified file with the changes specified by
+// the list of Updates. Keys are of the form <key 0>.<key 1>...<key n>. An error
+// is returned for any attempted update to a key that does not exist or does not
+// address a scalar value. Importantly, all comments, ordering, and formatting
+// in the file are preserved.
+func SetValuesInFile(file string, updates []Update) error {
+	inBytes, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("error reading file %q: %w", file, err)
+	}
+	outBytes, err := SetValuesInBytes(inBytes, updates)
+	if err != nil {
+		return fmt.Errorf("error mutating bytes: %w", err)
+	}
+	// This file should always exist already, so the permissions we choose here
+	// don't really matter. We went with 0600 just to appease the gosec linter.
+	if err = os.WriteFile(file, outBytes, 0600); err != nil {
+		return fmt.Errorf("error writing mutated bytes to file %q: %w", file, err)
+	}
+	return nil
+}
+
+// SetValuesInBytes returns a copy of the provided bytes with the changes
+// specified by Updates applied. Keys are of the form
+// <key 0>.<key 1>...<key n>. An error is returned for any attempted update to a
+// key that does not exist or does not address a scalar value. Importantly, all
+// comments, ordering, and formatting in the input bytes are preserved in the
+// output.
+func SetValuesInBytes(inBytes []byte, updates []Update) ([]byte, error) {
+	// Make sure we are dealing with valid TOML before attempting any
+	// modifications. This allows the scanner below to be lenient.
+	if _, err := Decode(inBytes); err != nil {
+		return nil, fmt.Errorf("error parsing input: %w", err)
+	}
+
+	values, err := scan(string(inBytes))
+	if err != nil {
+		return nil, fmt.Errorf("error scanning input: %w", err)
+	}
+
+	type change struct {
+		value
+		newValue string
+	}
+	changes := make([]change, 0, len(updates))
+	for _, update := range updates {
+		v, ok := values[update.Key]
+		if !ok {
+			return nil, fmt.Errorf("key %q not found", update.Key)
+		}
+		if strings.HasPrefix(v.raw, "[") || strings.HasPrefix(v.raw, "{") {
+			return nil, fmt.Errorf("key %q does not address a scalar value", update.Key)
+		}
+		newValue, err := encodeValue(update.Value, v.raw)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding value for key %q: %w", update.Key, err)
+		}
+		// If the same key is updated more than once, the last update wins.
+		changes = slices.DeleteFunc(changes, func(c change) bool {
+			return c.start == v.start
+		})
+		changes = append(changes, change{value: v, newValue: newValue})
+	}
+
+	// Apply changes from the end of the document to the beginning so that the
+	// offsets of yet-to-be-applied changes remain valid.
+	slices.SortFunc(changes, func(a, b change) int {
+		return b.start - a.start
+	})
+	out := string(inBytes)
+	for _, c := range changes {
+		out = out[:c.start] + c.newValue + out[c.end:]
+	}
+
+	outBytes := []byte(out)
+	if _, err := Decode(outBytes); err != nil {
+		return nil, fmt.Errorf("error parsing output: %w", err)
+	}
+	return outBytes, nil
+}
+
+// value describes the location of a value within a TOML document.
+type value struct {
+	// start is the offset of the first byte of the value.
+	start int
+	// end is the offset of the byte following the last byte of the value.
+	end int
+	// raw is the value as it appears in the document.
+	raw string
+}
+
+// scan returns the locations of all values in the provided TOML document that
+// are addressable using dot notation, indexed by their fully-qualified key.
+// Values belonging to arrays of tables are not addressable and are omitted.
+func scan(doc string) (map[string]value, error) {
+	s := &scanner{doc: doc}
+	values := map[string]value{}
+	var table []string
+	var inArrayTable bool
+	for {
+		s.skipWhitespaceAndComments()
+		if s.eof() {
+			return values, nil
+		}
+		if s.peek() == '[' {
+			s.pos++
+			inArrayTable = false
+			if !s.eof() && s.peek() == '[' {
+				s.pos++
+				inArrayTable = true
+			}
+			var err error
+			if table, err = s.key(); err != nil {
+				return nil, err
+			}
+			if err = s.expect(']'); err != nil {
+				return nil, err
+			}
+			if inArrayTable {
+				if err = s.expect(']'); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		key, err := s.key()
+		if err != nil {
+			return nil, err
+		}
+		if err = s.expect('='); err != nil {
+			return nil, err
+		}
+		s.skipInlineWhitespace()
+		start := s.pos
+		if err = s.value(false); err != nil {
+			return nil, err
+		}
+		if !inArrayTable {
+			fullKey := strings.Join(append(slices.Clone(table), key...), ".")
+			values[fullKey] = value{start: start, end: s.pos, raw: doc[start:s.pos]}
+		}
+	}
+}
+
+// scanner is a minimal, lenient TOML scanner that is only concerned with
+// locating keys and the boundaries of their values. It assumes the document has
+// already been validated by a proper TOML parser.
+type scanner struct {
+	doc string
+	pos int
+}
+
+func (s *scanner) eof() bool {
+	return s.pos >= len(s.doc)
+}
+
+func (s *scanner) peek() byte {
+	return s.doc[s.pos]
+}
+
+func (s *scanner) hasPrefix(prefix string) bool {
+	return strings.HasPrefix(s.doc[s.pos:], prefix)
+}
+
+func (s *scanner) skipInlineWhitespace() {
+	for !s.eof() && (s.peek() == ' ' || s.peek() == '\t') {
+		s.pos++
+	}
+}
+
+func (s *scanner) skipWhitespaceAndComments() {
+	for !s.eof() {
+		switch s.peek() {
+		case ' ', '\t', '\r', '\n':
+			s.pos++
+		case '#':
+			for !s.eof() && s.peek() != '\n' {
+				s.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (s *scanner) expect(c byte) error {
+	s.skipInlineWhitespace()
+	if s.eof() || s.peek() != c {
+		return fmt.Errorf("expected %q at offset %d", c, s.pos)
+	}
+	s.pos++
+	return nil
+}
+
+// key scans a possibly dotted key and returns its parts.
+func (s *scanner) key() ([]string, error) {
+	var parts []string
+	for {
+		s.skipInlineWhitespace()
+		if s.eof() {
+			return nil, fmt.Errorf("unexpected end of document at offset %d", s.pos)
+		}
+		switch s.peek() {
+		case '"', '\'':
+			start := s.pos
+			if err := s.string(); err != nil {
+				return nil, err
+			}
+			// Let a proper parser deal with escape sequences
+			unquoted := map[string]string{}
+			if err := toml.Unmarshal([]byte("k = "+s.doc[start:s.pos]), &unquoted); err != nil {
+				return nil, fmt.Errorf("invalid quoted key at offset %d: %w", start, err)
+			}
+			parts = append(parts, unquoted["k"])
+		default:
+			start := s.pos
+			for !s.eof() && isBareKeyChar(s.peek()) {
+				s.pos++
+			}
+			if s.pos == start {
+				return nil, fmt.Errorf("expected key at offset %d", s.pos)
+			}
+			parts = append(parts, s.doc[start:s.pos])
+		}
+		s.skipInlineWhitespace()
+		if s.eof() || s.peek() != '.' {
+			return parts, nil
+		}
+		s.pos++
+	}
+}
+
+// value advances the scanner past the value at the current position. If
+// nested is true, the value is an element of an array or inline table.
+func (s *scanner) value(nested bool) error {
+	if s.eof() {
+		return fmt.Errorf("unexpected end of document at offset %d", s.pos)
+	}
+	switch s.peek() {
+	case '"', '\'':
+		return s.string()
+	case '[', '{':
+		return s.container()
+	}
+	start := s.pos
+	for !s.eof() {
+		c := s.peek()
+		if c == '\n' || c == '#' || (nested && (c == ',' || c == ']' || c == '}')) {
+			break
+		}
+		s.pos++
+	}
+	// Trim trailing whitespace. Note that whitespace cannot be used as the
+	// terminator of a bare value, because dates may contain spaces.
+	for s.pos > start && strings.ContainsRune(" \t\r", rune(s.doc[s.pos-1])) {
+		s.pos--
+	}
+	return nil
+}
+
+// string advances the scanner past the string at the current position.
+func (s *scanner) string() error {
+	start := s.pos
+	quote := s.doc[s.pos : s.pos+1]
+	if s.hasPrefix(strings.Repeat(quote, 3)) {
+		quote = strings.Repeat(quote, 3)
+	}
+	s.pos += len(quote)
+	for !s.eof() {
+		if quote[0] == '"' && s.peek() == '\\' {
+			s.pos += 2
+			continue
+		}
+		if s.hasPrefix(quote) {
+			s.pos += len(quote)
+			// Multi-line strings may end with up to two additional quotes
+			// that are part of the string's content.
+			for i := 0; i < 2 && len(quote) == 3 && !s.eof() && s.peek() == quote[0]; i++ {
+				s.pos++
+			}
+			return nil
+		}
+		s.pos++
+	}
+	return fmt.Errorf("unterminated string at offset %d", start)
+}
+
+// container advances the scanner past the array or inline table at the
+// current position.
+func (s *scanner) container() error {
+	start := s.pos
+	closer := byte(']')
+	if s.peek() == '{' {
+		closer = '}'
+	}
+	s.pos++
+	for {
+		s.skipWhitespaceAndComments()
+		if s.eof() {
+			return fmt.Errorf("unterminated array or inline table at offset %d", start)
+		}
+		switch c := s.peek(); {
+		case c == closer:
+			s.pos++
+			return nil
+		case c == ',':
+			s.pos++
+		case closer == '}' && (isBareKeyChar(c) || c == '"' || c == '\''):
+			// Keys of inline tables
+			if _, err := s.key(); err != nil {
+				return err
+			}
+			if err := s.expect('='); err != nil {
+				return err
+			}
+			s.skipInlineWhitespace()
+			if err := s.value(true); err != nil {
+				return err
+			}
+		default:
+			if err := s.value(true); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func isBareKeyChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') ||
+		(c >= '0' && c <= '9') || c == '_' || c == '-'
+}
+
+// encodeValue returns the TOML representation of the provided value. The
+// existing raw value is used as a hint to preserve the style of the value
+// being replaced where possible.
+func encodeValue(val any, oldRaw string) (string, error) {
+	switch v := val.(type) {
+	case string:
+		// Preserve literal strings if the new value can be represented as one.
+		if strings.HasPrefix(oldRaw, "'") && !strings.HasPrefix(oldRaw, "'''") &&
+			!strings.ContainsAny(v, "'\r\n") && utf8.ValidString(v) {
+			return "'" + v + "'", nil
+		}
+		return quote(v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%d", v), nil
+	case float32:
+		return encodeFloat(float64(v), oldRaw), nil
+	case float64:
+		return encodeFloat(v, oldRaw), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", val)
+	}
+}
+
+// encodeFloat returns the TOML representation of the provided float. Because
+// all numbers in JSON (and therefore in step configuration) are floats,
+// integral values are written as integers unless the value being replaced was
+// itself a float.
+func encodeFloat(v float64, oldRaw string) string {
+	switch {
+	case math.IsNaN(v):
+		return "nan"
+	case math.IsInf(v, 1):
+		return "inf"
+	case math.IsInf(v, -1):
+		return "-inf"
+	}
+	wasFloat := strings.ContainsAny(oldRaw, ".eE") && !strings.HasPrefix(oldRaw, "0x")
+	if v == math.Trunc(v) && math.Abs(v) < 1e15 {
+		if wasFloat {
+			return strconv.FormatFloat(v, 'f', 1, 64)
+		}
+		return strconv.FormatFloat(v, 'f', 0, 64)
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// quote returns the provided string as a TOML basic string.
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\u%04X`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,200 @@
+package toml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetValuesInBytes(t *testing.T) {
+	testCases := []struct {
+		name       string
+		inBytes    []byte
+		updates    []Update
+		assertions func(*testing.T, []byte, error)
+	}{
+		{
+			name:    "invalid TOML",
+			inBytes: []byte(`key = `),
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "error parsing input")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name:    "key not found",
+			inBytes: []byte(`name = "kargo"`),
+			updates: []Update{{Key: "version", Value: "1.0.0"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, `key "version" not found`)
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name: "key addresses a table",
+			inBytes: []byte(`[package]
+name = "kargo"
+`),
+			updates: []Update{{Key: "package", Value: "foo"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, `key "package" not found`)
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name: "key addresses an array",
+			inBytes: []byte(`features = ["a", "b"]
+`),
+			updates: []Update{{Key: "features", Value: "c"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "does not address a scalar value")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name:    "unsupported value type",
+			inBytes: []byte(`name = "kargo"`),
+			updates: []Update{{Key: "name", Value: []string{"foo"}}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, "unsupported value type")
+				require.Nil(t, bytes)
+			},
+		},
+		{
+			name: "preserves comments, ordering, and formatting",
+			inBytes: []byte(`# This is a comment
+title = "example" # Trailing comment
+
+[package]
+name    = "kargo"
+version = "0.1.0"   # The version
+edition = '2021'
+authors = [
+  "Tony Stark", # Iron Man
+  "Bruce Banner",
+]
+
+[dependencies]
+serde = { version = "1.0", features = ["derive"] }
+tokio.version = "1.36"
+"quoted.key" = 1
+
+[[bin]]
+name = "ignored"
+
+[profile.release]
+opt-level = 3
+lto = false
+ratio = 0.5
+`),
+			updates: []Update{
+				{Key: "package.version", Value: "0.2.0"},
+				{Key: "package.edition", Value: "2024"},
+				{Key: "dependencies.tokio.version", Value: "1.37"},
+				{Key: "dependencies.quoted.key", Value: float64(2)},
+				{Key: "profile.release.opt-level", Value: float64(2)},
+				{Key: "profile.release.lto", Value: true},
+				{Key: "profile.release.ratio", Value: float64(1)},
+				{Key: "title", Value: `a "quoted" title`},
+			},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, `# This is a comment
+title = "a \"quoted\" title" # Trailing comment
+
+[package]
+name    = "kargo"
+version = "0.2.0"   # The version
+edition = '2024'
+authors = [
+  "Tony Stark", # Iron Man
+  "Bruce Banner",
+]
+
+[dependencies]
+serde = { version = "1.0", features = ["derive"] }
+tokio.version = "1.37"
+"quoted.key" = 2
+
+[[bin]]
+name = "ignored"
+
+[profile.release]
+opt-level = 2
+lto = true
+ratio = 1.0
+`, string(bytes))
+			},
+		},
+		{
+			name: "multi-line strings",
+			inBytes: []byte(`description = """
+A long
+description"""
+name = "kargo"
+`),
+			updates: []Update{
+				{Key: "description", Value: "short"},
+				{Key: "name", Value: "akuity"},
+			},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, `description = "short"
+name = "akuity"
+`, string(bytes))
+			},
+		},
+		{
+			name: "last update to a key wins",
+			inBytes: []byte(`name = "kargo"
+`),
+			updates: []Update{
+				{Key: "name", Value: "foo"},
+				{Key: "name", Value: "bar"},
+			},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(t, `name = "bar"
+`, string(bytes))
+			},
+		},
+		{
+			name: "keys in arrays of tables are not addressable",
+			inBytes: []byte(`[[bin]]
+name = "kargo"
+`),
+			updates: []Update{{Key: "bin.name", Value: "foo"}},
+			assertions: func(t *testing.T, bytes []byte, err error) {
+				require.ErrorContains(t, err, `key "bin.name" not found`)
+				require.Nil(t, bytes)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			bytes, err := SetValuesInBytes(testCase.inBytes, testCase.updates)
+			testCase.assertions(t, bytes, err)
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	data, err := Decode([]byte(`
+name = "kargo"
+
+[package]
+version = "1.0.0"
+port = 8080
+`))
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"name": "kargo",
+		"package": map[string]any{
+			"version": "1.0.0",
+			"port":    int64(8080),
+		},
+	}, data)
+
+	_, err = Decode([]byte(`name = `))
+	require.Error(t, err)
+}
@@ -0,0 +1,282 @@
+package verification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/expr-lang/expr"
+	"github.com/hashicorp/go-cleanhttp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	xhttp "github.com/akuity/kargo/pkg/http"
+	"github.com/akuity/kargo/pkg/io"
+)
+
+// measureHTTP makes a single measurement by sending the HTTP request
+// described by the provided VerificationHTTPCheck and evaluating the response.
+func measureHTTP(
+	ctx context.Context,
+	check kargoapi.VerificationHTTPCheck,
+) (kargoapi.VerificationPhase, string) {
+	method := check.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	req, err := http.NewRequestWithContext(
+		ctx,
+		method,
+		check.URL,
+		bytes.NewBufferString(check.Body),
+	)
+	if err != nil {
+		return kargoapi.VerificationPhaseError, fmt.Sprintf("error building HTTP request: %s", err)
+	}
+	for _, header := range check.Headers {
+		req.Header.Add(header.Name, header.Value)
+	}
+
+	resp, err := newHTTPClient(check.InsecureSkipTLSVerify, check.Timeout).Do(req)
+	if err != nil {
+		return kargoapi.VerificationPhaseError,
+			fmt.Sprintf("error sending HTTP request to %s: %s", check.URL, err)
+	}
+	defer resp.Body.Close()
+
+	if check.SuccessExpression == "" && check.FailureExpression == "" {
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return kargoapi.VerificationPhaseSuccessful, ""
+		}
+		return kargoapi.VerificationPhaseFailed, fmt.Sprintf(
+			"HTTP request to %s returned non-2xx status %d",
+			check.URL, resp.StatusCode,
+		)
+	}
+
+	env, err := xhttp.BuildResponseExprEnv(ctx, resp, "")
+	if err != nil {
+		return kargoapi.VerificationPhaseError,
+			fmt.Sprintf("error building expression context from HTTP response: %s", err)
+	}
+	phase, err := evaluateOutcome(check.SuccessExpression, check.FailureExpression, env)
+	if err != nil {
+		return kargoapi.VerificationPhaseError, err.Error()
+	}
+	return phase, outcomeMessage(phase, fmt.Sprintf(
+		"HTTP (%d) response from %s", resp.StatusCode, check.URL,
+	))
+}
+
+// prometheusResponse is the response of the instant query endpoint of the
+// Prometheus HTTP API.
+type prometheusResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// measurePrometheus makes a single measurement by running the query described
+// by the provided VerificationPrometheusCheck and evaluating the result.
+func measurePrometheus(
+	ctx context.Context,
+	check kargoapi.VerificationPrometheusCheck,
+) (kargoapi.VerificationPhase, string) {
+	queryURL, err := url.JoinPath(check.Address, "api", "v1", "query")
+	if err != nil {
+		return kargoapi.VerificationPhaseError,
+			fmt.Sprintf("error building query URL from address %q: %s", check.Address, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, queryURL, nil)
+	if err != nil {
+		return kargoapi.VerificationPhaseError, fmt.Sprintf("error building HTTP request: %s", err)
+	}
+	req.URL.RawQuery = url.Values{"query": []string{check.Query}}.Encode()
+	for _, header := range check.Headers {
+		req.Header.Add(header.Name, header.Value)
+	}
+
+	resp, err := newHTTPClient(check.InsecureSkipTLSVerify, check.Timeout).Do(req)
+	if err != nil {
+		return kargoapi.VerificationPhaseError,
+			fmt.Sprintf("error querying %s: %s", check.Address, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.LimitRead(resp.Body, xhttp.MaxResponseBytes)
+	if err != nil {
+		return kargoapi.VerificationPhaseError, fmt.Sprintf("error reading query response: %s", err)
+	}
+	promResp := prometheusResponse{}
+	if err = json.Unmarshal(body, &promResp); err != nil {
+		return kargoapi.VerificationPhaseError, fmt.Sprintf(
+			"error parsing (%d) response from %s: %s", resp.StatusCode, check.Address, err,
+		)
+	}
+	if promResp.Status != "success" {
+		return kargoapi.VerificationPhaseError, fmt.Sprintf(
+			"query against %s did not succeed: %s", check.Address, promResp.Error,
+		)
+	}
+	result, err := parsePrometheusResult(promResp.Data.ResultType, promResp.Data.Result)
+	if err != nil {
+		return kargoapi.VerificationPhaseError, err.Error()
+	}
+
+	phase, err := evaluateOutcome(
+		check.SuccessExpression,
+		check.FailureExpression,
+		map[string]any{"result": result},
+	)
+	if err != nil {
+		return kargoapi.VerificationPhaseError, err.Error()
+	}
+	return phase, outcomeMessage(phase, fmt.Sprintf("Query result %v", result))
+}
+
+// parsePrometheusResult converts the result of an instant query into a value
+// that can be used in expressions. Scalars are converted to a float64 and
+// instant vectors to a []float64.
+func parsePrometheusResult(resultType string, raw json.RawMessage) (any, error) {
+	switch resultType {
+	case "scalar":
+		var sample []any
+		if err := json.Unmarshal(raw, &sample); err != nil {
+			return nil, fmt.Errorf("error parsing scalar query result: %w", err)
+		}
+		return parsePrometheusSample(sample)
+	case "vector":
+		var samples []struct {
+			Value []any `json:"value"`
+		}
+		if err := json.Unmarshal(raw, &samples); err != nil {
+			return nil, fmt.Errorf("error parsing vector query result: %w", err)
+		}
+		values := make([]float64, len(samples))
+		for i, s := range samples {
+			var err error
+			if values[i], err = parsePrometheusSample(s.Value); err != nil {
+				return nil, err
+			}
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf(
+			"unsupported query result type %q; query must yield a scalar or an instant vector",
+			resultType,
+		)
+	}
+}
+
+// parsePrometheusSample parses a [<timestamp>, "<value>"] pair.
+func parsePrometheusSample(sample []any) (float64, error) {
+	if len(sample) != 2 {
+		return 0, fmt.Errorf("unexpected query result sample %v", sample)
+	}
+	str, ok := sample[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected query result sample value %v", sample[1])
+	}
+	value, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing query result sample value %q: %w", str, err)
+	}
+	return value, nil
+}
+
+// evaluateOutcome determines the outcome of a measurement by evaluating the
+// provided success and failure expressions over the provided environment. A
+// met failure expression takes precedence over a met success expression. If
+// only a success expression is defined and it is not met, the measurement has
+// failed. If only a failure expression is defined and it is not met, the
+// measurement was successful. If both are defined and neither is met, the
+// measurement was inconclusive.
+func evaluateOutcome(
+	successExpr string,
+	failureExpr string,
+	env map[string]any,
+) (kargoapi.VerificationPhase, error) {
+	failure, err := evaluateBoolExpression("failure", failureExpr, env)
+	if err != nil {
+		return "", err
+	}
+	if failure != nil && *failure {
+		return kargoapi.VerificationPhaseFailed, nil
+	}
+	success, err := evaluateBoolExpression("success", successExpr, env)
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case success != nil && *success:
+		return kargoapi.VerificationPhaseSuccessful, nil
+	case success != nil && failure != nil:
+		return kargoapi.VerificationPhaseInconclusive, nil
+	case success != nil:
+		return kargoapi.VerificationPhaseFailed, nil
+	default:
+		return kargoapi.VerificationPhaseSuccessful, nil
+	}
+}
+
+// evaluateBoolExpression evaluates the provided expression over the provided
+// environment. If the expression is empty, it returns nil.
+func evaluateBoolExpression(name, expression string, env map[string]any) (*bool, error) {
+	if expression == "" {
+		return nil, nil
+	}
+	program, err := expr.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling %s expression %q: %w", name, expression, err)
+	}
+	resAny, err := expr.Run(program, env)
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating %s expression %q: %w", name, expression, err)
+	}
+	res, ok := resAny.(bool)
+	if !ok {
+		return nil, fmt.Errorf(
+			"%s expression %q did not evaluate to a boolean (got %T)",
+			name, expression, resAny,
+		)
+	}
+	return &res, nil
+}
+
+// outcomeMessage returns a message describing a measurement that was not
+// successful.
+func outcomeMessage(phase kargoapi.VerificationPhase, subject string) string {
+	switch phase {
+	case kargoapi.VerificationPhaseFailed:
+		return subject + " met failure criteria"
+	case kargoapi.VerificationPhaseInconclusive:
+		return subject + " met neither success nor failure criteria"
+	default:
+		return ""
+	}
+}
+
+func newHTTPClient(insecureSkipTLSVerify bool, timeout *metav1.Duration) *http.Client {
+	httpTransport := cleanhttp.DefaultTransport()
+	if insecureSkipTLSVerify {
+		httpTransport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+	}
+	t := defaultTimeout
+	if timeout != nil && timeout.Duration > 0 {
+		t = timeout.Duration
+	}
+	return &http.Client{
+		Transport: httpTransport,
+		Timeout:   t,
+	}
+}
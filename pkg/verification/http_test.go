@@ -0,0 +1,218 @@
+package verification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_measurePrometheus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/query" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("query") {
+		case "scalar(up)":
+			_, _ = w.Write([]byte(
+				`{"status":"success","data":{"resultType":"scalar","result":[1700000000.123,"1"]}}`,
+			))
+		case "error_rate":
+			_, _ = w.Write([]byte(
+				`{"status":"success","data":{"resultType":"vector","result":[` +
+					`{"metric":{"pod":"a"},"value":[1700000000.123,"0.01"]},` +
+					`{"metric":{"pod":"b"},"value":[1700000000.123,"0.2"]}]}}`,
+			))
+		case "range[5m]":
+			_, _ = w.Write([]byte(
+				`{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+			))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":"error","errorType":"bad_data","error":"parse error"}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	testCases := []struct {
+		name       string
+		check      kargoapi.VerificationPrometheusCheck
+		assertions func(*testing.T, kargoapi.VerificationPhase, string)
+	}{
+		{
+			name: "scalar meets success criteria",
+			check: kargoapi.VerificationPrometheusCheck{
+				Address:           srv.URL,
+				Query:             "scalar(up)",
+				SuccessExpression: "result == 1",
+			},
+			assertions: func(t *testing.T, phase kargoapi.VerificationPhase, msg string) {
+				require.Equal(t, kargoapi.VerificationPhaseSuccessful, phase)
+				require.Empty(t, msg)
+			},
+		},
+		{
+			name: "vector meets failure criteria",
+			check: kargoapi.VerificationPrometheusCheck{
+				Address:           srv.URL + "/",
+				Query:             "error_rate",
+				FailureExpression: "any(result, # > 0.1)",
+			},
+			assertions: func(t *testing.T, phase kargoapi.VerificationPhase, msg string) {
+				require.Equal(t, kargoapi.VerificationPhaseFailed, phase)
+				require.Contains(t, msg, "met failure criteria")
+			},
+		},
+		{
+			name: "unsupported result type",
+			check: kargoapi.VerificationPrometheusCheck{
+				Address:           srv.URL,
+				Query:             "range[5m]",
+				SuccessExpression: "true",
+			},
+			assertions: func(t *testing.T, phase kargoapi.VerificationPhase, msg string) {
+				require.Equal(t, kargoapi.VerificationPhaseError, phase)
+				require.Contains(t, msg, `unsupported query result type "matrix"`)
+			},
+		},
+		{
+			name: "query error",
+			check: kargoapi.VerificationPrometheusCheck{
+				Address:           srv.URL,
+				Query:             "invalid(",
+				SuccessExpression: "true",
+			},
+			assertions: func(t *testing.T, phase kargoapi.VerificationPhase, msg string) {
+				require.Equal(t, kargoapi.VerificationPhaseError, phase)
+				require.Contains(t, msg, "did not succeed: parse error")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			phase, msg := measurePrometheus(context.Background(), testCase.check)
+			testCase.assertions(t, phase, msg)
+		})
+	}
+}
+
+func Test_parsePrometheusResult(t *testing.T) {
+	testCases := []struct {
+		name       string
+		resultType string
+		raw        string
+		assertions func(*testing.T, any, error)
+	}{
+		{
+			name:       "scalar",
+			resultType: "scalar",
+			raw:        `[1700000000.123,"0.5"]`,
+			assertions: func(t *testing.T, res any, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 0.5, res)
+			},
+		},
+		{
+			name:       "empty vector",
+			resultType: "vector",
+			raw:        `[]`,
+			assertions: func(t *testing.T, res any, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []float64{}, res)
+			},
+		},
+		{
+			name:       "invalid sample value",
+			resultType: "vector",
+			raw:        `[{"value":[1700000000.123,"not a number"]}]`,
+			assertions: func(t *testing.T, _ any, err error) {
+				require.ErrorContains(t, err, "error parsing query result sample value")
+			},
+		},
+		{
+			name:       "string",
+			resultType: "string",
+			raw:        `[1700000000.123,"foo"]`,
+			assertions: func(t *testing.T, _ any, err error) {
+				require.ErrorContains(t, err, "unsupported query result type")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := parsePrometheusResult(testCase.resultType, json.RawMessage(testCase.raw))
+			testCase.assertions(t, res, err)
+		})
+	}
+}
+
+func Test_evaluateOutcome(t *testing.T) {
+	env := map[string]any{"result": 5}
+	testCases := []struct {
+		name        string
+		successExpr string
+		failureExpr string
+		expected    kargoapi.VerificationPhase
+		expectedErr string
+	}{
+		{
+			name:     "no expressions",
+			expected: kargoapi.VerificationPhaseSuccessful,
+		},
+		{
+			name:        "success expression met",
+			successExpr: "result > 1",
+			expected:    kargoapi.VerificationPhaseSuccessful,
+		},
+		{
+			name:        "success expression not met",
+			successExpr: "result > 10",
+			expected:    kargoapi.VerificationPhaseFailed,
+		},
+		{
+			name:        "failure expression met",
+			failureExpr: "result > 1",
+			expected:    kargoapi.VerificationPhaseFailed,
+		},
+		{
+			name:        "failure expression not met",
+			failureExpr: "result > 10",
+			expected:    kargoapi.VerificationPhaseSuccessful,
+		},
+		{
+			name:        "failure takes precedence",
+			successExpr: "result > 1",
+			failureExpr: "result > 1",
+			expected:    kargoapi.VerificationPhaseFailed,
+		},
+		{
+			name:        "neither met",
+			successExpr: "result > 10",
+			failureExpr: "result < 1",
+			expected:    kargoapi.VerificationPhaseInconclusive,
+		},
+		{
+			name:        "not a boolean",
+			successExpr: "result",
+			expectedErr: "did not evaluate to a boolean",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			phase, err := evaluateOutcome(testCase.successExpr, testCase.failureExpr, env)
+			if testCase.expectedErr != "" {
+				require.ErrorContains(t, err, testCase.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, phase)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package verification
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/kubernetes"
+	"github.com/akuity/kargo/pkg/strings"
+)
+
+// maxJobNamePrefixLen is the maximum length of the prefix of the names of
+// Jobs implementing measurements. Names of Jobs must not exceed 63 characters
+// and the API server appends five random characters to the prefix.
+const maxJobNamePrefixLen = 57
+
+// startJob creates a Job implementing a single measurement of the provided
+// check and returns its name.
+func (r *Runner) startJob(
+	ctx context.Context,
+	req Request,
+	check kargoapi.VerificationCheck,
+) (string, error) {
+	stage := req.Stage
+	shortStageName := kubernetes.ShortenLabelValue(stage.Name)
+
+	labels := maps.Clone(check.Job.Labels)
+	if labels == nil {
+		labels = make(map[string]string, 2)
+	}
+	labels[kargoapi.LabelKeyStage] = shortStageName
+	labels[kargoapi.LabelKeyFreightCollection] = req.Freight.ID
+
+	annotations := maps.Clone(check.Job.Annotations)
+	if stage.Name != shortStageName {
+		if annotations == nil {
+			annotations = make(map[string]string, 1)
+		}
+		annotations[kargoapi.AnnotationKeyStage] = stage.Name
+	}
+
+	// The Job is owned by the Freight being verified, so it is garbage
+	// collected along with it.
+	ownerRefs := make([]metav1.OwnerReference, 0, len(req.Freight.Freight))
+	for _, ref := range req.Freight.Freight {
+		freight := &kargoapi.Freight{}
+		if err := r.client.Get(
+			ctx,
+			types.NamespacedName{Namespace: stage.Namespace, Name: ref.Name},
+			freight,
+		); err != nil {
+			return "", fmt.Errorf(
+				"error getting Freight %q in namespace %q: %w",
+				ref.Name, stage.Namespace, err,
+			)
+		}
+		ownerRefs = append(ownerRefs, metav1.OwnerReference{
+			APIVersion: kargoapi.GroupVersion.String(),
+			Kind:       "Freight",
+			Name:       freight.Name,
+			UID:        freight.UID,
+		})
+	}
+
+	namePrefix, _ := strings.HashShorten(
+		fmt.Sprintf("%s-%s", stage.Name, check.Name),
+		maxJobNamePrefixLen,
+		"-",
+		7,
+	)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       stage.Namespace,
+			GenerateName:    namePrefix + "-",
+			Labels:          labels,
+			Annotations:     annotations,
+			OwnerReferences: ownerRefs,
+		},
+		Spec: check.Job.Spec,
+	}
+	if err := r.client.Create(ctx, job); err != nil {
+		return "", fmt.Errorf(
+			"error creating Job in namespace %q: %w", stage.Namespace, err,
+		)
+	}
+	return job.Name, nil
+}
+
+// getJobResult returns the outcome of the measurement implemented by the
+// specified Job. The returned bool indicates whether the Job has finished.
+func (r *Runner) getJobResult(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (kargoapi.VerificationPhase, string, bool) {
+	job := &batchv1.Job{}
+	if err := r.client.Get(
+		ctx,
+		types.NamespacedName{Namespace: namespace, Name: name},
+		job,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return kargoapi.VerificationPhaseError,
+				fmt.Sprintf("Job %q in namespace %q no longer exists", name, namespace),
+				true
+		}
+		// Try again later.
+		return "", "", false
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return kargoapi.VerificationPhaseSuccessful, "", true
+		case batchv1.JobFailed:
+			msg := fmt.Sprintf("Job %q failed", name)
+			if cond.Message != "" {
+				msg = fmt.Sprintf("%s: %s", msg, cond.Message)
+			}
+			return kargoapi.VerificationPhaseFailed, msg, true
+		}
+	}
+	return "", "", false
+}
+
+// deleteJob deletes the specified Job along with its Pods.
+func (r *Runner) deleteJob(ctx context.Context, namespace, name string) error {
+	if err := r.client.Delete(
+		ctx,
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		},
+		client.PropagationPolicy(metav1.DeletePropagationBackground),
+	); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf(
+			"error deleting Job %q in namespace %q: %w", name, namespace, err,
+		)
+	}
+	return nil
+}
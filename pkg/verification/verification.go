@@ -0,0 +1,400 @@
+package verification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/expr-lang/expr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/expressions"
+	"github.com/akuity/kargo/pkg/logging"
+)
+
+const (
+	// defaultCount is the number of measurements made by a check that does not
+	// specify a count.
+	defaultCount = 1
+	// defaultInterval is the interval between measurements of a check that
+	// does not specify an interval.
+	defaultInterval = time.Minute
+	// defaultTimeout is the timeout of HTTP requests made by checks that do not
+	// specify a timeout.
+	defaultTimeout = 10 * time.Second
+	// jobPollInterval is the interval at which the Job implementing an
+	// in-progress measurement is checked for completion.
+	jobPollInterval = 10 * time.Second
+
+	// varsEnvKey is the key for variables in the ExpressionConfig.Env map.
+	varsEnvKey = "vars"
+)
+
+// ExpressionConfig holds the configuration for the evaluation of expressions
+// contained in the configuration of checks.
+type ExpressionConfig struct {
+	// Env is a (nested) map of variables that can be used in the expressions.
+	Env map[string]any
+	// Vars are the variables that can be used in the expressions. They are
+	// evaluated in the order they are defined. After the evaluation of the
+	// variables, they are available in the Env map as `${{ vars.<name> }}`.
+	Vars []kargoapi.ExpressionVariable
+	// Options are the options for the expression evaluation. It can be used to
+	// configure the behavior of the expression evaluation and the functions
+	// available.
+	Options []expr.Option
+}
+
+// Request describes a Verification process implemented by checks that are
+// performed natively by Kargo.
+type Request struct {
+	// Stage is the Stage whose current Freight is being verified. The checks
+	// performed are those of the Stage's Verification.
+	Stage *kargoapi.Stage
+	// Freight is the FreightCollection being verified.
+	Freight kargoapi.FreightCollection
+	// Info is the last known state of the Verification process.
+	Info kargoapi.VerificationInfo
+	// ExpressionConfig is the configuration for the evaluation of expressions
+	// contained in the configuration of checks.
+	ExpressionConfig ExpressionConfig
+}
+
+// Runner performs the checks of Verification processes that do not rely on
+// Argo Rollouts.
+type Runner struct {
+	client client.Client
+}
+
+// NewRunner returns a new Runner that uses the provided client to manage the
+// Jobs implementing measurements.
+func NewRunner(c client.Client) *Runner {
+	return &Runner{client: c}
+}
+
+// Run makes all measurements that are due for the checks of the Verification
+// process described by the provided Request and returns the updated state of
+// the process. Measurements implemented by Jobs are started, and their results
+// collected by subsequent calls, once they have completed.
+//
+// As soon as any check has failed, errored, or was inconclusive, the process
+// is concluded and measurements of the remaining checks are abandoned.
+func (r *Runner) Run(ctx context.Context, req Request, now time.Time) kargoapi.VerificationInfo {
+	info := *req.Info.DeepCopy()
+
+	var checks []kargoapi.VerificationCheck
+	if req.Stage.Spec.Verification != nil {
+		checks = req.Stage.Spec.Verification.Checks
+	}
+	if len(checks) == 0 {
+		info.Phase = kargoapi.VerificationPhaseError
+		info.Message = "Stage verification does not define any checks"
+		info.FinishTime = &metav1.Time{Time: now}
+		return info
+	}
+
+	eval := &evaluator{cfg: req.ExpressionConfig}
+	results := make([]kargoapi.VerificationCheckResult, len(checks))
+	for i, check := range checks {
+		res := kargoapi.VerificationCheckResult{
+			Name:  check.Name,
+			Phase: kargoapi.VerificationPhasePending,
+		}
+		for _, existing := range info.Checks {
+			if existing.Name == check.Name {
+				res = existing
+				break
+			}
+		}
+		if !res.Phase.IsTerminal() {
+			res = r.runCheck(ctx, req, eval, check, res, now)
+		}
+		results[i] = res
+	}
+	info.Checks = results
+	info.Phase, info.Message = summarize(results)
+
+	if info.Phase.IsTerminal() {
+		// Abandon any checks that have not concluded yet.
+		for i := range info.Checks {
+			res := &info.Checks[i]
+			if res.Phase.IsTerminal() {
+				continue
+			}
+			if res.Job != "" {
+				if err := r.deleteJob(ctx, req.Stage.Namespace, res.Job); err != nil {
+					logging.LoggerFromContext(ctx).Error(
+						err, "error deleting verification Job",
+						"job", res.Job,
+					)
+				}
+				res.Job = ""
+			}
+			res.Phase = kargoapi.VerificationPhaseAborted
+			res.Message = "Verification concluded before check completed"
+		}
+		info.FinishTime = &metav1.Time{Time: now}
+	}
+	return info
+}
+
+// Abort abandons all in-progress measurements of the Verification process
+// described by the provided VerificationInfo by deleting the Jobs
+// implementing them.
+func (r *Runner) Abort(ctx context.Context, namespace string, info kargoapi.VerificationInfo) error {
+	var errs []error
+	for _, res := range info.Checks {
+		if res.Job == "" {
+			continue
+		}
+		if err := r.deleteJob(ctx, namespace, res.Job); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// RequeueAfter returns the duration after which the Verification process
+// described by the provided VerificationInfo should next be advanced by
+// calling Run. It returns false if the process has concluded or is not
+// implemented by checks performed natively by Kargo.
+func RequeueAfter(
+	verification *kargoapi.Verification,
+	info *kargoapi.VerificationInfo,
+	now time.Time,
+) (time.Duration, bool) {
+	if verification == nil || !info.HasChecks() || info.Phase.IsTerminal() {
+		return 0, false
+	}
+	var next time.Duration
+	var found bool
+	for _, res := range info.Checks {
+		if res.Phase.IsTerminal() {
+			continue
+		}
+		var d time.Duration
+		switch {
+		case res.Job != "":
+			d = jobPollInterval
+		case res.LastMeasurementTime == nil:
+			d = 0
+		default:
+			interval := defaultInterval
+			for _, check := range verification.Checks {
+				if check.Name == res.Name {
+					interval = getInterval(check)
+					break
+				}
+			}
+			d = max(res.LastMeasurementTime.Add(interval).Sub(now), 0)
+		}
+		if !found || d < next {
+			next, found = d, true
+		}
+	}
+	return next, found
+}
+
+// runCheck collects the result of any in-progress measurement of the provided
+// check and makes the next measurement if one is due.
+func (r *Runner) runCheck(
+	ctx context.Context,
+	req Request,
+	eval *evaluator,
+	check kargoapi.VerificationCheck,
+	res kargoapi.VerificationCheckResult,
+	now time.Time,
+) kargoapi.VerificationCheckResult {
+	if res.Job != "" {
+		phase, msg, done := r.getJobResult(ctx, req.Stage.Namespace, res.Job)
+		if !done {
+			return res
+		}
+		res.Job = ""
+		recordMeasurement(&res, phase, msg)
+		if res.Phase = getCheckPhase(check, res); res.Phase.IsTerminal() {
+			return res
+		}
+	}
+
+	if res.Count >= getCount(check) {
+		return res
+	}
+	if res.LastMeasurementTime != nil && now.Before(res.LastMeasurementTime.Add(getInterval(check))) {
+		return res
+	}
+
+	res.Count++
+	res.LastMeasurementTime = &metav1.Time{Time: now}
+
+	evaluated, err := eval.evaluateCheck(check)
+	if err != nil {
+		recordMeasurement(
+			&res,
+			kargoapi.VerificationPhaseError,
+			fmt.Sprintf("error evaluating expressions in check configuration: %s", err),
+		)
+		res.Phase = getCheckPhase(check, res)
+		return res
+	}
+
+	var phase kargoapi.VerificationPhase
+	var msg string
+	switch {
+	case evaluated.Job != nil:
+		var jobName string
+		if jobName, err = r.startJob(ctx, req, evaluated); err != nil {
+			phase = kargoapi.VerificationPhaseError
+			msg = fmt.Sprintf("error starting Job: %s", err)
+			break
+		}
+		res.Job = jobName
+		res.Phase = kargoapi.VerificationPhaseRunning
+		return res
+	case evaluated.HTTP != nil:
+		phase, msg = measureHTTP(ctx, *evaluated.HTTP)
+	case evaluated.Prometheus != nil:
+		phase, msg = measurePrometheus(ctx, *evaluated.Prometheus)
+	default:
+		phase = kargoapi.VerificationPhaseError
+		msg = "check does not specify a job, http, or prometheus measurement"
+	}
+	recordMeasurement(&res, phase, msg)
+	res.Phase = getCheckPhase(check, res)
+	return res
+}
+
+// recordMeasurement records the outcome of a single measurement in the
+// provided VerificationCheckResult.
+func recordMeasurement(
+	res *kargoapi.VerificationCheckResult,
+	phase kargoapi.VerificationPhase,
+	msg string,
+) {
+	switch phase {
+	case kargoapi.VerificationPhaseSuccessful:
+		res.Successful++
+	case kargoapi.VerificationPhaseFailed:
+		res.Failed++
+	case kargoapi.VerificationPhaseInconclusive:
+		res.Inconclusive++
+	default:
+		res.Error++
+	}
+	res.Message = msg
+}
+
+// getCheckPhase returns the phase of a check based on the outcomes of the
+// measurements made so far.
+func getCheckPhase(
+	check kargoapi.VerificationCheck,
+	res kargoapi.VerificationCheckResult,
+) kargoapi.VerificationPhase {
+	switch {
+	case res.Failed > check.FailureLimit:
+		return kargoapi.VerificationPhaseFailed
+	case res.Error > check.FailureLimit:
+		return kargoapi.VerificationPhaseError
+	case res.Inconclusive > 0:
+		return kargoapi.VerificationPhaseInconclusive
+	case res.Job == "" && res.Count >= getCount(check):
+		return kargoapi.VerificationPhaseSuccessful
+	default:
+		return kargoapi.VerificationPhaseRunning
+	}
+}
+
+// summarize returns the phase of a Verification process and a message
+// describing it, based on the results of its checks.
+func summarize(results []kargoapi.VerificationCheckResult) (kargoapi.VerificationPhase, string) {
+	for _, phase := range []kargoapi.VerificationPhase{
+		kargoapi.VerificationPhaseError,
+		kargoapi.VerificationPhaseFailed,
+		kargoapi.VerificationPhaseInconclusive,
+	} {
+		for _, res := range results {
+			if res.Phase == phase {
+				return phase, fmt.Sprintf(
+					"Check %q concluded with phase %s: %s",
+					res.Name, res.Phase, res.Message,
+				)
+			}
+		}
+	}
+	for _, res := range results {
+		if res.Phase != kargoapi.VerificationPhaseSuccessful {
+			return kargoapi.VerificationPhaseRunning, ""
+		}
+	}
+	return kargoapi.VerificationPhaseSuccessful, ""
+}
+
+func getCount(check kargoapi.VerificationCheck) int32 {
+	if check.Count > 0 {
+		return check.Count
+	}
+	return defaultCount
+}
+
+func getInterval(check kargoapi.VerificationCheck) time.Duration {
+	if check.Interval != nil {
+		return check.Interval.Duration
+	}
+	return defaultInterval
+}
+
+// evaluator evaluates expressions contained in the configuration of checks.
+// The environment, including any variables, is built lazily and at most once.
+type evaluator struct {
+	cfg      ExpressionConfig
+	env      map[string]any
+	envErr   error
+	envBuilt bool
+}
+
+// evaluateCheck returns a copy of the provided check with all expressions
+// contained in its configuration evaluated.
+func (e *evaluator) evaluateCheck(check kargoapi.VerificationCheck) (kargoapi.VerificationCheck, error) {
+	env, err := e.getEnv()
+	if err != nil {
+		return check, err
+	}
+	checkJSON, err := json.Marshal(check)
+	if err != nil {
+		return check, err
+	}
+	if checkJSON, err = expressions.EvaluateJSONTemplate(checkJSON, env, e.cfg.Options...); err != nil {
+		return check, err
+	}
+	var evaluated kargoapi.VerificationCheck
+	if err = json.Unmarshal(checkJSON, &evaluated); err != nil {
+		return check, err
+	}
+	return evaluated, nil
+}
+
+func (e *evaluator) getEnv() (map[string]any, error) {
+	if e.envBuilt {
+		return e.env, e.envErr
+	}
+	e.envBuilt = true
+	e.env = make(map[string]any, len(e.cfg.Env)+1)
+	for k, v := range e.cfg.Env {
+		e.env[k] = v
+	}
+	vars := make(map[string]any, len(e.cfg.Vars))
+	e.env[varsEnvKey] = vars
+	for _, v := range e.cfg.Vars {
+		value, err := expressions.EvaluateTemplate(v.Value, e.env, e.cfg.Options...)
+		if err != nil {
+			e.envErr = fmt.Errorf("error evaluating variable %q: %w", v.Name, err)
+			return nil, e.envErr
+		}
+		vars[v.Name] = value
+	}
+	return e.env, nil
+}
@@ -0,0 +1,522 @@
+package verification
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestRunner_Run(t *testing.T) {
+	const testNamespace = "fake-project"
+	now := time.Now().Truncate(time.Second)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthy":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"version":"v1.2.3"}`))
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
+
+	newStage := func(checks ...kargoapi.VerificationCheck) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "test"},
+			Spec: kargoapi.StageSpec{
+				Verification: &kargoapi.Verification{Checks: checks},
+			},
+		}
+	}
+	freightCol := kargoapi.FreightCollection{
+		ID: "fake-collection",
+		Freight: map[string]kargoapi.FreightReference{
+			"Warehouse/fake-warehouse": {Name: "fake-freight"},
+		},
+	}
+	freight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "fake-freight"},
+	}
+
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		stage      *kargoapi.Stage
+		info       kargoapi.VerificationInfo
+		exprCfg    ExpressionConfig
+		assertions func(*testing.T, client.Client, kargoapi.VerificationInfo)
+	}{
+		{
+			name:  "no checks",
+			stage: newStage(),
+			assertions: func(t *testing.T, _ client.Client, info kargoapi.VerificationInfo) {
+				require.Equal(t, kargoapi.VerificationPhaseError, info.Phase)
+				require.Contains(t, info.Message, "does not define any checks")
+				require.NotNil(t, info.FinishTime)
+			},
+		},
+		{
+			name: "single successful HTTP measurement",
+			stage: newStage(kargoapi.VerificationCheck{
+				Name: "version",
+				HTTP: &kargoapi.VerificationHTTPCheck{
+					URL:               "${{ vars.url }}",
+					SuccessExpression: "response.body.version == 'v1.2.3'",
+				},
+			}),
+			exprCfg: ExpressionConfig{
+				Vars: []kargoapi.ExpressionVariable{
+					{Name: "url", Value: srv.URL + "/healthy"},
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, info kargoapi.VerificationInfo) {
+				require.Equal(t, kargoapi.VerificationPhaseSuccessful, info.Phase)
+				require.NotNil(t, info.FinishTime)
+				require.Len(t, info.Checks, 1)
+				require.Equal(t, kargoapi.VerificationCheckResult{
+					Name:                "version",
+					Phase:               kargoapi.VerificationPhaseSuccessful,
+					Count:               1,
+					Successful:          1,
+					LastMeasurementTime: &metav1.Time{Time: now},
+				}, info.Checks[0])
+			},
+		},
+		{
+			name: "failed measurement within failure limit",
+			stage: newStage(kargoapi.VerificationCheck{
+				Name:         "availability",
+				Count:        3,
+				FailureLimit: 1,
+				HTTP:         &kargoapi.VerificationHTTPCheck{URL: srv.URL + "/unavailable"},
+			}),
+			assertions: func(t *testing.T, _ client.Client, info kargoapi.VerificationInfo) {
+				require.Equal(t, kargoapi.VerificationPhaseRunning, info.Phase)
+				require.Nil(t, info.FinishTime)
+				require.Equal(t, kargoapi.VerificationPhaseRunning, info.Checks[0].Phase)
+				require.Equal(t, int32(1), info.Checks[0].Failed)
+				require.Contains(t, info.Checks[0].Message, "non-2xx status 503")
+			},
+		},
+		{
+			name: "failed measurement exceeding failure limit",
+			stage: newStage(kargoapi.VerificationCheck{
+				Name:         "availability",
+				Count:        3,
+				FailureLimit: 1,
+				HTTP:         &kargoapi.VerificationHTTPCheck{URL: srv.URL + "/unavailable"},
+			}),
+			info: kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+				Checks: []kargoapi.VerificationCheckResult{{
+					Name:                "availability",
+					Phase:               kargoapi.VerificationPhaseRunning,
+					Count:               1,
+					Failed:              1,
+					LastMeasurementTime: &metav1.Time{Time: now.Add(-2 * time.Minute)},
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, info kargoapi.VerificationInfo) {
+				require.Equal(t, kargoapi.VerificationPhaseFailed, info.Phase)
+				require.Contains(t, info.Message, `Check "availability" concluded with phase Failed`)
+				require.NotNil(t, info.FinishTime)
+				require.Equal(t, int32(2), info.Checks[0].Count)
+				require.Equal(t, int32(2), info.Checks[0].Failed)
+			},
+		},
+		{
+			name: "measurement not yet due",
+			stage: newStage(kargoapi.VerificationCheck{
+				Name:     "availability",
+				Count:    2,
+				Interval: &metav1.Duration{Duration: 5 * time.Minute},
+				HTTP:     &kargoapi.VerificationHTTPCheck{URL: srv.URL + "/unavailable"},
+			}),
+			info: kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+				Checks: []kargoapi.VerificationCheckResult{{
+					Name:                "availability",
+					Phase:               kargoapi.VerificationPhaseRunning,
+					Count:               1,
+					Successful:          1,
+					LastMeasurementTime: &metav1.Time{Time: now.Add(-time.Minute)},
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, info kargoapi.VerificationInfo) {
+				require.Equal(t, kargoapi.VerificationPhaseRunning, info.Phase)
+				require.Equal(t, int32(1), info.Checks[0].Count)
+			},
+		},
+		{
+			name: "expression evaluation error",
+			stage: newStage(kargoapi.VerificationCheck{
+				Name: "version",
+				HTTP: &kargoapi.VerificationHTTPCheck{URL: "${{ vars.missing.url }}"},
+			}),
+			assertions: func(t *testing.T, _ client.Client, info kargoapi.VerificationInfo) {
+				require.Equal(t, kargoapi.VerificationPhaseError, info.Phase)
+				require.Equal(t, int32(1), info.Checks[0].Error)
+				require.Contains(t, info.Checks[0].Message, "error evaluating expressions")
+			},
+		},
+		{
+			name:    "Job measurement started",
+			objects: []client.Object{freight.DeepCopy()},
+			stage: newStage(kargoapi.VerificationCheck{
+				Name: "smoke-test",
+				Job: &kargoapi.VerificationJobCheck{
+					Labels: map[string]string{"team": "guestbook"},
+					Spec: batchv1.JobSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{{
+									Name:  "test",
+									Image: "alpine",
+									Args:  []string{"${{ ctx.stage }}"},
+								}},
+							},
+						},
+					},
+				},
+			}),
+			exprCfg: ExpressionConfig{
+				Env: map[string]any{"ctx": map[string]any{"stage": "test"}},
+			},
+			assertions: func(t *testing.T, c client.Client, info kargoapi.VerificationInfo) {
+				require.Equal(t, kargoapi.VerificationPhaseRunning, info.Phase)
+				require.Equal(t, int32(1), info.Checks[0].Count)
+				require.NotEmpty(t, info.Checks[0].Job)
+
+				job := &batchv1.Job{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: testNamespace, Name: info.Checks[0].Job},
+					job,
+				))
+				require.Equal(t, "test-smoke-test-", job.GenerateName)
+				require.Equal(t, map[string]string{
+					"team":                             "guestbook",
+					kargoapi.LabelKeyStage:             "test",
+					kargoapi.LabelKeyFreightCollection: "fake-collection",
+				}, job.Labels)
+				require.Len(t, job.OwnerReferences, 1)
+				require.Equal(t, "fake-freight", job.OwnerReferences[0].Name)
+				require.Equal(t, []string{"test"}, job.Spec.Template.Spec.Containers[0].Args)
+			},
+		},
+		{
+			name: "Job measurement completed",
+			objects: []client.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "test-smoke-test-abcde"},
+					Status: batchv1.JobStatus{
+						Conditions: []batchv1.JobCondition{{
+							Type:   batchv1.JobComplete,
+							Status: corev1.ConditionTrue,
+						}},
+					},
+				},
+			},
+			stage: newStage(kargoapi.VerificationCheck{
+				Name: "smoke-test",
+				Job:  &kargoapi.VerificationJobCheck{},
+			}),
+			info: kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+				Checks: []kargoapi.VerificationCheckResult{{
+					Name:                "smoke-test",
+					Phase:               kargoapi.VerificationPhaseRunning,
+					Count:               1,
+					LastMeasurementTime: &metav1.Time{Time: now.Add(-time.Minute)},
+					Job:                 "test-smoke-test-abcde",
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, info kargoapi.VerificationInfo) {
+				require.Equal(t, kargoapi.VerificationPhaseSuccessful, info.Phase)
+				require.Empty(t, info.Checks[0].Job)
+				require.Equal(t, int32(1), info.Checks[0].Successful)
+			},
+		},
+		{
+			name: "Job measurement in progress",
+			objects: []client.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "test-smoke-test-abcde"},
+				},
+			},
+			stage: newStage(kargoapi.VerificationCheck{
+				Name: "smoke-test",
+				Job:  &kargoapi.VerificationJobCheck{},
+			}),
+			info: kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+				Checks: []kargoapi.VerificationCheckResult{{
+					Name:                "smoke-test",
+					Phase:               kargoapi.VerificationPhaseRunning,
+					Count:               1,
+					LastMeasurementTime: &metav1.Time{Time: now.Add(-time.Minute)},
+					Job:                 "test-smoke-test-abcde",
+				}},
+			},
+			assertions: func(t *testing.T, _ client.Client, info kargoapi.VerificationInfo) {
+				require.Equal(t, kargoapi.VerificationPhaseRunning, info.Phase)
+				require.Equal(t, "test-smoke-test-abcde", info.Checks[0].Job)
+			},
+		},
+		{
+			name: "failed check abandons other checks",
+			objects: []client.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "test-smoke-test-abcde"},
+				},
+			},
+			stage: newStage(
+				kargoapi.VerificationCheck{
+					Name: "smoke-test",
+					Job:  &kargoapi.VerificationJobCheck{},
+				},
+				kargoapi.VerificationCheck{
+					Name: "availability",
+					HTTP: &kargoapi.VerificationHTTPCheck{URL: srv.URL + "/unavailable"},
+				},
+			),
+			info: kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+				Checks: []kargoapi.VerificationCheckResult{{
+					Name:                "smoke-test",
+					Phase:               kargoapi.VerificationPhaseRunning,
+					Count:               1,
+					LastMeasurementTime: &metav1.Time{Time: now.Add(-time.Minute)},
+					Job:                 "test-smoke-test-abcde",
+				}},
+			},
+			assertions: func(t *testing.T, c client.Client, info kargoapi.VerificationInfo) {
+				require.Equal(t, kargoapi.VerificationPhaseFailed, info.Phase)
+				require.Equal(t, kargoapi.VerificationPhaseAborted, info.Checks[0].Phase)
+				require.Empty(t, info.Checks[0].Job)
+				require.Equal(t, kargoapi.VerificationPhaseFailed, info.Checks[1].Phase)
+
+				err := c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: testNamespace, Name: "test-smoke-test-abcde"},
+					&batchv1.Job{},
+				)
+				require.True(t, apierrors.IsNotFound(err))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				Build()
+			info := NewRunner(c).Run(
+				context.Background(),
+				Request{
+					Stage:            testCase.stage,
+					Freight:          freightCol,
+					Info:             testCase.info,
+					ExpressionConfig: testCase.exprCfg,
+				},
+				now,
+			)
+			testCase.assertions(t, c, info)
+		})
+	}
+}
+
+func TestRunner_Abort(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fake-project", Name: "fake-job"},
+		},
+	).Build()
+
+	require.NoError(t, NewRunner(c).Abort(
+		context.Background(),
+		"fake-project",
+		kargoapi.VerificationInfo{
+			Checks: []kargoapi.VerificationCheckResult{
+				{Name: "in-progress", Job: "fake-job"},
+				{Name: "gone", Job: "missing-job"},
+				{Name: "concluded"},
+			},
+		},
+	))
+	err := c.Get(
+		context.Background(),
+		types.NamespacedName{Namespace: "fake-project", Name: "fake-job"},
+		&batchv1.Job{},
+	)
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func TestRequeueAfter(t *testing.T) {
+	now := time.Now()
+	verification := &kargoapi.Verification{
+		Checks: []kargoapi.VerificationCheck{
+			{Name: "default-interval"},
+			{Name: "short-interval", Interval: &metav1.Duration{Duration: 30 * time.Second}},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		verification *kargoapi.Verification
+		info         *kargoapi.VerificationInfo
+		expected     time.Duration
+		expectedOK   bool
+	}{
+		{
+			name:         "no verification info",
+			verification: verification,
+		},
+		{
+			name:         "no checks",
+			verification: verification,
+			info:         &kargoapi.VerificationInfo{Phase: kargoapi.VerificationPhaseRunning},
+		},
+		{
+			name:         "terminal",
+			verification: verification,
+			info: &kargoapi.VerificationInfo{
+				Phase:  kargoapi.VerificationPhaseSuccessful,
+				Checks: []kargoapi.VerificationCheckResult{{Name: "default-interval"}},
+			},
+		},
+		{
+			name:         "next measurement due",
+			verification: verification,
+			info: &kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+				Checks: []kargoapi.VerificationCheckResult{
+					{
+						Name:                "default-interval",
+						Phase:               kargoapi.VerificationPhaseRunning,
+						LastMeasurementTime: &metav1.Time{Time: now.Add(-10 * time.Second)},
+					},
+					{
+						Name:                "short-interval",
+						Phase:               kargoapi.VerificationPhaseRunning,
+						LastMeasurementTime: &metav1.Time{Time: now.Add(-10 * time.Second)},
+					},
+				},
+			},
+			expected:   20 * time.Second,
+			expectedOK: true,
+		},
+		{
+			name:         "Job in progress",
+			verification: verification,
+			info: &kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+				Checks: []kargoapi.VerificationCheckResult{
+					{
+						Name:                "default-interval",
+						Phase:               kargoapi.VerificationPhaseRunning,
+						LastMeasurementTime: &metav1.Time{Time: now},
+						Job:                 "fake-job",
+					},
+				},
+			},
+			expected:   jobPollInterval,
+			expectedOK: true,
+		},
+		{
+			name:         "overdue",
+			verification: verification,
+			info: &kargoapi.VerificationInfo{
+				Phase: kargoapi.VerificationPhaseRunning,
+				Checks: []kargoapi.VerificationCheckResult{
+					{
+						Name:                "short-interval",
+						Phase:               kargoapi.VerificationPhaseRunning,
+						LastMeasurementTime: &metav1.Time{Time: now.Add(-time.Hour)},
+					},
+				},
+			},
+			expected:   0,
+			expectedOK: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			d, ok := RequeueAfter(testCase.verification, testCase.info, now)
+			require.Equal(t, testCase.expectedOK, ok)
+			require.Equal(t, testCase.expected, d)
+		})
+	}
+}
+
+func Test_getCheckPhase(t *testing.T) {
+	testCases := []struct {
+		name     string
+		check    kargoapi.VerificationCheck
+		res      kargoapi.VerificationCheckResult
+		expected kargoapi.VerificationPhase
+	}{
+		{
+			name:     "failures exceed limit",
+			check:    kargoapi.VerificationCheck{Count: 5, FailureLimit: 1},
+			res:      kargoapi.VerificationCheckResult{Count: 2, Failed: 2},
+			expected: kargoapi.VerificationPhaseFailed,
+		},
+		{
+			name:     "errors exceed limit",
+			check:    kargoapi.VerificationCheck{Count: 5},
+			res:      kargoapi.VerificationCheckResult{Count: 1, Error: 1},
+			expected: kargoapi.VerificationPhaseError,
+		},
+		{
+			name:     "inconclusive",
+			check:    kargoapi.VerificationCheck{Count: 5},
+			res:      kargoapi.VerificationCheckResult{Count: 1, Inconclusive: 1},
+			expected: kargoapi.VerificationPhaseInconclusive,
+		},
+		{
+			name:     "all measurements made",
+			check:    kargoapi.VerificationCheck{Count: 3, FailureLimit: 1},
+			res:      kargoapi.VerificationCheckResult{Count: 3, Successful: 2, Failed: 1},
+			expected: kargoapi.VerificationPhaseSuccessful,
+		},
+		{
+			name:     "last measurement in progress",
+			check:    kargoapi.VerificationCheck{Count: 3},
+			res:      kargoapi.VerificationCheckResult{Count: 3, Successful: 2, Job: "fake-job"},
+			expected: kargoapi.VerificationPhaseRunning,
+		},
+		{
+			name:     "default count",
+			check:    kargoapi.VerificationCheck{},
+			res:      kargoapi.VerificationCheckResult{Count: 1, Successful: 1},
+			expected: kargoapi.VerificationPhaseSuccessful,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, getCheckPhase(testCase.check, testCase.res))
+		})
+	}
+}
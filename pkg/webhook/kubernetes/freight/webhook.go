@@ -173,13 +173,14 @@ func (w *webhook) ValidateCreate(
 		errs = append(errs, fieldErr)
 	}
 
-	if len(freight.Commits) == 0 && len(freight.Images) == 0 && len(freight.Charts) == 0 {
+	if len(freight.Commits) == 0 && len(freight.Images) == 0 && len(freight.Charts) == 0 &&
+		len(freight.OCIArtifacts) == 0 && len(freight.Releases) == 0 {
 		errs = append(
 			errs,
 			field.Invalid(
 				field.NewPath(""),
 				freight,
-				"freight must contain at least one commit, image, or chart",
+				"freight must contain at least one commit, image, chart, OCI artifact, or release",
 			),
 		)
 	}
@@ -360,15 +361,21 @@ func (a artifactType) FreightPath() string {
 		return "images"
 	case artifactTypeChart:
 		return "charts"
+	case artifactTypeOCI:
+		return "ociArtifacts"
+	case artifactTypeRelease:
+		return "releases"
 	default:
 		return ""
 	}
 }
 
 const (
-	artifactTypeGit   artifactType = "git"
-	artifactTypeImage artifactType = "image"
-	artifactTypeChart artifactType = "chart"
+	artifactTypeGit     artifactType = "git"
+	artifactTypeImage   artifactType = "image"
+	artifactTypeChart   artifactType = "chart"
+	artifactTypeOCI     artifactType = "oci"
+	artifactTypeRelease artifactType = "release"
 )
 
 type artifactSubscription struct {
@@ -409,6 +416,18 @@ func validateFreightArtifacts(
 				Type: artifactTypeChart,
 			}] = false
 		}
+		if repo.OCI != nil {
+			subscriptions[artifactSubscription{
+				URL:  repo.OCI.RepoURL,
+				Type: artifactTypeOCI,
+			}] = false
+		}
+		if repo.Release != nil {
+			subscriptions[artifactSubscription{
+				URL:  repo.Release.RepoURL,
+				Type: artifactTypeRelease,
+			}] = false
+		}
 	}
 
 	var errs field.ErrorList
@@ -475,6 +494,46 @@ func validateFreightArtifacts(
 		)
 	}
 
+	for _, art := range freight.OCIArtifacts {
+		sub := artifactSubscription{
+			URL:  art.RepoURL,
+			Type: artifactTypeOCI,
+		}
+		if _, ok := subscriptions[sub]; ok {
+			subscriptions[sub] = true
+			counts[sub]++
+			continue
+		}
+		errs = append(
+			errs,
+			field.Invalid(
+				field.NewPath("ociArtifacts"),
+				art,
+				fmt.Sprintf("no subscription found for OCI repository in Warehouse %q", warehouse.Name),
+			),
+		)
+	}
+
+	for _, release := range freight.Releases {
+		sub := artifactSubscription{
+			URL:  release.RepoURL,
+			Type: artifactTypeRelease,
+		}
+		if _, ok := subscriptions[sub]; ok {
+			subscriptions[sub] = true
+			counts[sub]++
+			continue
+		}
+		errs = append(
+			errs,
+			field.Invalid(
+				field.NewPath("releases"),
+				release,
+				fmt.Sprintf("no subscription found for release repository in Warehouse %q", warehouse.Name),
+			),
+		)
+	}
+
 	// Check that each subscription is found exactly once.
 	for sub, found := range subscriptions {
 		if !found {
@@ -544,5 +603,23 @@ func compareFreight(existing, updated *kargoapi.Freight) (*field.Path, any, bool
 		}
 	}
 
+	if len(existing.OCIArtifacts) != len(updated.OCIArtifacts) {
+		return field.NewPath("ociArtifacts"), updated.OCIArtifacts, false
+	}
+	for i, art := range existing.OCIArtifacts {
+		if !art.DeepEquals(&updated.OCIArtifacts[i]) {
+			return field.NewPath("ociArtifacts").Index(i), updated.OCIArtifacts[i], false
+		}
+	}
+
+	if len(existing.Releases) != len(updated.Releases) {
+		return field.NewPath("releases"), updated.Releases, false
+	}
+	for i, release := range existing.Releases {
+		if !release.DeepEquals(&updated.Releases[i]) {
+			return field.NewPath("releases").Index(i), updated.Releases[i], false
+		}
+	}
+
 	return nil, nil, true
 }
@@ -310,7 +310,7 @@ func Test_webhook_ValidateCreate(t *testing.T) {
 				require.Contains(
 					t,
 					statusErr.ErrStatus.Message,
-					"freight must contain at least one commit, image, or chart",
+					"freight must contain at least one commit, image, chart, OCI artifact, or release",
 				)
 			},
 		},
@@ -1066,6 +1066,44 @@ func Test_webhook_ValidateFreightArtifacts(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "Freight with OCI artifact not matching Warehouse subscription",
+			freight: &kargoapi.Freight{
+				OCIArtifacts: []kargoapi.OCIArtifact{
+					{
+						RepoURL: "fake-repo-url",
+					},
+				},
+			},
+			warehouse: &kargoapi.Warehouse{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.ErrorContains(
+					t,
+					errs[0],
+					"no subscription found for OCI repository in Warehouse",
+				)
+			},
+		},
+		{
+			name: "Freight with release not matching Warehouse subscription",
+			freight: &kargoapi.Freight{
+				Releases: []kargoapi.Release{
+					{
+						RepoURL: "fake-repo-url",
+					},
+				},
+			},
+			warehouse: &kargoapi.Warehouse{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.ErrorContains(
+					t,
+					errs[0],
+					"no subscription found for release repository in Warehouse",
+				)
+			},
+		},
 		{
 			name: "success",
 			freight: &kargoapi.Freight{
@@ -1098,6 +1136,16 @@ func Test_webhook_ValidateFreightArtifacts(t *testing.T) {
 						RepoURL: "fake-another-chart-repo-url",
 					},
 				},
+				OCIArtifacts: []kargoapi.OCIArtifact{
+					{
+						RepoURL: "fake-oci-repo-url",
+					},
+				},
+				Releases: []kargoapi.Release{
+					{
+						RepoURL: "fake-release-repo-url",
+					},
+				},
 			},
 			warehouse: &kargoapi.Warehouse{
 				Spec: kargoapi.WarehouseSpec{
@@ -1139,6 +1187,16 @@ func Test_webhook_ValidateFreightArtifacts(t *testing.T) {
 								RepoURL: "fake-another-chart-repo-url",
 							},
 						},
+						{
+							OCI: &kargoapi.OCISubscription{
+								RepoURL: "fake-oci-repo-url",
+							},
+						},
+						{
+							Release: &kargoapi.ReleaseSubscription{
+								RepoURL: "fake-release-repo-url",
+							},
+						},
 					},
 				},
 			},
@@ -1266,6 +1324,26 @@ func Test_webhook_CompareFreight(t *testing.T) {
 				require.False(t, eq)
 			},
 		},
+		{
+			name: "different OCI artifact contents",
+			old:  &kargoapi.Freight{OCIArtifacts: []kargoapi.OCIArtifact{{RepoURL: "oci1", Digest: "digest1"}}},
+			new:  &kargoapi.Freight{OCIArtifacts: []kargoapi.OCIArtifact{{RepoURL: "oci1", Digest: "digest2"}}},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("ociArtifacts").Index(0), path)
+				require.Equal(t, freight.OCIArtifacts[0], val)
+				require.False(t, eq)
+			},
+		},
+		{
+			name: "different release contents",
+			old:  &kargoapi.Freight{Releases: []kargoapi.Release{{RepoURL: "repo1", Tag: "v1.0.0"}}},
+			new:  &kargoapi.Freight{Releases: []kargoapi.Release{{RepoURL: "repo1", Tag: "v1.1.0"}}},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("releases").Index(0), path)
+				require.Equal(t, freight.Releases[0], val)
+				require.False(t, eq)
+			},
+		},
 	}
 
 	for _, tt := range tests {
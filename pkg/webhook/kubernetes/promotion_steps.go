@@ -10,42 +10,69 @@ import (
 	"github.com/akuity/kargo/pkg/promotion"
 )
 
+// PromotionStepBlock pairs a block of PromotionSteps with the path of the
+// field it was defined in.
+type PromotionStepBlock struct {
+	Path  *field.Path
+	Steps []kargoapi.PromotionStep
+}
+
 func ValidatePromotionSteps(
 	f *field.Path,
 	steps []kargoapi.PromotionStep,
 ) field.ErrorList {
+	return ValidatePromotionStepBlocks(PromotionStepBlock{Path: f, Steps: steps})
+}
+
+// ValidatePromotionStepBlocks validates multiple blocks of PromotionSteps
+// which share a single namespace of step aliases, such as the main sequence of
+// steps and the onFailure and finally blocks of a PromotionTemplate. The steps
+// of parallel groups share the same namespace.
+func ValidatePromotionStepBlocks(blocks ...PromotionStepBlock) field.ErrorList {
 	errs := field.ErrorList{}
-	indicesByAlias := make(map[string]int)
-	for i, step := range steps {
-		stepAlias := strings.TrimSpace(step.As)
+	pathsByAlias := make(map[string]*field.Path)
+	validateAlias := func(f *field.Path, alias string) {
+		stepAlias := strings.TrimSpace(alias)
 		if stepAlias == "" {
-			continue
+			return
 		}
-		if existingIndex, exists := indicesByAlias[stepAlias]; exists {
+		if existingPath, exists := pathsByAlias[stepAlias]; exists {
 			errs = append(
 				errs,
 				field.Invalid(
-					f.Index(i).Child("as"),
+					f.Child("as"),
 					stepAlias,
 					fmt.Sprintf(
 						"step alias duplicates that of %s",
-						f.Index(existingIndex),
+						existingPath,
 					),
 				),
 			)
 		} else {
-			indicesByAlias[stepAlias] = i
+			pathsByAlias[stepAlias] = f
 		}
 		if promotion.ReservedStepAliasRegex.MatchString(stepAlias) {
 			errs = append(
 				errs,
 				field.Invalid(
-					f.Index(i).Child("as"),
+					f.Child("as"),
 					stepAlias,
 					"step alias is reserved",
 				),
 			)
 		}
 	}
+	for _, block := range blocks {
+		for i, step := range block.Steps {
+			validateAlias(block.Path.Index(i), step.As)
+			if step.Parallel == nil {
+				continue
+			}
+			groupPath := block.Path.Index(i).Child("parallel", "steps")
+			for j, groupStep := range step.Parallel.Steps {
+				validateAlias(groupPath.Index(j), groupStep.As)
+			}
+		}
+	}
 	return errs
 }
@@ -65,3 +65,62 @@ func TestValidatePromotionSteps(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePromotionStepBlocks(t *testing.T) {
+	errs := ValidatePromotionStepBlocks(
+		PromotionStepBlock{
+			Path:  field.NewPath("steps"),
+			Steps: []kargoapi.PromotionStep{{As: "update"}, {}, {As: "update[0]"}},
+		},
+		PromotionStepBlock{
+			Path: field.NewPath("onFailure"),
+			Steps: []kargoapi.PromotionStep{
+				{As: "revert"},
+				{As: "on-failure-step-1"},
+				{
+					As: "group",
+					Parallel: &kargoapi.PromotionStepGroup{
+						Steps: []kargoapi.PromotionGroupStep{
+							{Uses: "fake-step", As: "notify"},
+							{Uses: "fake-step", As: "revert"}, // Duplicate within a group!
+						},
+					},
+				},
+			},
+		},
+		PromotionStepBlock{
+			Path:  field.NewPath("finally"),
+			Steps: []kargoapi.PromotionStep{{As: "update"}}, // Duplicate across blocks!
+		},
+	)
+	require.Equal(
+		t,
+		field.ErrorList{
+			{
+				Type:     field.ErrorTypeInvalid,
+				Field:    "steps[2].as",
+				BadValue: "update[0]",
+				Detail:   "step alias is reserved",
+			},
+			{
+				Type:     field.ErrorTypeInvalid,
+				Field:    "onFailure[1].as",
+				BadValue: "on-failure-step-1",
+				Detail:   "step alias is reserved",
+			},
+			{
+				Type:     field.ErrorTypeInvalid,
+				Field:    "onFailure[2].parallel.steps[1].as",
+				BadValue: "revert",
+				Detail:   "step alias duplicates that of onFailure[0]",
+			},
+			{
+				Type:     field.ErrorTypeInvalid,
+				Field:    "finally[0].as",
+				BadValue: "update",
+				Detail:   "step alias duplicates that of steps[0]",
+			},
+		},
+		errs,
+	)
+}
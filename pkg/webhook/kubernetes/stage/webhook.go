@@ -208,21 +208,30 @@ func (w *webhook) validateSpec(
 		return errs
 	}
 
-	errs = append(
-		errs,
-		libWebhook.ValidatePromotionSteps(
-			f.Child("promotionTemplate").Child("spec").Child("steps"),
-			spec.PromotionTemplate.Spec.Steps,
-		)...,
-	)
+	templateSpecPath := f.Child("promotionTemplate").Child("spec")
+	blocks := []libWebhook.PromotionStepBlock{
+		{
+			Path:  templateSpecPath.Child("steps"),
+			Steps: spec.PromotionTemplate.Spec.Steps,
+		},
+		{
+			Path:  templateSpecPath.Child("onFailure"),
+			Steps: spec.PromotionTemplate.Spec.OnFailure,
+		},
+		{
+			Path:  templateSpecPath.Child("finally"),
+			Steps: spec.PromotionTemplate.Spec.Finally,
+		},
+	}
 
-	errs = append(
-		errs,
-		w.validatePromotionStepTaskRefsFn(
-			f.Child("promotionTemplate").Child("spec").Child("steps"),
-			spec.PromotionTemplate.Spec.Steps,
-		)...,
-	)
+	errs = append(errs, libWebhook.ValidatePromotionStepBlocks(blocks...)...)
+
+	for _, block := range blocks {
+		errs = append(
+			errs,
+			w.validatePromotionStepTaskRefsFn(block.Path, block.Steps)...,
+		)
+	}
 
 	return errs
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -16,6 +17,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/pkg/cron"
 	"github.com/akuity/kargo/pkg/image"
 	"github.com/akuity/kargo/pkg/urls"
 	libWebhook "github.com/akuity/kargo/pkg/webhook/kubernetes"
@@ -131,7 +133,64 @@ func (w *webhook) validateSpec(
 	if spec == nil { // nil spec is caught by declarative validations
 		return nil
 	}
-	return w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)
+	errs := w.validateSubs(f.Child("subscriptions"), spec.Subscriptions)
+	errs = append(errs, validateSchedule(f, spec)...)
+	return append(
+		errs,
+		validateBlackoutWindows(f.Child("blackoutWindows"), spec.BlackoutWindows)...,
+	)
+}
+
+func validateSchedule(f *field.Path, spec *kargoapi.WarehouseSpec) field.ErrorList {
+	var errs field.ErrorList
+	loc := time.UTC
+	if spec.TimeZone != "" {
+		if spec.Schedule == "" {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Child("timeZone"),
+					spec.TimeZone,
+					fmt.Sprintf(
+						"%s may only be specified along with %s",
+						f.Child("timeZone"),
+						f.Child("schedule"),
+					),
+				),
+			)
+		}
+		var err error
+		if loc, err = time.LoadLocation(spec.TimeZone); err != nil {
+			errs = append(errs, field.Invalid(f.Child("timeZone"), spec.TimeZone, err.Error()))
+			loc = time.UTC
+		}
+	}
+	if spec.Schedule != "" {
+		if _, err := cron.Parse(spec.Schedule, loc); err != nil {
+			errs = append(errs, field.Invalid(f.Child("schedule"), spec.Schedule, err.Error()))
+		}
+	}
+	return errs
+}
+
+func validateBlackoutWindows(
+	f *field.Path,
+	windows []kargoapi.BlackoutWindow,
+) field.ErrorList {
+	var errs field.ErrorList
+	for i, window := range windows {
+		if !window.End.After(window.Start.Time) {
+			errs = append(
+				errs,
+				field.Invalid(
+					f.Index(i).Child("end"),
+					window.End,
+					fmt.Sprintf("must be after %s", f.Index(i).Child("start")),
+				),
+			)
+		}
+	}
+	return errs
 }
 
 func (w *webhook) validateSubs(
@@ -168,6 +227,14 @@ func (w *webhook) validateSub(
 		repoTypes++
 		errs = append(errs, w.validateChartSub(f.Child("chart"), *sub.Chart, seen)...)
 	}
+	if sub.OCI != nil {
+		repoTypes++
+		errs = append(errs, w.validateOCISub(f.Child("oci"), *sub.OCI, seen)...)
+	}
+	if sub.Release != nil {
+		repoTypes++
+		errs = append(errs, w.validateReleaseSub(f.Child("release"), *sub.Release, seen)...)
+	}
 	if repoTypes != 1 {
 		errs = append(
 			errs,
@@ -175,7 +242,9 @@ func (w *webhook) validateSub(
 				f,
 				sub,
 				fmt.Sprintf(
-					"exactly one of %s.git, %s.image, or %s.chart must be non-empty",
+					"exactly one of %s.git, %s.image, %s.chart, %s.oci, or %s.release must be non-empty",
+					f.String(),
+					f.String(),
 					f.String(),
 					f.String(),
 					f.String(),
@@ -270,6 +339,46 @@ func (w *webhook) validateChartSub(
 	return errs
 }
 
+func (w *webhook) validateOCISub(
+	f *field.Path,
+	sub kargoapi.OCISubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	var errs field.ErrorList
+	if sub.SelectionStrategy == kargoapi.ImageSelectionStrategySemVer || sub.SelectionStrategy == "" {
+		if sub.Constraint != "" {
+			if err := validateSemverConstraint(
+				f.Child("constraint"),
+				sub.Constraint,
+			); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if err := seen.addOCI(sub, f); err != nil {
+		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
+	}
+	return errs
+}
+
+func (w *webhook) validateReleaseSub(
+	f *field.Path,
+	sub kargoapi.ReleaseSubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	var errs field.ErrorList
+	if err := validateSemverConstraint(
+		f.Child("semverConstraint"),
+		sub.SemverConstraint,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	if err := seen.addRelease(sub, f); err != nil {
+		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
+	}
+	return errs
+}
+
 func validateSemverConstraint(
 	f *field.Path,
 	semverConstraint string,
@@ -325,3 +434,23 @@ func (s uniqueSubSet) addChart(sub kargoapi.ChartSubscription, isHTTP bool, p *f
 	s[k] = p
 	return nil
 }
+
+func (s uniqueSubSet) addOCI(sub kargoapi.OCISubscription, p *field.Path) error {
+	// As with images, the normalization of Helm chart repository URLs is used
+	// here to ensure the uniqueness of the repository reference.
+	k := subscriptionKey{kind: "oci", id: urls.NormalizeChart(sub.RepoURL)}
+	if _, exists := s[k]; exists {
+		return fmt.Errorf("subscription for OCI repository already exists at %q", s[k])
+	}
+	s[k] = p
+	return nil
+}
+
+func (s uniqueSubSet) addRelease(sub kargoapi.ReleaseSubscription, p *field.Path) error {
+	k := subscriptionKey{kind: "release", id: urls.NormalizeGit(sub.RepoURL)}
+	if _, exists := s[k]; exists {
+		return fmt.Errorf("subscription for release repository already exists at %q", s[k])
+	}
+	s[k] = p
+	return nil
+}
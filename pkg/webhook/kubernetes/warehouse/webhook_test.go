@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -260,7 +261,8 @@ func TestValidateSpec(t *testing.T) {
 							Field:    "spec.subscriptions[0]",
 							BadValue: spec.Subscriptions[0],
 							Detail: "exactly one of spec.subscriptions[0].git, " +
-								"spec.subscriptions[0].image, or spec.subscriptions[0].chart " +
+								"spec.subscriptions[0].image, spec.subscriptions[0].chart, " +
+								"spec.subscriptions[0].oci, or spec.subscriptions[0].release " +
 								"must be non-empty",
 						},
 						{
@@ -301,6 +303,130 @@ func TestValidateSpec(t *testing.T) {
 	}
 }
 
+func TestValidateSchedule(t *testing.T) {
+	testCases := []struct {
+		name       string
+		spec       kargoapi.WarehouseSpec
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "no schedule",
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "time zone without schedule",
+			spec: kargoapi.WarehouseSpec{
+				TimeZone: "UTC",
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "spec.timeZone", errs[0].Field)
+				require.Equal(
+					t,
+					"spec.timeZone may only be specified along with spec.schedule",
+					errs[0].Detail,
+				)
+			},
+		},
+		{
+			name: "invalid time zone",
+			spec: kargoapi.WarehouseSpec{
+				Schedule: "0 2 * * 1-5",
+				TimeZone: "Nowhere/Special",
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "spec.timeZone", errs[0].Field)
+				require.Equal(t, "Nowhere/Special", errs[0].BadValue)
+			},
+		},
+		{
+			name: "invalid schedule",
+			spec: kargoapi.WarehouseSpec{
+				Schedule: "0 25 * * *",
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "spec.schedule", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "above maximum")
+			},
+		},
+		{
+			name: "valid",
+			spec: kargoapi.WarehouseSpec{
+				Schedule: "0 2 * * 1-5",
+				TimeZone: "America/New_York",
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				validateSchedule(field.NewPath("spec"), &testCase.spec),
+			)
+		})
+	}
+}
+
+func TestValidateBlackoutWindows(t *testing.T) {
+	start := metav1.NewTime(time.Date(2025, 3, 24, 0, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(start.Add(7 * 24 * time.Hour))
+	testCases := []struct {
+		name       string
+		windows    []kargoapi.BlackoutWindow
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "end before start",
+			windows: []kargoapi.BlackoutWindow{
+				{Start: start, End: end},
+				{Start: end, End: start},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "spec.blackoutWindows[1].end", errs[0].Field)
+				require.Equal(t, "must be after spec.blackoutWindows[1].start", errs[0].Detail)
+			},
+		},
+		{
+			name: "end equal to start",
+			windows: []kargoapi.BlackoutWindow{
+				{Start: start, End: start},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "spec.blackoutWindows[0].end", errs[0].Field)
+			},
+		},
+		{
+			name: "valid",
+			windows: []kargoapi.BlackoutWindow{
+				{Start: start, End: end, Reason: "End of quarter freeze"},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				validateBlackoutWindows(
+					field.NewPath("spec").Child("blackoutWindows"),
+					testCase.windows,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateSubs(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -357,8 +483,8 @@ func TestValidateSubs(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "subs[0]",
 							BadValue: subs[0],
-							Detail: "exactly one of subs[0].git, subs[0].image, or " +
-								"subs[0].chart must be non-empty",
+							Detail: "exactly one of subs[0].git, subs[0].image, " +
+								"subs[0].chart, subs[0].oci, or subs[0].release must be non-empty",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -473,7 +599,8 @@ func TestValidateSub(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "sub",
 							BadValue: sub,
-							Detail:   "exactly one of sub.git, sub.image, or sub.chart must be non-empty",
+							Detail: "exactly one of sub.git, sub.image, sub.chart, sub.oci, " +
+								"or sub.release must be non-empty",
 						},
 					},
 					errs,
@@ -635,6 +762,147 @@ func TestValidateImageSub(t *testing.T) {
 	}
 }
 
+func TestValidateOCISub(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.OCISubscription
+		seen       uniqueSubSet
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "invalid",
+			sub: kargoapi.OCISubscription{
+				RepoURL:    "bogus",
+				Constraint: "bogus",
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "oci",
+					id:   "bogus",
+				}: field.NewPath("spec.subscriptions[0].oci"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "oci.constraint",
+							BadValue: "bogus",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "oci",
+							BadValue: "bogus",
+							Detail:   "subscription for OCI repository already exists at \"spec.subscriptions[0].oci\"",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "mutable tag constraint with Digest selection strategy",
+			sub: kargoapi.OCISubscription{
+				RepoURL:           "example/artifact",
+				SelectionStrategy: kargoapi.ImageSelectionStrategyDigest,
+				Constraint:        "latest",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "valid",
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateOCISub(
+					field.NewPath("oci"),
+					testCase.sub,
+					testCase.seen,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateReleaseSub(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.ReleaseSubscription
+		seen       uniqueSubSet
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "invalid",
+			sub: kargoapi.ReleaseSubscription{
+				RepoURL:          "https://github.com/example/repo",
+				SemverConstraint: "bogus",
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "release",
+					id:   "https://github.com/example/repo",
+				}: field.NewPath("spec.subscriptions[0].release"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "release.semverConstraint",
+							BadValue: "bogus",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "release",
+							BadValue: "https://github.com/example/repo",
+							Detail: "subscription for release repository already exists at " +
+								"\"spec.subscriptions[0].release\"",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "valid",
+			sub: kargoapi.ReleaseSubscription{
+				RepoURL:          "https://github.com/example/repo",
+				SemverConstraint: "^1.0.0",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateReleaseSub(
+					field.NewPath("release"),
+					testCase.sub,
+					testCase.seen,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateChartSub(t *testing.T) {
 	testCases := []struct {
 		name       string
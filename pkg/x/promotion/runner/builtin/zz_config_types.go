@@ -128,6 +128,35 @@ type DeleteConfig struct {
 	Strict bool `json:"strict,omitempty"`
 }
 
+type DotenvParseConfig struct {
+	// An array of outputs to extract from the .env file.
+	Outputs []DotenvParse `json:"outputs"`
+	// The path to the .env file to be parsed.
+	Path string `json:"path"`
+}
+
+type DotenvParse struct {
+	// The expression used to extract data from the .env file.
+	FromExpression string `json:"fromExpression"`
+	// The name of the output variable to store the result.
+	Name string `json:"name"`
+}
+
+type DotenvUpdateConfig struct {
+	// The path to a .env file.
+	Path string `json:"path"`
+	// A list of updates to apply to the .env file. Variables that are not already defined are
+	// appended to the file.
+	Updates []DotenvUpdate `json:"updates"`
+}
+
+type DotenvUpdate struct {
+	// The name of the variable whose value needs to be updated.
+	Key string `json:"key"`
+	// The new value for the specified key.
+	Value interface{} `json:"value"`
+}
+
 type GitClearConfig struct {
 	// Path to a working directory of a local repository from which to remove all files,
 	// excluding the .git/ directory.
@@ -278,6 +307,38 @@ type GitPushConfig struct {
 	TargetBranch string `json:"targetBranch,omitempty"`
 }
 
+type GitTagConfig struct {
+	// The commit to tag, specified by ID (SHA), abbreviated ID, or any other revision (e.g. a
+	// branch or tag) Git can resolve to a commit. If not specified, the commit at the head of
+	// the currently checked out branch is tagged.
+	Commit string `json:"commit,omitempty"`
+	// Whether to overwrite the tag if it already exists in the remote repository and points to
+	// a different commit. Default is false.
+	Force bool `json:"force,omitempty"`
+	// The annotation message for the tag. If not specified, the name of the tag is used.
+	Message string `json:"message,omitempty"`
+	// The path to a working directory of a local repository.
+	Path string `json:"path"`
+	// The name of the tag to create and push.
+	Tag string `json:"tag"`
+	// Optional information about the creator of the tag. If provided, this takes precedence
+	// over both system-level defaults and any optional, default authorship information
+	// configured in the `git-clone` step.
+	Tagger *GitTagConfigTagger `json:"tagger,omitempty"`
+}
+
+// Optional information about the creator of the tag. If provided, this takes precedence
+// over both system-level defaults and any optional, default authorship information
+// configured in the `git-clone` step.
+type GitTagConfigTagger struct {
+	// The email of the tagger.
+	Email string `json:"email"`
+	// The name of the tagger.
+	Name string `json:"name"`
+	// The GPG signing key for the tagger. If provided, the tag is signed.
+	SigningKey string `json:"signingKey,omitempty"`
+}
+
 type GitWaitForPRConfig struct {
 	// Indicates whether to skip TLS verification when cloning the repository. Default is false.
 	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
@@ -442,6 +503,70 @@ type HTTPDownloadConfigQueryParam struct {
 	Value string `json:"value"`
 }
 
+type HTTPHealthConfig struct {
+	// The body of the HTTP request.
+	Body string `json:"body,omitempty"`
+	// A reference to a PEM-encoded CA certificate bundle to trust in addition to the system's
+	// trusted CAs when verifying the server's certificate.
+	CACertFrom *HTTPHealthValueSource `json:"caCertFrom,omitempty"`
+	// An expression to evaluate to determine if the response indicates the Stage is unhealthy.
+	FailureExpression string `json:"failureExpression,omitempty"`
+	// Headers to include in the HTTP request.
+	Headers []HTTPHealthHeader `json:"headers,omitempty"`
+	// Whether to skip TLS verification when making the request. (Not recommended.)
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// The HTTP method to use for the request.
+	Method string `json:"method,omitempty"`
+	// Query parameters to include in the HTTP request.
+	QueryParams []HTTPHealthQueryParam `json:"queryParams,omitempty"`
+	// Optionally overrides the Content-Type header for response parsing. Accepts MIME media
+	// type values: 'application/json', 'application/yaml', or 'text/plain'. If not set, uses
+	// the Content-Type header from the response with JSON fallback.
+	ResponseContentType string `json:"responseContentType,omitempty"`
+	// An expression to evaluate to determine if the response indicates the Stage is healthy.
+	SuccessExpression string `json:"successExpression,omitempty"`
+	// The maximum time to wait for the request to complete. If not specified, the default is 10
+	// seconds.
+	Timeout string `json:"timeout,omitempty"`
+	// The URL to send the HTTP request to.
+	URL string `json:"url"`
+}
+
+// A reference to a PEM-encoded CA certificate bundle to trust in addition to the system's
+// trusted CAs when verifying the server's certificate.
+//
+// A reference to the value of the header. Mutually exclusive with 'value'.
+type HTTPHealthValueSource struct {
+	// A reference to a key of a Secret in the Project namespace. The value is retrieved every
+	// time the health check is performed.
+	SecretKeyRef SecretKeyRef `json:"secretKeyRef"`
+}
+
+// A reference to a key of a Secret in the Project namespace. The value is retrieved every
+// time the health check is performed.
+type SecretKeyRef struct {
+	// The key of the Secret's data to select.
+	Key string `json:"key"`
+	// The name of the Secret.
+	Name string `json:"name"`
+}
+
+type HTTPHealthHeader struct {
+	// The name of the header.
+	Name string `json:"name"`
+	// The value of the header. Mutually exclusive with 'valueFrom'.
+	Value string `json:"value,omitempty"`
+	// A reference to the value of the header. Mutually exclusive with 'value'.
+	ValueFrom *HTTPHealthValueSource `json:"valueFrom,omitempty"`
+}
+
+type HTTPHealthQueryParam struct {
+	// The name of the query parameter.
+	Name string `json:"name"`
+	// The value of the query parameter.
+	Value string `json:"value"`
+}
+
 type JSONParseConfig struct {
 	// An array of outputs to extract from the JSON file.
 	Outputs []JSONParse `json:"outputs"`
@@ -470,6 +595,25 @@ type JSONUpdate struct {
 	Value interface{} `json:"value"`
 }
 
+type KubernetesHealthConfig struct {
+	// Kubernetes resources whose health should be assessed as part of the health of the Stage.
+	Resources []KubernetesHealthResource `json:"resources"`
+}
+
+type KubernetesHealthResource struct {
+	// The API version of the resource(s). e.g. 'apps/v1'.
+	APIVersion string `json:"apiVersion"`
+	// The kind of the resource(s). e.g. 'Deployment'.
+	Kind string `json:"kind"`
+	// A label selector (e.g. 'app=guestbook') matching the resources. Mutually exclusive with
+	// 'name'.
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// The name of the resource. Mutually exclusive with 'labelSelector'.
+	Name string `json:"name,omitempty"`
+	// The namespace of the resource(s). Must be omitted for cluster-scoped resources.
+	Namespace string `json:"namespace,omitempty"`
+}
+
 type KustomizeBuildConfig struct {
 	// OutPath is the file path to write the built manifests to.
 	OutPath string `json:"outPath"`
@@ -541,6 +685,58 @@ type OCIDownloadConfig struct {
 	OutPath string `json:"outPath"`
 }
 
+type OCIPushConfig struct {
+	// Annotations to add to the artifact's manifest. These are merged with the annotations
+	// Kargo adds to identify the Freight, Stage, and Promotion the artifact was produced by,
+	// and take precedence over them.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// ConfigMediaType is the media type of the artifact's config. Defaults to
+	// 'application/vnd.oci.image.config.v1+json'.
+	ConfigMediaType string `json:"configMediaType,omitempty"`
+	// ImageRef is the reference to push the OCI artifact to, in the format
+	// 'registry/repository:tag'.
+	ImageRef string `json:"imageRef"`
+	// Whether to skip TLS verification when pushing the artifact. Defaults to false.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// MediaType of the artifact's layer. Defaults to
+	// 'application/vnd.oci.image.layer.v1.tar+gzip' if the path is a directory and
+	// 'application/octet-stream' if it is a file.
+	MediaType string `json:"mediaType,omitempty"`
+	// Path to the file or directory to push. If a directory is specified, its contents are
+	// packaged as a gzipped tarball. This path is relative to the temporary workspace that
+	// Kargo provisions for use by the promotion process.
+	Path string `json:"path"`
+}
+
+type PropertiesParseConfig struct {
+	// An array of outputs to extract from the properties file.
+	Outputs []PropertiesParse `json:"outputs"`
+	// The path to the properties file to be parsed.
+	Path string `json:"path"`
+}
+
+type PropertiesParse struct {
+	// The expression used to extract data from the properties file.
+	FromExpression string `json:"fromExpression"`
+	// The name of the output variable to store the result.
+	Name string `json:"name"`
+}
+
+type PropertiesUpdateConfig struct {
+	// The path to a properties file.
+	Path string `json:"path"`
+	// A list of updates to apply to the properties file. Properties that are not already
+	// defined are appended to the file.
+	Updates []PropertiesUpdate `json:"updates"`
+}
+
+type PropertiesUpdate struct {
+	// The key of the property whose value needs to be updated.
+	Key string `json:"key"`
+	// The new value for the specified key.
+	Value interface{} `json:"value"`
+}
+
 type SetMetadataConfig struct {
 	// List of metadata updates to apply to various resources
 	Updates []Update `json:"updates"`
@@ -555,6 +751,99 @@ type Update struct {
 	Values map[string]interface{} `json:"values"`
 }
 
+type SOPSDecryptConfig struct {
+	// The format of the encrypted file. If not specified, it is inferred from the file's
+	// extension.
+	InputType *SopsFileType `json:"inputType,omitempty"`
+	// The name of a Secret in the Project's namespace containing the key material used to
+	// decrypt the file. The 'age' key of the Secret may contain one or more age identities and
+	// the 'pgp' key may contain one or more ASCII-armored PGP private keys.
+	KeySecret string `json:"keySecret"`
+	// Path to the file the decrypted content should be written to. If not specified, the file
+	// at 'path' is decrypted in place.
+	OutPath string `json:"outPath,omitempty"`
+	// The format of the decrypted file. If not specified, it is inferred from the output file's
+	// extension.
+	OutputType *SopsFileType `json:"outputType,omitempty"`
+	// Path to the SOPS-encrypted file to decrypt. This path is relative to the temporary
+	// workspace that Kargo provisions for use by the promotion process.
+	Path string `json:"path"`
+}
+
+type SOPSEncryptConfig struct {
+	// The age public keys to encrypt the file for.
+	AgeRecipients []string `json:"ageRecipients,omitempty"`
+	// Path to a SOPS configuration file (typically named .sops.yaml) whose creation rules
+	// determine how the file is encrypted. The 'path' is matched against the creation rules
+	// relative to the directory containing this file. Mutually exclusive with 'ageRecipients'
+	// and 'pgpFingerprints'.
+	ConfigPath string `json:"configPath,omitempty"`
+	// If specified, only values whose keys match this regular expression are encrypted.
+	// Ignored if 'configPath' is specified.
+	EncryptedRegex string `json:"encryptedRegex,omitempty"`
+	// The format of the plaintext file. If not specified, it is inferred from the file's
+	// extension.
+	InputType *SopsFileType `json:"inputType,omitempty"`
+	// The name of a Secret in the Project's namespace containing additional key material. The
+	// 'pgp' key of the Secret may contain one or more ASCII-armored PGP keys that will be made
+	// available for encryption.
+	KeySecret string `json:"keySecret,omitempty"`
+	// Path to the file the encrypted content should be written to. If not specified, the file
+	// at 'path' is encrypted in place.
+	OutPath string `json:"outPath,omitempty"`
+	// The format of the encrypted file. If not specified, it is inferred from the output file's
+	// extension.
+	OutputType *SopsFileType `json:"outputType,omitempty"`
+	// Path to the plaintext file to encrypt. This path is relative to the temporary workspace
+	// that Kargo provisions for use by the promotion process.
+	Path string `json:"path"`
+	// The fingerprints of the PGP public keys to encrypt the file for. The public keys must be
+	// available from the Secret referenced by 'keySecret'.
+	PGPFingerprints []string `json:"pgpFingerprints,omitempty"`
+}
+
+type TemplateRenderConfig struct {
+	// Path to the file or directory the rendered output should be written to. If 'path' refers
+	// to a directory, this is also treated as a directory. This path is relative to the
+	// temporary workspace that Kargo provisions for use by the promotion process.
+	OutPath string `json:"outPath"`
+	// Path to a template file or to a directory of template files to render. This path is
+	// relative to the temporary workspace that Kargo provisions for use by the promotion
+	// process.
+	Path string `json:"path"`
+	// Whether rendering should fail if a template references a map key that does not exist.
+	// Defaults to false, in which case such references render as an empty value.
+	Strict bool `json:"strict,omitempty"`
+}
+
+type TOMLParseConfig struct {
+	// An array of outputs to extract from the TOML file.
+	Outputs []TOMLParse `json:"outputs"`
+	// The path to the TOML file to be parsed.
+	Path string `json:"path"`
+}
+
+type TOMLParse struct {
+	// The expression used to extract data from the TOML file.
+	FromExpression string `json:"fromExpression"`
+	// The name of the output variable to store the result.
+	Name string `json:"name"`
+}
+
+type TOMLUpdateConfig struct {
+	// The path to a TOML file.
+	Path string `json:"path"`
+	// A list of updates to apply to the TOML file.
+	Updates []TOMLUpdate `json:"updates"`
+}
+
+type TOMLUpdate struct {
+	// The key whose value needs to be updated. For nested values, use a TOML dot notation path.
+	Key string `json:"key"`
+	// The new value for the specified key.
+	Value interface{} `json:"value"`
+}
+
 type UntarConfig struct {
 	// Ignore is a (multiline) string of glob patterns to ignore when extracting files. It
 	// accepts the same syntax as .gitignore files.
@@ -652,6 +941,18 @@ const (
 	Kustomize OutputFormat = "kustomize"
 )
 
+// The format of the encrypted file. If not specified, it is inferred from the file's
+// extension.
+type SopsFileType string
+
+const (
+	Binary SopsFileType = "binary"
+	Dotenv SopsFileType = "dotenv"
+	INI    SopsFileType = "ini"
+	JSON   SopsFileType = "json"
+	YAML   SopsFileType = "yaml"
+)
+
 // Kind of resource to update metadata for
 type Kind string
 
@@ -6,7 +6,9 @@
 
 import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv1";
 import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv1";
-import type { LocalObjectReference } from "../../k8s.io/api/core/v1/generated_pb";
+import type { JobSpec } from "../../k8s.io/api/batch/v1/generated_pb";
+import { file_k8s_io_api_batch_v1_generated } from "../../k8s.io/api/batch/v1/generated_pb";
+import type { LocalObjectReference, ResourceRequirements } from "../../k8s.io/api/core/v1/generated_pb";
 import { file_k8s_io_api_core_v1_generated } from "../../k8s.io/api/core/v1/generated_pb";
 import type { JSON } from "../../k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1/generated_pb";
 import { file_k8s_io_apiextensions_apiserver_pkg_apis_apiextensions_v1_generated } from "../../k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1/generated_pb";
@@ -20,7 +22,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1alpha1/generated.proto.
  */
 export const file_api_v1alpha1_generated: GenFile = /*@__PURE__*/
-  fileDesc("ChxhcGkvdjFhbHBoYTEvZ2VuZXJhdGVkLnByb3RvEiRnaXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEiMgoTQW5hbHlzaXNSdW5Bcmd1bWVudBIMCgRuYW1lGAEgASgJEg0KBXZhbHVlGAIgASgJIrACChNBbmFseXNpc1J1bk1ldGFkYXRhElUKBmxhYmVscxgBIAMoCzJFLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BbmFseXNpc1J1bk1ldGFkYXRhLkxhYmVsc0VudHJ5El8KC2Fubm90YXRpb25zGAIgAygLMkouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuTWV0YWRhdGEuQW5ub3RhdGlvbnNFbnRyeRotCgtMYWJlbHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJGChRBbmFseXNpc1J1blJlZmVyZW5jZRIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCRINCgVwaGFzZRgDIAEoCSI3ChlBbmFseXNpc1RlbXBsYXRlUmVmZXJlbmNlEgwKBG5hbWUYASABKAkSDAoEa2luZBgCIAEoCSJPCg1BcHByb3ZlZFN0YWdlEj4KCmFwcHJvdmVkQXQYASABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSI4ChVBcmdvQ0RBcHBIZWFsdGhTdGF0dXMSDgoGc3RhdHVzGAEgASgJEg8KB21lc3NhZ2UYAiABKAki1AEKD0FyZ29DREFwcFN0YXR1cxIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCRJRCgxoZWFsdGhTdGF0dXMYAyABKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXJnb0NEQXBwSGVhbHRoU3RhdHVzEk0KCnN5bmNTdGF0dXMYBCABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXJnb0NEQXBwU3luY1N0YXR1cyJKChNBcmdvQ0RBcHBTeW5jU3RhdHVzEg4KBnN0YXR1cxgBIAEoCRIQCghyZXZpc2lvbhgCIAEoCRIRCglyZXZpc2lvbnMYAyADKAkieAogQXJ0aWZhY3RvcnlXZWJob29rUmVjZWl2ZXJDb25maWcSOwoJc2VjcmV0UmVmGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLkxvY2FsT2JqZWN0UmVmZXJlbmNlEhcKD3ZpcnR1YWxSZXBvTmFtZRgCIAEoCSIvChRBdXRvUHJvbW90aW9uT3B0aW9ucxIXCg9zZWxlY3Rpb25Qb2xpY3kYASABKAkiWQoaQXp1cmVXZWJob29rUmVjZWl2ZXJDb25maWcSOwoJc2VjcmV0UmVmGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLkxvY2FsT2JqZWN0UmVmZXJlbmNlIl0KHkJpdGJ1Y2tldFdlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2UiNwoFQ2hhcnQSDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEg8KB3ZlcnNpb24YAyABKAkiYQoUQ2hhcnREaXNjb3ZlcnlSZXN1bHQSDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKEHNlbXZlckNvbnN0cmFpbnQYAyABKAkSEAoIdmVyc2lvbnMYBCADKAkiZAoRQ2hhcnRTdWJzY3JpcHRpb24SDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKEHNlbXZlckNvbnN0cmFpbnQYAyABKAkSFgoOZGlzY292ZXJ5TGltaXQYBCABKAUi5QEKDUNsdXN0ZXJDb25maWcSQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRJFCgRzcGVjGAIgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNsdXN0ZXJDb25maWdTcGVjEkkKBnN0YXR1cxgDIAEoCzI5LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DbHVzdGVyQ29uZmlnU3RhdHVzIpkBChFDbHVzdGVyQ29uZmlnTGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRJCCgVpdGVtcxgCIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DbHVzdGVyQ29uZmlnImoKEUNsdXN0ZXJDb25maWdTcGVjElUKEHdlYmhvb2tSZWNlaXZlcnMYASADKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2ViaG9va1JlY2VpdmVyQ29uZmlnIuoBChNDbHVzdGVyQ29uZmlnU3RhdHVzEkMKCmNvbmRpdGlvbnMYASADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEhoKEm9ic2VydmVkR2VuZXJhdGlvbhgDIAEoAxIaChJsYXN0SGFuZGxlZFJlZnJlc2gYBCABKAkSVgoQd2ViaG9va1JlY2VpdmVycxgCIAMoCzI8LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XZWJob29rUmVjZWl2ZXJEZXRhaWxzIqEBChRDbHVzdGVyUHJvbW90aW9uVGFzaxJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkUKBHNwZWMYAiABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGFza1NwZWMipwEKGENsdXN0ZXJQcm9tb3Rpb25UYXNrTGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRJJCgVpdGVtcxgCIAMoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DbHVzdGVyUHJvbW90aW9uVGFzayJJCgxDdXJyZW50U3RhZ2USOQoFc2luY2UYASABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSK2AgoTRGlzY292ZXJlZEFydGlmYWN0cxJACgxkaXNjb3ZlcmVkQXQYBCABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRJFCgNnaXQYASADKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0RGlzY292ZXJ5UmVzdWx0EkoKBmltYWdlcxgCIAMoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZURpc2NvdmVyeVJlc3VsdBJKCgZjaGFydHMYAyADKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2hhcnREaXNjb3ZlcnlSZXN1bHQisAEKEERpc2NvdmVyZWRDb21taXQSCgoCaWQYASABKAkSDgoGYnJhbmNoGAIgASgJEgsKA3RhZxgDIAEoCRIPCgdzdWJqZWN0GAQgASgJEg4KBmF1dGhvchgFIAEoCRIRCgljb21taXR0ZXIYBiABKAkSPwoLY3JlYXRvckRhdGUYByABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSKQAgoYRGlzY292ZXJlZEltYWdlUmVmZXJlbmNlEgsKA3RhZxgBIAEoCRIOCgZkaWdlc3QYAiABKAkSZAoLYW5ub3RhdGlvbnMYBSADKAsyTy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRGlzY292ZXJlZEltYWdlUmVmZXJlbmNlLkFubm90YXRpb25zRW50cnkSPQoJY3JlYXRlZEF0GAQgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUaMgoQQW5ub3RhdGlvbnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIl0KHkRvY2tlckh1YldlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2UiMQoSRXhwcmVzc2lvblZhcmlhYmxlEgwKBG5hbWUYASABKAkSDQoFdmFsdWUYAiABKAkiogMKB0ZyZWlnaHQSQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRINCgVhbGlhcxgHIAEoCRJDCgZvcmlnaW4YCSABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodE9yaWdpbhJACgdjb21taXRzGAMgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdENvbW1pdBI7CgZpbWFnZXMYBCADKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSW1hZ2USOwoGY2hhcnRzGAUgAygLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNoYXJ0EkMKBnN0YXR1cxgGIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzIq0CChFGcmVpZ2h0Q29sbGVjdGlvbhIKCgJpZBgDIAEoCRJRCgVpdGVtcxgBIAMoCzJCLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0Q29sbGVjdGlvbi5JdGVtc0VudHJ5ElMKE3ZlcmlmaWNhdGlvbkhpc3RvcnkYAiADKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuVmVyaWZpY2F0aW9uSW5mbxpkCgpJdGVtc0VudHJ5EgsKA2tleRgBIAEoCRJFCgV2YWx1ZRgCIAEoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0UmVmZXJlbmNlOgI4ASItChdGcmVpZ2h0Q3JlYXRpb25Dcml0ZXJpYRISCgpleHByZXNzaW9uGAEgASgJIo0BCgtGcmVpZ2h0TGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRI8CgVpdGVtcxgCIAMoCzItLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0IisKDUZyZWlnaHRPcmlnaW4SDAoEa2luZBgBIAEoCRIMCgRuYW1lGAIgASgJIqECChBGcmVpZ2h0UmVmZXJlbmNlEgwKBG5hbWUYASABKAkSQwoGb3JpZ2luGAggASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRPcmlnaW4SQAoHY29tbWl0cxgCIAMoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXRDb21taXQSOwoGaW1hZ2VzGAMgAygLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlEjsKBmNoYXJ0cxgEIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydCKcAQoORnJlaWdodFJlcXVlc3QSQwoGb3JpZ2luGAEgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRPcmlnaW4SRQoHc291cmNlcxgCIAEoCzI0LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U291cmNlcyLyAQoORnJlaWdodFNvdXJjZXMSDgoGZGlyZWN0GAEgASgIEg4KBnN0YWdlcxgCIAMoCRJIChByZXF1aXJlZFNvYWtUaW1lGAMgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkR1cmF0aW9uEhwKFGF2YWlsYWJpbGl0eVN0cmF0ZWd5GAQgASgJElgKFGF1dG9Qcm9tb3Rpb25PcHRpb25zGAUgASgLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkF1dG9Qcm9tb3Rpb25PcHRpb25zIp0GCg1GcmVpZ2h0U3RhdHVzElkKC2N1cnJlbnRseUluGAMgAygLMkQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGF0dXMuQ3VycmVudGx5SW5FbnRyeRJXCgp2ZXJpZmllZEluGAEgAygLMkMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGF0dXMuVmVyaWZpZWRJbkVudHJ5ElkKC2FwcHJvdmVkRm9yGAIgAygLMkQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTdGF0dXMuQXBwcm92ZWRGb3JFbnRyeRJTCghtZXRhZGF0YRgEIAMoCzJBLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzLk1ldGFkYXRhRW50cnkaZgoQQ3VycmVudGx5SW5FbnRyeRILCgNrZXkYASABKAkSQQoFdmFsdWUYAiABKAsyMi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ3VycmVudFN0YWdlOgI4ARpmCg9WZXJpZmllZEluRW50cnkSCwoDa2V5GAEgASgJEkIKBXZhbHVlGAIgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWVkU3RhZ2U6AjgBGmcKEEFwcHJvdmVkRm9yRW50cnkSCwoDa2V5GAEgASgJEkIKBXZhbHVlGAIgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFwcHJvdmVkU3RhZ2U6AjgBGm8KDU1ldGFkYXRhRW50cnkSCwoDa2V5GAEgASgJEk0KBXZhbHVlGAIgASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPTjoCOAEirwIKFEdlbmVyaWNXZWJob29rQWN0aW9uEg4KBmFjdGlvbhgBIAEoCRIWCg53aGVuRXhwcmVzc2lvbhgCIAEoCRJeCgpwYXJhbWV0ZXJzGAMgAygLMkouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdlbmVyaWNXZWJob29rQWN0aW9uLlBhcmFtZXRlcnNFbnRyeRJcCgd0YXJnZXRzGAQgAygLMksuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdlbmVyaWNXZWJob29rVGFyZ2V0U2VsZWN0aW9uQ3JpdGVyaWEaMQoPUGFyYW1ldGVyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiqAEKHEdlbmVyaWNXZWJob29rUmVjZWl2ZXJDb25maWcSOwoJc2VjcmV0UmVmGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLkxvY2FsT2JqZWN0UmVmZXJlbmNlEksKB2FjdGlvbnMYAiADKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2VuZXJpY1dlYmhvb2tBY3Rpb24i2wEKJUdlbmVyaWNXZWJob29rVGFyZ2V0U2VsZWN0aW9uQ3JpdGVyaWESDAoEa2luZBgBIAEoCRIMCgRuYW1lGAIgASgJEkoKDWxhYmVsU2VsZWN0b3IYAyABKAsyMy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGFiZWxTZWxlY3RvchJKCg1pbmRleFNlbGVjdG9yGAQgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkluZGV4U2VsZWN0b3IieQoJR2l0Q29tbWl0Eg8KB3JlcG9VUkwYASABKAkSCgoCaWQYAiABKAkSDgoGYnJhbmNoGAMgASgJEgsKA3RhZxgEIAEoCRIPCgdtZXNzYWdlGAYgASgJEg4KBmF1dGhvchgHIAEoCRIRCgljb21taXR0ZXIYCCABKAkibgoSR2l0RGlzY292ZXJ5UmVzdWx0Eg8KB3JlcG9VUkwYASABKAkSRwoHY29tbWl0cxgCIAMoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkQ29tbWl0IloKG0dpdEh1YldlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2UiWgobR2l0TGFiV2ViaG9va1JlY2VpdmVyQ29uZmlnEjsKCXNlY3JldFJlZhgBIAEoCzIoLms4cy5pby5hcGkuY29yZS52MS5Mb2NhbE9iamVjdFJlZmVyZW5jZSLdAgoPR2l0U3Vic2NyaXB0aW9uEg8KB3JlcG9VUkwYASABKAkSHwoXY29tbWl0U2VsZWN0aW9uU3RyYXRlZ3kYAiABKAkSDgoGYnJhbmNoGAMgASgJEhUKDXN0cmljdFNlbXZlcnMYCyABKAgSGAoQc2VtdmVyQ29uc3RyYWludBgEIAEoCRIRCglhbGxvd1RhZ3MYBSABKAkSGAoQYWxsb3dUYWdzUmVnZXhlcxgNIAMoCRISCgppZ25vcmVUYWdzGAYgAygJEhkKEWlnbm9yZVRhZ3NSZWdleGVzGA4gAygJEhgKEGV4cHJlc3Npb25GaWx0ZXIYDCABKAkSHQoVaW5zZWN1cmVTa2lwVExTVmVyaWZ5GAcgASgIEhQKDGluY2x1ZGVQYXRocxgIIAMoCRIUCgxleGNsdWRlUGF0aHMYCSADKAkSFgoOZGlzY292ZXJ5TGltaXQYCiABKAUiWQoaR2l0ZWFXZWJob29rUmVjZWl2ZXJDb25maWcSOwoJc2VjcmV0UmVmGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLkxvY2FsT2JqZWN0UmVmZXJlbmNlIloKG0hhcmJvcldlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2UiyAEKBkhlYWx0aBIOCgZzdGF0dXMYASABKAkSDgoGaXNzdWVzGAIgAygJEk4KBmNvbmZpZxgEIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04STgoGb3V0cHV0GAUgASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPTiJvCg9IZWFsdGhDaGVja1N0ZXASDAoEdXNlcxgBIAEoCRJOCgZjb25maWcYAiABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OIh4KC0hlYWx0aFN0YXRzEg8KB2hlYWx0aHkYASABKAMivAEKBUltYWdlEg8KB3JlcG9VUkwYASABKAkSCwoDdGFnGAMgASgJEg4KBmRpZ2VzdBgEIAEoCRJRCgthbm5vdGF0aW9ucxgFIAMoCzI8LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZS5Bbm5vdGF0aW9uc0VudHJ5GjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKNAQoUSW1hZ2VEaXNjb3ZlcnlSZXN1bHQSDwoHcmVwb1VSTBgBIAEoCRIQCghwbGF0Zm9ybRgCIAEoCRJSCgpyZWZlcmVuY2VzGAMgAygLMj4uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRpc2NvdmVyZWRJbWFnZVJlZmVyZW5jZSKUAgoRSW1hZ2VTdWJzY3JpcHRpb24SDwoHcmVwb1VSTBgBIAEoCRIeChZpbWFnZVNlbGVjdGlvblN0cmF0ZWd5GAMgASgJEhUKDXN0cmljdFNlbXZlcnMYCiABKAgSEgoKY29uc3RyYWludBgLIAEoCRIRCglhbGxvd1RhZ3MYBSABKAkSGAoQYWxsb3dUYWdzUmVnZXhlcxgNIAMoCRISCgppZ25vcmVUYWdzGAYgAygJEhkKEWlnbm9yZVRhZ3NSZWdleGVzGA4gAygJEhAKCHBsYXRmb3JtGAcgASgJEh0KFWluc2VjdXJlU2tpcFRMU1ZlcmlmeRgIIAEoCBIWCg5kaXNjb3ZlcnlMaW1pdBgJIAEoBSJlCg1JbmRleFNlbGVjdG9yElQKDG1hdGNoSW5kaWNlcxgBIAMoCzI+LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbmRleFNlbGVjdG9yUmVxdWlyZW1lbnQiSAoYSW5kZXhTZWxlY3RvclJlcXVpcmVtZW50EgsKA2tleRgBIAEoCRIQCghvcGVyYXRvchgCIAEoCRINCgV2YWx1ZRgDIAEoCSKSAQoHUHJvamVjdBJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkMKBnN0YXR1cxgDIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0U3RhdHVzIuUBCg1Qcm9qZWN0Q29uZmlnEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESRQoEc3BlYxgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0Q29uZmlnU3BlYxJJCgZzdGF0dXMYAyABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdENvbmZpZ1N0YXR1cyKZAQoRUHJvamVjdENvbmZpZ0xpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESQgoFaXRlbXMYAiADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdENvbmZpZyK8AQoRUHJvamVjdENvbmZpZ1NwZWMSUAoRcHJvbW90aW9uUG9saWNpZXMYASADKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uUG9saWN5ElUKEHdlYmhvb2tSZWNlaXZlcnMYAiADKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2ViaG9va1JlY2VpdmVyQ29uZmlnIuoBChNQcm9qZWN0Q29uZmlnU3RhdHVzEkMKCmNvbmRpdGlvbnMYASADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEhoKEm9ic2VydmVkR2VuZXJhdGlvbhgDIAEoAxIaChJsYXN0SGFuZGxlZFJlZnJlc2gYBCABKAkSVgoQd2ViaG9va1JlY2VpdmVycxgCIAMoCzI8LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XZWJob29rUmVjZWl2ZXJEZXRhaWxzIo0BCgtQcm9qZWN0TGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRI8CgVpdGVtcxgCIAMoCzItLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0IpoBCgxQcm9qZWN0U3RhdHMSSAoKd2FyZWhvdXNlcxgBIAEoCzI0LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XYXJlaG91c2VTdGF0cxJACgZzdGFnZXMYAiABKAsyMC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2VTdGF0cyKXAQoNUHJvamVjdFN0YXR1cxJDCgpjb25kaXRpb25zGAMgAygLMi8uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkNvbmRpdGlvbhJBCgVzdGF0cxgEIAEoCzIyLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0U3RhdHMi2QEKCVByb21vdGlvbhJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkEKBHNwZWMYAiABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3BlYxJFCgZzdGF0dXMYAyABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RhdHVzIpEBCg1Qcm9tb3Rpb25MaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEj4KBWl0ZW1zGAIgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbiKUAQoPUHJvbW90aW9uUG9saWN5Eg0KBXN0YWdlGAEgASgJElQKDXN0YWdlU2VsZWN0b3IYAyABKAsyPS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uUG9saWN5U2VsZWN0b3ISHAoUYXV0b1Byb21vdGlvbkVuYWJsZWQYAiABKAgicwoXUHJvbW90aW9uUG9saWN5U2VsZWN0b3ISDAoEbmFtZRgBIAEoCRJKCg1sYWJlbFNlbGVjdG9yGAIgASgLMjMuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxhYmVsU2VsZWN0b3Ii8gEKElByb21vdGlvblJlZmVyZW5jZRIMCgRuYW1lGAEgASgJEkcKB2ZyZWlnaHQYAiABKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFJlZmVyZW5jZRJFCgZzdGF0dXMYAyABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RhdHVzEj4KCmZpbmlzaGVkQXQYBCABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSK7AQoNUHJvbW90aW9uU3BlYxINCgVzdGFnZRgBIAEoCRIPCgdmcmVpZ2h0GAIgASgJEkYKBHZhcnMYBCADKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRXhwcmVzc2lvblZhcmlhYmxlEkIKBXN0ZXBzGAMgAygLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblN0ZXAi9gQKD1Byb21vdGlvblN0YXR1cxIaChJsYXN0SGFuZGxlZFJlZnJlc2gYBCABKAkSDQoFcGhhc2UYASABKAkSDwoHbWVzc2FnZRgCIAEoCRJHCgdmcmVpZ2h0GAUgASgLMjYuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRSZWZlcmVuY2USUgoRZnJlaWdodENvbGxlY3Rpb24YByABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodENvbGxlY3Rpb24SSwoMaGVhbHRoQ2hlY2tzGAggAygLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkhlYWx0aENoZWNrU3RlcBI9CglzdGFydGVkQXQYDCABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRI+CgpmaW5pc2hlZEF0GAYgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSEwoLY3VycmVudFN0ZXAYCSABKAMSWgoVc3RlcEV4ZWN1dGlvbk1ldGFkYXRhGAsgAygLMjsuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0ZXBFeGVjdXRpb25NZXRhZGF0YRJNCgVzdGF0ZRgKIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04i+wIKDVByb21vdGlvblN0ZXASDAoEdXNlcxgBIAEoCRJKCgR0YXNrGAUgASgLMjwuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblRhc2tSZWZlcmVuY2USCgoCYXMYAiABKAkSCgoCaWYYByABKAkSFwoPY29udGludWVPbkVycm9yGAggASgIEkcKBXJldHJ5GAQgASgLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblN0ZXBSZXRyeRJGCgR2YXJzGAYgAygLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkV4cHJlc3Npb25WYXJpYWJsZRJOCgZjb25maWcYAyABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OIm0KElByb21vdGlvblN0ZXBSZXRyeRI/Cgd0aW1lb3V0GAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkR1cmF0aW9uEhYKDmVycm9yVGhyZXNob2xkGAIgASgNIpoBCg1Qcm9tb3Rpb25UYXNrEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESRQoEc3BlYxgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UYXNrU3BlYyKZAQoRUHJvbW90aW9uVGFza0xpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESQgoFaXRlbXMYAiADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGFzayI0ChZQcm9tb3Rpb25UYXNrUmVmZXJlbmNlEgwKBG5hbWUYASABKAkSDAoEa2luZBgCIAEoCSKfAQoRUHJvbW90aW9uVGFza1NwZWMSRgoEdmFycxgBIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5FeHByZXNzaW9uVmFyaWFibGUSQgoFc3RlcHMYAiADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcCJeChFQcm9tb3Rpb25UZW1wbGF0ZRJJCgRzcGVjGAEgASgLMjsuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblRlbXBsYXRlU3BlYyKjAQoVUHJvbW90aW9uVGVtcGxhdGVTcGVjEkYKBHZhcnMYAiADKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRXhwcmVzc2lvblZhcmlhYmxlEkIKBXN0ZXBzGAEgAygLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblN0ZXAiWAoZUXVheVdlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2Ui5gEKEFJlcG9TdWJzY3JpcHRpb24SQgoDZ2l0GAEgASgLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdFN1YnNjcmlwdGlvbhJGCgVpbWFnZRgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZVN1YnNjcmlwdGlvbhJGCgVjaGFydBgDIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydFN1YnNjcmlwdGlvbiLNAQoFU3RhZ2USQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRI9CgRzcGVjGAIgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlU3BlYxJBCgZzdGF0dXMYAyABKAsyMS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2VTdGF0dXMiiQEKCVN0YWdlTGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRI6CgVpdGVtcxgCIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZSLQAgoJU3RhZ2VTcGVjEg0KBXNoYXJkGAQgASgJEkYKBHZhcnMYByADKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRXhwcmVzc2lvblZhcmlhYmxlEk4KEHJlcXVlc3RlZEZyZWlnaHQYBSADKAsyNC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFJlcXVlc3QSUgoRcHJvbW90aW9uVGVtcGxhdGUYBiABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGVtcGxhdGUSSAoMdmVyaWZpY2F0aW9uGAMgASgLMjIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWNhdGlvbiJeCgpTdGFnZVN0YXRzEg0KBWNvdW50GAIgASgDEkEKBmhlYWx0aBgBIAEoCzIxLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5IZWFsdGhTdGF0cyK4BQoLU3RhZ2VTdGF0dXMSQwoKY29uZGl0aW9ucxgNIAMoCzIvLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5Db25kaXRpb24SGgoSbGFzdEhhbmRsZWRSZWZyZXNoGAsgASgJEk8KDmZyZWlnaHRIaXN0b3J5GAQgAygLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRDb2xsZWN0aW9uEhYKDmZyZWlnaHRTdW1tYXJ5GAwgASgJEjwKBmhlYWx0aBgIIAEoCzIsLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5IZWFsdGgSGgoSb2JzZXJ2ZWRHZW5lcmF0aW9uGAYgASgDElIKEGN1cnJlbnRQcm9tb3Rpb24YByABKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uUmVmZXJlbmNlEk8KDWxhc3RQcm9tb3Rpb24YCiABKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uUmVmZXJlbmNlEhwKFGF1dG9Qcm9tb3Rpb25FbmFibGVkGA4gASgIElEKCG1ldGFkYXRhGA8gAygLMj8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlU3RhdHVzLk1ldGFkYXRhRW50cnkabwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSTQoFdmFsdWUYAiABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OOgI4ASLzAQoVU3RlcEV4ZWN1dGlvbk1ldGFkYXRhEg0KBWFsaWFzGAEgASgJEj0KCXN0YXJ0ZWRBdBgCIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEj4KCmZpbmlzaGVkQXQYAyABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRISCgplcnJvckNvdW50GAQgASgNEg4KBnN0YXR1cxgFIAEoCRIPCgdtZXNzYWdlGAYgASgJEhcKD2NvbnRpbnVlT25FcnJvchgHIAEoCCKLAgoMVmVyaWZpY2F0aW9uEloKEWFuYWx5c2lzVGVtcGxhdGVzGAEgAygLMj8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzVGVtcGxhdGVSZWZlcmVuY2USVgoTYW5hbHlzaXNSdW5NZXRhZGF0YRgCIAEoCzI5LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BbmFseXNpc1J1bk1ldGFkYXRhEkcKBGFyZ3MYAyADKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQW5hbHlzaXNSdW5Bcmd1bWVudCKdAgoQVmVyaWZpY2F0aW9uSW5mbxIKCgJpZBgEIAEoCRINCgVhY3RvchgHIAEoCRI9CglzdGFydFRpbWUYBSABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRINCgVwaGFzZRgBIAEoCRIPCgdtZXNzYWdlGAIgASgJEk8KC2FuYWx5c2lzUnVuGAMgASgLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuUmVmZXJlbmNlEj4KCmZpbmlzaFRpbWUYBiABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSKUAQoNVmVyaWZpZWRTdGFnZRI+Cgp2ZXJpZmllZEF0GAEgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSQwoLbG9uZ2VzdFNvYWsYAiABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24i2QEKCVdhcmVob3VzZRJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkEKBHNwZWMYAiABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlU3BlYxJFCgZzdGF0dXMYAyABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlU3RhdHVzIpEBCg1XYXJlaG91c2VMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEj4KBWl0ZW1zGAIgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldhcmVob3VzZSKuAgoNV2FyZWhvdXNlU3BlYxINCgVzaGFyZBgCIAEoCRJACghpbnRlcnZhbBgEIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5EdXJhdGlvbhIdChVmcmVpZ2h0Q3JlYXRpb25Qb2xpY3kYAyABKAkSTQoNc3Vic2NyaXB0aW9ucxgBIAMoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5SZXBvU3Vic2NyaXB0aW9uEl4KF2ZyZWlnaHRDcmVhdGlvbkNyaXRlcmlhGAUgASgLMj0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRDcmVhdGlvbkNyaXRlcmlhImIKDldhcmVob3VzZVN0YXRzEg0KBWNvdW50GAIgASgDEkEKBmhlYWx0aBgBIAEoCzIxLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5IZWFsdGhTdGF0cyL9AQoPV2FyZWhvdXNlU3RhdHVzEkMKCmNvbmRpdGlvbnMYCSADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEhoKEmxhc3RIYW5kbGVkUmVmcmVzaBgGIAEoCRIaChJvYnNlcnZlZEdlbmVyYXRpb24YBCABKAMSFQoNbGFzdEZyZWlnaHRJRBgIIAEoCRJWChNkaXNjb3ZlcmVkQXJ0aWZhY3RzGAcgASgLMjkuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRpc2NvdmVyZWRBcnRpZmFjdHMi8wYKFVdlYmhvb2tSZWNlaXZlckNvbmZpZxIMCgRuYW1lGAEgASgJElcKCWJpdGJ1Y2tldBgFIAEoCzJELmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5CaXRidWNrZXRXZWJob29rUmVjZWl2ZXJDb25maWcSVwoJZG9ja2VyaHViGAYgASgLMkQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRvY2tlckh1YldlYmhvb2tSZWNlaXZlckNvbmZpZxJRCgZnaXRodWIYAiABKAsyQS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0SHViV2ViaG9va1JlY2VpdmVyQ29uZmlnElEKBmdpdGxhYhgDIAEoCzJBLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXRMYWJXZWJob29rUmVjZWl2ZXJDb25maWcSUQoGaGFyYm9yGAogASgLMkEuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkhhcmJvcldlYmhvb2tSZWNlaXZlckNvbmZpZxJNCgRxdWF5GAQgASgLMj8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlF1YXlXZWJob29rUmVjZWl2ZXJDb25maWcSWwoLYXJ0aWZhY3RvcnkYCSABKAsyRi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXJ0aWZhY3RvcnlXZWJob29rUmVjZWl2ZXJDb25maWcSTwoFYXp1cmUYCCABKAsyQC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXp1cmVXZWJob29rUmVjZWl2ZXJDb25maWcSTwoFZ2l0ZWEYByABKAsyQC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0ZWFXZWJob29rUmVjZWl2ZXJDb25maWcSUwoHZ2VuZXJpYxgLIAEoCzJCLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HZW5lcmljV2ViaG9va1JlY2VpdmVyQ29uZmlnIkEKFldlYmhvb2tSZWNlaXZlckRldGFpbHMSDAoEbmFtZRgBIAEoCRIMCgRwYXRoGAMgASgJEgsKA3VybBgEIAEoCUKXAgooY29tLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMUIOR2VuZXJhdGVkUHJvdG9QAVokZ2l0aHViLmNvbS9ha3VpdHkva2FyZ28vYXBpL3YxYWxwaGExogIFR0NBS0GqAiRHaXRodWIuQ29tLkFrdWl0eS5LYXJnby5BcGkuVjFhbHBoYTHKAiRHaXRodWJcQ29tXEFrdWl0eVxLYXJnb1xBcGlcVjFhbHBoYTHiAjBHaXRodWJcQ29tXEFrdWl0eVxLYXJnb1xBcGlcVjFhbHBoYTFcR1BCTWV0YWRhdGHqAilHaXRodWI6OkNvbTo6QWt1aXR5OjpLYXJnbzo6QXBpOjpWMWFscGhhMQ", [file_k8s_io_api_core_v1_generated, file_k8s_io_apiextensions_apiserver_pkg_apis_apiextensions_v1_generated, file_k8s_io_apimachinery_pkg_apis_meta_v1_generated, file_k8s_io_apimachinery_pkg_runtime_generated, file_k8s_io_apimachinery_pkg_runtime_schema_generated]);
+  fileDesc("ChxhcGkvdjFhbHBoYTEvZ2VuZXJhdGVkLnByb3RvEiRnaXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEiMgoTQW5hbHlzaXNSdW5Bcmd1bWVudBIMCgRuYW1lGAEgASgJEg0KBXZhbHVlGAIgASgJIrACChNBbmFseXNpc1J1bk1ldGFkYXRhElUKBmxhYmVscxgBIAMoCzJFLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BbmFseXNpc1J1bk1ldGFkYXRhLkxhYmVsc0VudHJ5El8KC2Fubm90YXRpb25zGAIgAygLMkouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuTWV0YWRhdGEuQW5ub3RhdGlvbnNFbnRyeRotCgtMYWJlbHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJGChRBbmFseXNpc1J1blJlZmVyZW5jZRIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCRINCgVwaGFzZRgDIAEoCSI3ChlBbmFseXNpc1RlbXBsYXRlUmVmZXJlbmNlEgwKBG5hbWUYASABKAkSDAoEa2luZBgCIAEoCSJPCg1BcHByb3ZlZFN0YWdlEj4KCmFwcHJvdmVkQXQYASABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSI4ChVBcmdvQ0RBcHBIZWFsdGhTdGF0dXMSDgoGc3RhdHVzGAEgASgJEg8KB21lc3NhZ2UYAiABKAki1AEKD0FyZ29DREFwcFN0YXR1cxIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCRJRCgxoZWFsdGhTdGF0dXMYAyABKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXJnb0NEQXBwSGVhbHRoU3RhdHVzEk0KCnN5bmNTdGF0dXMYBCABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXJnb0NEQXBwU3luY1N0YXR1cyJKChNBcmdvQ0RBcHBTeW5jU3RhdHVzEg4KBnN0YXR1cxgBIAEoCRIQCghyZXZpc2lvbhgCIAEoCRIRCglyZXZpc2lvbnMYAyADKAkieAogQXJ0aWZhY3RvcnlXZWJob29rUmVjZWl2ZXJDb25maWcSOwoJc2VjcmV0UmVmGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLkxvY2FsT2JqZWN0UmVmZXJlbmNlEhcKD3ZpcnR1YWxSZXBvTmFtZRgCIAEoCSIvChRBdXRvUHJvbW90aW9uT3B0aW9ucxIXCg9zZWxlY3Rpb25Qb2xpY3kYASABKAkiWQoaQXp1cmVXZWJob29rUmVjZWl2ZXJDb25maWcSOwoJc2VjcmV0UmVmGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLkxvY2FsT2JqZWN0UmVmZXJlbmNlIl0KHkJpdGJ1Y2tldFdlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2UilAEKDkJsYWNrb3V0V2luZG93EjkKBXN0YXJ0GAEgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSNwoDZW5kGAIgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSDgoGcmVhc29uGAMgASgJIjcKBUNoYXJ0Eg8KB3JlcG9VUkwYASABKAkSDAoEbmFtZRgCIAEoCRIPCgd2ZXJzaW9uGAMgASgJImEKFENoYXJ0RGlzY292ZXJ5UmVzdWx0Eg8KB3JlcG9VUkwYASABKAkSDAoEbmFtZRgCIAEoCRIYChBzZW12ZXJDb25zdHJhaW50GAMgASgJEhAKCHZlcnNpb25zGAQgAygJIrQBChFDaGFydFN1YnNjcmlwdGlvbhIPCgdyZXBvVVJMGAEgASgJEgwKBG5hbWUYAiABKAkSGAoQc2VtdmVyQ29uc3RyYWludBgDIAEoCRIZChFzZWxlY3Rpb25TdHJhdGVneRgGIAEoCRIZChFzb3J0S2V5RXhwcmVzc2lvbhgHIAEoCRIYChBleHByZXNzaW9uRmlsdGVyGAUgASgJEhYKDmRpc2NvdmVyeUxpbWl0GAQgASgFIuUBCg1DbHVzdGVyQ29uZmlnEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESRQoEc3BlYxgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DbHVzdGVyQ29uZmlnU3BlYxJJCgZzdGF0dXMYAyABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2x1c3RlckNvbmZpZ1N0YXR1cyKZAQoRQ2x1c3RlckNvbmZpZ0xpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESQgoFaXRlbXMYAiADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2x1c3RlckNvbmZpZyJqChFDbHVzdGVyQ29uZmlnU3BlYxJVChB3ZWJob29rUmVjZWl2ZXJzGAEgAygLMjsuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldlYmhvb2tSZWNlaXZlckNvbmZpZyLqAQoTQ2x1c3RlckNvbmZpZ1N0YXR1cxJDCgpjb25kaXRpb25zGAEgAygLMi8uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkNvbmRpdGlvbhIaChJvYnNlcnZlZEdlbmVyYXRpb24YAyABKAMSGgoSbGFzdEhhbmRsZWRSZWZyZXNoGAQgASgJElYKEHdlYmhvb2tSZWNlaXZlcnMYAiADKAsyPC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2ViaG9va1JlY2VpdmVyRGV0YWlscyKhAQoUQ2x1c3RlclByb21vdGlvblRhc2sSQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRJFCgRzcGVjGAIgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblRhc2tTcGVjIqcBChhDbHVzdGVyUHJvbW90aW9uVGFza0xpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESSQoFaXRlbXMYAiADKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2x1c3RlclByb21vdGlvblRhc2siSQoMQ3VycmVudFN0YWdlEjkKBXNpbmNlGAEgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUi3gMKE0Rpc2NvdmVyZWRBcnRpZmFjdHMSQAoMZGlzY292ZXJlZEF0GAQgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSRQoDZ2l0GAEgAygLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdERpc2NvdmVyeVJlc3VsdBJKCgZpbWFnZXMYAiADKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSW1hZ2VEaXNjb3ZlcnlSZXN1bHQSSgoGY2hhcnRzGAMgAygLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNoYXJ0RGlzY292ZXJ5UmVzdWx0ElYKDG9jaUFydGlmYWN0cxgFIAMoCzJALmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5PQ0lBcnRpZmFjdERpc2NvdmVyeVJlc3VsdBJOCghyZWxlYXNlcxgGIAMoCzI8LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5SZWxlYXNlRGlzY292ZXJ5UmVzdWx0IvkBChBEaXNjb3ZlcmVkQ29tbWl0EgoKAmlkGAEgASgJEg4KBmJyYW5jaBgCIAEoCRILCgN0YWcYAyABKAkSDwoHc3ViamVjdBgEIAEoCRIOCgZhdXRob3IYBSABKAkSEQoJY29tbWl0dGVyGAYgASgJEj8KC2NyZWF0b3JEYXRlGAcgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSRwoMcHVsbFJlcXVlc3RzGAggAygLMjEuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlB1bGxSZXF1ZXN0IpACChhEaXNjb3ZlcmVkSW1hZ2VSZWZlcmVuY2USCwoDdGFnGAEgASgJEg4KBmRpZ2VzdBgCIAEoCRJkCgthbm5vdGF0aW9ucxgFIAMoCzJPLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkSW1hZ2VSZWZlcmVuY2UuQW5ub3RhdGlvbnNFbnRyeRI9CgljcmVhdGVkQXQYBCABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRoyChBBbm5vdGF0aW9uc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEioAIKFURpc2NvdmVyZWRPQ0lBcnRpZmFjdBILCgN0YWcYASABKAkSDgoGZGlnZXN0GAIgASgJEhQKDGFydGlmYWN0VHlwZRgDIAEoCRJhCgthbm5vdGF0aW9ucxgEIAMoCzJMLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkT0NJQXJ0aWZhY3QuQW5ub3RhdGlvbnNFbnRyeRI9CgljcmVhdGVkQXQYBSABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRoyChBBbm5vdGF0aW9uc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEi1AEKEURpc2NvdmVyZWRSZWxlYXNlEgsKA3RhZxgBIAEoCRIMCgRuYW1lGAIgASgJEgsKA3VybBgDIAEoCRISCgpwcmVyZWxlYXNlGAQgASgIEkIKBmFzc2V0cxgFIAMoCzIyLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5SZWxlYXNlQXNzZXQSPwoLcHVibGlzaGVkQXQYBiABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSJdCh5Eb2NrZXJIdWJXZWJob29rUmVjZWl2ZXJDb25maWcSOwoJc2VjcmV0UmVmGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLkxvY2FsT2JqZWN0UmVmZXJlbmNlIjEKEkV4cHJlc3Npb25WYXJpYWJsZRIMCgRuYW1lGAEgASgJEg0KBXZhbHVlGAIgASgJIqwECgdGcmVpZ2h0EkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESDQoFYWxpYXMYByABKAkSQwoGb3JpZ2luGAkgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRPcmlnaW4SQAoHY29tbWl0cxgDIAMoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXRDb21taXQSOwoGaW1hZ2VzGAQgAygLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlEjsKBmNoYXJ0cxgFIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydBJHCgxvY2lBcnRpZmFjdHMYCiADKAsyMS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuT0NJQXJ0aWZhY3QSPwoIcmVsZWFzZXMYCyADKAsyLS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUmVsZWFzZRJDCgZzdGF0dXMYBiABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFN0YXR1cyKtAgoRRnJlaWdodENvbGxlY3Rpb24SCgoCaWQYAyABKAkSUQoFaXRlbXMYASADKAsyQi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodENvbGxlY3Rpb24uSXRlbXNFbnRyeRJTChN2ZXJpZmljYXRpb25IaXN0b3J5GAIgAygLMjYuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWNhdGlvbkluZm8aZAoKSXRlbXNFbnRyeRILCgNrZXkYASABKAkSRQoFdmFsdWUYAiABKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFJlZmVyZW5jZToCOAEiLQoXRnJlaWdodENyZWF0aW9uQ3JpdGVyaWESEgoKZXhwcmVzc2lvbhgBIAEoCSKNAQoLRnJlaWdodExpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESPAoFaXRlbXMYAiADKAsyLS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodCIrCg1GcmVpZ2h0T3JpZ2luEgwKBGtpbmQYASABKAkSDAoEbmFtZRgCIAEoCSKrAwoQRnJlaWdodFJlZmVyZW5jZRIMCgRuYW1lGAEgASgJEkMKBm9yaWdpbhgIIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0T3JpZ2luEkAKB2NvbW1pdHMYAiADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0Q29tbWl0EjsKBmltYWdlcxgDIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZRI7CgZjaGFydHMYBCADKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2hhcnQSRwoMb2NpQXJ0aWZhY3RzGAkgAygLMjEuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLk9DSUFydGlmYWN0Ej8KCHJlbGVhc2VzGAogAygLMi0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlJlbGVhc2UinAEKDkZyZWlnaHRSZXF1ZXN0EkMKBm9yaWdpbhgBIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0T3JpZ2luEkUKB3NvdXJjZXMYAiABKAsyNC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFNvdXJjZXMi8gEKDkZyZWlnaHRTb3VyY2VzEg4KBmRpcmVjdBgBIAEoCBIOCgZzdGFnZXMYAiADKAkSSAoQcmVxdWlyZWRTb2FrVGltZRgDIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5EdXJhdGlvbhIcChRhdmFpbGFiaWxpdHlTdHJhdGVneRgEIAEoCRJYChRhdXRvUHJvbW90aW9uT3B0aW9ucxgFIAEoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BdXRvUHJvbW90aW9uT3B0aW9ucyKdBgoNRnJlaWdodFN0YXR1cxJZCgtjdXJyZW50bHlJbhgDIAMoCzJELmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzLkN1cnJlbnRseUluRW50cnkSVwoKdmVyaWZpZWRJbhgBIAMoCzJDLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzLlZlcmlmaWVkSW5FbnRyeRJZCgthcHByb3ZlZEZvchgCIAMoCzJELmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzLkFwcHJvdmVkRm9yRW50cnkSUwoIbWV0YWRhdGEYBCADKAsyQS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFN0YXR1cy5NZXRhZGF0YUVudHJ5GmYKEEN1cnJlbnRseUluRW50cnkSCwoDa2V5GAEgASgJEkEKBXZhbHVlGAIgASgLMjIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkN1cnJlbnRTdGFnZToCOAEaZgoPVmVyaWZpZWRJbkVudHJ5EgsKA2tleRgBIAEoCRJCCgV2YWx1ZRgCIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5WZXJpZmllZFN0YWdlOgI4ARpnChBBcHByb3ZlZEZvckVudHJ5EgsKA2tleRgBIAEoCRJCCgV2YWx1ZRgCIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BcHByb3ZlZFN0YWdlOgI4ARpvCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRJNCgV2YWx1ZRgCIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT046AjgBIq8CChRHZW5lcmljV2ViaG9va0FjdGlvbhIOCgZhY3Rpb24YASABKAkSFgoOd2hlbkV4cHJlc3Npb24YAiABKAkSXgoKcGFyYW1ldGVycxgDIAMoCzJKLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HZW5lcmljV2ViaG9va0FjdGlvbi5QYXJhbWV0ZXJzRW50cnkSXAoHdGFyZ2V0cxgEIAMoCzJLLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HZW5lcmljV2ViaG9va1RhcmdldFNlbGVjdGlvbkNyaXRlcmlhGjEKD1BhcmFtZXRlcnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIqgBChxHZW5lcmljV2ViaG9va1JlY2VpdmVyQ29uZmlnEjsKCXNlY3JldFJlZhgBIAEoCzIoLms4cy5pby5hcGkuY29yZS52MS5Mb2NhbE9iamVjdFJlZmVyZW5jZRJLCgdhY3Rpb25zGAIgAygLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdlbmVyaWNXZWJob29rQWN0aW9uItsBCiVHZW5lcmljV2ViaG9va1RhcmdldFNlbGVjdGlvbkNyaXRlcmlhEgwKBGtpbmQYASABKAkSDAoEbmFtZRgCIAEoCRJKCg1sYWJlbFNlbGVjdG9yGAMgASgLMjMuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxhYmVsU2VsZWN0b3ISSgoNaW5kZXhTZWxlY3RvchgEIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbmRleFNlbGVjdG9yIsIBCglHaXRDb21taXQSDwoHcmVwb1VSTBgBIAEoCRIKCgJpZBgCIAEoCRIOCgZicmFuY2gYAyABKAkSCwoDdGFnGAQgASgJEg8KB21lc3NhZ2UYBiABKAkSDgoGYXV0aG9yGAcgASgJEhEKCWNvbW1pdHRlchgIIAEoCRJHCgxwdWxsUmVxdWVzdHMYCSADKAsyMS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHVsbFJlcXVlc3QibgoSR2l0RGlzY292ZXJ5UmVzdWx0Eg8KB3JlcG9VUkwYASABKAkSRwoHY29tbWl0cxgCIAMoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkQ29tbWl0IloKG0dpdEh1YldlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2UiWgobR2l0TGFiV2ViaG9va1JlY2VpdmVyQ29uZmlnEjsKCXNlY3JldFJlZhgBIAEoCzIoLms4cy5pby5hcGkuY29yZS52MS5Mb2NhbE9iamVjdFJlZmVyZW5jZSLMAwoPR2l0U3Vic2NyaXB0aW9uEg8KB3JlcG9VUkwYASABKAkSHwoXY29tbWl0U2VsZWN0aW9uU3RyYXRlZ3kYAiABKAkSDgoGYnJhbmNoGAMgASgJEhUKDXN0cmljdFNlbXZlcnMYCyABKAgSGAoQc2VtdmVyQ29uc3RyYWludBgEIAEoCRIRCglhbGxvd1RhZ3MYBSABKAkSGAoQYWxsb3dUYWdzUmVnZXhlcxgNIAMoCRISCgppZ25vcmVUYWdzGAYgAygJEhkKEWlnbm9yZVRhZ3NSZWdleGVzGA4gAygJEhgKEGV4cHJlc3Npb25GaWx0ZXIYDCABKAkSGQoRc29ydEtleUV4cHJlc3Npb24YDyABKAkSHQoVaW5zZWN1cmVTa2lwVExTVmVyaWZ5GAcgASgIEhQKDGluY2x1ZGVQYXRocxgIIAMoCRIUCgxleGNsdWRlUGF0aHMYCSADKAkSFgoOZGlzY292ZXJ5TGltaXQYCiABKAUSUgoRcHVsbFJlcXVlc3RMb29rdXAYECABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHVsbFJlcXVlc3RMb29rdXAiWQoaR2l0ZWFXZWJob29rUmVjZWl2ZXJDb25maWcSOwoJc2VjcmV0UmVmGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLkxvY2FsT2JqZWN0UmVmZXJlbmNlIloKG0hhcmJvcldlYmhvb2tSZWNlaXZlckNvbmZpZxI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2UiyAEKBkhlYWx0aBIOCgZzdGF0dXMYASABKAkSDgoGaXNzdWVzGAIgAygJEk4KBmNvbmZpZxgEIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04STgoGb3V0cHV0GAUgASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPTiJvCg9IZWFsdGhDaGVja1N0ZXASDAoEdXNlcxgBIAEoCRJOCgZjb25maWcYAiABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OIh4KC0hlYWx0aFN0YXRzEg8KB2hlYWx0aHkYASABKAMivAEKBUltYWdlEg8KB3JlcG9VUkwYASABKAkSCwoDdGFnGAMgASgJEg4KBmRpZ2VzdBgEIAEoCRJRCgthbm5vdGF0aW9ucxgFIAMoCzI8LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZS5Bbm5vdGF0aW9uc0VudHJ5GjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASLdAQoUSW1hZ2VEaXNjb3ZlcnlSZXN1bHQSDwoHcmVwb1VSTBgBIAEoCRIQCghwbGF0Zm9ybRgCIAEoCRJSCgpyZWZlcmVuY2VzGAMgAygLMj4uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRpc2NvdmVyZWRJbWFnZVJlZmVyZW5jZRJOCghyZWplY3RlZBgEIAMoCzI8LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5SZWplY3RlZEltYWdlUmVmZXJlbmNlIp4DChFJbWFnZVN1YnNjcmlwdGlvbhIPCgdyZXBvVVJMGAEgASgJEh4KFmltYWdlU2VsZWN0aW9uU3RyYXRlZ3kYAyABKAkSFQoNc3RyaWN0U2VtdmVycxgKIAEoCBISCgpjb25zdHJhaW50GAsgASgJEhEKCWFsbG93VGFncxgFIAEoCRIYChBhbGxvd1RhZ3NSZWdleGVzGA0gAygJEhIKCmlnbm9yZVRhZ3MYBiADKAkSGQoRaWdub3JlVGFnc1JlZ2V4ZXMYDiADKAkSGAoQZXhwcmVzc2lvbkZpbHRlchgPIAEoCRIZChFzb3J0S2V5RXhwcmVzc2lvbhgRIAEoCRIQCghwbGF0Zm9ybRgHIAEoCRIdChVpbnNlY3VyZVNraXBUTFNWZXJpZnkYCCABKAgSFgoOZGlzY292ZXJ5TGltaXQYCSABKAUSUwoMdmVyaWZpY2F0aW9uGBAgASgLMj0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlVmVyaWZpY2F0aW9uUG9saWN5ItkBChdJbWFnZVZlcmlmaWNhdGlvblBvbGljeRJUCgpwdWJsaWNLZXlzGAEgAygLMkAuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlVmVyaWZpY2F0aW9uUHVibGljS2V5EkoKB2tleWxlc3MYAiABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuS2V5bGVzc1ZlcmlmaWNhdGlvbhIcChRyZXF1aXJlZEF0dGVzdGF0aW9ucxgDIAMoCSJmChpJbWFnZVZlcmlmaWNhdGlvblB1YmxpY0tleRI7CglzZWNyZXRSZWYYASABKAsyKC5rOHMuaW8uYXBpLmNvcmUudjEuTG9jYWxPYmplY3RSZWZlcmVuY2USCwoDa2V5GAIgASgJImUKDUluZGV4U2VsZWN0b3ISVAoMbWF0Y2hJbmRpY2VzGAEgAygLMj4uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkluZGV4U2VsZWN0b3JSZXF1aXJlbWVudCJIChhJbmRleFNlbGVjdG9yUmVxdWlyZW1lbnQSCwoDa2V5GAEgASgJEhAKCG9wZXJhdG9yGAIgASgJEg0KBXZhbHVlGAMgASgJIjwKD0tleWxlc3NJZGVudGl0eRITCgtpc3N1ZXJSZWdleBgBIAEoCRIUCgxzdWJqZWN0UmVnZXgYAiABKAkiqQEKE0tleWxlc3NWZXJpZmljYXRpb24SRwoVdHJ1c3RlZFJvb3RzU2VjcmV0UmVmGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLkxvY2FsT2JqZWN0UmVmZXJlbmNlEkkKCmlkZW50aXRpZXMYAiADKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuS2V5bGVzc0lkZW50aXR5It4BCgtPQ0lBcnRpZmFjdBIPCgdyZXBvVVJMGAEgASgJEgsKA3RhZxgCIAEoCRIOCgZkaWdlc3QYAyABKAkSFAoMYXJ0aWZhY3RUeXBlGAQgASgJElcKC2Fubm90YXRpb25zGAUgAygLMkIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLk9DSUFydGlmYWN0LkFubm90YXRpb25zRW50cnkaMgoQQW5ub3RhdGlvbnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIn4KGk9DSUFydGlmYWN0RGlzY292ZXJ5UmVzdWx0Eg8KB3JlcG9VUkwYASABKAkSTwoKcmVmZXJlbmNlcxgCIAMoCzI7LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkT0NJQXJ0aWZhY3Qi/wEKD09DSVN1YnNjcmlwdGlvbhIPCgdyZXBvVVJMGAEgASgJEhUKDWFydGlmYWN0VHlwZXMYAiADKAkSEgoKbWVkaWFUeXBlcxgDIAMoCRIZChFzZWxlY3Rpb25TdHJhdGVneRgEIAEoCRIVCg1zdHJpY3RTZW12ZXJzGAUgASgIEhIKCmNvbnN0cmFpbnQYBiABKAkSGAoQYWxsb3dUYWdzUmVnZXhlcxgHIAMoCRIZChFpZ25vcmVUYWdzUmVnZXhlcxgIIAMoCRIdChVpbnNlY3VyZVNraXBUTFNWZXJpZnkYCSABKAgSFgoOZGlzY292ZXJ5TGltaXQYCiABKAUikgEKB1Byb2plY3QSQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRJDCgZzdGF0dXMYAyABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdFN0YXR1cyLlAQoNUHJvamVjdENvbmZpZxJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkUKBHNwZWMYAiABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdENvbmZpZ1NwZWMSSQoGc3RhdHVzGAMgASgLMjkuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3RDb25maWdTdGF0dXMimQEKEVByb2plY3RDb25maWdMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEkIKBWl0ZW1zGAIgAygLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3RDb25maWcikgIKEVByb2plY3RDb25maWdTcGVjElAKEXByb21vdGlvblBvbGljaWVzGAEgAygLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblBvbGljeRJVChB3ZWJob29rUmVjZWl2ZXJzGAIgAygLMjsuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldlYmhvb2tSZWNlaXZlckNvbmZpZxJUCg9wcm9tb3Rpb25Xb3JrZXIYAyABKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uV29ya2VyQ29uZmlnIuoBChNQcm9qZWN0Q29uZmlnU3RhdHVzEkMKCmNvbmRpdGlvbnMYASADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEhoKEm9ic2VydmVkR2VuZXJhdGlvbhgDIAEoAxIaChJsYXN0SGFuZGxlZFJlZnJlc2gYBCABKAkSVgoQd2ViaG9va1JlY2VpdmVycxgCIAMoCzI8LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XZWJob29rUmVjZWl2ZXJEZXRhaWxzIo0BCgtQcm9qZWN0TGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRI8CgVpdGVtcxgCIAMoCzItLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0IpoBCgxQcm9qZWN0U3RhdHMSSAoKd2FyZWhvdXNlcxgBIAEoCzI0LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XYXJlaG91c2VTdGF0cxJACgZzdGFnZXMYAiABKAsyMC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2VTdGF0cyKXAQoNUHJvamVjdFN0YXR1cxJDCgpjb25kaXRpb25zGAMgAygLMi8uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkNvbmRpdGlvbhJBCgVzdGF0cxgEIAEoCzIyLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0U3RhdHMi2QEKCVByb21vdGlvbhJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkEKBHNwZWMYAiABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3BlYxJFCgZzdGF0dXMYAyABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RhdHVzIrQCChJQcm9tb3Rpb25Hcm91cFN0ZXASDAoEdXNlcxgBIAEoCRIKCgJhcxgCIAEoCRIKCgJpZhgDIAEoCRIXCg9jb250aW51ZU9uRXJyb3IYBCABKAgSRwoFcmV0cnkYBSABKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcFJldHJ5EkYKBHZhcnMYBiADKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRXhwcmVzc2lvblZhcmlhYmxlEk4KBmNvbmZpZxgHIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04ikQEKDVByb21vdGlvbkxpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESPgoFaXRlbXMYAiADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uIpQBCg9Qcm9tb3Rpb25Qb2xpY3kSDQoFc3RhZ2UYASABKAkSVAoNc3RhZ2VTZWxlY3RvchgDIAEoCzI9LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25Qb2xpY3lTZWxlY3RvchIcChRhdXRvUHJvbW90aW9uRW5hYmxlZBgCIAEoCCJzChdQcm9tb3Rpb25Qb2xpY3lTZWxlY3RvchIMCgRuYW1lGAEgASgJEkoKDWxhYmVsU2VsZWN0b3IYAiABKAsyMy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGFiZWxTZWxlY3RvciLyAQoSUHJvbW90aW9uUmVmZXJlbmNlEgwKBG5hbWUYASABKAkSRwoHZnJlaWdodBgCIAEoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0UmVmZXJlbmNlEkUKBnN0YXR1cxgDIAEoCzI1LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGF0dXMSPgoKZmluaXNoZWRBdBgEIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIskCCg1Qcm9tb3Rpb25TcGVjEg0KBXN0YWdlGAEgASgJEg8KB2ZyZWlnaHQYAiABKAkSRgoEdmFycxgEIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5FeHByZXNzaW9uVmFyaWFibGUSQgoFc3RlcHMYAyADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcBJGCglvbkZhaWx1cmUYBSADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcBJECgdmaW5hbGx5GAYgAygLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblN0ZXAi9gQKD1Byb21vdGlvblN0YXR1cxIaChJsYXN0SGFuZGxlZFJlZnJlc2gYBCABKAkSDQoFcGhhc2UYASABKAkSDwoHbWVzc2FnZRgCIAEoCRJHCgdmcmVpZ2h0GAUgASgLMjYuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRSZWZlcmVuY2USUgoRZnJlaWdodENvbGxlY3Rpb24YByABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodENvbGxlY3Rpb24SSwoMaGVhbHRoQ2hlY2tzGAggAygLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkhlYWx0aENoZWNrU3RlcBI9CglzdGFydGVkQXQYDCABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRI+CgpmaW5pc2hlZEF0GAYgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSEwoLY3VycmVudFN0ZXAYCSABKAMSWgoVc3RlcEV4ZWN1dGlvbk1ldGFkYXRhGAsgAygLMjsuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0ZXBFeGVjdXRpb25NZXRhZGF0YRJNCgVzdGF0ZRgKIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04i2AMKDVByb21vdGlvblN0ZXASDAoEdXNlcxgBIAEoCRJKCgR0YXNrGAUgASgLMjwuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblRhc2tSZWZlcmVuY2USCgoCYXMYAiABKAkSCgoCaWYYByABKAkSFwoPY29udGludWVPbkVycm9yGAggASgIEkcKBXJldHJ5GAQgASgLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblN0ZXBSZXRyeRIPCgdmb3JFYWNoGAkgASgJEkoKCHBhcmFsbGVsGAogASgLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblN0ZXBHcm91cBJGCgR2YXJzGAYgAygLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkV4cHJlc3Npb25WYXJpYWJsZRJOCgZjb25maWcYAyABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OInUKElByb21vdGlvblN0ZXBHcm91cBIWCg5tYXhDb25jdXJyZW5jeRgBIAEoBRJHCgVzdGVwcxgCIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25Hcm91cFN0ZXAihwIKElByb21vdGlvblN0ZXBSZXRyeRI/Cgd0aW1lb3V0GAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkR1cmF0aW9uEhYKDmVycm9yVGhyZXNob2xkGAIgASgNElAKB2JhY2tvZmYYAyABKAsyPy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcFJldHJ5QmFja29mZhJGCg5hdHRlbXB0VGltZW91dBgEIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5EdXJhdGlvbiLJAQoZUHJvbW90aW9uU3RlcFJldHJ5QmFja29mZhJHCg9pbml0aWFsSW50ZXJ2YWwYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24SDgoGZmFjdG9yGAIgASgFEkMKC21heEludGVydmFsGAMgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkR1cmF0aW9uEg4KBmppdHRlchgEIAEoBSKaAQoNUHJvbW90aW9uVGFzaxJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkUKBHNwZWMYAiABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGFza1NwZWMimQEKEVByb21vdGlvblRhc2tMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEkIKBWl0ZW1zGAIgAygLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblRhc2siNAoWUHJvbW90aW9uVGFza1JlZmVyZW5jZRIMCgRuYW1lGAEgASgJEgwKBGtpbmQYAiABKAkinwEKEVByb21vdGlvblRhc2tTcGVjEkYKBHZhcnMYASADKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRXhwcmVzc2lvblZhcmlhYmxlEkIKBXN0ZXBzGAIgAygLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblN0ZXAiXgoRUHJvbW90aW9uVGVtcGxhdGUSSQoEc3BlYxgBIAEoCzI7LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UZW1wbGF0ZVNwZWMisQIKFVByb21vdGlvblRlbXBsYXRlU3BlYxJGCgR2YXJzGAIgAygLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkV4cHJlc3Npb25WYXJpYWJsZRJCCgVzdGVwcxgBIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGVwEkYKCW9uRmFpbHVyZRgDIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGVwEkQKB2ZpbmFsbHkYBCADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcCJUChVQcm9tb3Rpb25Xb3JrZXJDb25maWcSOwoJcmVzb3VyY2VzGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLlJlc291cmNlUmVxdWlyZW1lbnRzIkkKC1B1bGxSZXF1ZXN0Eg4KBm51bWJlchgBIAEoAxINCgV0aXRsZRgCIAEoCRILCgN1cmwYAyABKAkSDgoGbGFiZWxzGAQgAygJIiUKEVB1bGxSZXF1ZXN0TG9va3VwEhAKCHByb3ZpZGVyGAEgASgJIlgKGVF1YXlXZWJob29rUmVjZWl2ZXJDb25maWcSOwoJc2VjcmV0UmVmGAEgASgLMiguazhzLmlvLmFwaS5jb3JlLnYxLkxvY2FsT2JqZWN0UmVmZXJlbmNlIkUKFlJlamVjdGVkSW1hZ2VSZWZlcmVuY2USCwoDdGFnGAEgASgJEg4KBmRpZ2VzdBgCIAEoCRIOCgZyZWFzb24YAyABKAkihgEKB1JlbGVhc2USDwoHcmVwb1VSTBgBIAEoCRILCgN0YWcYAiABKAkSDAoEbmFtZRgDIAEoCRILCgN1cmwYBCABKAkSQgoGYXNzZXRzGAUgAygLMjIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlJlbGVhc2VBc3NldCIpCgxSZWxlYXNlQXNzZXQSDAoEbmFtZRgBIAEoCRILCgN1cmwYAiABKAkidAoWUmVsZWFzZURpc2NvdmVyeVJlc3VsdBIPCgdyZXBvVVJMGAEgASgJEkkKCHJlbGVhc2VzGAIgAygLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRpc2NvdmVyZWRSZWxlYXNlIrwBChNSZWxlYXNlU3Vic2NyaXB0aW9uEg8KB3JlcG9VUkwYASABKAkSEAoIcHJvdmlkZXIYAiABKAkSFQoNc3RyaWN0U2VtdmVycxgDIAEoCBIYChBzZW12ZXJDb25zdHJhaW50GAQgASgJEhoKEmV4Y2x1ZGVQcmVyZWxlYXNlcxgFIAEoCBIdChVpbnNlY3VyZVNraXBUTFNWZXJpZnkYBiABKAgSFgoOZGlzY292ZXJ5TGltaXQYByABKAUi9gIKEFJlcG9TdWJzY3JpcHRpb24SQgoDZ2l0GAEgASgLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdFN1YnNjcmlwdGlvbhJGCgVpbWFnZRgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZVN1YnNjcmlwdGlvbhJGCgVjaGFydBgDIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydFN1YnNjcmlwdGlvbhJCCgNvY2kYBCABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuT0NJU3Vic2NyaXB0aW9uEkoKB3JlbGVhc2UYBSABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUmVsZWFzZVN1YnNjcmlwdGlvbiLNAQoFU3RhZ2USQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRI9CgRzcGVjGAIgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlU3BlYxJBCgZzdGF0dXMYAyABKAsyMS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2VTdGF0dXMiiQEKCVN0YWdlTGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRI6CgVpdGVtcxgCIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZSLQAgoJU3RhZ2VTcGVjEg0KBXNoYXJkGAQgASgJEkYKBHZhcnMYByADKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRXhwcmVzc2lvblZhcmlhYmxlEk4KEHJlcXVlc3RlZEZyZWlnaHQYBSADKAsyNC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFJlcXVlc3QSUgoRcHJvbW90aW9uVGVtcGxhdGUYBiABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGVtcGxhdGUSSAoMdmVyaWZpY2F0aW9uGAMgASgLMjIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWNhdGlvbiJeCgpTdGFnZVN0YXRzEg0KBWNvdW50GAIgASgDEkEKBmhlYWx0aBgBIAEoCzIxLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5IZWFsdGhTdGF0cyK4BQoLU3RhZ2VTdGF0dXMSQwoKY29uZGl0aW9ucxgNIAMoCzIvLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5Db25kaXRpb24SGgoSbGFzdEhhbmRsZWRSZWZyZXNoGAsgASgJEk8KDmZyZWlnaHRIaXN0b3J5GAQgAygLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRDb2xsZWN0aW9uEhYKDmZyZWlnaHRTdW1tYXJ5GAwgASgJEjwKBmhlYWx0aBgIIAEoCzIsLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5IZWFsdGgSGgoSb2JzZXJ2ZWRHZW5lcmF0aW9uGAYgASgDElIKEGN1cnJlbnRQcm9tb3Rpb24YByABKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uUmVmZXJlbmNlEk8KDWxhc3RQcm9tb3Rpb24YCiABKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uUmVmZXJlbmNlEhwKFGF1dG9Qcm9tb3Rpb25FbmFibGVkGA4gASgIElEKCG1ldGFkYXRhGA8gAygLMj8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlU3RhdHVzLk1ldGFkYXRhRW50cnkabwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSTQoFdmFsdWUYAiABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OOgI4ASLXAgoVU3RlcEV4ZWN1dGlvbk1ldGFkYXRhEg0KBWFsaWFzGAEgASgJEj0KCXN0YXJ0ZWRBdBgCIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEj4KCmZpbmlzaGVkQXQYAyABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRISCgplcnJvckNvdW50GAQgASgNEg4KBnN0YXR1cxgFIAEoCRIPCgdtZXNzYWdlGAYgASgJEhcKD2NvbnRpbnVlT25FcnJvchgHIAEoCBINCgVibG9jaxgIIAEoCRIQCghhdHRlbXB0cxgJIAEoDRJBCg1uZXh0QXR0ZW1wdEF0GAogASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUi1AIKDFZlcmlmaWNhdGlvbhJaChFhbmFseXNpc1RlbXBsYXRlcxgBIAMoCzI/LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BbmFseXNpc1RlbXBsYXRlUmVmZXJlbmNlElYKE2FuYWx5c2lzUnVuTWV0YWRhdGEYAiABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQW5hbHlzaXNSdW5NZXRhZGF0YRJHCgRhcmdzGAMgAygLMjkuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuQXJndW1lbnQSRwoGY2hlY2tzGAQgAygLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWNhdGlvbkNoZWNrIvMCChFWZXJpZmljYXRpb25DaGVjaxIMCgRuYW1lGAEgASgJEg0KBWNvdW50GAIgASgFEkAKCGludGVydmFsGAMgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkR1cmF0aW9uEhQKDGZhaWx1cmVMaW1pdBgEIAEoBRJHCgNqb2IYBSABKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuVmVyaWZpY2F0aW9uSm9iQ2hlY2sSSQoEaHR0cBgGIAEoCzI7LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5WZXJpZmljYXRpb25IVFRQQ2hlY2sSVQoKcHJvbWV0aGV1cxgHIAEoCzJBLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5WZXJpZmljYXRpb25Qcm9tZXRoZXVzQ2hlY2si9QEKF1ZlcmlmaWNhdGlvbkNoZWNrUmVzdWx0EgwKBG5hbWUYASABKAkSDQoFcGhhc2UYAiABKAkSDwoHbWVzc2FnZRgDIAEoCRINCgVjb3VudBgEIAEoBRISCgpzdWNjZXNzZnVsGAUgASgFEg4KBmZhaWxlZBgGIAEoBRIUCgxpbmNvbmNsdXNpdmUYByABKAUSDQoFZXJyb3IYCCABKAUSRwoTbGFzdE1lYXN1cmVtZW50VGltZRgJIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEgsKA2pvYhgKIAEoCSKnAgoVVmVyaWZpY2F0aW9uSFRUUENoZWNrEgsKA3VybBgBIAEoCRIOCgZtZXRob2QYAiABKAkSTQoHaGVhZGVycxgDIAMoCzI8LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5WZXJpZmljYXRpb25IVFRQSGVhZGVyEgwKBGJvZHkYBCABKAkSHQoVaW5zZWN1cmVTa2lwVExTVmVyaWZ5GAUgASgIEj8KB3RpbWVvdXQYBiABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24SGQoRc3VjY2Vzc0V4cHJlc3Npb24YByABKAkSGQoRZmFpbHVyZUV4cHJlc3Npb24YCCABKAkiNQoWVmVyaWZpY2F0aW9uSFRUUEhlYWRlchIMCgRuYW1lGAEgASgJEg0KBXZhbHVlGAIgASgJIuwCChBWZXJpZmljYXRpb25JbmZvEgoKAmlkGAQgASgJEg0KBWFjdG9yGAcgASgJEj0KCXN0YXJ0VGltZRgFIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEg0KBXBoYXNlGAEgASgJEg8KB21lc3NhZ2UYAiABKAkSTwoLYW5hbHlzaXNSdW4YAyABKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQW5hbHlzaXNSdW5SZWZlcmVuY2USPgoKZmluaXNoVGltZRgGIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEk0KBmNoZWNrcxgIIAMoCzI9LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5WZXJpZmljYXRpb25DaGVja1Jlc3VsdCLfAgoUVmVyaWZpY2F0aW9uSm9iQ2hlY2sSVgoGbGFiZWxzGAEgAygLMkYuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWNhdGlvbkpvYkNoZWNrLkxhYmVsc0VudHJ5EmAKC2Fubm90YXRpb25zGAIgAygLMksuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWNhdGlvbkpvYkNoZWNrLkFubm90YXRpb25zRW50cnkSKgoEc3BlYxgDIAEoCzIcLms4cy5pby5hcGkuYmF0Y2gudjEuSm9iU3BlYxotCgtMYWJlbHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKiAgobVmVyaWZpY2F0aW9uUHJvbWV0aGV1c0NoZWNrEg8KB2FkZHJlc3MYASABKAkSDQoFcXVlcnkYAiABKAkSTQoHaGVhZGVycxgDIAMoCzI8LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5WZXJpZmljYXRpb25IVFRQSGVhZGVyEh0KFWluc2VjdXJlU2tpcFRMU1ZlcmlmeRgEIAEoCBI/Cgd0aW1lb3V0GAUgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkR1cmF0aW9uEhkKEXN1Y2Nlc3NFeHByZXNzaW9uGAYgASgJEhkKEWZhaWx1cmVFeHByZXNzaW9uGAcgASgJIpQBCg1WZXJpZmllZFN0YWdlEj4KCnZlcmlmaWVkQXQYASABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRJDCgtsb25nZXN0U29haxgCIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5EdXJhdGlvbiLZAQoJV2FyZWhvdXNlEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESQQoEc3BlYxgCIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XYXJlaG91c2VTcGVjEkUKBnN0YXR1cxgDIAEoCzI1LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XYXJlaG91c2VTdGF0dXMikQEKDVdhcmVob3VzZUxpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESPgoFaXRlbXMYAiADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlIsIDCg1XYXJlaG91c2VTcGVjEg0KBXNoYXJkGAIgASgJEkAKCGludGVydmFsGAQgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkR1cmF0aW9uEh0KFWZyZWlnaHRDcmVhdGlvblBvbGljeRgDIAEoCRJNCg1zdWJzY3JpcHRpb25zGAEgAygLMjYuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlJlcG9TdWJzY3JpcHRpb24SXgoXZnJlaWdodENyZWF0aW9uQ3JpdGVyaWEYBSABKAsyPS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodENyZWF0aW9uQ3JpdGVyaWESEAoIc2NoZWR1bGUYBiABKAkSEAoIdGltZVpvbmUYByABKAkSTQoPYmxhY2tvdXRXaW5kb3dzGAggAygLMjQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkJsYWNrb3V0V2luZG93Eh8KF3JlZnJlc2hlc0J5cGFzc1NjaGVkdWxlGAkgASgIImIKDldhcmVob3VzZVN0YXRzEg0KBWNvdW50GAIgASgDEkEKBmhlYWx0aBgBIAEoCzIxLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5IZWFsdGhTdGF0cyLEAgoPV2FyZWhvdXNlU3RhdHVzEkMKCmNvbmRpdGlvbnMYCSADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEhoKEmxhc3RIYW5kbGVkUmVmcmVzaBgGIAEoCRIaChJvYnNlcnZlZEdlbmVyYXRpb24YBCABKAMSFQoNbGFzdEZyZWlnaHRJRBgIIAEoCRJWChNkaXNjb3ZlcmVkQXJ0aWZhY3RzGAcgASgLMjkuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRpc2NvdmVyZWRBcnRpZmFjdHMSRQoRbmV4dERpc2NvdmVyeVRpbWUYCiABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSLzBgoVV2ViaG9va1JlY2VpdmVyQ29uZmlnEgwKBG5hbWUYASABKAkSVwoJYml0YnVja2V0GAUgASgLMkQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkJpdGJ1Y2tldFdlYmhvb2tSZWNlaXZlckNvbmZpZxJXCglkb2NrZXJodWIYBiABKAsyRC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRG9ja2VySHViV2ViaG9va1JlY2VpdmVyQ29uZmlnElEKBmdpdGh1YhgCIAEoCzJBLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXRIdWJXZWJob29rUmVjZWl2ZXJDb25maWcSUQoGZ2l0bGFiGAMgASgLMkEuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdExhYldlYmhvb2tSZWNlaXZlckNvbmZpZxJRCgZoYXJib3IYCiABKAsyQS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSGFyYm9yV2ViaG9va1JlY2VpdmVyQ29uZmlnEk0KBHF1YXkYBCABKAsyPy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUXVheVdlYmhvb2tSZWNlaXZlckNvbmZpZxJbCgthcnRpZmFjdG9yeRgJIAEoCzJGLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BcnRpZmFjdG9yeVdlYmhvb2tSZWNlaXZlckNvbmZpZxJPCgVhenVyZRgIIAEoCzJALmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BenVyZVdlYmhvb2tSZWNlaXZlckNvbmZpZxJPCgVnaXRlYRgHIAEoCzJALmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXRlYVdlYmhvb2tSZWNlaXZlckNvbmZpZxJTCgdnZW5lcmljGAsgASgLMkIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdlbmVyaWNXZWJob29rUmVjZWl2ZXJDb25maWciQQoWV2ViaG9va1JlY2VpdmVyRGV0YWlscxIMCgRuYW1lGAEgASgJEgwKBHBhdGgYAyABKAkSCwoDdXJsGAQgASgJQpcCCihjb20uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExQg5HZW5lcmF0ZWRQcm90b1ABWiRnaXRodWIuY29tL2FrdWl0eS9rYXJnby9hcGkvdjFhbHBoYTGiAgVHQ0FLQaoCJEdpdGh1Yi5Db20uQWt1aXR5LkthcmdvLkFwaS5WMWFscGhhMcoCJEdpdGh1YlxDb21cQWt1aXR5XEthcmdvXEFwaVxWMWFscGhhMeICMEdpdGh1YlxDb21cQWt1aXR5XEthcmdvXEFwaVxWMWFscGhhMVxHUEJNZXRhZGF0YeoCKUdpdGh1Yjo6Q29tOjpBa3VpdHk6OkthcmdvOjpBcGk6OlYxYWxwaGEx", [file_k8s_io_api_batch_v1_generated, file_k8s_io_api_core_v1_generated, file_k8s_io_apiextensions_apiserver_pkg_apis_apiextensions_v1_generated, file_k8s_io_apimachinery_pkg_apis_meta_v1_generated, file_k8s_io_apimachinery_pkg_runtime_generated, file_k8s_io_apimachinery_pkg_runtime_schema_generated]);
 
 /**
  * AnalysisRunArgument represents an argument to be added to an AnalysisRun.
@@ -446,6 +448,49 @@ export type BitbucketWebhookReceiverConfig = Message<"github.com.akuity.kargo.ap
 export const BitbucketWebhookReceiverConfigSchema: GenMessage<BitbucketWebhookReceiverConfig> = /*@__PURE__*/
   messageDesc(file_api_v1alpha1_generated, 11);
 
+/**
+ * BlackoutWindow is a period of time during which a Warehouse will not
+ * discover artifacts.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.BlackoutWindow
+ */
+export type BlackoutWindow = Message<"github.com.akuity.kargo.api.v1alpha1.BlackoutWindow"> & {
+  /**
+   * Start is the time at which the window begins.
+   *
+   * +kubebuilder:validation:Required
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time start = 1;
+   */
+  start?: Time;
+
+  /**
+   * End is the time at which the window ends. It must be after Start.
+   *
+   * +kubebuilder:validation:Required
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time end = 2;
+   */
+  end?: Time;
+
+  /**
+   * Reason is an optional, human-readable explanation of the window's
+   * purpose. e.g. "End of quarter freeze"
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string reason = 3;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.BlackoutWindow.
+ * Use `create(BlackoutWindowSchema)` to create a new message.
+ */
+export const BlackoutWindowSchema: GenMessage<BlackoutWindow> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 12);
+
 /**
  * Chart describes a specific version of a Helm chart.
  *
@@ -484,7 +529,7 @@ export type Chart = Message<"github.com.akuity.kargo.api.v1alpha1.Chart"> & {
  * Use `create(ChartSchema)` to create a new message.
  */
 export const ChartSchema: GenMessage<Chart> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 12);
+  messageDesc(file_api_v1alpha1_generated, 13);
 
 /**
  * ChartDiscoveryResult represents the result of a chart discovery operation for
@@ -537,11 +582,13 @@ export type ChartDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1
  * Use `create(ChartDiscoveryResultSchema)` to create a new message.
  */
 export const ChartDiscoveryResultSchema: GenMessage<ChartDiscoveryResult> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 13);
+  messageDesc(file_api_v1alpha1_generated, 14);
 
 /**
  * ChartSubscription defines a subscription to a Helm chart repository.
  *
+ * +kubebuilder:validation:XValidation:message="If selectionStrategy is Expression, sortKeyExpression must be set",rule="!(has(self.selectionStrategy) && self.selectionStrategy == 'Expression') || has(self.sortKeyExpression)"
+ *
  * @generated from message github.com.akuity.kargo.api.v1alpha1.ChartSubscription
  */
 export type ChartSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.ChartSubscription"> & {
@@ -587,10 +634,84 @@ export type ChartSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Ch
    */
   semverConstraint: string;
 
+  /**
+   * SelectionStrategy specifies the rules for how to order the versions of
+   * the chart. This field is optional. When left unspecified, the field is
+   * implicitly treated as if its value were "SemVer".
+   *
+   * Accepted values:
+   *
+   * - "SemVer": Selects the semantically greatest chart version.
+   *
+   * - "Expression": Selects the chart version with the greatest key, as
+   *   extracted from each version by the expression in the SortKeyExpression
+   *   field. Useful when versions follow a scheme that semantic version
+   *   precedence does not order correctly. The SemverConstraint field can
+   *   optionally be used to narrow the set of versions eligible for selection.
+   *
+   * +kubebuilder:default=SemVer
+   *
+   * @generated from field: optional string selectionStrategy = 6;
+   */
+  selectionStrategy: string;
+
+  /**
+   * SortKeyExpression is an expression that extracts a sortable key from each
+   * chart version. The value in this field only has any effect when the
+   * SelectionStrategy is Expression, in which case it is required. Versions
+   * are ordered from greatest to least key.
+   *
+   * The expression should be a valid expr-lang expression that evaluates to
+   * a number, a string, a time, a semantic version (e.g. as returned by the
+   * `semverParse()` function), or a list of any of these, in which case keys
+   * are compared element by element. All versions must produce keys of the
+   * same type. Versions for which the expression evaluates to nil are
+   * excluded from consideration.
+   *
+   * Available variables:
+   *   - `version`: The version of the chart.
+   *
+   * Refer to the expr-lang documentation for more details on syntax and
+   * capabilities of the expression language: https://expr-lang.org.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string sortKeyExpression = 7;
+   */
+  sortKeyExpression: string;
+
+  /**
+   * ExpressionFilter is an expression that can optionally be used to limit
+   * the chart versions that are considered based on their metadata. The
+   * filter is applied after the SemverConstraint field.
+   *
+   * The expression should be a valid expr-lang expression that evaluates to
+   * true or false. When the expression evaluates to true, the chart version
+   * is included in the set that is considered. When the expression evaluates
+   * to false, the chart version is excluded.
+   *
+   * Available variables:
+   *   - `version`: The version of the chart.
+   *   - `appVersion`: The appVersion of the chart.
+   *   - `annotations`: A map of the annotations from the chart's metadata.
+   *
+   * Note that for charts stored in OCI registries, evaluating this expression
+   * requires retrieving the metadata of each candidate chart version.
+   *
+   * Refer to the expr-lang documentation for more details on syntax and
+   * capabilities of the expression language: https://expr-lang.org.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string expressionFilter = 5;
+   */
+  expressionFilter: string;
+
   /**
    * DiscoveryLimit is an optional limit on the number of chart versions that
    * can be discovered for this subscription. The limit is applied after
-   * filtering charts based on the SemverConstraint field.
+   * filtering charts based on the SemverConstraint and ExpressionFilter
+   * fields.
    * When left unspecified, the field is implicitly treated as if its value
    * were "20". The upper limit for this field is 100.
    *
@@ -608,7 +729,7 @@ export type ChartSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Ch
  * Use `create(ChartSubscriptionSchema)` to create a new message.
  */
 export const ChartSubscriptionSchema: GenMessage<ChartSubscription> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 14);
+  messageDesc(file_api_v1alpha1_generated, 15);
 
 /**
  * ClusterConfig is a resource type that describes cluster-level Kargo
@@ -642,7 +763,7 @@ export type ClusterConfig = Message<"github.com.akuity.kargo.api.v1alpha1.Cluste
  * Use `create(ClusterConfigSchema)` to create a new message.
  */
 export const ClusterConfigSchema: GenMessage<ClusterConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 15);
+  messageDesc(file_api_v1alpha1_generated, 16);
 
 /**
  * ClusterConfigList contains a list of ClusterConfigs.
@@ -666,7 +787,7 @@ export type ClusterConfigList = Message<"github.com.akuity.kargo.api.v1alpha1.Cl
  * Use `create(ClusterConfigListSchema)` to create a new message.
  */
 export const ClusterConfigListSchema: GenMessage<ClusterConfigList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 16);
+  messageDesc(file_api_v1alpha1_generated, 17);
 
 /**
  * ClusterConfigSpec describes cluster-level Kargo configuration.
@@ -688,7 +809,7 @@ export type ClusterConfigSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Cl
  * Use `create(ClusterConfigSpecSchema)` to create a new message.
  */
 export const ClusterConfigSpecSchema: GenMessage<ClusterConfigSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 17);
+  messageDesc(file_api_v1alpha1_generated, 18);
 
 /**
  * ClusterConfigStatus describes the current status of a ClusterConfig.
@@ -740,7 +861,7 @@ export type ClusterConfigStatus = Message<"github.com.akuity.kargo.api.v1alpha1.
  * Use `create(ClusterConfigStatusSchema)` to create a new message.
  */
 export const ClusterConfigStatusSchema: GenMessage<ClusterConfigStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 18);
+  messageDesc(file_api_v1alpha1_generated, 19);
 
 /**
  * @generated from message github.com.akuity.kargo.api.v1alpha1.ClusterPromotionTask
@@ -767,7 +888,7 @@ export type ClusterPromotionTask = Message<"github.com.akuity.kargo.api.v1alpha1
  * Use `create(ClusterPromotionTaskSchema)` to create a new message.
  */
 export const ClusterPromotionTaskSchema: GenMessage<ClusterPromotionTask> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 19);
+  messageDesc(file_api_v1alpha1_generated, 20);
 
 /**
  * ClusterPromotionTaskList contains a list of PromotionTasks.
@@ -791,7 +912,7 @@ export type ClusterPromotionTaskList = Message<"github.com.akuity.kargo.api.v1al
  * Use `create(ClusterPromotionTaskListSchema)` to create a new message.
  */
 export const ClusterPromotionTaskListSchema: GenMessage<ClusterPromotionTaskList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 20);
+  messageDesc(file_api_v1alpha1_generated, 21);
 
 /**
  * CurrentStage reflects a Stage's current use of Freight.
@@ -814,7 +935,7 @@ export type CurrentStage = Message<"github.com.akuity.kargo.api.v1alpha1.Current
  * Use `create(CurrentStageSchema)` to create a new message.
  */
 export const CurrentStageSchema: GenMessage<CurrentStage> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 21);
+  messageDesc(file_api_v1alpha1_generated, 22);
 
 /**
  * DiscoveredArtifacts holds the artifacts discovered by the Warehouse for its
@@ -861,6 +982,26 @@ export type DiscoveredArtifacts = Message<"github.com.akuity.kargo.api.v1alpha1.
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ChartDiscoveryResult charts = 3;
    */
   charts: ChartDiscoveryResult[];
+
+  /**
+   * OCIArtifacts holds the artifacts discovered by the Warehouse for the OCI
+   * subscriptions.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.OCIArtifactDiscoveryResult ociArtifacts = 5;
+   */
+  ociArtifacts: OCIArtifactDiscoveryResult[];
+
+  /**
+   * Releases holds the releases discovered by the Warehouse for the release
+   * subscriptions.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ReleaseDiscoveryResult releases = 6;
+   */
+  releases: ReleaseDiscoveryResult[];
 };
 
 /**
@@ -868,7 +1009,7 @@ export type DiscoveredArtifacts = Message<"github.com.akuity.kargo.api.v1alpha1.
  * Use `create(DiscoveredArtifactsSchema)` to create a new message.
  */
 export const DiscoveredArtifactsSchema: GenMessage<DiscoveredArtifacts> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 22);
+  messageDesc(file_api_v1alpha1_generated, 23);
 
 /**
  * DiscoveredCommit represents a commit discovered by a Warehouse for a
@@ -932,6 +1073,15 @@ export type DiscoveredCommit = Message<"github.com.akuity.kargo.api.v1alpha1.Dis
    * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time creatorDate = 7;
    */
   creatorDate?: Time;
+
+  /**
+   * PullRequests describes the pull requests that contain the commit. This
+   * field is only populated for the latest discovered commit, and only when
+   * the GitSubscription specifies a PullRequestLookup.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PullRequest pullRequests = 8;
+   */
+  pullRequests: PullRequest[];
 };
 
 /**
@@ -939,7 +1089,7 @@ export type DiscoveredCommit = Message<"github.com.akuity.kargo.api.v1alpha1.Dis
  * Use `create(DiscoveredCommitSchema)` to create a new message.
  */
 export const DiscoveredCommitSchema: GenMessage<DiscoveredCommit> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 23);
+  messageDesc(file_api_v1alpha1_generated, 24);
 
 /**
  * DiscoveredImageReference represents an image reference discovered by a
@@ -993,7 +1143,128 @@ export type DiscoveredImageReference = Message<"github.com.akuity.kargo.api.v1al
  * Use `create(DiscoveredImageReferenceSchema)` to create a new message.
  */
 export const DiscoveredImageReferenceSchema: GenMessage<DiscoveredImageReference> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 24);
+  messageDesc(file_api_v1alpha1_generated, 25);
+
+/**
+ * DiscoveredOCIArtifact represents an artifact discovered by a Warehouse for
+ * an OCISubscription.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.DiscoveredOCIArtifact
+ */
+export type DiscoveredOCIArtifact = Message<"github.com.akuity.kargo.api.v1alpha1.DiscoveredOCIArtifact"> & {
+  /**
+   * Tag is the tag of the artifact.
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:MaxLength=128
+   * +kubebuilder:validation:Pattern=`^[\w.\-\_]+$`
+   * +akuity:test-kubebuilder-pattern=Tag
+   *
+   * @generated from field: optional string tag = 1;
+   */
+  tag: string;
+
+  /**
+   * Digest is the digest of the artifact's manifest.
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:Pattern=`^[a-z0-9]+:[a-f0-9]+$`
+   * +akuity:test-kubebuilder-pattern=Digest
+   *
+   * @generated from field: optional string digest = 2;
+   */
+  digest: string;
+
+  /**
+   * ArtifactType is the type of the artifact.
+   *
+   * @generated from field: optional string artifactType = 3;
+   */
+  artifactType: string;
+
+  /**
+   * Annotations is a map of key-value pairs read from the artifact's manifest.
+   *
+   * @generated from field: map<string, string> annotations = 4;
+   */
+  annotations: { [key: string]: string };
+
+  /**
+   * CreatedAt is the time the artifact was created. This field is optional,
+   * and only populated if the artifact's manifest is annotated with its
+   * creation time.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time createdAt = 5;
+   */
+  createdAt?: Time;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.DiscoveredOCIArtifact.
+ * Use `create(DiscoveredOCIArtifactSchema)` to create a new message.
+ */
+export const DiscoveredOCIArtifactSchema: GenMessage<DiscoveredOCIArtifact> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 26);
+
+/**
+ * DiscoveredRelease represents a release discovered by a Warehouse for a
+ * ReleaseSubscription.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.DiscoveredRelease
+ */
+export type DiscoveredRelease = Message<"github.com.akuity.kargo.api.v1alpha1.DiscoveredRelease"> & {
+  /**
+   * Tag is the name of the tag the release was created from.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string tag = 1;
+   */
+  tag: string;
+
+  /**
+   * Name is the name (title) of the release.
+   *
+   * @generated from field: optional string name = 2;
+   */
+  name: string;
+
+  /**
+   * URL is the URL of the release's web page.
+   *
+   * @generated from field: optional string url = 3;
+   */
+  url: string;
+
+  /**
+   * Prerelease indicates whether the release is marked as a prerelease by the
+   * Git forge.
+   *
+   * @generated from field: optional bool prerelease = 4;
+   */
+  prerelease: boolean;
+
+  /**
+   * Assets is a list of the assets attached to the release.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ReleaseAsset assets = 5;
+   */
+  assets: ReleaseAsset[];
+
+  /**
+   * PublishedAt is the time the release was published.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time publishedAt = 6;
+   */
+  publishedAt?: Time;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.DiscoveredRelease.
+ * Use `create(DiscoveredReleaseSchema)` to create a new message.
+ */
+export const DiscoveredReleaseSchema: GenMessage<DiscoveredRelease> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 27);
 
 /**
  * DockerHubWebhookReceiverConfig describes a webhook receiver that is
@@ -1026,7 +1297,7 @@ export type DockerHubWebhookReceiverConfig = Message<"github.com.akuity.kargo.ap
  * Use `create(DockerHubWebhookReceiverConfigSchema)` to create a new message.
  */
 export const DockerHubWebhookReceiverConfigSchema: GenMessage<DockerHubWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 25);
+  messageDesc(file_api_v1alpha1_generated, 28);
 
 /**
  * ExpressionVariable describes a single variable that may be referenced by
@@ -1064,7 +1335,7 @@ export type ExpressionVariable = Message<"github.com.akuity.kargo.api.v1alpha1.E
  * Use `create(ExpressionVariableSchema)` to create a new message.
  */
 export const ExpressionVariableSchema: GenMessage<ExpressionVariable> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 26);
+  messageDesc(file_api_v1alpha1_generated, 29);
 
 /**
  * Freight represents a collection of versioned artifacts.
@@ -1122,6 +1393,20 @@ export type Freight = Message<"github.com.akuity.kargo.api.v1alpha1.Freight"> &
    */
   charts: Chart[];
 
+  /**
+   * OCIArtifacts describes specific versions of specific OCI artifacts.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.OCIArtifact ociArtifacts = 10;
+   */
+  ociArtifacts: OCIArtifact[];
+
+  /**
+   * Releases describes specific releases of repositories hosted by Git forges.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Release releases = 11;
+   */
+  releases: Release[];
+
   /**
    * Status describes the current status of this Freight.
    *
@@ -1135,7 +1420,7 @@ export type Freight = Message<"github.com.akuity.kargo.api.v1alpha1.Freight"> &
  * Use `create(FreightSchema)` to create a new message.
  */
 export const FreightSchema: GenMessage<Freight> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 27);
+  messageDesc(file_api_v1alpha1_generated, 30);
 
 /**
  * FreightCollection is a collection of FreightReferences, each of which
@@ -1175,7 +1460,7 @@ export type FreightCollection = Message<"github.com.akuity.kargo.api.v1alpha1.Fr
  * Use `create(FreightCollectionSchema)` to create a new message.
  */
 export const FreightCollectionSchema: GenMessage<FreightCollection> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 28);
+  messageDesc(file_api_v1alpha1_generated, 31);
 
 /**
  * FreightCreationCriteria defines criteria that must be satisfied for Freight
@@ -1198,7 +1483,7 @@ export type FreightCreationCriteria = Message<"github.com.akuity.kargo.api.v1alp
  * Use `create(FreightCreationCriteriaSchema)` to create a new message.
  */
 export const FreightCreationCriteriaSchema: GenMessage<FreightCreationCriteria> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 29);
+  messageDesc(file_api_v1alpha1_generated, 32);
 
 /**
  * FreightList is a list of Freight resources.
@@ -1222,7 +1507,7 @@ export type FreightList = Message<"github.com.akuity.kargo.api.v1alpha1.FreightL
  * Use `create(FreightListSchema)` to create a new message.
  */
 export const FreightListSchema: GenMessage<FreightList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 30);
+  messageDesc(file_api_v1alpha1_generated, 33);
 
 /**
  * FreightOrigin describes a kind of Freight in terms of where it may have
@@ -1259,7 +1544,7 @@ export type FreightOrigin = Message<"github.com.akuity.kargo.api.v1alpha1.Freigh
  * Use `create(FreightOriginSchema)` to create a new message.
  */
 export const FreightOriginSchema: GenMessage<FreightOrigin> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 31);
+  messageDesc(file_api_v1alpha1_generated, 34);
 
 /**
  * FreightReference is a simplified representation of a piece of Freight -- not
@@ -1304,6 +1589,20 @@ export type FreightReference = Message<"github.com.akuity.kargo.api.v1alpha1.Fre
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Chart charts = 4;
    */
   charts: Chart[];
+
+  /**
+   * OCIArtifacts describes specific versions of specific OCI artifacts.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.OCIArtifact ociArtifacts = 9;
+   */
+  ociArtifacts: OCIArtifact[];
+
+  /**
+   * Releases describes specific releases of repositories hosted by Git forges.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Release releases = 10;
+   */
+  releases: Release[];
 };
 
 /**
@@ -1311,7 +1610,7 @@ export type FreightReference = Message<"github.com.akuity.kargo.api.v1alpha1.Fre
  * Use `create(FreightReferenceSchema)` to create a new message.
  */
 export const FreightReferenceSchema: GenMessage<FreightReference> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 32);
+  messageDesc(file_api_v1alpha1_generated, 35);
 
 /**
  * FreightRequest expresses a Stage's need for Freight having originated from a
@@ -1344,7 +1643,7 @@ export type FreightRequest = Message<"github.com.akuity.kargo.api.v1alpha1.Freig
  * Use `create(FreightRequestSchema)` to create a new message.
  */
 export const FreightRequestSchema: GenMessage<FreightRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 33);
+  messageDesc(file_api_v1alpha1_generated, 36);
 
 /**
  * @generated from message github.com.akuity.kargo.api.v1alpha1.FreightSources
@@ -1422,7 +1721,7 @@ export type FreightSources = Message<"github.com.akuity.kargo.api.v1alpha1.Freig
  * Use `create(FreightSourcesSchema)` to create a new message.
  */
 export const FreightSourcesSchema: GenMessage<FreightSources> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 34);
+  messageDesc(file_api_v1alpha1_generated, 37);
 
 /**
  * FreightStatus describes a piece of Freight's most recently observed state.
@@ -1470,7 +1769,7 @@ export type FreightStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Freigh
  * Use `create(FreightStatusSchema)` to create a new message.
  */
 export const FreightStatusSchema: GenMessage<FreightStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 35);
+  messageDesc(file_api_v1alpha1_generated, 38);
 
 /**
  * GenericWebhookAction describes an action to be performed on a resource
@@ -1525,7 +1824,7 @@ export type GenericWebhookAction = Message<"github.com.akuity.kargo.api.v1alpha1
  * Use `create(GenericWebhookActionSchema)` to create a new message.
  */
 export const GenericWebhookActionSchema: GenMessage<GenericWebhookAction> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 36);
+  messageDesc(file_api_v1alpha1_generated, 39);
 
 /**
  * GenericWebhookReceiverConfig describes a generic webhook receiver that can be
@@ -1575,7 +1874,7 @@ export type GenericWebhookReceiverConfig = Message<"github.com.akuity.kargo.api.
  * Use `create(GenericWebhookReceiverConfigSchema)` to create a new message.
  */
 export const GenericWebhookReceiverConfigSchema: GenMessage<GenericWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 37);
+  messageDesc(file_api_v1alpha1_generated, 40);
 
 /**
  * GenericWebhookTargetSelectionCriteria describes selection criteria for resources to which some
@@ -1631,7 +1930,7 @@ export type GenericWebhookTargetSelectionCriteria = Message<"github.com.akuity.k
  * Use `create(GenericWebhookTargetSelectionCriteriaSchema)` to create a new message.
  */
 export const GenericWebhookTargetSelectionCriteriaSchema: GenMessage<GenericWebhookTargetSelectionCriteria> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 38);
+  messageDesc(file_api_v1alpha1_generated, 41);
 
 /**
  * GitCommit describes a specific commit from a specific Git repository.
@@ -1690,6 +1989,16 @@ export type GitCommit = Message<"github.com.akuity.kargo.api.v1alpha1.GitCommit"
    * @generated from field: optional string committer = 8;
    */
   committer: string;
+
+  /**
+   * PullRequests describes the pull requests (or equivalent; e.g. GitLab merge
+   * requests) that contain the commit. This is only populated when the
+   * GitSubscription from which the commit was discovered specifies a
+   * PullRequestLookup.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PullRequest pullRequests = 9;
+   */
+  pullRequests: PullRequest[];
 };
 
 /**
@@ -1697,7 +2006,7 @@ export type GitCommit = Message<"github.com.akuity.kargo.api.v1alpha1.GitCommit"
  * Use `create(GitCommitSchema)` to create a new message.
  */
 export const GitCommitSchema: GenMessage<GitCommit> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 39);
+  messageDesc(file_api_v1alpha1_generated, 42);
 
 /**
  * GitDiscoveryResult represents the result of a Git discovery operation for a
@@ -1734,7 +2043,7 @@ export type GitDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1.G
  * Use `create(GitDiscoveryResultSchema)` to create a new message.
  */
 export const GitDiscoveryResultSchema: GenMessage<GitDiscoveryResult> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 40);
+  messageDesc(file_api_v1alpha1_generated, 43);
 
 /**
  * GitHubWebhookReceiverConfig describes a webhook receiver that is compatible
@@ -1768,7 +2077,7 @@ export type GitHubWebhookReceiverConfig = Message<"github.com.akuity.kargo.api.v
  * Use `create(GitHubWebhookReceiverConfigSchema)` to create a new message.
  */
 export const GitHubWebhookReceiverConfigSchema: GenMessage<GitHubWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 41);
+  messageDesc(file_api_v1alpha1_generated, 44);
 
 /**
  * GitLabWebhookReceiverConfig describes a webhook receiver that is compatible
@@ -1802,11 +2111,13 @@ export type GitLabWebhookReceiverConfig = Message<"github.com.akuity.kargo.api.v
  * Use `create(GitLabWebhookReceiverConfigSchema)` to create a new message.
  */
 export const GitLabWebhookReceiverConfigSchema: GenMessage<GitLabWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 42);
+  messageDesc(file_api_v1alpha1_generated, 45);
 
 /**
  * GitSubscription defines a subscription to a Git repository.
  *
+ * +kubebuilder:validation:XValidation:message="If commitSelectionStrategy is Expression, sortKeyExpression must be set",rule="!(has(self.commitSelectionStrategy) && self.commitSelectionStrategy == 'Expression') || has(self.sortKeyExpression)"
+ *
  * @generated from message github.com.akuity.kargo.api.v1alpha1.GitSubscription
  */
 export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitSubscription"> & {
@@ -1846,6 +2157,13 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
    *   tag. The AllowTagsRegexes and IgnoreTagsRegexes fields can optionally be
    *   used to narrow the set of tags eligible for selection.
    *
+   * - "Expression": Selects the commit referenced by the tag with the greatest
+   *   key, as extracted from each tag by the expression in the
+   *   SortKeyExpression field. Useful when tags follow a scheme that none of
+   *   the other strategies order correctly. The AllowTagsRegexes and
+   *   IgnoreTagsRegexes fields can optionally be used to narrow the set of tags
+   *   eligible for selection.
+   *
    * +kubebuilder:default=NewestFromBranch
    *
    * @generated from field: optional string commitSelectionStrategy = 2;
@@ -1903,7 +2221,7 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
    * AllowTags is a regular expression that can optionally be used to limit the
    * tags that are considered in determining the newest commit of interest. The
    * value in this field only has any effect when the CommitSelectionStrategy is
-   * Lexical, NewestTag, or SemVer. This field is optional.
+   * Expression, Lexical, NewestTag, or SemVer. This field is optional.
    *
    * Deprecated: Use AllowTagsRegexes instead. Beginning in v1.11.0, artifact
    * discovery will FAIL if this field is non-empty. This field will be removed
@@ -1919,8 +2237,8 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
    * AllowTagsRegexes is a list of regular expressions that can optionally be
    * used to limit the tags that are considered in determining the newest commit
    * of interest. The values in this field only have any effect when the
-   * CommitSelectionStrategy is Lexical, NewestTag, or SemVer. This field is
-   * optional.
+   * CommitSelectionStrategy is Expression, Lexical, NewestTag, or SemVer. This
+   * field is optional.
    *
    * +kubebuilder:validation:Optional
    *
@@ -1932,8 +2250,8 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
    * IgnoreTags is a list of tags that must be ignored when determining the
    * newest commit of interest. No regular expressions or glob patterns are
    * supported yet. The value in this field only has any effect when the
-   * CommitSelectionStrategy is Lexical, NewestTag, or SemVer. This field is
-   * optional.
+   * CommitSelectionStrategy is Expression, Lexical, NewestTag, or SemVer. This
+   * field is optional.
    *
    * Deprecated: Use IgnoreTagsRegexes instead. Beginning in v1.11.0, artifact
    * discovery will FAIL if this field is non-empty. This field will be removed
@@ -1949,8 +2267,8 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
    * IgnoreTagsRegexes is a list of regular expressions that can optionally be
    * used to exclude tags from consideration when determining the newest commit
    * of interest. The values in this field only have any effect when the
-   * CommitSelectionStrategy is Lexical, NewestTag, or SemVer. This field is
-   * optional.
+   * CommitSelectionStrategy is Expression, Lexical, NewestTag, or SemVer. This
+   * field is optional.
    *
    * +kubebuilder:validation:Optional
    *
@@ -1965,7 +2283,8 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
    *
    * For commit-based strategies (NewestFromBranch), the filter applies to
    * commits and has access to commit metadata variables.
-   * For tag-based strategies (Lexical, NewestTag, SemVer), the filter applies
+   * For tag-based strategies (Expression, Lexical, NewestTag, SemVer), the
+   * filter applies
    * to tags and has access to tag metadata variables. The filter is applied
    * after AllowTagsRegexes, IgnoreTagsRegexes, and SemverConstraint fields.
    *
@@ -1984,7 +2303,7 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
    * 	   "Name <email>".
    *   - `subject`: The subject (first line) of the commit message.
    *
-   * For Lexical, NewestTag, SemVer (tag filtering):
+   * For Expression, Lexical, NewestTag, SemVer (tag filtering):
    *   - `tag`: The name of the tag.
    *   - `id`: The ID (sha) of the commit associated with the tag.
    *   - `creatorDate`: The creation date of an annotated tag, or the commit
@@ -2010,15 +2329,43 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
   expressionFilter: string;
 
   /**
-   * InsecureSkipTLSVerify specifies whether certificate verification errors
-   * should be ignored when connecting to the repository. This should be enabled
-   * only with great caution.
+   * SortKeyExpression is an expression that extracts a sortable key from each
+   * tag. The value in this field only has any effect when the
+   * CommitSelectionStrategy is Expression, in which case it is required. Tags
+   * are ordered from greatest to least key.
    *
-   * @generated from field: optional bool insecureSkipTLSVerify = 7;
-   */
-  insecureSkipTLSVerify: boolean;
-
-  /**
+   * The expression should be a valid expr-lang expression that evaluates to
+   * a number, a string, a time, a semantic version (e.g. as returned by the
+   * `semverParse()` function), or a list of any of these, in which case keys
+   * are compared element by element. All tags must produce keys of the same
+   * type. Tags for which the expression evaluates to nil are excluded from
+   * consideration. The variables available to the expression are the same as
+   * those available to the ExpressionFilter for tag-based strategies.
+   *
+   * For example, the following orders tags of the form "2026.10.3-b412" by
+   * date and then by build number:
+   *
+   *   [semverParse(split(tag, "-b")[0]), int(split(tag, "-b")[1])]
+   *
+   * Refer to the expr-lang documentation for more details on syntax and
+   * capabilities of the expression language: https://expr-lang.org.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string sortKeyExpression = 15;
+   */
+  sortKeyExpression: string;
+
+  /**
+   * InsecureSkipTLSVerify specifies whether certificate verification errors
+   * should be ignored when connecting to the repository. This should be enabled
+   * only with great caution.
+   *
+   * @generated from field: optional bool insecureSkipTLSVerify = 7;
+   */
+  insecureSkipTLSVerify: boolean;
+
+  /**
    * IncludePaths is a list of selectors that designate paths in the repository
    * that should trigger the production of new Freight when changes are detected
    * therein. When specified, only changes in the identified paths will trigger
@@ -2072,6 +2419,21 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
    * @generated from field: optional int32 discoveryLimit = 10;
    */
   discoveryLimit: number;
+
+  /**
+   * PullRequestLookup, when specified, causes the pull requests containing
+   * the latest discovered commit to be looked up using the Git hosting
+   * provider's API. Details of those pull requests (number, title, URL, and
+   * labels) are recorded alongside the commit and carried into any Freight
+   * built from it. This field is optional. Lookups require credentials for the
+   * repository that include an access token with permission to read pull
+   * requests. A failed lookup does not prevent discovery of the commit.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PullRequestLookup pullRequestLookup = 16;
+   */
+  pullRequestLookup?: PullRequestLookup;
 };
 
 /**
@@ -2079,7 +2441,7 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
  * Use `create(GitSubscriptionSchema)` to create a new message.
  */
 export const GitSubscriptionSchema: GenMessage<GitSubscription> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 43);
+  messageDesc(file_api_v1alpha1_generated, 46);
 
 /**
  * GiteaWebhookReceiverConfig describes a webhook receiver that is compatible
@@ -2113,7 +2475,7 @@ export type GiteaWebhookReceiverConfig = Message<"github.com.akuity.kargo.api.v1
  * Use `create(GiteaWebhookReceiverConfigSchema)` to create a new message.
  */
 export const GiteaWebhookReceiverConfigSchema: GenMessage<GiteaWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 44);
+  messageDesc(file_api_v1alpha1_generated, 47);
 
 /**
  * HarborWebhookReceiverConfig describes a webhook receiver that is compatible
@@ -2147,7 +2509,7 @@ export type HarborWebhookReceiverConfig = Message<"github.com.akuity.kargo.api.v
  * Use `create(HarborWebhookReceiverConfigSchema)` to create a new message.
  */
 export const HarborWebhookReceiverConfigSchema: GenMessage<HarborWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 45);
+  messageDesc(file_api_v1alpha1_generated, 48);
 
 /**
  * Health describes the health of a Stage.
@@ -2191,7 +2553,7 @@ export type Health = Message<"github.com.akuity.kargo.api.v1alpha1.Health"> & {
  * Use `create(HealthSchema)` to create a new message.
  */
 export const HealthSchema: GenMessage<Health> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 46);
+  messageDesc(file_api_v1alpha1_generated, 49);
 
 /**
  * HealthCheckStep describes a health check directive which can be executed by
@@ -2222,7 +2584,7 @@ export type HealthCheckStep = Message<"github.com.akuity.kargo.api.v1alpha1.Heal
  * Use `create(HealthCheckStepSchema)` to create a new message.
  */
 export const HealthCheckStepSchema: GenMessage<HealthCheckStep> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 47);
+  messageDesc(file_api_v1alpha1_generated, 50);
 
 /**
  * HealthStats contains a summary of the collective health of some resource
@@ -2244,7 +2606,7 @@ export type HealthStats = Message<"github.com.akuity.kargo.api.v1alpha1.HealthSt
  * Use `create(HealthStatsSchema)` to create a new message.
  */
 export const HealthStatsSchema: GenMessage<HealthStats> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 48);
+  messageDesc(file_api_v1alpha1_generated, 51);
 
 /**
  * Image describes a specific version of a container image.
@@ -2288,7 +2650,7 @@ export type Image = Message<"github.com.akuity.kargo.api.v1alpha1.Image"> & {
  * Use `create(ImageSchema)` to create a new message.
  */
 export const ImageSchema: GenMessage<Image> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 49);
+  messageDesc(file_api_v1alpha1_generated, 52);
 
 /**
  * ImageDiscoveryResult represents the result of an image discovery operation
@@ -2327,6 +2689,18 @@ export type ImageDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.DiscoveredImageReference references = 3;
    */
   references: DiscoveredImageReference[];
+
+  /**
+   * Rejected is a list of image references that were otherwise eligible for
+   * selection, but were rejected because they did not satisfy the
+   * ImageSubscription's verification policy. This field is only populated if
+   * the ImageSubscription specifies a verification policy.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.RejectedImageReference rejected = 4;
+   */
+  rejected: RejectedImageReference[];
 };
 
 /**
@@ -2334,12 +2708,13 @@ export type ImageDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1
  * Use `create(ImageDiscoveryResultSchema)` to create a new message.
  */
 export const ImageDiscoveryResultSchema: GenMessage<ImageDiscoveryResult> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 50);
+  messageDesc(file_api_v1alpha1_generated, 53);
 
 /**
  * ImageSubscription defines a subscription to an image repository.
  *
  * +kubebuilder:validation:XValidation:message="If imageSelectionStrategy is Digest, constraint must be set",rule="!(self.imageSelectionStrategy == 'Digest') || has(self.constraint)"
+ * +kubebuilder:validation:XValidation:message="If imageSelectionStrategy is Expression, sortKeyExpression must be set",rule="!(self.imageSelectionStrategy == 'Expression') || has(self.sortKeyExpression)"
  *
  * @generated from message github.com.akuity.kargo.api.v1alpha1.ImageSubscription
  */
@@ -2367,6 +2742,13 @@ export type ImageSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Im
    * - "Digest": Selects the image currently referenced by the tag specified
    *   by the Constraint field.
    *
+   * - "Expression": Selects the image referenced by the tag with the greatest
+   *   key, as extracted from each tag by the expression in the
+   *   SortKeyExpression field. Useful when tags follow a scheme that none of
+   *   the other strategies order correctly. The AllowTagsRegexes and
+   *   IgnoreTagsRegexes fields can optionally be used to narrow the set of tags
+   *   eligible for selection.
+   *
    * - "Lexical": Selects the image referenced by the lexicographically greatest
    *   tag. This strategy is useful when tags embed a leading date or timestamp.
    *   The AllowTagsRegexes and IgnoreTagsRegexes fields can optionally be used
@@ -2477,6 +2859,64 @@ export type ImageSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Im
    */
   ignoreTagsRegexes: string[];
 
+  /**
+   * ExpressionFilter is an expression that can optionally be used to limit
+   * the images that are considered in determining the newest version of an
+   * image based on their metadata. The filter is applied after the
+   * AllowTagsRegexes, IgnoreTagsRegexes, Constraint, and Platform fields.
+   *
+   * The expression should be a valid expr-lang expression that evaluates to
+   * true or false. When the expression evaluates to true, the image is
+   * included in the set that is considered. When the expression evaluates to
+   * false, the image is excluded.
+   *
+   * Available variables:
+   *   - `tag`: The tag of the image.
+   *   - `digest`: The digest of the image.
+   *   - `annotations`: A map of the image's OCI annotations.
+   *   - `createdAt`: The time at which the image was created. This may be nil
+   *     if the image does not record a creation time, so expressions should
+   *     guard against this (e.g. `createdAt != nil && ...`).
+   *
+   * Refer to the expr-lang documentation for more details on syntax and
+   * capabilities of the expression language: https://expr-lang.org.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string expressionFilter = 15;
+   */
+  expressionFilter: string;
+
+  /**
+   * SortKeyExpression is an expression that extracts a sortable key from each
+   * tag. The value in this field only has any effect when the
+   * ImageSelectionStrategy is Expression, in which case it is required. Tags
+   * are ordered from greatest to least key.
+   *
+   * The expression should be a valid expr-lang expression that evaluates to
+   * a number, a string, a time, a semantic version (e.g. as returned by the
+   * `semverParse()` function), or a list of any of these, in which case keys
+   * are compared element by element. All tags must produce keys of the same
+   * type. Tags for which the expression evaluates to nil are excluded from
+   * consideration.
+   *
+   * Available variables:
+   *   - `tag`: The tag of the image.
+   *
+   * For example, the following orders tags of the form "2026.10.3-b412" by
+   * date and then by build number:
+   *
+   *   [semverParse(split(tag, "-b")[0]), int(split(tag, "-b")[1])]
+   *
+   * Refer to the expr-lang documentation for more details on syntax and
+   * capabilities of the expression language: https://expr-lang.org.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string sortKeyExpression = 17;
+   */
+  sortKeyExpression: string;
+
   /**
    * Platform is a string of the form <os>/<arch> that limits the tags that can
    * be considered when searching for new versions of an image. This field is
@@ -2505,9 +2945,9 @@ export type ImageSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Im
   /**
    * DiscoveryLimit is an optional limit on the number of image references
    * that can be discovered for this subscription. The limit is applied after
-   * filtering images based on the AllowTagsRegexes and IgnoreTagsRegexes
-   * fields. When left unspecified, the field is implicitly treated as if its
-   * value were "20". The upper limit for this field is 100.
+   * filtering images based on the AllowTagsRegexes, IgnoreTagsRegexes, and
+   * ExpressionFilter fields. When left unspecified, the field is implicitly
+   * treated as if its value were "20". The upper limit for this field is 100.
    *
    * +kubebuilder:validation:Minimum=1
    * +kubebuilder:validation:Maximum=100
@@ -2516,6 +2956,18 @@ export type ImageSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Im
    * @generated from field: optional int32 discoveryLimit = 9;
    */
   discoveryLimit: number;
+
+  /**
+   * Verification is an optional policy describing the signatures and
+   * attestations an image must carry to be eligible for selection. Images
+   * that do not satisfy the policy are never selected and are instead
+   * reported as rejected in the Warehouse's status.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ImageVerificationPolicy verification = 16;
+   */
+  verification?: ImageVerificationPolicy;
 };
 
 /**
@@ -2523,7 +2975,100 @@ export type ImageSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Im
  * Use `create(ImageSubscriptionSchema)` to create a new message.
  */
 export const ImageSubscriptionSchema: GenMessage<ImageSubscription> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 51);
+  messageDesc(file_api_v1alpha1_generated, 54);
+
+/**
+ * ImageVerificationPolicy describes the signatures and attestations an image
+ * must carry to be eligible for selection. Signatures and attestations are
+ * expected to have been produced by cosign and stored alongside the image in
+ * the same repository using cosign's tag-based conventions (i.e.
+ * sha256-<digest>.sig and sha256-<digest>.att).
+ *
+ * An image satisfies the policy if it carries at least one signature that can
+ * be verified using any of the PublicKeys or that was produced by any of the
+ * Keyless identities AND, for each of the RequiredAttestations, carries an
+ * attestation of that predicate type that can be verified in the same manner.
+ *
+ * +kubebuilder:validation:XValidation:message="At least one of publicKeys or keyless must be set",rule="(has(self.publicKeys) && size(self.publicKeys) > 0) || has(self.keyless)"
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ImageVerificationPolicy
+ */
+export type ImageVerificationPolicy = Message<"github.com.akuity.kargo.api.v1alpha1.ImageVerificationPolicy"> & {
+  /**
+   * PublicKeys is a list of references to PEM-encoded public keys, any of
+   * which may be used to verify an image's signatures and attestations.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ImageVerificationPublicKey publicKeys = 1;
+   */
+  publicKeys: ImageVerificationPublicKey[];
+
+  /**
+   * Keyless describes the identities that are trusted to have produced
+   * "keyless" signatures and attestations, i.e. those made using short-lived
+   * certificates issued by a certificate authority such as Sigstore's Fulcio.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.KeylessVerification keyless = 2;
+   */
+  keyless?: KeylessVerification;
+
+  /**
+   * RequiredAttestations is a list of in-toto predicate types (e.g.
+   * "https://slsa.dev/provenance/v1") for which an image must carry a
+   * verifiable attestation.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: repeated string requiredAttestations = 3;
+   */
+  requiredAttestations: string[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ImageVerificationPolicy.
+ * Use `create(ImageVerificationPolicySchema)` to create a new message.
+ */
+export const ImageVerificationPolicySchema: GenMessage<ImageVerificationPolicy> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 55);
+
+/**
+ * ImageVerificationPublicKey references a PEM-encoded public key stored in a
+ * Secret in the Warehouse's namespace.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ImageVerificationPublicKey
+ */
+export type ImageVerificationPublicKey = Message<"github.com.akuity.kargo.api.v1alpha1.ImageVerificationPublicKey"> & {
+  /**
+   * SecretRef is a reference to a Secret in the Warehouse's namespace that
+   * contains the public key.
+   *
+   * +kubebuilder:validation:Required
+   *
+   * @generated from field: optional k8s.io.api.core.v1.LocalObjectReference secretRef = 1;
+   */
+  secretRef?: LocalObjectReference;
+
+  /**
+   * Key is the key within the Secret's data whose value is the PEM-encoded
+   * public key. When left unspecified, the field is implicitly treated as if
+   * its value were "cosign.pub".
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string key = 2;
+   */
+  key: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ImageVerificationPublicKey.
+ * Use `create(ImageVerificationPublicKeySchema)` to create a new message.
+ */
+export const ImageVerificationPublicKeySchema: GenMessage<ImageVerificationPublicKey> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 56);
 
 /**
  * IndexSelector defines selection criteria that match resources on the basis of
@@ -2547,7 +3092,7 @@ export type IndexSelector = Message<"github.com.akuity.kargo.api.v1alpha1.IndexS
  * Use `create(IndexSelectorSchema)` to create a new message.
  */
 export const IndexSelectorSchema: GenMessage<IndexSelector> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 52);
+  messageDesc(file_api_v1alpha1_generated, 57);
 
 /**
  * IndexSelectorRequirement encapsulates a requirement used to select indexes
@@ -2590,152 +3135,467 @@ export type IndexSelectorRequirement = Message<"github.com.akuity.kargo.api.v1al
  * Use `create(IndexSelectorRequirementSchema)` to create a new message.
  */
 export const IndexSelectorRequirementSchema: GenMessage<IndexSelectorRequirement> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 53);
+  messageDesc(file_api_v1alpha1_generated, 58);
 
 /**
- * Project is a resource type that reconciles to a specially labeled namespace
- * and other TODO: TBD project-level resources.
+ * KeylessIdentity describes an identity that is trusted to have produced
+ * keyless signatures and attestations.
  *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.Project
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.KeylessIdentity
  */
-export type Project = Message<"github.com.akuity.kargo.api.v1alpha1.Project"> & {
+export type KeylessIdentity = Message<"github.com.akuity.kargo.api.v1alpha1.KeylessIdentity"> & {
   /**
-   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ObjectMeta metadata = 1;
+   * IssuerRegex is a regular expression that must match the OIDC issuer
+   * recorded in the signing certificate. e.g.
+   * "^https://token\.actions\.githubusercontent\.com$"
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string issuerRegex = 1;
    */
-  metadata?: ObjectMeta;
+  issuerRegex: string;
 
   /**
-   * Status describes the Project's current status.
+   * SubjectRegex is a regular expression that must match the subject (an
+   * email address or URI) recorded in the signing certificate. e.g.
+   * "^https://github\.com/example/repo/\.github/workflows/release\.yaml@refs/tags/.*$"
    *
-   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ProjectStatus status = 3;
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string subjectRegex = 2;
    */
-  status?: ProjectStatus;
+  subjectRegex: string;
 };
 
 /**
- * Describes the message github.com.akuity.kargo.api.v1alpha1.Project.
- * Use `create(ProjectSchema)` to create a new message.
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.KeylessIdentity.
+ * Use `create(KeylessIdentitySchema)` to create a new message.
  */
-export const ProjectSchema: GenMessage<Project> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 54);
+export const KeylessIdentitySchema: GenMessage<KeylessIdentity> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 59);
 
 /**
- * ProjectConfig is a resource type that describes the configuration of a
- * Project.
+ * KeylessVerification describes the certificate authorities and identities
+ * that are trusted to have produced keyless signatures and attestations.
  *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.ProjectConfig
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.KeylessVerification
  */
-export type ProjectConfig = Message<"github.com.akuity.kargo.api.v1alpha1.ProjectConfig"> & {
-  /**
-   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ObjectMeta metadata = 1;
-   */
-  metadata?: ObjectMeta;
-
+export type KeylessVerification = Message<"github.com.akuity.kargo.api.v1alpha1.KeylessVerification"> & {
   /**
-   * Spec describes the configuration of a Project.
+   * TrustedRootsSecretRef is a reference to a Secret in the Warehouse's
+   * namespace that contains the PEM-encoded certificates of the trusted
+   * certificate authority (and any intermediates) under the "ca.crt" key.
+   * The Secret must also contain one or more PEM-encoded transparency log
+   * (Rekor) public keys under the "rekor.pub" key. Every keyless signature
+   * must have been recorded in one of these logs. The time at which it was
+   * integrated into the log, as attested to by the log's signed entry
+   * timestamp, is used to validate short-lived signing certificates.
    *
-   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ProjectConfigSpec spec = 2;
+   * +kubebuilder:validation:Required
+   *
+   * @generated from field: optional k8s.io.api.core.v1.LocalObjectReference trustedRootsSecretRef = 1;
    */
-  spec?: ProjectConfigSpec;
+  trustedRootsSecretRef?: LocalObjectReference;
 
   /**
-   * Status describes the current status of a ProjectConfig.
+   * Identities is a list of identities, any of which is trusted to have
+   * produced an image's signatures and attestations.
    *
-   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ProjectConfigStatus status = 3;
+   * +kubebuilder:validation:MinItems=1
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.KeylessIdentity identities = 2;
    */
-  status?: ProjectConfigStatus;
+  identities: KeylessIdentity[];
 };
 
 /**
- * Describes the message github.com.akuity.kargo.api.v1alpha1.ProjectConfig.
- * Use `create(ProjectConfigSchema)` to create a new message.
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.KeylessVerification.
+ * Use `create(KeylessVerificationSchema)` to create a new message.
  */
-export const ProjectConfigSchema: GenMessage<ProjectConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 55);
+export const KeylessVerificationSchema: GenMessage<KeylessVerification> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 60);
 
 /**
- * ProjectConfigList is a list of ProjectConfig resources.
+ * OCIArtifact describes a specific version of an arbitrary OCI artifact.
  *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.ProjectConfigList
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.OCIArtifact
  */
-export type ProjectConfigList = Message<"github.com.akuity.kargo.api.v1alpha1.ProjectConfigList"> & {
+export type OCIArtifact = Message<"github.com.akuity.kargo.api.v1alpha1.OCIArtifact"> & {
   /**
-   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ListMeta metadata = 1;
+   * RepoURL describes the repository in which the artifact can be found.
+   *
+   * @generated from field: optional string repoURL = 1;
    */
-  metadata?: ListMeta;
+  repoURL: string;
 
   /**
-   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ProjectConfig items = 2;
+   * Tag identifies a specific version of the artifact in the repository
+   * specified by RepoURL.
+   *
+   * @generated from field: optional string tag = 2;
    */
-  items: ProjectConfig[];
-};
+  tag: string;
 
-/**
- * Describes the message github.com.akuity.kargo.api.v1alpha1.ProjectConfigList.
- * Use `create(ProjectConfigListSchema)` to create a new message.
- */
-export const ProjectConfigListSchema: GenMessage<ProjectConfigList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 56);
+  /**
+   * Digest identifies a specific version of the artifact in the repository
+   * specified by RepoURL. This is a more precise identifier than Tag.
+   *
+   * @generated from field: optional string digest = 3;
+   */
+  digest: string;
 
-/**
- * ProjectConfigSpec describes the configuration of a Project.
- *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.ProjectConfigSpec
- */
-export type ProjectConfigSpec = Message<"github.com.akuity.kargo.api.v1alpha1.ProjectConfigSpec"> & {
   /**
-   * PromotionPolicies defines policies governing the promotion of Freight to
-   * specific Stages within the Project.
+   * ArtifactType is the type of the artifact.
    *
-   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionPolicy promotionPolicies = 1;
+   * @generated from field: optional string artifactType = 4;
    */
-  promotionPolicies: PromotionPolicy[];
+  artifactType: string;
 
   /**
-   * WebhookReceivers describes Project-specific webhook receivers used for
-   * processing events from various external platforms
+   * Annotations is a map of arbitrary metadata for the artifact.
    *
-   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.WebhookReceiverConfig webhookReceivers = 2;
+   * @generated from field: map<string, string> annotations = 5;
    */
-  webhookReceivers: WebhookReceiverConfig[];
+  annotations: { [key: string]: string };
 };
 
 /**
- * Describes the message github.com.akuity.kargo.api.v1alpha1.ProjectConfigSpec.
- * Use `create(ProjectConfigSpecSchema)` to create a new message.
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.OCIArtifact.
+ * Use `create(OCIArtifactSchema)` to create a new message.
  */
-export const ProjectConfigSpecSchema: GenMessage<ProjectConfigSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 57);
+export const OCIArtifactSchema: GenMessage<OCIArtifact> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 61);
 
 /**
- * ProjectConfigStatus describes the current status of a ProjectConfig.
+ * OCIArtifactDiscoveryResult represents the result of an artifact discovery
+ * operation for an OCISubscription.
  *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.ProjectConfigStatus
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.OCIArtifactDiscoveryResult
  */
-export type ProjectConfigStatus = Message<"github.com.akuity.kargo.api.v1alpha1.ProjectConfigStatus"> & {
+export type OCIArtifactDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1.OCIArtifactDiscoveryResult"> & {
   /**
-   * Conditions contains the last observations of the Project Config's current
-   * state.
+   * RepoURL is the repository URL of the artifacts, as specified in the
+   * OCISubscription.
    *
-   * +patchMergeKey=type
-   * +patchStrategy=merge
-   * +listType=map
-   * +listMapKey=type
+   * +kubebuilder:validation:MinLength=1
    *
-   * @generated from field: repeated k8s.io.apimachinery.pkg.apis.meta.v1.Condition conditions = 1;
+   * @generated from field: optional string repoURL = 1;
    */
-  conditions: Condition[];
+  repoURL: string;
 
   /**
-   * ObservedGeneration represents the .metadata.generation that this
-   * ProjectConfig was reconciled against.
+   * References is a list of artifacts discovered by the Warehouse for the
+   * OCISubscription. An empty list indicates that the discovery operation was
+   * successful, but no artifacts matching the OCISubscription criteria were
+   * found.
    *
-   * @generated from field: optional int64 observedGeneration = 3;
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.DiscoveredOCIArtifact references = 2;
    */
-  observedGeneration: bigint;
+  references: DiscoveredOCIArtifact[];
+};
 
-  /**
-   * LastHandledRefresh holds the value of the most recent AnnotationKeyRefresh
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.OCIArtifactDiscoveryResult.
+ * Use `create(OCIArtifactDiscoveryResultSchema)` to create a new message.
+ */
+export const OCIArtifactDiscoveryResultSchema: GenMessage<OCIArtifactDiscoveryResult> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 62);
+
+/**
+ * OCISubscription defines a subscription to a repository within an OCI
+ * registry that contains arbitrary artifacts, such as Kubernetes manifests,
+ * Terraform modules, or WASM plugins, rather than container images.
+ *
+ * +kubebuilder:validation:XValidation:message="If selectionStrategy is Digest, constraint must be set",rule="!(self.selectionStrategy == 'Digest') || has(self.constraint)"
+ * +kubebuilder:validation:XValidation:message="selectionStrategy Expression is not supported for OCI subscriptions",rule="!has(self.selectionStrategy) || self.selectionStrategy != 'Expression'"
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.OCISubscription
+ */
+export type OCISubscription = Message<"github.com.akuity.kargo.api.v1alpha1.OCISubscription"> & {
+  /**
+   * RepoURL specifies the URL of the repository to subscribe to. The value in
+   * this field MUST NOT include a tag or digest. This field is required.
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:Pattern=`^(\w+([\.-]\w+)*(:[\d]+)?/)?(\w+([\.-]\w+)*)(/\w+([\.-]\w+)*)*$`
+   * +akuity:test-kubebuilder-pattern=ImageRepoURL
+   *
+   * @generated from field: optional string repoURL = 1;
+   */
+  repoURL: string;
+
+  /**
+   * ArtifactTypes is an optional list of artifact types that artifacts must
+   * have to be eligible for selection. The artifact type of an artifact is
+   * read from the artifactType field of its manifest or, if that is not set,
+   * from the media type of its config. When left unspecified, artifacts of
+   * any type are eligible.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: repeated string artifactTypes = 2;
+   */
+  artifactTypes: string[];
+
+  /**
+   * MediaTypes is an optional list of layer media types. When specified, only
+   * artifacts with at least one layer of one of these media types are eligible
+   * for selection.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: repeated string mediaTypes = 3;
+   */
+  mediaTypes: string[];
+
+  /**
+   * SelectionStrategy specifies the rules for how to identify the newest
+   * version of the artifact specified by the RepoURL field. This field is
+   * optional. When left unspecified, the field is implicitly treated as if its
+   * value were "SemVer". The accepted values and their semantics are the same
+   * as those of the ImageSelectionStrategy field of an ImageSubscription, with
+   * the exceptions that the "NewestBuild" strategy determines the age of an
+   * artifact from the org.opencontainers.image.created annotation of its
+   * manifest and that the "Expression" strategy is not supported.
+   *
+   * +kubebuilder:default=SemVer
+   *
+   * @generated from field: optional string selectionStrategy = 4;
+   */
+  selectionStrategy: string;
+
+  /**
+   * StrictSemvers specifies whether only "strict" semver tags should be
+   * considered. A "strict" semver tag is one containing ALL of major, minor,
+   * and patch version components. This is enabled by default, but only has any
+   * effect when the SelectionStrategy is SemVer.
+   *
+   * +kubebuilder:default=true
+   *
+   * @generated from field: optional bool strictSemvers = 5;
+   */
+  strictSemvers: boolean;
+
+  /**
+   * Constraint specifies SelectionStrategy-specific constraints on what new
+   * artifact revisions are permissible. Refer to the Constraint field of an
+   * ImageSubscription to learn how each strategy uses this field.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string constraint = 6;
+   */
+  constraint: string;
+
+  /**
+   * AllowTagsRegexes is a list of regular expressions that can optionally be
+   * used to limit the tags that are considered in determining the newest
+   * revision of an artifact. This field is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: repeated string allowTagsRegexes = 7;
+   */
+  allowTagsRegexes: string[];
+
+  /**
+   * IgnoreTagsRegexes is a list of regular expressions that can optionally be
+   * used to exclude tags from consideration when determining the newest
+   * revision of an artifact. This field is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: repeated string ignoreTagsRegexes = 8;
+   */
+  ignoreTagsRegexes: string[];
+
+  /**
+   * InsecureSkipTLSVerify specifies whether certificate verification errors
+   * should be ignored when connecting to the repository. This should be enabled
+   * only with great caution.
+   *
+   * @generated from field: optional bool insecureSkipTLSVerify = 9;
+   */
+  insecureSkipTLSVerify: boolean;
+
+  /**
+   * DiscoveryLimit is an optional limit on the number of artifacts that can be
+   * discovered for this subscription. The limit is applied after filtering
+   * artifacts based on all other criteria. When left unspecified, the field is
+   * implicitly treated as if its value were "20". The upper limit for this
+   * field is 100.
+   *
+   * +kubebuilder:validation:Minimum=1
+   * +kubebuilder:validation:Maximum=100
+   * +kubebuilder:default=20
+   *
+   * @generated from field: optional int32 discoveryLimit = 10;
+   */
+  discoveryLimit: number;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.OCISubscription.
+ * Use `create(OCISubscriptionSchema)` to create a new message.
+ */
+export const OCISubscriptionSchema: GenMessage<OCISubscription> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 63);
+
+/**
+ * Project is a resource type that reconciles to a specially labeled namespace
+ * and other TODO: TBD project-level resources.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.Project
+ */
+export type Project = Message<"github.com.akuity.kargo.api.v1alpha1.Project"> & {
+  /**
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ObjectMeta metadata = 1;
+   */
+  metadata?: ObjectMeta;
+
+  /**
+   * Status describes the Project's current status.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ProjectStatus status = 3;
+   */
+  status?: ProjectStatus;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.Project.
+ * Use `create(ProjectSchema)` to create a new message.
+ */
+export const ProjectSchema: GenMessage<Project> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 64);
+
+/**
+ * ProjectConfig is a resource type that describes the configuration of a
+ * Project.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ProjectConfig
+ */
+export type ProjectConfig = Message<"github.com.akuity.kargo.api.v1alpha1.ProjectConfig"> & {
+  /**
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ObjectMeta metadata = 1;
+   */
+  metadata?: ObjectMeta;
+
+  /**
+   * Spec describes the configuration of a Project.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ProjectConfigSpec spec = 2;
+   */
+  spec?: ProjectConfigSpec;
+
+  /**
+   * Status describes the current status of a ProjectConfig.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ProjectConfigStatus status = 3;
+   */
+  status?: ProjectConfigStatus;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ProjectConfig.
+ * Use `create(ProjectConfigSchema)` to create a new message.
+ */
+export const ProjectConfigSchema: GenMessage<ProjectConfig> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 65);
+
+/**
+ * ProjectConfigList is a list of ProjectConfig resources.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ProjectConfigList
+ */
+export type ProjectConfigList = Message<"github.com.akuity.kargo.api.v1alpha1.ProjectConfigList"> & {
+  /**
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ListMeta metadata = 1;
+   */
+  metadata?: ListMeta;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ProjectConfig items = 2;
+   */
+  items: ProjectConfig[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ProjectConfigList.
+ * Use `create(ProjectConfigListSchema)` to create a new message.
+ */
+export const ProjectConfigListSchema: GenMessage<ProjectConfigList> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 66);
+
+/**
+ * ProjectConfigSpec describes the configuration of a Project.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ProjectConfigSpec
+ */
+export type ProjectConfigSpec = Message<"github.com.akuity.kargo.api.v1alpha1.ProjectConfigSpec"> & {
+  /**
+   * PromotionPolicies defines policies governing the promotion of Freight to
+   * specific Stages within the Project.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionPolicy promotionPolicies = 1;
+   */
+  promotionPolicies: PromotionPolicy[];
+
+  /**
+   * WebhookReceivers describes Project-specific webhook receivers used for
+   * processing events from various external platforms
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.WebhookReceiverConfig webhookReceivers = 2;
+   */
+  webhookReceivers: WebhookReceiverConfig[];
+
+  /**
+   * PromotionWorker describes the worker Pods that execute the Project's
+   * Promotions. It has no effect unless the controller is configured to
+   * execute Promotions in worker Pods.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionWorkerConfig promotionWorker = 3;
+   */
+  promotionWorker?: PromotionWorkerConfig;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ProjectConfigSpec.
+ * Use `create(ProjectConfigSpecSchema)` to create a new message.
+ */
+export const ProjectConfigSpecSchema: GenMessage<ProjectConfigSpec> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 67);
+
+/**
+ * ProjectConfigStatus describes the current status of a ProjectConfig.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ProjectConfigStatus
+ */
+export type ProjectConfigStatus = Message<"github.com.akuity.kargo.api.v1alpha1.ProjectConfigStatus"> & {
+  /**
+   * Conditions contains the last observations of the Project Config's current
+   * state.
+   *
+   * +patchMergeKey=type
+   * +patchStrategy=merge
+   * +listType=map
+   * +listMapKey=type
+   *
+   * @generated from field: repeated k8s.io.apimachinery.pkg.apis.meta.v1.Condition conditions = 1;
+   */
+  conditions: Condition[];
+
+  /**
+   * ObservedGeneration represents the .metadata.generation that this
+   * ProjectConfig was reconciled against.
+   *
+   * @generated from field: optional int64 observedGeneration = 3;
+   */
+  observedGeneration: bigint;
+
+  /**
+   * LastHandledRefresh holds the value of the most recent AnnotationKeyRefresh
    * annotation that was handled by the controller. This field can be used to
    * determine whether the request to refresh the resource has been handled.
    * +optional
@@ -2758,7 +3618,7 @@ export type ProjectConfigStatus = Message<"github.com.akuity.kargo.api.v1alpha1.
  * Use `create(ProjectConfigStatusSchema)` to create a new message.
  */
 export const ProjectConfigStatusSchema: GenMessage<ProjectConfigStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 58);
+  messageDesc(file_api_v1alpha1_generated, 68);
 
 /**
  * ProjectList is a list of Project resources.
@@ -2782,7 +3642,7 @@ export type ProjectList = Message<"github.com.akuity.kargo.api.v1alpha1.ProjectL
  * Use `create(ProjectListSchema)` to create a new message.
  */
 export const ProjectListSchema: GenMessage<ProjectList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 59);
+  messageDesc(file_api_v1alpha1_generated, 69);
 
 /**
  * ProjectStats contains a summary of the collective state of a Project's
@@ -2812,7 +3672,7 @@ export type ProjectStats = Message<"github.com.akuity.kargo.api.v1alpha1.Project
  * Use `create(ProjectStatsSchema)` to create a new message.
  */
 export const ProjectStatsSchema: GenMessage<ProjectStats> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 60);
+  messageDesc(file_api_v1alpha1_generated, 70);
 
 /**
  * ProjectStatus describes a Project's current status.
@@ -2846,7 +3706,7 @@ export type ProjectStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Projec
  * Use `create(ProjectStatusSchema)` to create a new message.
  */
 export const ProjectStatusSchema: GenMessage<ProjectStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 61);
+  messageDesc(file_api_v1alpha1_generated, 71);
 
 /**
  * Promotion represents a request to transition a particular Stage into a
@@ -2884,16 +3744,91 @@ export type Promotion = Message<"github.com.akuity.kargo.api.v1alpha1.Promotion"
  * Use `create(PromotionSchema)` to create a new message.
  */
 export const PromotionSchema: GenMessage<Promotion> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 62);
+  messageDesc(file_api_v1alpha1_generated, 72);
 
 /**
- * PromotionList contains a list of Promotion
+ * PromotionGroupStep describes a directive to be executed as part of a
+ * PromotionStepGroup. It supports a subset of the fields of a PromotionStep.
  *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionList
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionGroupStep
  */
-export type PromotionList = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionList"> & {
+export type PromotionGroupStep = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionGroupStep"> & {
   /**
-   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ListMeta metadata = 1;
+   * Uses identifies a runner that can execute this step.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string uses = 1;
+   */
+  uses: string;
+
+  /**
+   * As is the alias this step can be referred to as.
+   *
+   * @generated from field: optional string as = 2;
+   */
+  as: string;
+
+  /**
+   * If is an optional expression that, if present, must evaluate to a boolean
+   * value. If the expression evaluates to false, the step will be skipped.
+   * If the expression does not evaluate to a boolean value, the step will be
+   * considered to have failed.
+   *
+   * @generated from field: optional string if = 3;
+   */
+  if: string;
+
+  /**
+   * ContinueOnError is a boolean value that, if set to true, will prevent a
+   * failure of this step from impacting the status of the group.
+   *
+   * @generated from field: optional bool continueOnError = 4;
+   */
+  continueOnError: boolean;
+
+  /**
+   * Retry is the retry policy for this step.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionStepRetry retry = 5;
+   */
+  retry?: PromotionStepRetry;
+
+  /**
+   * Vars is a list of variables that can be referenced by expressions in
+   * the step's Config. The values override the values specified in the
+   * PromotionSpec.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ExpressionVariable vars = 6;
+   */
+  vars: ExpressionVariable[];
+
+  /**
+   * Config is opaque configuration for the PromotionGroupStep that is
+   * understood only by each PromotionGroupStep's implementation. It is legal
+   * to utilize expressions in defining values at any level of this block.
+   * See https://docs.kargo.io/user-guide/reference-docs/expressions for details.
+   *
+   * @generated from field: optional k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSON config = 7;
+   */
+  config?: JSON;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionGroupStep.
+ * Use `create(PromotionGroupStepSchema)` to create a new message.
+ */
+export const PromotionGroupStepSchema: GenMessage<PromotionGroupStep> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 73);
+
+/**
+ * PromotionList contains a list of Promotion
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionList
+ */
+export type PromotionList = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionList"> & {
+  /**
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ListMeta metadata = 1;
    */
   metadata?: ListMeta;
 
@@ -2908,7 +3843,7 @@ export type PromotionList = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionListSchema)` to create a new message.
  */
 export const PromotionListSchema: GenMessage<PromotionList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 63);
+  messageDesc(file_api_v1alpha1_generated, 74);
 
 /**
  * PromotionPolicy defines policies governing the promotion of Freight to a
@@ -2957,7 +3892,7 @@ export type PromotionPolicy = Message<"github.com.akuity.kargo.api.v1alpha1.Prom
  * Use `create(PromotionPolicySchema)` to create a new message.
  */
 export const PromotionPolicySchema: GenMessage<PromotionPolicy> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 64);
+  messageDesc(file_api_v1alpha1_generated, 75);
 
 /**
  * PromotionPolicySelector is a selector that matches the resource to which
@@ -3013,7 +3948,7 @@ export type PromotionPolicySelector = Message<"github.com.akuity.kargo.api.v1alp
  * Use `create(PromotionPolicySelectorSchema)` to create a new message.
  */
 export const PromotionPolicySelectorSchema: GenMessage<PromotionPolicySelector> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 65);
+  messageDesc(file_api_v1alpha1_generated, 76);
 
 /**
  * PromotionReference contains the relevant information about a Promotion
@@ -3056,7 +3991,7 @@ export type PromotionReference = Message<"github.com.akuity.kargo.api.v1alpha1.P
  * Use `create(PromotionReferenceSchema)` to create a new message.
  */
 export const PromotionReferenceSchema: GenMessage<PromotionReference> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 66);
+  messageDesc(file_api_v1alpha1_generated, 77);
 
 /**
  * PromotionSpec describes the desired transition of a specific Stage into a
@@ -3109,11 +4044,32 @@ export type PromotionSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
    *
    * +kubebuilder:validation:Required
    * +kubebuilder:validation:MinItems=1
-   * +kubebuilder:validation:items:XValidation:message="Promotion step must have uses set and must not reference a task",rule="has(self.uses) && !has(self.task)"
+   * +kubebuilder:validation:items:XValidation:message="Promotion step must have exactly one of uses or parallel set and must not reference a task",rule="!has(self.task) && has(self.uses) != has(self.parallel)"
    *
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionStep steps = 3;
    */
   steps: PromotionStep[];
+
+  /**
+   * OnFailure specifies the directives to be executed, in order, if any of
+   * the directives in the Steps field failed or errored.
+   *
+   * +kubebuilder:validation:items:XValidation:message="Promotion step must have exactly one of uses or parallel set and must not reference a task",rule="!has(self.task) && has(self.uses) != has(self.parallel)"
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionStep onFailure = 5;
+   */
+  onFailure: PromotionStep[];
+
+  /**
+   * Finally specifies the directives to be executed, in order, after all
+   * directives in the Steps and OnFailure fields have been executed,
+   * regardless of their outcome.
+   *
+   * +kubebuilder:validation:items:XValidation:message="Promotion step must have exactly one of uses or parallel set and must not reference a task",rule="!has(self.task) && has(self.uses) != has(self.parallel)"
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionStep finally = 6;
+   */
+  finally: PromotionStep[];
 };
 
 /**
@@ -3121,7 +4077,7 @@ export type PromotionSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionSpecSchema)` to create a new message.
  */
 export const PromotionSpecSchema: GenMessage<PromotionSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 67);
+  messageDesc(file_api_v1alpha1_generated, 78);
 
 /**
  * PromotionStatus describes the current state of the transition represented by
@@ -3226,7 +4182,7 @@ export type PromotionStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Prom
  * Use `create(PromotionStatusSchema)` to create a new message.
  */
 export const PromotionStatusSchema: GenMessage<PromotionStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 68);
+  messageDesc(file_api_v1alpha1_generated, 79);
 
 /**
  * PromotionStep describes a directive to be executed as part of a Promotion.
@@ -3286,6 +4242,31 @@ export type PromotionStep = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
    */
   retry?: PromotionStepRetry;
 
+  /**
+   * ForEach is an optional expression that, if present, must evaluate to a
+   * list. When a Promotion is built, the step is expanded into one step for
+   * each item in the list, with the item and its index being available to
+   * expressions as vars.item and vars.index. Each expanded step is assigned
+   * an alias of the form "<alias>[<index>]", and the outputs of all expanded
+   * steps are aggregated into a list under the alias of the original step.
+   * Note that the expression is evaluated when the Promotion is built, and
+   * can therefore not reference the outputs of other steps.
+   *
+   * @generated from field: optional string forEach = 9;
+   */
+  forEach: string;
+
+  /**
+   * Parallel is a group of steps that are executed concurrently in place of
+   * this step. A step that defines a parallel group must not set Uses or
+   * Task. The step succeeds only if all steps in the group succeed or are
+   * skipped, and otherwise assumes the status of the step in the group that
+   * fared worst.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionStepGroup parallel = 10;
+   */
+  parallel?: PromotionStepGroup;
+
   /**
    * Vars is a list of variables that can be referenced by expressions in
    * the step's Config. The values override the values specified in the
@@ -3311,7 +4292,43 @@ export type PromotionStep = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionStepSchema)` to create a new message.
  */
 export const PromotionStepSchema: GenMessage<PromotionStep> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 69);
+  messageDesc(file_api_v1alpha1_generated, 80);
+
+/**
+ * PromotionStepGroup describes a group of steps that are executed
+ * concurrently. Each step in the group is executed in its own subdirectory of
+ * the Promotion's working directory, named after the alias of the step.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionStepGroup
+ */
+export type PromotionStepGroup = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionStepGroup"> & {
+  /**
+   * MaxConcurrency is the maximum number of steps in the group that are
+   * executed concurrently. If not specified, all steps in the group are
+   * executed concurrently.
+   *
+   * +kubebuilder:validation:Minimum=1
+   *
+   * @generated from field: optional int32 maxConcurrency = 1;
+   */
+  maxConcurrency: number;
+
+  /**
+   * Steps are the steps in the group.
+   *
+   * +kubebuilder:validation:MinItems=1
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionGroupStep steps = 2;
+   */
+  steps: PromotionGroupStep[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionStepGroup.
+ * Use `create(PromotionStepGroupSchema)` to create a new message.
+ */
+export const PromotionStepGroupSchema: GenMessage<PromotionStepGroup> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 81);
 
 /**
  * PromotionStepRetry describes the retry policy for a PromotionStep.
@@ -3363,6 +4380,31 @@ export type PromotionStepRetry = Message<"github.com.akuity.kargo.api.v1alpha1.P
    * @generated from field: optional uint32 errorThreshold = 2;
    */
   errorThreshold: number;
+
+  /**
+   * Backoff is the policy for delaying the next attempt to execute a step
+   * after an attempt errored or failed. If this field is set to nil, the next
+   * attempt will be made when the Promotion is next reconciled.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionStepRetryBackoff backoff = 3;
+   */
+  backoff?: PromotionStepRetryBackoff;
+
+  /**
+   * AttemptTimeout is the maximum interval a single attempt to execute the
+   * step may take. An attempt that exceeds this interval is considered to
+   * have errored and counts towards the ErrorThreshold.
+   *
+   * If this field is set to nil or 0, the duration of an attempt will not be
+   * bounded.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+   * +akuity:test-kubebuilder-pattern=Duration
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration attemptTimeout = 4;
+   */
+  attemptTimeout?: Duration;
 };
 
 /**
@@ -3370,7 +4412,69 @@ export type PromotionStepRetry = Message<"github.com.akuity.kargo.api.v1alpha1.P
  * Use `create(PromotionStepRetrySchema)` to create a new message.
  */
 export const PromotionStepRetrySchema: GenMessage<PromotionStepRetry> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 70);
+  messageDesc(file_api_v1alpha1_generated, 82);
+
+/**
+ * PromotionStepRetryBackoff describes an exponential backoff policy for
+ * attempts to execute a step.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionStepRetryBackoff
+ */
+export type PromotionStepRetryBackoff = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionStepRetryBackoff"> & {
+  /**
+   * InitialInterval is the delay before the first retry of a step. If this
+   * field is set to nil, the effective default will be 10s.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+   * +akuity:test-kubebuilder-pattern=Duration
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration initialInterval = 1;
+   */
+  initialInterval?: Duration;
+
+  /**
+   * Factor is the factor the delay is multiplied by after each consecutive
+   * failed attempt. If this field is set to 0, the effective default will be
+   * 2.
+   *
+   * +kubebuilder:validation:Minimum=0
+   *
+   * @generated from field: optional int32 factor = 2;
+   */
+  factor: number;
+
+  /**
+   * MaxInterval is the maximum delay between two attempts. If this field is
+   * set to nil, the effective default will be 5m.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+   * +akuity:test-kubebuilder-pattern=Duration
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration maxInterval = 3;
+   */
+  maxInterval?: Duration;
+
+  /**
+   * Jitter is the maximum percentage of the delay that is randomly added to
+   * it, to prevent the steps of many Promotions from being retried in
+   * lockstep.
+   *
+   * +kubebuilder:validation:Minimum=0
+   * +kubebuilder:validation:Maximum=100
+   *
+   * @generated from field: optional int32 jitter = 4;
+   */
+  jitter: number;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionStepRetryBackoff.
+ * Use `create(PromotionStepRetryBackoffSchema)` to create a new message.
+ */
+export const PromotionStepRetryBackoffSchema: GenMessage<PromotionStepRetryBackoff> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 83);
 
 /**
  * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTask
@@ -3397,7 +4501,7 @@ export type PromotionTask = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionTaskSchema)` to create a new message.
  */
 export const PromotionTaskSchema: GenMessage<PromotionTask> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 71);
+  messageDesc(file_api_v1alpha1_generated, 84);
 
 /**
  * PromotionTaskList contains a list of PromotionTasks.
@@ -3421,7 +4525,7 @@ export type PromotionTaskList = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
  * Use `create(PromotionTaskListSchema)` to create a new message.
  */
 export const PromotionTaskListSchema: GenMessage<PromotionTaskList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 72);
+  messageDesc(file_api_v1alpha1_generated, 85);
 
 /**
  * PromotionTaskReference describes a reference to a PromotionTask.
@@ -3459,7 +4563,7 @@ export type PromotionTaskReference = Message<"github.com.akuity.kargo.api.v1alph
  * Use `create(PromotionTaskReferenceSchema)` to create a new message.
  */
 export const PromotionTaskReferenceSchema: GenMessage<PromotionTaskReference> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 73);
+  messageDesc(file_api_v1alpha1_generated, 86);
 
 /**
  * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTaskSpec
@@ -3481,7 +4585,7 @@ export type PromotionTaskSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
    *
    * +kubebuilder:validation:Required
    * +kubebuilder:validation:MinItems=1
-   * +kubebuilder:validation:items:XValidation:message="PromotionTask step must have uses set and must not reference another task",rule="has(self.uses) && !has(self.task)"
+   * +kubebuilder:validation:items:XValidation:message="PromotionTask step must have uses set and must not reference another task or define a parallel group",rule="has(self.uses) && !has(self.task) && !has(self.parallel)"
    *
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionStep steps = 2;
    */
@@ -3493,7 +4597,7 @@ export type PromotionTaskSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
  * Use `create(PromotionTaskSpecSchema)` to create a new message.
  */
 export const PromotionTaskSpecSchema: GenMessage<PromotionTaskSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 74);
+  messageDesc(file_api_v1alpha1_generated, 87);
 
 /**
  * PromotionTemplate defines a template for a Promotion that can be used to
@@ -3513,7 +4617,7 @@ export type PromotionTemplate = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
  * Use `create(PromotionTemplateSchema)` to create a new message.
  */
 export const PromotionTemplateSchema: GenMessage<PromotionTemplate> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 75);
+  messageDesc(file_api_v1alpha1_generated, 88);
 
 /**
  * PromotionTemplateSpec describes the (partial) specification of a Promotion
@@ -3537,13 +4641,42 @@ export type PromotionTemplateSpec = Message<"github.com.akuity.kargo.api.v1alpha
    * are listed in this field.
    *
    * +kubebuilder:validation:MinItems=1
-   * +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses or task set",rule="(has(self.uses) ? !has(self.task) : has(self.task))"
+   * +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses, task, or parallel set",rule="[has(self.uses), has(self.task), has(self.parallel)].filter(x, x).size() == 1"
    * +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set continueOnError",rule="!has(self.task) || !has(self.continueOnError)"
    * +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set retry",rule="!has(self.task) || !has(self.retry)"
+   * +kubebuilder:validation:items:XValidation:message="PromotionTemplate step with a parallel group cannot set retry or forEach",rule="!has(self.parallel) || (!has(self.retry) && !has(self.forEach))"
    *
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionStep steps = 1;
    */
   steps: PromotionStep[];
+
+  /**
+   * OnFailure specifies the directives to be executed, in order, if any of
+   * the directives in the Steps field failed or errored. This is useful for
+   * compensating for a partially applied change.
+   *
+   * +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses, task, or parallel set",rule="[has(self.uses), has(self.task), has(self.parallel)].filter(x, x).size() == 1"
+   * +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set continueOnError",rule="!has(self.task) || !has(self.continueOnError)"
+   * +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set retry",rule="!has(self.task) || !has(self.retry)"
+   * +kubebuilder:validation:items:XValidation:message="PromotionTemplate step with a parallel group cannot set retry or forEach",rule="!has(self.parallel) || (!has(self.retry) && !has(self.forEach))"
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionStep onFailure = 3;
+   */
+  onFailure: PromotionStep[];
+
+  /**
+   * Finally specifies the directives to be executed, in order, after all
+   * directives in the Steps and OnFailure fields have been executed,
+   * regardless of their outcome. This is useful for cleaning up.
+   *
+   * +kubebuilder:validation:items:XValidation:message="PromotionTemplate step must have exactly one of uses, task, or parallel set",rule="[has(self.uses), has(self.task), has(self.parallel)].filter(x, x).size() == 1"
+   * +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set continueOnError",rule="!has(self.task) || !has(self.continueOnError)"
+   * +kubebuilder:validation:items:XValidation:message="PromotionTemplate step referencing a task cannot set retry",rule="!has(self.task) || !has(self.retry)"
+   * +kubebuilder:validation:items:XValidation:message="PromotionTemplate step with a parallel group cannot set retry or forEach",rule="!has(self.parallel) || (!has(self.retry) && !has(self.forEach))"
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionStep finally = 4;
+   */
+  finally: PromotionStep[];
 };
 
 /**
@@ -3551,7 +4684,102 @@ export type PromotionTemplateSpec = Message<"github.com.akuity.kargo.api.v1alpha
  * Use `create(PromotionTemplateSpecSchema)` to create a new message.
  */
 export const PromotionTemplateSpecSchema: GenMessage<PromotionTemplateSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 76);
+  messageDesc(file_api_v1alpha1_generated, 89);
+
+/**
+ * PromotionWorkerConfig describes the worker Pods that execute a Project's
+ * Promotions.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionWorkerConfig
+ */
+export type PromotionWorkerConfig = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionWorkerConfig"> & {
+  /**
+   * Resources describes the compute resources (e.g. CPU and memory) requested
+   * by, and the limits enforced on, each worker Pod.
+   *
+   * @generated from field: optional k8s.io.api.core.v1.ResourceRequirements resources = 1;
+   */
+  resources?: ResourceRequirements;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionWorkerConfig.
+ * Use `create(PromotionWorkerConfigSchema)` to create a new message.
+ */
+export const PromotionWorkerConfigSchema: GenMessage<PromotionWorkerConfig> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 90);
+
+/**
+ * PullRequest describes a pull request (or equivalent; e.g. a GitLab merge
+ * request) hosted by a Git provider.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PullRequest
+ */
+export type PullRequest = Message<"github.com.akuity.kargo.api.v1alpha1.PullRequest"> & {
+  /**
+   * Number is the number of the pull request, which is unique only within a
+   * single repository.
+   *
+   * @generated from field: optional int64 number = 1;
+   */
+  number: bigint;
+
+  /**
+   * Title is the title of the pull request.
+   *
+   * @generated from field: optional string title = 2;
+   */
+  title: string;
+
+  /**
+   * URL is the URL of the pull request's web page.
+   *
+   * @generated from field: optional string url = 3;
+   */
+  url: string;
+
+  /**
+   * Labels are the labels applied to the pull request.
+   *
+   * @generated from field: repeated string labels = 4;
+   */
+  labels: string[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PullRequest.
+ * Use `create(PullRequestSchema)` to create a new message.
+ */
+export const PullRequestSchema: GenMessage<PullRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 91);
+
+/**
+ * PullRequestLookup describes how to look up the pull requests containing
+ * commits discovered from a Git repository.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PullRequestLookup
+ */
+export type PullRequestLookup = Message<"github.com.akuity.kargo.api.v1alpha1.PullRequestLookup"> & {
+  /**
+   * Provider is the name of the Git provider whose API should be used to look
+   * up pull requests. Currently 'github' and 'gitlab' are supported. When left
+   * unspecified, the provider is inferred from the RepoURL of the
+   * GitSubscription.
+   *
+   * +kubebuilder:validation:Optional
+   * +kubebuilder:validation:Enum=github;gitlab
+   *
+   * @generated from field: optional string provider = 1;
+   */
+  provider: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PullRequestLookup.
+ * Use `create(PullRequestLookupSchema)` to create a new message.
+ */
+export const PullRequestLookupSchema: GenMessage<PullRequestLookup> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 92);
 
 /**
  * QuayWebhookReceiverConfig describes a webhook receiver that is compatible
@@ -3587,24 +4815,272 @@ export type QuayWebhookReceiverConfig = Message<"github.com.akuity.kargo.api.v1a
  * Use `create(QuayWebhookReceiverConfigSchema)` to create a new message.
  */
 export const QuayWebhookReceiverConfigSchema: GenMessage<QuayWebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 77);
+  messageDesc(file_api_v1alpha1_generated, 93);
 
 /**
- * RepoSubscription describes a subscription to ONE OF a Git repository, a
- * container image repository, or a Helm chart repository.
+ * RejectedImageReference represents an image reference that was rejected by
+ * a Warehouse because it did not satisfy an ImageSubscription's verification
+ * policy.
  *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.RepoSubscription
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.RejectedImageReference
  */
-export type RepoSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.RepoSubscription"> & {
+export type RejectedImageReference = Message<"github.com.akuity.kargo.api.v1alpha1.RejectedImageReference"> & {
   /**
-   * Git describes a subscriptions to a Git repository.
+   * Tag is the tag of the image.
    *
-   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.GitSubscription git = 1;
+   * @generated from field: optional string tag = 1;
    */
-  git?: GitSubscription;
+  tag: string;
 
   /**
-   * Image describes a subscription to container image repository.
+   * Digest is the digest of the image.
+   *
+   * @generated from field: optional string digest = 2;
+   */
+  digest: string;
+
+  /**
+   * Reason is a human-readable explanation of why the image was rejected.
+   *
+   * @generated from field: optional string reason = 3;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.RejectedImageReference.
+ * Use `create(RejectedImageReferenceSchema)` to create a new message.
+ */
+export const RejectedImageReferenceSchema: GenMessage<RejectedImageReference> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 94);
+
+/**
+ * Release describes a specific release of a repository hosted by a Git forge.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.Release
+ */
+export type Release = Message<"github.com.akuity.kargo.api.v1alpha1.Release"> & {
+  /**
+   * RepoURL is the URL of the repository the release belongs to.
+   *
+   * @generated from field: optional string repoURL = 1;
+   */
+  repoURL: string;
+
+  /**
+   * Tag is the name of the tag the release was created from.
+   *
+   * @generated from field: optional string tag = 2;
+   */
+  tag: string;
+
+  /**
+   * Name is the name (title) of the release.
+   *
+   * @generated from field: optional string name = 3;
+   */
+  name: string;
+
+  /**
+   * URL is the URL of the release's web page.
+   *
+   * @generated from field: optional string url = 4;
+   */
+  url: string;
+
+  /**
+   * Assets is a list of the assets attached to the release.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ReleaseAsset assets = 5;
+   */
+  assets: ReleaseAsset[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.Release.
+ * Use `create(ReleaseSchema)` to create a new message.
+ */
+export const ReleaseSchema: GenMessage<Release> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 95);
+
+/**
+ * ReleaseAsset describes an asset attached to a release.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ReleaseAsset
+ */
+export type ReleaseAsset = Message<"github.com.akuity.kargo.api.v1alpha1.ReleaseAsset"> & {
+  /**
+   * Name is the name of the asset.
+   *
+   * @generated from field: optional string name = 1;
+   */
+  name: string;
+
+  /**
+   * URL is the URL from which the asset can be downloaded.
+   *
+   * @generated from field: optional string url = 2;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ReleaseAsset.
+ * Use `create(ReleaseAssetSchema)` to create a new message.
+ */
+export const ReleaseAssetSchema: GenMessage<ReleaseAsset> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 96);
+
+/**
+ * ReleaseDiscoveryResult represents the result of a release discovery
+ * operation for a ReleaseSubscription.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ReleaseDiscoveryResult
+ */
+export type ReleaseDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1.ReleaseDiscoveryResult"> & {
+  /**
+   * RepoURL is the repository URL of the ReleaseSubscription.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string repoURL = 1;
+   */
+  repoURL: string;
+
+  /**
+   * Releases is a list of releases discovered by the Warehouse for the
+   * ReleaseSubscription. An empty list indicates that the discovery operation
+   * was successful, but no releases matching the ReleaseSubscription criteria
+   * were found.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.DiscoveredRelease releases = 2;
+   */
+  releases: DiscoveredRelease[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ReleaseDiscoveryResult.
+ * Use `create(ReleaseDiscoveryResultSchema)` to create a new message.
+ */
+export const ReleaseDiscoveryResultSchema: GenMessage<ReleaseDiscoveryResult> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 97);
+
+/**
+ * ReleaseSubscription defines a subscription to the releases of a repository
+ * hosted by a Git forge such as GitHub, GitLab, or Gitea. Releases are
+ * discovered using the forge's API rather than by cloning the repository.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ReleaseSubscription
+ */
+export type ReleaseSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.ReleaseSubscription"> & {
+  /**
+   * RepoURL is the URL of the repository whose releases are subscribed to.
+   * This is a required field.
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:Pattern=`^https?://[\w.-]+(:\d{1,5})?/.+$`
+   *
+   * @generated from field: optional string repoURL = 1;
+   */
+  repoURL: string;
+
+  /**
+   * Provider is the name of the Git provider to use for discovering releases.
+   * Currently 'gitea', 'github', and 'gitlab' are supported. When left
+   * unspecified, the provider is inferred from the RepoURL.
+   *
+   * +kubebuilder:validation:Optional
+   * +kubebuilder:validation:Enum=gitea;github;gitlab
+   *
+   * @generated from field: optional string provider = 2;
+   */
+  provider: string;
+
+  /**
+   * StrictSemvers specifies whether only releases with "strict" semver tags
+   * should be considered. A "strict" semver tag is one containing ALL of
+   * major, minor, and patch version components. This is enabled by default.
+   *
+   * +kubebuilder:default=true
+   *
+   * @generated from field: optional bool strictSemvers = 3;
+   */
+  strictSemvers: boolean;
+
+  /**
+   * SemverConstraint specifies constraints on what releases are considered,
+   * based on their tags. Releases whose tags are not valid semantic versions
+   * are never considered. This field is optional. When left unspecified, there
+   * will be no constraints, which means the release with the semantically
+   * greatest tag will always be used.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string semverConstraint = 4;
+   */
+  semverConstraint: string;
+
+  /**
+   * ExcludePrereleases specifies whether releases that are marked as
+   * prereleases by the Git forge, or whose tags have a semver prerelease
+   * component, should be excluded from consideration.
+   *
+   * @generated from field: optional bool excludePrereleases = 5;
+   */
+  excludePrereleases: boolean;
+
+  /**
+   * InsecureSkipTLSVerify specifies whether certificate verification errors
+   * should be ignored when connecting to the Git forge's API. This should be
+   * enabled only with great caution.
+   *
+   * @generated from field: optional bool insecureSkipTLSVerify = 6;
+   */
+  insecureSkipTLSVerify: boolean;
+
+  /**
+   * DiscoveryLimit is an optional limit on the number of releases that can be
+   * discovered for this subscription. The limit is applied after filtering
+   * releases based on all other criteria. When left unspecified, the field is
+   * implicitly treated as if its value were "20". The upper limit for this
+   * field is 100.
+   *
+   * +kubebuilder:validation:Minimum=1
+   * +kubebuilder:validation:Maximum=100
+   * +kubebuilder:default=20
+   *
+   * @generated from field: optional int32 discoveryLimit = 7;
+   */
+  discoveryLimit: number;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ReleaseSubscription.
+ * Use `create(ReleaseSubscriptionSchema)` to create a new message.
+ */
+export const ReleaseSubscriptionSchema: GenMessage<ReleaseSubscription> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 98);
+
+/**
+ * RepoSubscription describes a subscription to ONE OF a Git repository, a
+ * container image repository, a Helm chart repository, a repository of
+ * arbitrary OCI artifacts, or the releases of a repository hosted by a Git
+ * forge.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.RepoSubscription
+ */
+export type RepoSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.RepoSubscription"> & {
+  /**
+   * Git describes a subscriptions to a Git repository.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.GitSubscription git = 1;
+   */
+  git?: GitSubscription;
+
+  /**
+   * Image describes a subscription to container image repository.
    *
    * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ImageSubscription image = 2;
    */
@@ -3616,6 +5092,21 @@ export type RepoSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Rep
    * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ChartSubscription chart = 3;
    */
   chart?: ChartSubscription;
+
+  /**
+   * OCI describes a subscription to a repository of arbitrary OCI artifacts.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.OCISubscription oci = 4;
+   */
+  oci?: OCISubscription;
+
+  /**
+   * Release describes a subscription to the releases of a repository hosted
+   * by a Git forge such as GitHub, GitLab, or Gitea.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ReleaseSubscription release = 5;
+   */
+  release?: ReleaseSubscription;
 };
 
 /**
@@ -3623,7 +5114,7 @@ export type RepoSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Rep
  * Use `create(RepoSubscriptionSchema)` to create a new message.
  */
 export const RepoSubscriptionSchema: GenMessage<RepoSubscription> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 78);
+  messageDesc(file_api_v1alpha1_generated, 99);
 
 /**
  * Stage is the Kargo API's main type.
@@ -3659,7 +5150,7 @@ export type Stage = Message<"github.com.akuity.kargo.api.v1alpha1.Stage"> & {
  * Use `create(StageSchema)` to create a new message.
  */
 export const StageSchema: GenMessage<Stage> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 79);
+  messageDesc(file_api_v1alpha1_generated, 100);
 
 /**
  * StageList is a list of Stage resources.
@@ -3683,7 +5174,7 @@ export type StageList = Message<"github.com.akuity.kargo.api.v1alpha1.StageList"
  * Use `create(StageListSchema)` to create a new message.
  */
 export const StageListSchema: GenMessage<StageList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 80);
+  messageDesc(file_api_v1alpha1_generated, 101);
 
 /**
  * StageSpec describes the sources of Freight used by a Stage and how to
@@ -3750,7 +5241,7 @@ export type StageSpec = Message<"github.com.akuity.kargo.api.v1alpha1.StageSpec"
  * Use `create(StageSpecSchema)` to create a new message.
  */
 export const StageSpecSchema: GenMessage<StageSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 81);
+  messageDesc(file_api_v1alpha1_generated, 102);
 
 /**
  * StageStats contains a summary of the collective state of a Project's
@@ -3779,7 +5270,7 @@ export type StageStats = Message<"github.com.akuity.kargo.api.v1alpha1.StageStat
  * Use `create(StageStatsSchema)` to create a new message.
  */
 export const StageStatsSchema: GenMessage<StageStats> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 82);
+  messageDesc(file_api_v1alpha1_generated, 103);
 
 /**
  * StageStatus describes a Stages's current and recent Freight, health, and
@@ -3787,216 +5278,559 @@ export const StageStatsSchema: GenMessage<StageStats> = /*@__PURE__*/
  *
  * @generated from message github.com.akuity.kargo.api.v1alpha1.StageStatus
  */
-export type StageStatus = Message<"github.com.akuity.kargo.api.v1alpha1.StageStatus"> & {
+export type StageStatus = Message<"github.com.akuity.kargo.api.v1alpha1.StageStatus"> & {
+  /**
+   * Conditions contains the last observations of the Stage's current
+   * state.
+   * +patchMergeKey=type
+   * +patchStrategy=merge
+   * +listType=map
+   * +listMapKey=type
+   *
+   * @generated from field: repeated k8s.io.apimachinery.pkg.apis.meta.v1.Condition conditions = 13;
+   */
+  conditions: Condition[];
+
+  /**
+   * LastHandledRefresh holds the value of the most recent AnnotationKeyRefresh
+   * annotation that was handled by the controller. This field can be used to
+   * determine whether the request to refresh the resource has been handled.
+   * +optional
+   *
+   * @generated from field: optional string lastHandledRefresh = 11;
+   */
+  lastHandledRefresh: string;
+
+  /**
+   * FreightHistory is a list of recent Freight selections that were deployed
+   * to the Stage. By default, the last ten Freight selections are stored.
+   * The first item in the list is the most recent Freight selection and
+   * currently deployed to the Stage, subsequent items are older selections.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.FreightCollection freightHistory = 4;
+   */
+  freightHistory: FreightCollection[];
+
+  /**
+   * FreightSummary is human-readable text maintained by the controller that
+   * summarizes what Freight is currently deployed to the Stage. For Stages that
+   * request a single piece of Freight AND the request has been fulfilled, this
+   * field will simply contain the name of the Freight. For Stages that request
+   * a single piece of Freight AND the request has NOT been fulfilled, or for
+   * Stages that request multiple pieces of Freight, this field will contain a
+   * summary of fulfilled/requested Freight. The existence of this field is a
+   * workaround for kubectl limitations so that this complex but valuable
+   * information can be displayed in a column in response to `kubectl get
+   * stages`.
+   *
+   * @generated from field: optional string freightSummary = 12;
+   */
+  freightSummary: string;
+
+  /**
+   * Health is the Stage's last observed health.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.Health health = 8;
+   */
+  health?: Health;
+
+  /**
+   * ObservedGeneration represents the .metadata.generation that this Stage
+   * status was reconciled against.
+   *
+   * @generated from field: optional int64 observedGeneration = 6;
+   */
+  observedGeneration: bigint;
+
+  /**
+   * CurrentPromotion is a reference to the currently Running promotion.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionReference currentPromotion = 7;
+   */
+  currentPromotion?: PromotionReference;
+
+  /**
+   * LastPromotion is a reference to the last completed promotion.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionReference lastPromotion = 10;
+   */
+  lastPromotion?: PromotionReference;
+
+  /**
+   * AutoPromotionEnabled indicates whether automatic promotion is enabled
+   * for the Stage based on the ProjectConfig.
+   *
+   * @generated from field: optional bool autoPromotionEnabled = 14;
+   */
+  autoPromotionEnabled: boolean;
+
+  /**
+   * Metadata is a map of arbitrary metadata associated with the Stage.
+   * This is useful for storing additional information about the Stage
+   * that can be shared across promotions, verifications, or other processes.
+   *
+   * @generated from field: map<string, k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSON> metadata = 15;
+   */
+  metadata: { [key: string]: JSON };
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.StageStatus.
+ * Use `create(StageStatusSchema)` to create a new message.
+ */
+export const StageStatusSchema: GenMessage<StageStatus> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 104);
+
+/**
+ * StepExecutionMetadata tracks metadata pertaining to the execution of
+ * a promotion step.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.StepExecutionMetadata
+ */
+export type StepExecutionMetadata = Message<"github.com.akuity.kargo.api.v1alpha1.StepExecutionMetadata"> & {
+  /**
+   * Alias is the alias of the step.
+   *
+   * @generated from field: optional string alias = 1;
+   */
+  alias: string;
+
+  /**
+   * StartedAt is the time at which the first attempt to execute the step
+   * began.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time startedAt = 2;
+   */
+  startedAt?: Time;
+
+  /**
+   * FinishedAt is the time at which the final attempt to execute the step
+   * completed.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time finishedAt = 3;
+   */
+  finishedAt?: Time;
+
+  /**
+   * ErrorCount tracks consecutive failed attempts to execute the step.
+   *
+   * @generated from field: optional uint32 errorCount = 4;
+   */
+  errorCount: number;
+
+  /**
+   * Status is the high-level outcome of the step.
+   *
+   * @generated from field: optional string status = 5;
+   */
+  status: string;
+
+  /**
+   * Message is a display message about the step, including any errors.
+   *
+   * @generated from field: optional string message = 6;
+   */
+  message: string;
+
+  /**
+   * ContinueOnError is a boolean value that, if set to true, will cause the
+   * Promotion to continue executing the next step even if this step fails. It
+   * also will not permit this failure to impact the overall status of the
+   * Promotion.
+   *
+   * @generated from field: optional bool continueOnError = 7;
+   */
+  continueOnError: boolean;
+
+  /**
+   * Block is the block of steps the step belongs to. It is empty for steps
+   * belonging to the main sequence of steps and either "onFailure" or
+   * "finally" otherwise.
+   *
+   * @generated from field: optional string block = 8;
+   */
+  block: string;
+
+  /**
+   * Attempts is the number of attempts made to execute the step.
+   *
+   * @generated from field: optional uint32 attempts = 9;
+   */
+  attempts: number;
+
+  /**
+   * NextAttemptAt is the time before which no further attempt to execute the
+   * step will be made, as determined by the backoff policy of the step.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time nextAttemptAt = 10;
+   */
+  nextAttemptAt?: Time;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.StepExecutionMetadata.
+ * Use `create(StepExecutionMetadataSchema)` to create a new message.
+ */
+export const StepExecutionMetadataSchema: GenMessage<StepExecutionMetadata> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 105);
+
+/**
+ * Verification describes how to verify that a Promotion has been successful
+ * using either Argo Rollouts AnalysisTemplates or checks performed natively by
+ * Kargo.
+ *
+ * +kubebuilder:validation:XValidation:message="analysisTemplates and checks are mutually exclusive",rule="!has(self.analysisTemplates) || !has(self.checks)"
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.Verification
+ */
+export type Verification = Message<"github.com.akuity.kargo.api.v1alpha1.Verification"> & {
+  /**
+   * AnalysisTemplates is a list of AnalysisTemplates from which AnalysisRuns
+   * should be created to verify a Stage's current Freight is fit to be promoted
+   * downstream.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.AnalysisTemplateReference analysisTemplates = 1;
+   */
+  analysisTemplates: AnalysisTemplateReference[];
+
+  /**
+   * AnalysisRunMetadata contains optional metadata that should be applied to
+   * all AnalysisRuns.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.AnalysisRunMetadata analysisRunMetadata = 2;
+   */
+  analysisRunMetadata?: AnalysisRunMetadata;
+
+  /**
+   * Args lists arguments that should be added to all AnalysisRuns.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.AnalysisRunArgument args = 3;
+   */
+  args: AnalysisRunArgument[];
+
+  /**
+   * Checks is a list of checks Kargo should perform natively (i.e. without
+   * Argo Rollouts) to verify a Stage's current Freight is fit to be promoted
+   * downstream. Verification succeeds when all checks have succeeded and
+   * fails as soon as any one check has failed. This field is mutually
+   * exclusive with AnalysisTemplates.
+   *
+   * +kubebuilder:validation:items:XValidation:message="check must have exactly one of job, http, or prometheus set",rule="[has(self.job), has(self.http), has(self.prometheus)].filter(x, x).size() == 1"
+   * +listType=map
+   * +listMapKey=name
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.VerificationCheck checks = 4;
+   */
+  checks: VerificationCheck[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.Verification.
+ * Use `create(VerificationSchema)` to create a new message.
+ */
+export const VerificationSchema: GenMessage<Verification> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 106);
+
+/**
+ * VerificationCheck describes a check Kargo performs natively as part of a
+ * Verification. A check consists of one or more measurements, each of which is
+ * made by running a Job, sending an HTTP request, or querying a
+ * Prometheus-compatible endpoint.
+ *
+ * String fields may contain expressions enclosed by ${{ and }}. These are
+ * evaluated prior to each measurement, using the same functions and variables
+ * that are available to the args of a Verification. The successExpression and
+ * failureExpression fields are themselves expressions, evaluated after each
+ * measurement, and must therefore not be enclosed by ${{ and }}.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.VerificationCheck
+ */
+export type VerificationCheck = Message<"github.com.akuity.kargo.api.v1alpha1.VerificationCheck"> & {
+  /**
+   * Name is the name of the check. It must be unique within the Verification.
+   *
+   * +kubebuilder:validation:Required
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:MaxLength=32
+   * +kubebuilder:validation:Pattern=^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+   *
+   * @generated from field: optional string name = 1;
+   */
+  name: string;
+
+  /**
+   * Count is the number of measurements to make. If this field is set to 0,
+   * the effective default will be 1.
+   *
+   * +kubebuilder:validation:Minimum=0
+   *
+   * @generated from field: optional int32 count = 2;
+   */
+  count: number;
+
+  /**
+   * Interval is the interval between the start of consecutive measurements.
+   * If this field is set to nil, the effective default will be 1m.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+   * +akuity:test-kubebuilder-pattern=Duration
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration interval = 3;
+   */
+  interval?: Duration;
+
+  /**
+   * FailureLimit is the number of measurements that may fail (or error)
+   * before the check is considered to have failed (or errored). The default
+   * of 0 causes the check to fail after a single failed measurement.
+   *
+   * +kubebuilder:validation:Minimum=0
+   *
+   * @generated from field: optional int32 failureLimit = 4;
+   */
+  failureLimit: number;
+
+  /**
+   * Job describes a Kubernetes Job to run for each measurement. The
+   * measurement succeeds if the Job completes successfully and fails if the
+   * Job fails.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.VerificationJobCheck job = 5;
+   */
+  job?: VerificationJobCheck;
+
+  /**
+   * HTTP describes an HTTP request to send for each measurement.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.VerificationHTTPCheck http = 6;
+   */
+  http?: VerificationHTTPCheck;
+
+  /**
+   * Prometheus describes a query to run against a Prometheus-compatible
+   * endpoint for each measurement.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.VerificationPrometheusCheck prometheus = 7;
+   */
+  prometheus?: VerificationPrometheusCheck;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.VerificationCheck.
+ * Use `create(VerificationCheckSchema)` to create a new message.
+ */
+export const VerificationCheckSchema: GenMessage<VerificationCheck> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 107);
+
+/**
+ * VerificationCheckResult contains the result of a single VerificationCheck.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.VerificationCheckResult
+ */
+export type VerificationCheckResult = Message<"github.com.akuity.kargo.api.v1alpha1.VerificationCheckResult"> & {
   /**
-   * Conditions contains the last observations of the Stage's current
-   * state.
-   * +patchMergeKey=type
-   * +patchStrategy=merge
-   * +listType=map
-   * +listMapKey=type
+   * Name is the name of the VerificationCheck.
    *
-   * @generated from field: repeated k8s.io.apimachinery.pkg.apis.meta.v1.Condition conditions = 13;
+   * @generated from field: optional string name = 1;
    */
-  conditions: Condition[];
+  name: string;
 
   /**
-   * LastHandledRefresh holds the value of the most recent AnnotationKeyRefresh
-   * annotation that was handled by the controller. This field can be used to
-   * determine whether the request to refresh the resource has been handled.
-   * +optional
+   * Phase is the current phase of the check.
    *
-   * @generated from field: optional string lastHandledRefresh = 11;
+   * @generated from field: optional string phase = 2;
    */
-  lastHandledRefresh: string;
+  phase: string;
 
   /**
-   * FreightHistory is a list of recent Freight selections that were deployed
-   * to the Stage. By default, the last ten Freight selections are stored.
-   * The first item in the list is the most recent Freight selection and
-   * currently deployed to the Stage, subsequent items are older selections.
+   * Message may contain additional information about the outcome of the
+   * most recent measurement.
    *
-   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.FreightCollection freightHistory = 4;
+   * @generated from field: optional string message = 3;
    */
-  freightHistory: FreightCollection[];
+  message: string;
 
   /**
-   * FreightSummary is human-readable text maintained by the controller that
-   * summarizes what Freight is currently deployed to the Stage. For Stages that
-   * request a single piece of Freight AND the request has been fulfilled, this
-   * field will simply contain the name of the Freight. For Stages that request
-   * a single piece of Freight AND the request has NOT been fulfilled, or for
-   * Stages that request multiple pieces of Freight, this field will contain a
-   * summary of fulfilled/requested Freight. The existence of this field is a
-   * workaround for kubectl limitations so that this complex but valuable
-   * information can be displayed in a column in response to `kubectl get
-   * stages`.
+   * Count is the number of measurements made so far.
    *
-   * @generated from field: optional string freightSummary = 12;
+   * @generated from field: optional int32 count = 4;
    */
-  freightSummary: string;
+  count: number;
 
   /**
-   * Health is the Stage's last observed health.
+   * Successful is the number of successful measurements.
    *
-   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.Health health = 8;
+   * @generated from field: optional int32 successful = 5;
    */
-  health?: Health;
+  successful: number;
 
   /**
-   * ObservedGeneration represents the .metadata.generation that this Stage
-   * status was reconciled against.
+   * Failed is the number of failed measurements.
    *
-   * @generated from field: optional int64 observedGeneration = 6;
+   * @generated from field: optional int32 failed = 6;
    */
-  observedGeneration: bigint;
+  failed: number;
 
   /**
-   * CurrentPromotion is a reference to the currently Running promotion.
+   * Inconclusive is the number of inconclusive measurements.
    *
-   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionReference currentPromotion = 7;
+   * @generated from field: optional int32 inconclusive = 7;
    */
-  currentPromotion?: PromotionReference;
+  inconclusive: number;
 
   /**
-   * LastPromotion is a reference to the last completed promotion.
+   * Error is the number of measurements that could not be made or evaluated.
    *
-   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionReference lastPromotion = 10;
+   * @generated from field: optional int32 error = 8;
    */
-  lastPromotion?: PromotionReference;
+  error: number;
 
   /**
-   * AutoPromotionEnabled indicates whether automatic promotion is enabled
-   * for the Stage based on the ProjectConfig.
+   * LastMeasurementTime is the time at which the most recent measurement was
+   * started.
    *
-   * @generated from field: optional bool autoPromotionEnabled = 14;
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time lastMeasurementTime = 9;
    */
-  autoPromotionEnabled: boolean;
+  lastMeasurementTime?: Time;
 
   /**
-   * Metadata is a map of arbitrary metadata associated with the Stage.
-   * This is useful for storing additional information about the Stage
-   * that can be shared across promotions, verifications, or other processes.
+   * Job is the name of the Job implementing a measurement that is still in
+   * progress, if any.
    *
-   * @generated from field: map<string, k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSON> metadata = 15;
+   * @generated from field: optional string job = 10;
    */
-  metadata: { [key: string]: JSON };
+  job: string;
 };
 
 /**
- * Describes the message github.com.akuity.kargo.api.v1alpha1.StageStatus.
- * Use `create(StageStatusSchema)` to create a new message.
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.VerificationCheckResult.
+ * Use `create(VerificationCheckResultSchema)` to create a new message.
  */
-export const StageStatusSchema: GenMessage<StageStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 83);
+export const VerificationCheckResultSchema: GenMessage<VerificationCheckResult> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 108);
 
 /**
- * StepExecutionMetadata tracks metadata pertaining to the execution of
- * a promotion step.
+ * VerificationHTTPCheck describes an HTTP request that is sent to make a
+ * measurement for a VerificationCheck.
  *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.StepExecutionMetadata
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.VerificationHTTPCheck
  */
-export type StepExecutionMetadata = Message<"github.com.akuity.kargo.api.v1alpha1.StepExecutionMetadata"> & {
+export type VerificationHTTPCheck = Message<"github.com.akuity.kargo.api.v1alpha1.VerificationHTTPCheck"> & {
   /**
-   * Alias is the alias of the step.
+   * URL is the URL to send the request to.
    *
-   * @generated from field: optional string alias = 1;
+   * +kubebuilder:validation:Required
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string url = 1;
    */
-  alias: string;
+  url: string;
 
   /**
-   * StartedAt is the time at which the first attempt to execute the step
-   * began.
+   * Method is the HTTP method of the request. If this field is left empty,
+   * the effective default will be GET.
    *
-   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time startedAt = 2;
+   * +kubebuilder:validation:Enum=GET;HEAD;POST;PUT;PATCH
+   *
+   * @generated from field: optional string method = 2;
    */
-  startedAt?: Time;
+  method: string;
 
   /**
-   * FinishedAt is the time at which the final attempt to execute the step
-   * completed.
+   * Headers are headers to include in the request.
    *
-   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time finishedAt = 3;
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.VerificationHTTPHeader headers = 3;
    */
-  finishedAt?: Time;
+  headers: VerificationHTTPHeader[];
 
   /**
-   * ErrorCount tracks consecutive failed attempts to execute the step.
+   * Body is the body of the request.
    *
-   * @generated from field: optional uint32 errorCount = 4;
+   * @generated from field: optional string body = 4;
    */
-  errorCount: number;
+  body: string;
 
   /**
-   * Status is the high-level outcome of the step.
+   * InsecureSkipTLSVerify indicates whether to skip verification of the
+   * server's TLS certificate.
    *
-   * @generated from field: optional string status = 5;
+   * @generated from field: optional bool insecureSkipTLSVerify = 5;
    */
-  status: string;
+  insecureSkipTLSVerify: boolean;
 
   /**
-   * Message is a display message about the step, including any errors.
+   * Timeout is the maximum duration of the request. If this field is set to
+   * nil, the effective default will be 10s.
    *
-   * @generated from field: optional string message = 6;
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+   * +akuity:test-kubebuilder-pattern=Duration
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration timeout = 6;
    */
-  message: string;
+  timeout?: Duration;
 
   /**
-   * ContinueOnError is a boolean value that, if set to true, will cause the
-   * Promotion to continue executing the next step even if this step fails. It
-   * also will not permit this failure to impact the overall status of the
-   * Promotion.
+   * SuccessExpression is an expression that, if it evaluates to true,
+   * indicates the measurement was successful. The expression is evaluated
+   * against the response, using the same conventions as the http promotion
+   * step. If both this field and FailureExpression are left empty, any 2xx
+   * response is considered successful.
    *
-   * @generated from field: optional bool continueOnError = 7;
+   * @generated from field: optional string successExpression = 7;
    */
-  continueOnError: boolean;
+  successExpression: string;
+
+  /**
+   * FailureExpression is an expression that, if it evaluates to true,
+   * indicates the measurement failed.
+   *
+   * @generated from field: optional string failureExpression = 8;
+   */
+  failureExpression: string;
 };
 
 /**
- * Describes the message github.com.akuity.kargo.api.v1alpha1.StepExecutionMetadata.
- * Use `create(StepExecutionMetadataSchema)` to create a new message.
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.VerificationHTTPCheck.
+ * Use `create(VerificationHTTPCheckSchema)` to create a new message.
  */
-export const StepExecutionMetadataSchema: GenMessage<StepExecutionMetadata> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 84);
+export const VerificationHTTPCheckSchema: GenMessage<VerificationHTTPCheck> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 109);
 
 /**
- * Verification describes how to verify that a Promotion has been successful
- * using Argo Rollouts AnalysisTemplates.
+ * VerificationHTTPHeader is a header to include in an HTTP request made by a
+ * VerificationCheck.
  *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.Verification
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.VerificationHTTPHeader
  */
-export type Verification = Message<"github.com.akuity.kargo.api.v1alpha1.Verification"> & {
+export type VerificationHTTPHeader = Message<"github.com.akuity.kargo.api.v1alpha1.VerificationHTTPHeader"> & {
   /**
-   * AnalysisTemplates is a list of AnalysisTemplates from which AnalysisRuns
-   * should be created to verify a Stage's current Freight is fit to be promoted
-   * downstream.
+   * Name is the name of the header.
    *
-   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.AnalysisTemplateReference analysisTemplates = 1;
-   */
-  analysisTemplates: AnalysisTemplateReference[];
-
-  /**
-   * AnalysisRunMetadata contains optional metadata that should be applied to
-   * all AnalysisRuns.
+   * +kubebuilder:validation:Required
+   * +kubebuilder:validation:MinLength=1
    *
-   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.AnalysisRunMetadata analysisRunMetadata = 2;
+   * @generated from field: optional string name = 1;
    */
-  analysisRunMetadata?: AnalysisRunMetadata;
+  name: string;
 
   /**
-   * Args lists arguments that should be added to all AnalysisRuns.
+   * Value is the value of the header. An expression such as
+   * ${{ secret('my-secret').token }} may be used to reference a value stored
+   * in a Secret.
    *
-   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.AnalysisRunArgument args = 3;
+   * @generated from field: optional string value = 2;
    */
-  args: AnalysisRunArgument[];
+  value: string;
 };
 
 /**
- * Describes the message github.com.akuity.kargo.api.v1alpha1.Verification.
- * Use `create(VerificationSchema)` to create a new message.
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.VerificationHTTPHeader.
+ * Use `create(VerificationHTTPHeaderSchema)` to create a new message.
  */
-export const VerificationSchema: GenMessage<Verification> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 85);
+export const VerificationHTTPHeaderSchema: GenMessage<VerificationHTTPHeader> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 110);
 
 /**
  * VerificationInfo contains the details of an instance of a Verification
@@ -4059,6 +5893,14 @@ export type VerificationInfo = Message<"github.com.akuity.kargo.api.v1alpha1.Ver
    * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time finishTime = 6;
    */
   finishTime?: Time;
+
+  /**
+   * Checks contains the results of the checks performed natively by Kargo
+   * to implement the Verification process.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.VerificationCheckResult checks = 8;
+   */
+  checks: VerificationCheckResult[];
 };
 
 /**
@@ -4066,7 +5908,134 @@ export type VerificationInfo = Message<"github.com.akuity.kargo.api.v1alpha1.Ver
  * Use `create(VerificationInfoSchema)` to create a new message.
  */
 export const VerificationInfoSchema: GenMessage<VerificationInfo> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 86);
+  messageDesc(file_api_v1alpha1_generated, 111);
+
+/**
+ * VerificationJobCheck describes a Kubernetes Job that is run to make a
+ * measurement for a VerificationCheck.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.VerificationJobCheck
+ */
+export type VerificationJobCheck = Message<"github.com.akuity.kargo.api.v1alpha1.VerificationJobCheck"> & {
+  /**
+   * Labels are additional labels to apply to each Job.
+   *
+   * @generated from field: map<string, string> labels = 1;
+   */
+  labels: { [key: string]: string };
+
+  /**
+   * Annotations are additional annotations to apply to each Job.
+   *
+   * @generated from field: map<string, string> annotations = 2;
+   */
+  annotations: { [key: string]: string };
+
+  /**
+   * Spec is the specification of the Job. The Job is created in the
+   * namespace of the Stage.
+   *
+   * +kubebuilder:validation:Required
+   * +kubebuilder:validation:Schemaless
+   * +kubebuilder:validation:Type=object
+   * +kubebuilder:pruning:PreserveUnknownFields
+   *
+   * @generated from field: optional k8s.io.api.batch.v1.JobSpec spec = 3;
+   */
+  spec?: JobSpec;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.VerificationJobCheck.
+ * Use `create(VerificationJobCheckSchema)` to create a new message.
+ */
+export const VerificationJobCheckSchema: GenMessage<VerificationJobCheck> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 112);
+
+/**
+ * VerificationPrometheusCheck describes a query that is run against a
+ * Prometheus-compatible endpoint to make a measurement for a VerificationCheck.
+ *
+ * +kubebuilder:validation:XValidation:message="at least one of successExpression or failureExpression must be set",rule="has(self.successExpression) || has(self.failureExpression)"
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.VerificationPrometheusCheck
+ */
+export type VerificationPrometheusCheck = Message<"github.com.akuity.kargo.api.v1alpha1.VerificationPrometheusCheck"> & {
+  /**
+   * Address is the base URL of the Prometheus-compatible API. e.g.
+   * http://prometheus.monitoring.svc:9090
+   *
+   * +kubebuilder:validation:Required
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string address = 1;
+   */
+  address: string;
+
+  /**
+   * Query is the PromQL query to run. The query must yield a scalar or an
+   * instant vector.
+   *
+   * +kubebuilder:validation:Required
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string query = 2;
+   */
+  query: string;
+
+  /**
+   * Headers are headers to include in requests to the API. e.g. For
+   * authentication.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.VerificationHTTPHeader headers = 3;
+   */
+  headers: VerificationHTTPHeader[];
+
+  /**
+   * InsecureSkipTLSVerify indicates whether to skip verification of the
+   * server's TLS certificate.
+   *
+   * @generated from field: optional bool insecureSkipTLSVerify = 4;
+   */
+  insecureSkipTLSVerify: boolean;
+
+  /**
+   * Timeout is the maximum duration of the query. If this field is set to
+   * nil, the effective default will be 10s.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
+   * +akuity:test-kubebuilder-pattern=Duration
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration timeout = 5;
+   */
+  timeout?: Duration;
+
+  /**
+   * SuccessExpression is an expression that, if it evaluates to true,
+   * indicates the measurement was successful. The result of the query is
+   * available to the expression as `result`. For a scalar, this is a number.
+   * For an instant vector, this is a list of numbers.
+   *
+   * @generated from field: optional string successExpression = 6;
+   */
+  successExpression: string;
+
+  /**
+   * FailureExpression is an expression that, if it evaluates to true,
+   * indicates the measurement failed.
+   *
+   * @generated from field: optional string failureExpression = 7;
+   */
+  failureExpression: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.VerificationPrometheusCheck.
+ * Use `create(VerificationPrometheusCheckSchema)` to create a new message.
+ */
+export const VerificationPrometheusCheckSchema: GenMessage<VerificationPrometheusCheck> = /*@__PURE__*/
+  messageDesc(file_api_v1alpha1_generated, 113);
 
 /**
  * VerifiedStage describes a Stage in which Freight has been verified.
@@ -4098,7 +6067,7 @@ export type VerifiedStage = Message<"github.com.akuity.kargo.api.v1alpha1.Verifi
  * Use `create(VerifiedStageSchema)` to create a new message.
  */
 export const VerifiedStageSchema: GenMessage<VerifiedStage> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 87);
+  messageDesc(file_api_v1alpha1_generated, 114);
 
 /**
  * Warehouse is a source of Freight.
@@ -4133,7 +6102,7 @@ export type Warehouse = Message<"github.com.akuity.kargo.api.v1alpha1.Warehouse"
  * Use `create(WarehouseSchema)` to create a new message.
  */
 export const WarehouseSchema: GenMessage<Warehouse> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 88);
+  messageDesc(file_api_v1alpha1_generated, 115);
 
 /**
  * WarehouseList is a list of Warehouse resources.
@@ -4157,7 +6126,7 @@ export type WarehouseList = Message<"github.com.akuity.kargo.api.v1alpha1.Wareho
  * Use `create(WarehouseListSchema)` to create a new message.
  */
 export const WarehouseListSchema: GenMessage<WarehouseList> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 89);
+  messageDesc(file_api_v1alpha1_generated, 116);
 
 /**
  * WarehouseSpec describes sources of versioned artifacts to be included in
@@ -4184,7 +6153,8 @@ export type WarehouseSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Wareho
    * Interval is the reconciliation interval for this Warehouse. On each
    * reconciliation, the Warehouse will discover new artifacts and optionally
    * produce new Freight. This field is optional. When left unspecified, the
-   * field is implicitly treated as if its value were "5m0s".
+   * field is implicitly treated as if its value were "5m0s". This field has
+   * no effect when the Schedule field is specified.
    *
    * +kubebuilder:validation:Type=string
    * +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(s|m|h))+$`
@@ -4233,6 +6203,58 @@ export type WarehouseSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Wareho
    * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.FreightCreationCriteria freightCreationCriteria = 5;
    */
   freightCreationCriteria?: FreightCreationCriteria;
+
+  /**
+   * Schedule is an optional cron expression (e.g. "0 2 * * 1-5") specifying
+   * when the Warehouse should periodically discover new artifacts. When
+   * specified, it takes the place of the Interval field for that purpose. A
+   * Warehouse will still discover artifacts promptly when first created and
+   * when its spec is modified. Descriptors such as "@daily" are also
+   * supported.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string schedule = 6;
+   */
+  schedule: string;
+
+  /**
+   * TimeZone is the name of the time zone (e.g. "America/New_York") in which
+   * the Schedule is evaluated. This field is optional. When left unspecified,
+   * the Schedule is evaluated in UTC.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string timeZone = 7;
+   */
+  timeZone: string;
+
+  /**
+   * BlackoutWindows are periods of time during which the Warehouse will
+   * neither discover artifacts nor produce Freight, regardless of its Interval
+   * or Schedule. Discovery that would otherwise have occurred during a
+   * blackout window is deferred until the window ends or, for a Warehouse
+   * with a Schedule, until the first scheduled time thereafter.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.BlackoutWindow blackoutWindows = 8;
+   */
+  blackoutWindows: BlackoutWindow[];
+
+  /**
+   * RefreshesBypassSchedule specifies whether a request to refresh the
+   * Warehouse (e.g. one issued in response to a webhook from an artifact
+   * repository) should result in immediate artifact discovery even when the
+   * Warehouse's Schedule does not call for it or a blackout window is in
+   * effect. By default, such requests are honored only at the next time
+   * discovery is permitted.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional bool refreshesBypassSchedule = 9;
+   */
+  refreshesBypassSchedule: boolean;
 };
 
 /**
@@ -4240,7 +6262,7 @@ export type WarehouseSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Wareho
  * Use `create(WarehouseSpecSchema)` to create a new message.
  */
 export const WarehouseSpecSchema: GenMessage<WarehouseSpec> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 90);
+  messageDesc(file_api_v1alpha1_generated, 117);
 
 /**
  * WarehouseStats contains a summary of the collective state of a Project's
@@ -4270,7 +6292,7 @@ export type WarehouseStats = Message<"github.com.akuity.kargo.api.v1alpha1.Wareh
  * Use `create(WarehouseStatsSchema)` to create a new message.
  */
 export const WarehouseStatsSchema: GenMessage<WarehouseStats> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 91);
+  messageDesc(file_api_v1alpha1_generated, 118);
 
 /**
  * WarehouseStatus describes a Warehouse's most recently observed state.
@@ -4322,6 +6344,17 @@ export type WarehouseStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Ware
    * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.DiscoveredArtifacts discoveredArtifacts = 7;
    */
   discoveredArtifacts?: DiscoveredArtifacts;
+
+  /**
+   * NextDiscoveryTime is the next time at which the Warehouse is scheduled to
+   * discover artifacts, taking blackout windows into account. It is only set
+   * for Warehouses with a Schedule.
+   *
+   * +optional
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time nextDiscoveryTime = 10;
+   */
+  nextDiscoveryTime?: Time;
 };
 
 /**
@@ -4329,7 +6362,7 @@ export type WarehouseStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Ware
  * Use `create(WarehouseStatusSchema)` to create a new message.
  */
 export const WarehouseStatusSchema: GenMessage<WarehouseStatus> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 92);
+  messageDesc(file_api_v1alpha1_generated, 119);
 
 /**
  * WebhookReceiverConfig describes the configuration for a single webhook
@@ -4436,7 +6469,7 @@ export type WebhookReceiverConfig = Message<"github.com.akuity.kargo.api.v1alpha
  * Use `create(WebhookReceiverConfigSchema)` to create a new message.
  */
 export const WebhookReceiverConfigSchema: GenMessage<WebhookReceiverConfig> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 93);
+  messageDesc(file_api_v1alpha1_generated, 120);
 
 /**
  * WebhookReceiverDetails encapsulates the details of a webhook receiver.
@@ -4471,5 +6504,5 @@ export type WebhookReceiverDetails = Message<"github.com.akuity.kargo.api.v1alph
  * Use `create(WebhookReceiverDetailsSchema)` to create a new message.
  */
 export const WebhookReceiverDetailsSchema: GenMessage<WebhookReceiverDetails> = /*@__PURE__*/
-  messageDesc(file_api_v1alpha1_generated, 94);
+  messageDesc(file_api_v1alpha1_generated, 121);
 